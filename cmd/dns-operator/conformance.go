@@ -0,0 +1,64 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	"github.com/openshift/cluster-dns-operator/pkg/conformance"
+	operatorclient "github.com/openshift/cluster-dns-operator/pkg/operator/client"
+
+	"sigs.k8s.io/controller-runtime/pkg/client/config"
+)
+
+// runConformance checks that the cluster DNS of the cluster of the kubeconfig
+// serves the records that the Kubernetes DNS specification requires, writes
+// the results as a JUnit report to the file named by the given command-line
+// arguments, or to standard output, and returns an error if any check fails.
+func runConformance(args []string) error {
+	fs := flag.NewFlagSet("conformance", flag.ContinueOnError)
+	opts := conformance.Options{}
+	fs.StringVar(&opts.Nameserver, "nameserver", "", "address of the DNS service to query (default the cluster IP of the default dns)")
+	fs.StringVar(&opts.ClusterDomain, "cluster-domain", "", "cluster domain (default the cluster domain of the default dns)")
+	fs.DurationVar(&opts.Timeout, "timeout", 30*time.Second, "how long to retry each check before it fails")
+	junitPath := fs.String("junit", "", "file to which to write the JUnit report (default standard output)")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if fs.NArg() != 0 {
+		return fmt.Errorf("unexpected arguments: %v", fs.Args())
+	}
+
+	kubeConfig, err := config.GetConfig()
+	if err != nil {
+		return fmt.Errorf("failed to get kube config: %v", err)
+	}
+	kubeClient, err := operatorclient.NewClient(kubeConfig)
+	if err != nil {
+		return fmt.Errorf("failed to create kube client: %v", err)
+	}
+	suite, err := conformance.Run(context.Background(), kubeClient, opts)
+	if err != nil {
+		return err
+	}
+
+	var w io.Writer = os.Stdout
+	if len(*junitPath) != 0 {
+		f, err := os.Create(*junitPath)
+		if err != nil {
+			return fmt.Errorf("failed to create %s: %v", *junitPath, err)
+		}
+		defer f.Close()
+		w = f
+	}
+	if err := suite.WriteJUnit(w); err != nil {
+		return fmt.Errorf("failed to write JUnit report: %v", err)
+	}
+	if failures := suite.Failures(); failures != 0 {
+		return fmt.Errorf("%d of %d checks failed", failures, len(suite.Results))
+	}
+	return nil
+}
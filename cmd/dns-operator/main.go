@@ -2,6 +2,7 @@ package main
 
 import (
 	"os"
+	"strings"
 
 	"github.com/openshift/cluster-dns-operator/pkg/operator"
 	operatorconfig "github.com/openshift/cluster-dns-operator/pkg/operator/config"
@@ -15,33 +16,39 @@ import (
 )
 
 func main() {
+	// The render command writes the bootstrap manifests for installers
+	// and exits without starting the operator.
+	if len(os.Args) > 1 && os.Args[1] == "render" {
+		if err := render(os.Args[2:]); err != nil {
+			logrus.Fatalf("failed to render: %v", err)
+		}
+		return
+	}
+	// The conformance command checks the cluster DNS of a live cluster
+	// against the Kubernetes DNS specification and exits.
+	if len(os.Args) > 1 && os.Args[1] == "conformance" {
+		if err := runConformance(os.Args[2:]); err != nil {
+			logrus.Fatalf("conformance failed: %v", err)
+		}
+		return
+	}
+
 	metrics.DefaultBindAddress = ":60000"
 
 	// Collect operator configuration.
-	releaseVersion := os.Getenv("RELEASE_VERSION")
-	if len(releaseVersion) == 0 {
-		releaseVersion = controller.UnknownVersionValue
-		logrus.Infof("RELEASE_VERSION environment variable is missing, defaulting to %q", controller.UnknownVersionValue)
-	}
-	coreDNSImage := os.Getenv("IMAGE")
-	if len(coreDNSImage) == 0 {
-		logrus.Fatalf("IMAGE environment variable is required")
+	operatorConfig, err := operatorconfig.LoadFromEnvironment()
+	if err != nil {
+		logrus.Fatalf("invalid operator configuration: %v", err)
 	}
-	cliImage := os.Getenv("OPENSHIFT_CLI_IMAGE")
-	if len(cliImage) == 0 {
-		logrus.Fatalf("OPENSHIFT_CLI_IMAGE environment variable is required")
+	if len(operatorConfig.OperatorReleaseVersion) == 0 {
+		operatorConfig.OperatorReleaseVersion = controller.UnknownVersionValue
+		logrus.Infof("operator release version is not set, defaulting to %q", controller.UnknownVersionValue)
 	}
-
-	kubeRBACProxyImage := os.Getenv("KUBE_RBAC_PROXY_IMAGE")
-	if len(kubeRBACProxyImage) == 0 {
-		logrus.Fatalf("KUBE_RBAC_PROXY_IMAGE environment variable is required")
+	if len(operatorConfig.CoreDNSVersion) == 0 {
+		logrus.Warningf("CoreDNS version is not set, rendering the Corefile for CoreDNS %s", controller.MinimumCoreDNSVersion)
 	}
-
-	operatorConfig := operatorconfig.Config{
-		OperatorReleaseVersion: releaseVersion,
-		CoreDNSImage:           coreDNSImage,
-		OpenshiftCLIImage:      cliImage,
-		KubeRBACProxyImage:     kubeRBACProxyImage,
+	if images := operatorConfig.TagReferencedImages(); len(images) != 0 {
+		logrus.Warningf("images are not referenced by digest and will not be pulled from image mirrors: %s", strings.Join(images, ", "))
 	}
 
 	kubeConfig, err := config.GetConfig()
@@ -49,7 +56,7 @@ func main() {
 		logrus.Fatalf("failed to get kube config %v", err)
 	}
 	// Set up and start the operator.
-	op, err := operator.New(operatorConfig, kubeConfig)
+	op, err := operator.New(*operatorConfig, kubeConfig)
 	if err != nil {
 		logrus.Fatalf("failed to create operator: %v", err)
 	}
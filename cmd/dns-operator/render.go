@@ -0,0 +1,95 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"github.com/openshift/cluster-dns-operator/pkg/manifests/bootstrap"
+	operatorcontroller "github.com/openshift/cluster-dns-operator/pkg/operator/controller"
+)
+
+// render writes the bootstrap manifests, the bootstrap dns static pod, and its
+// Corefile to the directories named by the given command-line arguments.  With
+// --render-feature-gates, it instead writes the manifests that the operator
+// deploys for every combination of the optional cluster capabilities, one
+// directory per combination, so that CI can compare them across changes.
+func render(args []string) error {
+	fs := flag.NewFlagSet("render", flag.ContinueOnError)
+	opts := bootstrap.Options{}
+	fs.StringVar(&opts.CoreDNSImage, "coredns-image", "", "CoreDNS image")
+	fs.StringVar(&opts.CoreDNSVersion, "coredns-version", "", "version of CoreDNS in the CoreDNS image")
+	fs.StringVar(&opts.OpenshiftCLIImage, "openshift-cli-image", "", "openshift client image")
+	fs.StringVar(&opts.KubeRBACProxyImage, "kube-rbac-proxy-image", "", "kube-rbac-proxy image")
+	fs.StringVar(&opts.ClusterIP, "cluster-ip", "", "cluster IP of the dns service")
+	fs.StringVar(&opts.ClusterDomain, "cluster-domain", "cluster.local", "cluster domain")
+	fs.StringVar(&opts.Kubeconfig, "kubeconfig", bootstrap.DefaultKubeconfig, "path of the kubeconfig on the bootstrap node")
+	manifestDir := fs.String("manifest-output-dir", "", "directory to which to write the bootstrap manifests")
+	staticPodDir := fs.String("static-pod-output-dir", filepath.Dir(operatorcontroller.BootstrapDNSManifest), "directory to which to write the bootstrap dns static pod manifest")
+	configDir := fs.String("config-output-dir", operatorcontroller.BootstrapDNSDirectory, "directory to which to write the Corefile of the bootstrap dns static pod")
+	renderFeatureGates := fs.Bool("render-feature-gates", false, "write the manifests that the operator deploys for every combination of optional cluster capabilities to subdirectories of --manifest-output-dir instead of the bootstrap manifests")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if fs.NArg() != 0 {
+		return fmt.Errorf("unexpected arguments: %v", fs.Args())
+	}
+	if len(*manifestDir) == 0 {
+		return fmt.Errorf("--manifest-output-dir is required")
+	}
+	if *renderFeatureGates {
+		return renderProfiles(opts, *manifestDir)
+	}
+
+	manifests, err := bootstrap.Render(opts)
+	if err != nil {
+		return fmt.Errorf("failed to render bootstrap manifests: %v", err)
+	}
+	pod, err := bootstrap.RenderStaticPod(opts)
+	if err != nil {
+		return fmt.Errorf("failed to render bootstrap dns static pod: %v", err)
+	}
+	for _, m := range manifests {
+		if err := writeFile(*manifestDir, m.Filename, m.Data); err != nil {
+			return err
+		}
+	}
+	// Write the Corefile before the static pod manifest so that the kubelet
+	// never starts the pod without its Corefile.
+	if err := writeFile(*configDir, "Corefile", pod.Corefile); err != nil {
+		return err
+	}
+	return writeFile(*staticPodDir, pod.Pod.Filename, pod.Pod.Data)
+}
+
+// renderProfiles writes the manifests of each profile to a subdirectory of the
+// given directory named after the profile.
+func renderProfiles(opts bootstrap.Options, dir string) error {
+	profiles, err := bootstrap.RenderProfiles(opts)
+	if err != nil {
+		return fmt.Errorf("failed to render profiles: %v", err)
+	}
+	for _, profile := range profiles {
+		for _, m := range profile.Manifests {
+			if err := writeFile(filepath.Join(dir, profile.Name), m.Filename, m.Data); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// writeFile writes the given data to the named file in the given directory,
+// creating the directory if it does not exist.
+func writeFile(dir, name string, data []byte) error {
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return fmt.Errorf("failed to create directory %s: %v", dir, err)
+	}
+	path := filepath.Join(dir, name)
+	if err := ioutil.WriteFile(path, data, 0644); err != nil {
+		return fmt.Errorf("failed to write %s: %v", path, err)
+	}
+	return nil
+}
@@ -10,10 +10,18 @@ require (
 	github.com/kevinburke/go-bindata v3.11.0+incompatible
 	github.com/konsorten/go-windows-terminal-sequences v1.0.2 // indirect
 	github.com/openshift/api v0.0.0-20200324173355-9b3bdf846ea1
+	github.com/prometheus/client_golang v1.0.0
+	github.com/prometheus/client_model v0.2.0
+	github.com/prometheus/common v0.4.1
 	github.com/sirupsen/logrus v1.4.2
 	golang.org/x/text v0.3.3 // indirect
 	k8s.io/api v0.18.3
 	k8s.io/apimachinery v0.18.3
 	k8s.io/client-go v0.18.3
 	sigs.k8s.io/controller-runtime v0.6.0
+	sigs.k8s.io/yaml v1.2.0
 )
+
+// The DNS operator API carries types that have not landed in openshift/api
+// yet; see third_party/openshift-api/README.md.
+replace github.com/openshift/api => ./third_party/openshift-api
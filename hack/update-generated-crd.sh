@@ -1,11 +1,11 @@
 #!/bin/bash
 set -euo pipefail
 
-VENDORED_CRD='vendor/github.com/openshift/api/operator/v1/0000_70_dns-operator_00-custom-resource-definition.yaml'
+API_CRD='third_party/openshift-api/operator/v1/0000_70_dns-operator_00-custom-resource-definition.yaml'
 LOCAL_CRD='manifests/0000_70_dns-operator_00-custom-resource-definition.yaml'
 
 if [[ -z "${SKIP_COPY+1}" ]]; then
-  if ! cmp -s "$LOCAL_CRD" "$VENDORED_CRD"; then
-    cp -f "$VENDORED_CRD" "$LOCAL_CRD"
+  if ! cmp -s "$LOCAL_CRD" "$API_CRD"; then
+    cp -f "$API_CRD" "$LOCAL_CRD"
   fi
 fi
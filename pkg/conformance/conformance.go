@@ -0,0 +1,374 @@
+// Package conformance checks that the cluster DNS serves the records that the
+// Kubernetes DNS-based service discovery specification requires, against a
+// live cluster.
+package conformance
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"sort"
+	"strings"
+	"time"
+
+	operatorv1 "github.com/openshift/api/operator/v1"
+	operatorcontroller "github.com/openshift/cluster-dns-operator/pkg/operator/controller"
+
+	"github.com/sirupsen/logrus"
+
+	corev1 "k8s.io/api/core/v1"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/wait"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	// serviceName and headlessServiceName are the names of the services
+	// that the checks look up.
+	serviceName         = "conformance"
+	headlessServiceName = "conformance-headless"
+
+	// portName and port are the name and number of the port of both
+	// services, which their SRV records advertise.
+	portName = "http"
+	port     = 80
+
+	// endpointHostname is the hostname of the first endpoint of the
+	// headless service, which has a record of its own.
+	endpointHostname = "endpoint-a"
+
+	// pollInterval is the interval at which a failing check is retried
+	// until the records that it looks up have propagated to CoreDNS.
+	pollInterval = time.Second
+)
+
+// headlessEndpointIPs are the addresses of the endpoints of the headless
+// service.  They are in TEST-NET-1 (RFC 5737), so they are never reached.
+var headlessEndpointIPs = []string{"192.0.2.10", "192.0.2.11"}
+
+// Options are the options of a conformance run.
+type Options struct {
+	// Nameserver is the address of the DNS service to query.  If empty,
+	// the cluster IP of the default dns is used.
+	Nameserver string
+	// ClusterDomain is the cluster domain.  If empty, the cluster domain
+	// of the default dns is used.
+	ClusterDomain string
+	// Timeout is how long each check is retried before it fails.
+	Timeout time.Duration
+}
+
+// resolver looks up records.  *net.Resolver implements it.
+type resolver interface {
+	LookupIP(ctx context.Context, network, host string) ([]net.IP, error)
+	LookupSRV(ctx context.Context, service, proto, name string) (string, []*net.SRV, error)
+	LookupAddr(ctx context.Context, addr string) ([]string, error)
+}
+
+// newResolver returns a resolver that sends every query to the given
+// nameserver.
+func newResolver(nameserver string) *net.Resolver {
+	return &net.Resolver{
+		PreferGo: true,
+		Dial: func(ctx context.Context, network, _ string) (net.Conn, error) {
+			d := net.Dialer{}
+			return d.DialContext(ctx, network, net.JoinHostPort(nameserver, "53"))
+		},
+	}
+}
+
+// fixture is what the checks look up: the services that a run creates and a
+// pod of the dns.
+type fixture struct {
+	namespace     string
+	clusterDomain string
+	clusterIP     string
+	podIP         string
+	podNamespace  string
+}
+
+// serviceFQDN returns the fully qualified name of the named service of the
+// fixture.
+func (f *fixture) serviceFQDN(name string) string {
+	return fmt.Sprintf("%s.%s.svc.%s.", name, f.namespace, f.clusterDomain)
+}
+
+// check is a conformance check.
+type check struct {
+	name string
+	run  func(ctx context.Context, r resolver) error
+}
+
+// checks returns the conformance checks of the given fixture.
+func checks(f *fixture) []check {
+	service := f.serviceFQDN(serviceName)
+	headless := f.serviceFQDN(headlessServiceName)
+	ip := net.ParseIP(f.clusterIP)
+	isIPv4 := ip != nil && ip.To4() != nil
+	checks := []check{{
+		name: "ClusterIP service has an A record",
+		run: func(ctx context.Context, r resolver) error {
+			if !isIPv4 {
+				return expectNotFound(r.LookupIP(ctx, "ip4", service))
+			}
+			return expectIPs(r.LookupIP(ctx, "ip4", service))(f.clusterIP)
+		},
+	}, {
+		name: "ClusterIP service has an AAAA record",
+		run: func(ctx context.Context, r resolver) error {
+			if isIPv4 {
+				return expectNotFound(r.LookupIP(ctx, "ip6", service))
+			}
+			return expectIPs(r.LookupIP(ctx, "ip6", service))(f.clusterIP)
+		},
+	}, {
+		name: "ClusterIP service has an SRV record for a named port",
+		run: func(ctx context.Context, r resolver) error {
+			return expectSRVs(r.LookupSRV(ctx, portName, "tcp", service))(service)
+		},
+	}, {
+		name: "ClusterIP service has a PTR record",
+		run: func(ctx context.Context, r resolver) error {
+			names, err := r.LookupAddr(ctx, f.clusterIP)
+			if err != nil {
+				return err
+			}
+			for _, name := range names {
+				if strings.EqualFold(name, service) {
+					return nil
+				}
+			}
+			return fmt.Errorf("expected PTR record %s, got %v", service, names)
+		},
+	}, {
+		name: "headless service has an A record for each endpoint",
+		run: func(ctx context.Context, r resolver) error {
+			return expectIPs(r.LookupIP(ctx, "ip4", headless))(headlessEndpointIPs...)
+		},
+	}, {
+		name: "headless service endpoint with a hostname has an A record",
+		run: func(ctx context.Context, r resolver) error {
+			return expectIPs(r.LookupIP(ctx, "ip4", endpointHostname+"."+headless))(headlessEndpointIPs[0])
+		},
+	}, {
+		name: "headless service has an SRV record for each endpoint",
+		run: func(ctx context.Context, r resolver) error {
+			return expectSRVs(r.LookupSRV(ctx, portName, "tcp", headless))(endpointHostname+"."+headless, strings.Replace(headlessEndpointIPs[1], ".", "-", -1)+"."+headless)
+		},
+	}, {
+		name: "name of a service that does not exist is not found",
+		run: func(ctx context.Context, r resolver) error {
+			return expectNotFound(r.LookupIP(ctx, "ip", f.serviceFQDN("does-not-exist")))
+		},
+	}}
+	if len(f.podIP) != 0 {
+		pod := fmt.Sprintf("%s.%s.pod.%s.", strings.NewReplacer(".", "-", ":", "-").Replace(f.podIP), f.podNamespace, f.clusterDomain)
+		checks = append(checks, check{
+			name: "pod has an A or AAAA record",
+			run: func(ctx context.Context, r resolver) error {
+				return expectIPs(r.LookupIP(ctx, "ip", pod))(f.podIP)
+			},
+		})
+	}
+	return checks
+}
+
+// expectIPs returns a function that returns an error unless the given result
+// of a lookup is exactly the addresses that the function is given.
+func expectIPs(ips []net.IP, err error) func(expected ...string) error {
+	return func(expected ...string) error {
+		if err != nil {
+			return err
+		}
+		actual := []string{}
+		for _, ip := range ips {
+			actual = append(actual, ip.String())
+		}
+		want := []string{}
+		for _, s := range expected {
+			if ip := net.ParseIP(s); ip != nil {
+				want = append(want, ip.String())
+			}
+		}
+		sort.Strings(actual)
+		sort.Strings(want)
+		if strings.Join(actual, " ") != strings.Join(want, " ") {
+			return fmt.Errorf("expected addresses %v, got %v", want, actual)
+		}
+		return nil
+	}
+}
+
+// expectSRVs returns a function that returns an error unless the given result
+// of a lookup is an SRV record for the port of the fixture for each of the
+// targets that the function is given.
+func expectSRVs(_ string, srvs []*net.SRV, err error) func(targets ...string) error {
+	return func(targets ...string) error {
+		if err != nil {
+			return err
+		}
+		actual := []string{}
+		for _, srv := range srvs {
+			if srv.Port != port {
+				return fmt.Errorf("expected SRV record for %s to have port %d, got %d", srv.Target, port, srv.Port)
+			}
+			actual = append(actual, strings.ToLower(srv.Target))
+		}
+		want := append([]string{}, targets...)
+		sort.Strings(actual)
+		sort.Strings(want)
+		if strings.Join(actual, " ") != strings.Join(want, " ") {
+			return fmt.Errorf("expected SRV targets %v, got %v", want, actual)
+		}
+		return nil
+	}
+}
+
+// expectNotFound returns an error unless the given result of a lookup is that
+// the name has no records.
+func expectNotFound(ips []net.IP, err error) error {
+	if err == nil {
+		return fmt.Errorf("expected no records, got %v", ips)
+	}
+	if dnsErr, ok := err.(*net.DNSError); ok && dnsErr.IsNotFound {
+		return nil
+	}
+	return fmt.Errorf("expected no records, got error: %v", err)
+}
+
+// runChecks runs the given checks, retrying each until it passes or the
+// timeout expires, and returns the results.
+func runChecks(ctx context.Context, r resolver, checks []check, timeout time.Duration) *Suite {
+	suite := &Suite{Name: "Kubernetes DNS-based service discovery"}
+	for _, c := range checks {
+		start := time.Now()
+		var lastErr error
+		if err := wait.PollImmediate(pollInterval, timeout, func() (bool, error) {
+			queryCtx, cancel := context.WithTimeout(ctx, pollInterval*5)
+			defer cancel()
+			lastErr = c.run(queryCtx, r)
+			return lastErr == nil, nil
+		}); err != nil && lastErr == nil {
+			lastErr = err
+		}
+		result := Result{Name: c.name, Duration: time.Since(start)}
+		if lastErr != nil {
+			result.Failure = lastErr.Error()
+			logrus.Errorf("FAIL: %s: %v", c.name, lastErr)
+		} else {
+			logrus.Infof("PASS: %s", c.name)
+		}
+		suite.Results = append(suite.Results, result)
+	}
+	return suite
+}
+
+// Run creates the services that the checks look up in a new namespace, runs
+// the checks against the DNS service of the cluster, and deletes the
+// namespace.
+func Run(ctx context.Context, kubeClient client.Client, opts Options) (*Suite, error) {
+	dns := &operatorv1.DNS{}
+	if err := kubeClient.Get(ctx, types.NamespacedName{Name: operatorcontroller.DefaultDNSController}, dns); err != nil {
+		return nil, fmt.Errorf("failed to get default dns: %v", err)
+	}
+	nameserver, clusterDomain := opts.Nameserver, opts.ClusterDomain
+	if len(nameserver) == 0 {
+		nameserver = dns.Status.ClusterIP
+	}
+	if len(clusterDomain) == 0 {
+		clusterDomain = dns.Status.ClusterDomain
+	}
+	if len(nameserver) == 0 || len(clusterDomain) == 0 {
+		return nil, fmt.Errorf("default dns does not report its cluster IP and cluster domain yet")
+	}
+
+	ns := &corev1.Namespace{
+		ObjectMeta: metav1.ObjectMeta{
+			GenerateName: "openshift-dns-conformance-",
+		},
+	}
+	if err := kubeClient.Create(ctx, ns); err != nil {
+		return nil, fmt.Errorf("failed to create namespace: %v", err)
+	}
+	logrus.Infof("created namespace %s", ns.Name)
+	defer func() {
+		if err := kubeClient.Delete(context.Background(), ns); err != nil {
+			logrus.Warningf("failed to delete namespace %s: %v", ns.Name, err)
+		}
+	}()
+
+	service, err := createServices(ctx, kubeClient, ns.Name)
+	if err != nil {
+		return nil, err
+	}
+	f := &fixture{
+		namespace:     ns.Name,
+		clusterDomain: strings.TrimSuffix(clusterDomain, "."),
+		clusterIP:     service.Spec.ClusterIP,
+	}
+	if pod, err := dnsPod(ctx, kubeClient, dns); err != nil {
+		logrus.Warningf("not checking pod records: %v", err)
+	} else {
+		f.podIP, f.podNamespace = pod.Status.PodIP, pod.Namespace
+	}
+	return runChecks(ctx, newResolver(nameserver), checks(f), opts.Timeout), nil
+}
+
+// createServices creates the services that the checks look up in the given
+// namespace and returns the ClusterIP service.  The headless service has no
+// selector, and its endpoints are created explicitly, so that no pods are
+// needed.
+func createServices(ctx context.Context, kubeClient client.Client, namespace string) (*corev1.Service, error) {
+	ports := []corev1.ServicePort{{Name: portName, Protocol: corev1.ProtocolTCP, Port: port}}
+	service := &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: serviceName},
+		Spec:       corev1.ServiceSpec{Ports: ports},
+	}
+	headless := &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: headlessServiceName},
+		Spec:       corev1.ServiceSpec{ClusterIP: corev1.ClusterIPNone, Ports: ports},
+	}
+	addresses := []corev1.EndpointAddress{}
+	for i, ip := range headlessEndpointIPs {
+		address := corev1.EndpointAddress{IP: ip}
+		if i == 0 {
+			address.Hostname = endpointHostname
+		}
+		addresses = append(addresses, address)
+	}
+	endpoints := &corev1.Endpoints{
+		ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: headlessServiceName},
+		Subsets: []corev1.EndpointSubset{{
+			Addresses: addresses,
+			Ports:     []corev1.EndpointPort{{Name: portName, Protocol: corev1.ProtocolTCP, Port: port}},
+		}},
+	}
+	if err := kubeClient.Create(ctx, service); err != nil {
+		return nil, fmt.Errorf("failed to create service %s/%s: %v", namespace, service.Name, err)
+	}
+	if err := kubeClient.Create(ctx, headless); err != nil {
+		return nil, fmt.Errorf("failed to create service %s/%s: %v", namespace, headless.Name, err)
+	}
+	if err := kubeClient.Create(ctx, endpoints); err != nil {
+		return nil, fmt.Errorf("failed to create endpoints %s/%s: %v", namespace, endpoints.Name, err)
+	}
+	return service, nil
+}
+
+// dnsPod returns a running pod of the given dns.
+func dnsPod(ctx context.Context, kubeClient client.Client, dns *operatorv1.DNS) (*corev1.Pod, error) {
+	pods := &corev1.PodList{}
+	selector := operatorcontroller.DNSDaemonSetPodSelector(dns)
+	if err := kubeClient.List(ctx, pods, client.InNamespace(operatorcontroller.DNSDaemonSetName(dns).Namespace), client.MatchingLabels(selector.MatchLabels)); err != nil {
+		return nil, fmt.Errorf("failed to list dns pods: %v", err)
+	}
+	for i := range pods.Items {
+		if pods.Items[i].Status.Phase == corev1.PodRunning && len(pods.Items[i].Status.PodIP) != 0 {
+			return &pods.Items[i], nil
+		}
+	}
+	return nil, fmt.Errorf("dns %s has no running pods", dns.Name)
+}
@@ -0,0 +1,161 @@
+package conformance
+
+import (
+	"bytes"
+	"context"
+	"net"
+	"strings"
+	"testing"
+	"time"
+)
+
+// fakeResolver is a resolver that answers from maps, keyed by name, and
+// reports every other name as not found.
+type fakeResolver struct {
+	ips  map[string][]string
+	srvs map[string][]*net.SRV
+	ptrs map[string][]string
+}
+
+func notFound(name string) error {
+	return &net.DNSError{Err: "no such host", Name: name, IsNotFound: true}
+}
+
+func (r *fakeResolver) LookupIP(_ context.Context, network, host string) ([]net.IP, error) {
+	ips := []net.IP{}
+	for _, s := range r.ips[host] {
+		ip := net.ParseIP(s)
+		switch {
+		case network == "ip4" && ip.To4() == nil:
+		case network == "ip6" && ip.To4() != nil:
+		default:
+			ips = append(ips, ip)
+		}
+	}
+	if len(ips) == 0 {
+		return nil, notFound(host)
+	}
+	return ips, nil
+}
+
+func (r *fakeResolver) LookupSRV(_ context.Context, service, proto, name string) (string, []*net.SRV, error) {
+	cname := "_" + service + "._" + proto + "." + name
+	if srvs, ok := r.srvs[cname]; ok {
+		return cname, srvs, nil
+	}
+	return "", nil, notFound(cname)
+}
+
+func (r *fakeResolver) LookupAddr(_ context.Context, addr string) ([]string, error) {
+	if names, ok := r.ptrs[addr]; ok {
+		return names, nil
+	}
+	return nil, notFound(addr)
+}
+
+// conformingResolver returns a resolver that serves the records that the
+// checks of the given fixture expect.
+func conformingResolver(f *fixture) *fakeResolver {
+	service := f.serviceFQDN(serviceName)
+	headless := f.serviceFQDN(headlessServiceName)
+	return &fakeResolver{
+		ips: map[string][]string{
+			service:                           {f.clusterIP},
+			headless:                          headlessEndpointIPs,
+			endpointHostname + "." + headless: {headlessEndpointIPs[0]},
+			"10-128-0-5.openshift-dns.pod.cluster.local.": {"10.128.0.5"},
+		},
+		srvs: map[string][]*net.SRV{
+			"_http._tcp." + service: {{Target: service, Port: port}},
+			"_http._tcp." + headless: {
+				{Target: endpointHostname + "." + headless, Port: port},
+				{Target: "192-0-2-11." + headless, Port: port},
+			},
+		},
+		ptrs: map[string][]string{
+			f.clusterIP: {service},
+		},
+	}
+}
+
+// TestRunChecks verifies that the checks pass against a resolver that serves
+// the records that the Kubernetes DNS specification requires and fail against
+// one that does not.
+func TestRunChecks(t *testing.T) {
+	f := &fixture{
+		namespace:     "openshift-dns-conformance-abcde",
+		clusterDomain: "cluster.local",
+		clusterIP:     "172.30.12.34",
+		podIP:         "10.128.0.5",
+		podNamespace:  "openshift-dns",
+	}
+	suite := runChecks(context.Background(), conformingResolver(f), checks(f), 10*time.Millisecond)
+	if len(suite.Results) != 9 {
+		t.Errorf("expected 9 results, got %d", len(suite.Results))
+	}
+	for _, r := range suite.Results {
+		if len(r.Failure) != 0 {
+			t.Errorf("expected check %q to pass, got failure: %s", r.Name, r.Failure)
+		}
+	}
+
+	r := conformingResolver(f)
+	delete(r.ptrs, f.clusterIP)
+	r.ips[f.serviceFQDN("does-not-exist")] = []string{"172.30.0.1"}
+	r.srvs["_http._tcp."+f.serviceFQDN(serviceName)][0].Port = 8080
+	suite = runChecks(context.Background(), r, checks(f), 10*time.Millisecond)
+	failed := []string{}
+	for _, r := range suite.Results {
+		if len(r.Failure) != 0 {
+			failed = append(failed, r.Name)
+		}
+	}
+	expected := []string{
+		"ClusterIP service has an SRV record for a named port",
+		"ClusterIP service has a PTR record",
+		"name of a service that does not exist is not found",
+	}
+	if strings.Join(failed, "\n") != strings.Join(expected, "\n") {
+		t.Errorf("expected failed checks %q, got %q", expected, failed)
+	}
+	if suite.Failures() != len(expected) {
+		t.Errorf("expected %d failures, got %d", len(expected), suite.Failures())
+	}
+}
+
+// TestChecksWithoutPod verifies that the pod record is not checked when there
+// is no dns pod.
+func TestChecksWithoutPod(t *testing.T) {
+	f := &fixture{namespace: "ns", clusterDomain: "cluster.local", clusterIP: "172.30.0.10"}
+	for _, c := range checks(f) {
+		if strings.HasPrefix(c.name, "pod ") {
+			t.Errorf("unexpected check %q", c.name)
+		}
+	}
+}
+
+// TestWriteJUnit verifies that the results are written as a JUnit report.
+func TestWriteJUnit(t *testing.T) {
+	suite := &Suite{
+		Name: "dns",
+		Results: []Result{
+			{Name: "passes", Duration: 1500 * time.Millisecond},
+			{Name: "fails", Duration: 250 * time.Millisecond, Failure: "expected <a>, got <b>"},
+		},
+	}
+	var buf bytes.Buffer
+	if err := suite.WriteJUnit(&buf); err != nil {
+		t.Fatal(err)
+	}
+	expected := `<?xml version="1.0" encoding="UTF-8"?>
+<testsuite name="dns" tests="2" failures="1" time="1.750">
+  <testcase name="passes" classname="dns" time="1.500"></testcase>
+  <testcase name="fails" classname="dns" time="0.250">
+    <failure message="expected &lt;a&gt;, got &lt;b&gt;">expected &lt;a&gt;, got &lt;b&gt;</failure>
+  </testcase>
+</testsuite>
+`
+	if buf.String() != expected {
+		t.Errorf("expected:\n%s\ngot:\n%s", expected, buf.String())
+	}
+}
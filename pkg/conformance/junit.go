@@ -0,0 +1,98 @@
+package conformance
+
+import (
+	"encoding/xml"
+	"fmt"
+	"io"
+	"time"
+)
+
+// Result is the result of a conformance check.
+type Result struct {
+	// Name is the name of the check.
+	Name string
+	// Duration is how long the check took, including retries.
+	Duration time.Duration
+	// Failure is why the check failed, or empty if it passed.
+	Failure string
+}
+
+// Suite is the results of a conformance run.
+type Suite struct {
+	// Name is the name of the suite.
+	Name string
+	// Results is the results of the checks, in the order that they ran.
+	Results []Result
+}
+
+// Failures returns the number of failed checks.
+func (s *Suite) Failures() int {
+	failures := 0
+	for _, r := range s.Results {
+		if len(r.Failure) != 0 {
+			failures++
+		}
+	}
+	return failures
+}
+
+// junitTestSuite, junitTestCase, and junitFailure are the elements of a JUnit
+// report that CI systems read.
+type junitTestSuite struct {
+	XMLName   xml.Name        `xml:"testsuite"`
+	Name      string          `xml:"name,attr"`
+	Tests     int             `xml:"tests,attr"`
+	Failures  int             `xml:"failures,attr"`
+	Time      string          `xml:"time,attr"`
+	TestCases []junitTestCase `xml:"testcase"`
+}
+
+type junitTestCase struct {
+	Name      string        `xml:"name,attr"`
+	ClassName string        `xml:"classname,attr"`
+	Time      string        `xml:"time,attr"`
+	Failure   *junitFailure `xml:"failure,omitempty"`
+}
+
+type junitFailure struct {
+	Message string `xml:"message,attr"`
+	Text    string `xml:",chardata"`
+}
+
+// junitTime formats the given duration in seconds, as JUnit reports do.
+func junitTime(d time.Duration) string {
+	return fmt.Sprintf("%.3f", d.Seconds())
+}
+
+// WriteJUnit writes the results as a JUnit report to the given writer.
+func (s *Suite) WriteJUnit(w io.Writer) error {
+	suite := junitTestSuite{
+		Name:     s.Name,
+		Tests:    len(s.Results),
+		Failures: s.Failures(),
+	}
+	var total time.Duration
+	for _, r := range s.Results {
+		total += r.Duration
+		testCase := junitTestCase{
+			Name:      r.Name,
+			ClassName: s.Name,
+			Time:      junitTime(r.Duration),
+		}
+		if len(r.Failure) != 0 {
+			testCase.Failure = &junitFailure{Message: r.Failure, Text: r.Failure}
+		}
+		suite.TestCases = append(suite.TestCases, testCase)
+	}
+	suite.Time = junitTime(total)
+	if _, err := io.WriteString(w, xml.Header); err != nil {
+		return err
+	}
+	enc := xml.NewEncoder(w)
+	enc.Indent("", "  ")
+	if err := enc.Encode(suite); err != nil {
+		return err
+	}
+	_, err := io.WriteString(w, "\n")
+	return err
+}
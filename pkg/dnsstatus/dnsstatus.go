@@ -0,0 +1,64 @@
+// Package dnsstatus interprets the status of the DNSes that the operator
+// manages.  Other components, such as the ingress and machine config
+// operators, use it instead of reimplementing the interpretation, so that they
+// read the status the same way as the operator does when it reports its own
+// status.
+package dnsstatus
+
+import (
+	"net"
+	"strings"
+
+	operatorv1 "github.com/openshift/api/operator/v1"
+
+	"github.com/openshift/cluster-dns-operator/pkg/util/conditions"
+)
+
+// DefaultClusterDomain is the cluster domain of a DNS that does not report
+// one.
+const DefaultClusterDomain = "cluster.local"
+
+// IsDNSAvailable returns a Boolean indicating whether the given DNS reports
+// that it is available.  A DNS without an Available condition is not.
+func IsDNSAvailable(dns *operatorv1.DNS) bool {
+	return hasConditionStatus(dns, operatorv1.OperatorStatusTypeAvailable, operatorv1.ConditionTrue)
+}
+
+// IsDNSDegraded returns a Boolean indicating whether the given DNS is degraded.
+// A DNS is considered degraded unless it reports that it is not, so a DNS that
+// the operator has not yet reconciled is degraded.
+func IsDNSDegraded(dns *operatorv1.DNS) bool {
+	return !hasConditionStatus(dns, operatorv1.OperatorStatusTypeDegraded, operatorv1.ConditionFalse)
+}
+
+// IsDNSProgressing returns a Boolean indicating whether the given DNS is
+// progressing.  A DNS is considered progressing unless it reports that it is
+// not, so a DNS that the operator has not yet reconciled is progressing.
+func IsDNSProgressing(dns *operatorv1.DNS) bool {
+	return !hasConditionStatus(dns, operatorv1.OperatorStatusTypeProgressing, operatorv1.ConditionFalse)
+}
+
+// hasConditionStatus returns a Boolean indicating whether the given DNS has a
+// condition of the given type with the given status.
+func hasConditionStatus(dns *operatorv1.DNS, conditionType string, status operatorv1.ConditionStatus) bool {
+	condition := conditions.FindOperatorCondition(dns.Status.Conditions, conditionType)
+	return condition != nil && condition.Status == status
+}
+
+// DNSClusterIP returns the cluster IP address of the Service of the given DNS,
+// which pods use as their name server, or nil if the DNS does not report a
+// valid one yet.
+func DNSClusterIP(dns *operatorv1.DNS) net.IP {
+	return net.ParseIP(dns.Status.ClusterIP)
+}
+
+// EffectiveClusterDomain returns the cluster domain that the given DNS serves,
+// without a trailing dot, or DefaultClusterDomain if the DNS does not report
+// one yet.
+func EffectiveClusterDomain(dns *operatorv1.DNS) string {
+	domain := strings.ToLower(strings.TrimSuffix(dns.Status.ClusterDomain, "."))
+	if len(domain) == 0 {
+		return DefaultClusterDomain
+	}
+	return domain
+}
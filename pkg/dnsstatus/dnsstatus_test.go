@@ -0,0 +1,101 @@
+package dnsstatus
+
+import (
+	"net"
+	"testing"
+
+	operatorv1 "github.com/openshift/api/operator/v1"
+)
+
+func TestDNSConditions(t *testing.T) {
+	testCases := []struct {
+		description         string
+		conditions          []operatorv1.OperatorCondition
+		expectedAvailable   bool
+		expectedDegraded    bool
+		expectedProgressing bool
+	}{
+		{
+			description:         "no conditions",
+			expectedDegraded:    true,
+			expectedProgressing: true,
+		},
+		{
+			description: "available",
+			conditions: []operatorv1.OperatorCondition{
+				{Type: operatorv1.OperatorStatusTypeAvailable, Status: operatorv1.ConditionTrue},
+				{Type: operatorv1.OperatorStatusTypeDegraded, Status: operatorv1.ConditionFalse},
+				{Type: operatorv1.OperatorStatusTypeProgressing, Status: operatorv1.ConditionFalse},
+			},
+			expectedAvailable: true,
+		},
+		{
+			description: "degraded and progressing",
+			conditions: []operatorv1.OperatorCondition{
+				{Type: operatorv1.OperatorStatusTypeAvailable, Status: operatorv1.ConditionTrue},
+				{Type: operatorv1.OperatorStatusTypeDegraded, Status: operatorv1.ConditionTrue},
+				{Type: operatorv1.OperatorStatusTypeProgressing, Status: operatorv1.ConditionTrue},
+			},
+			expectedAvailable:   true,
+			expectedDegraded:    true,
+			expectedProgressing: true,
+		},
+		{
+			description: "unknown",
+			conditions: []operatorv1.OperatorCondition{
+				{Type: operatorv1.OperatorStatusTypeAvailable, Status: operatorv1.ConditionUnknown},
+				{Type: operatorv1.OperatorStatusTypeDegraded, Status: operatorv1.ConditionUnknown},
+				{Type: operatorv1.OperatorStatusTypeProgressing, Status: operatorv1.ConditionUnknown},
+			},
+			expectedDegraded:    true,
+			expectedProgressing: true,
+		},
+	}
+	for _, tc := range testCases {
+		dns := &operatorv1.DNS{Status: operatorv1.DNSStatus{Conditions: tc.conditions}}
+		if actual := IsDNSAvailable(dns); actual != tc.expectedAvailable {
+			t.Errorf("%q: expected available %t, got %t", tc.description, tc.expectedAvailable, actual)
+		}
+		if actual := IsDNSDegraded(dns); actual != tc.expectedDegraded {
+			t.Errorf("%q: expected degraded %t, got %t", tc.description, tc.expectedDegraded, actual)
+		}
+		if actual := IsDNSProgressing(dns); actual != tc.expectedProgressing {
+			t.Errorf("%q: expected progressing %t, got %t", tc.description, tc.expectedProgressing, actual)
+		}
+	}
+}
+
+func TestDNSClusterIP(t *testing.T) {
+	testCases := []struct {
+		clusterIP string
+		expected  net.IP
+	}{
+		{clusterIP: "", expected: nil},
+		{clusterIP: "not an address", expected: nil},
+		{clusterIP: "172.30.0.10", expected: net.ParseIP("172.30.0.10")},
+		{clusterIP: "fd02::a", expected: net.ParseIP("fd02::a")},
+	}
+	for _, tc := range testCases {
+		dns := &operatorv1.DNS{Status: operatorv1.DNSStatus{ClusterIP: tc.clusterIP}}
+		if actual := DNSClusterIP(dns); !actual.Equal(tc.expected) {
+			t.Errorf("%q: expected %v, got %v", tc.clusterIP, tc.expected, actual)
+		}
+	}
+}
+
+func TestEffectiveClusterDomain(t *testing.T) {
+	testCases := []struct {
+		clusterDomain string
+		expected      string
+	}{
+		{clusterDomain: "", expected: "cluster.local"},
+		{clusterDomain: "cluster.local", expected: "cluster.local"},
+		{clusterDomain: "Example.Internal.", expected: "example.internal"},
+	}
+	for _, tc := range testCases {
+		dns := &operatorv1.DNS{Status: operatorv1.DNSStatus{ClusterDomain: tc.clusterDomain}}
+		if actual := EffectiveClusterDomain(dns); actual != tc.expected {
+			t.Errorf("%q: expected %q, got %q", tc.clusterDomain, tc.expected, actual)
+		}
+	}
+}
@@ -1,15 +1,18 @@
 // Code generated by go-bindata. DO NOT EDIT.
 // sources:
 // assets/dns/cluster-role-binding.yaml (223B)
-// assets/dns/cluster-role.yaml (397B)
-// assets/dns/daemonset.yaml (6.128kB)
+// assets/dns/cluster-role.yaml (462B)
+// assets/dns/daemonset.yaml (8.942kB)
 // assets/dns/metrics/cluster-role-binding.yaml (279B)
 // assets/dns/metrics/cluster-role.yaml (246B)
 // assets/dns/metrics/role-binding.yaml (293B)
 // assets/dns/metrics/role.yaml (284B)
-// assets/dns/namespace.yaml (369B)
+// assets/dns/namespace.yaml (773B)
 // assets/dns/service-account.yaml (85B)
 // assets/dns/service.yaml (520B)
+// assets/dns/viewer/cluster-role.yaml (563B)
+// assets/dns/viewer/role-binding.yaml (507B)
+// assets/dns/viewer/role.yaml (312B)
 
 package manifests
 
@@ -78,7 +81,7 @@ func (fi bindataFileInfo) Sys() interface{} {
 	return nil
 }
 
-var _assetsDnsClusterRoleBindingYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x6c\xce\x31\x8e\x83\x40\x0c\x05\xd0\x7e\x4e\xe1\x0b\xc0\x6a\xbb\xd5\x74\x9b\xdc\x80\x48\xe9\xcd\x8c\x09\x0e\x60\xa3\xb1\x87\x22\xa7\x8f\x10\x4a\x45\x3a\x17\xfe\xff\xfd\x89\x25\x47\xb8\xce\xd5\x9c\x4a\xa7\x33\x5d\x58\x32\xcb\x23\xe0\xca\x77\x2a\xc6\x2a\x11\x4a\x8f\xa9\xc5\xea\xa3\x16\x7e\xa1\xb3\x4a\x3b\xfd\x59\xcb\xfa\xb3\xfd\x86\x85\x1c\x33\x3a\xc6\x00\x00\x20\xb8\x50\x04\x5d\x49\x6c\xe4\xc1\x9b\x2c\x16\xac\xf6\x4f\x4a\x6e\x31\x34\x70\x78\x37\x2a\x1b\x27\xfa\x4f\x49\xab\x78\xf8\xc4\xf6\xe7\xe3\xb6\x15\xd3\xa9\xa7\xe8\x4c\x1d\x0d\x3b\x74\x9a\x1d\xbe\xd3\xef\x00\x00\x00\xff\xff\xfa\x62\xe7\x50\xdf\x00\x00\x00")
+var _assetsDnsClusterRoleBindingYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x6c\xce\x31\x8e\x83\x40\x0c\x05\xd0\x7e\x4e\xe1\x0b\xc0\x6a\xbb\xd5\x74\x9b\xdc\x80\x48\xe9\xcd\x8c\x09\x0e\x60\xa3\xb1\x87\x22\xa7\x8f\x10\x4a\x45\x3a\x17\xfe\xff\xfd\x89\x25\x47\xb8\xce\xd5\x9c\x4a\xa7\x33\x5d\x58\x32\xcb\x23\xe0\xca\x77\x2a\xc6\x2a\x11\x4a\x8f\xa9\xc5\xea\xa3\x16\x7e\xa1\xb3\x4a\x3b\xfd\x59\xcb\xfa\xb3\xfd\x86\x85\x1c\x33\x3a\xc6\x00\x00\x20\xb8\x50\x04\x5d\x49\x6c\xe4\xc1\x9b\x2c\x16\xac\xf6\x4f\x4a\x6e\x31\x34\x70\x78\x37\x2a\x1b\x27\xfa\x4f\x49\xab\x78\xf8\xc4\xf6\xe7\xe3\xb6\x15\xd3\xa9\xa7\xe8\x4c\x1d\x0d\x3b\x74\x9a\x1d\xbe\xd3\xef\x01\x00\xfa\x62\xe7\x50\xdf\x00\x00\x00")
 
 func assetsDnsClusterRoleBindingYamlBytes() ([]byte, error) {
 	return bindataRead(
@@ -98,7 +101,7 @@ func assetsDnsClusterRoleBindingYaml() (*asset, error) {
 	return a, nil
 }
 
-var _assetsDnsClusterRoleYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x7c\x90\xb1\x4e\xc4\x30\x10\x44\x7b\x7f\x85\x75\xfd\x05\xd1\xa1\xb4\x14\xf4\x14\xf4\x1b\x67\x50\x96\xe4\x76\xad\xdd\x75\x4e\xe2\xeb\x51\x2e\x57\xa0\x8b\xa0\x9b\x19\xd9\xf3\x3c\x9e\x59\xc6\x3e\xbf\x2e\xcd\x03\xf6\xae\x0b\x12\x55\xfe\x80\x39\xab\xf4\xd9\x06\x2a\x1d\xb5\x98\xd4\xf8\x9b\x82\x55\xba\xf9\xc5\x3b\xd6\xa7\xf5\x39\x5d\x10\x34\x52\x50\x9f\x72\x16\xba\xa0\xcf\x5a\x21\x3e\xf1\x67\x9c\x47\xf1\x64\x6d\x81\xf7\xe9\x9c\xa9\xf2\x9b\x69\xab\xbe\x9d\x3c\xe7\xd3\x29\xe5\x6c\x70\x6d\x56\x70\xcf\x20\x63\x55\x96\xf0\x9b\x73\xd8\xca\x05\xbb\xa9\x3a\xee\x62\x63\x78\xa5\x3d\x5f\x61\xc3\xfd\xee\xc2\x1e\x37\x71\xa5\x28\x53\x3a\x02\xb7\x01\x90\xe0\xf2\x7b\xc1\xf1\x0d\xa1\x33\xc4\xb0\x32\xae\x0f\x84\x62\xa0\xc0\x1f\xcd\x8f\x5f\x73\x2c\xf6\x36\x7c\xa1\x04\x95\x02\xf7\xff\x00\x3f\x01\x00\x00\xff\xff\x76\x1b\x55\x2e\x8d\x01\x00\x00")
+var _assetsDnsClusterRoleYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x94\x90\x31\x4f\xc3\x50\x0c\x84\xf7\xf7\x2b\x9e\xba\x37\x88\x0d\x65\x65\x60\x67\x60\x77\x5e\x0e\xc5\x24\xb5\x9f\x6c\xbf\x54\xe2\xd7\xa3\x34\x1d\x50\x8b\x10\x6c\xe7\xd3\xf9\x3b\xe9\x66\x96\xb1\xcf\xcf\x4b\xf3\x80\xbd\xea\x82\x44\x95\xdf\x60\xce\x2a\x7d\xb6\x81\x4a\x47\x2d\x26\x35\xfe\xa4\x60\x95\x6e\x7e\xf2\x8e\xf5\x61\x7d\x4c\x27\x04\x8d\x14\xd4\xa7\x9c\x85\x4e\xe8\xb3\x56\x88\x4f\xfc\x1e\xc7\x51\x3c\x59\x5b\xe0\x7d\x3a\x66\xaa\xfc\x62\xda\xaa\x6f\xc9\x63\x3e\x1c\x52\xce\x06\xd7\x66\x05\x57\x0f\x32\x56\x65\x09\xbf\x24\x1c\xb6\x72\xc1\x7e\x54\x1d\x77\xb1\x75\x78\xa5\xdd\x5f\x61\xc3\xf5\x77\x61\x8f\x8b\x38\x53\x94\x29\xfd\xb1\x70\x85\xc4\x0d\xa8\x18\x28\xf0\x03\x60\x5b\x00\x12\x5c\xbe\x4f\x70\xcf\x0c\x9d\x21\x86\x95\x71\xfe\x0f\xf9\x76\xdb\x7b\xb0\xb7\xe1\x03\x25\xa8\x14\xb8\xff\x56\xf0\x35\x00\xc4\x5f\x09\x13\xce\x01\x00\x00")
 
 func assetsDnsClusterRoleYamlBytes() ([]byte, error) {
 	return bindataRead(
@@ -113,12 +116,12 @@ func assetsDnsClusterRoleYaml() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "assets/dns/cluster-role.yaml", size: 397, mode: os.FileMode(420), modTime: time.Unix(1, 0)}
-	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x84, 0xae, 0xd1, 0xba, 0xfa, 0x6b, 0xf8, 0x6e, 0x8d, 0x28, 0xc2, 0xa7, 0xaf, 0xc9, 0x3b, 0xc7, 0xcd, 0x80, 0xbe, 0xec, 0x98, 0xb4, 0x61, 0xa0, 0x9, 0xae, 0xa, 0xd8, 0xb2, 0x2e, 0x16, 0xf2}}
+	info := bindataFileInfo{name: "assets/dns/cluster-role.yaml", size: 462, mode: os.FileMode(420), modTime: time.Unix(1, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x6e, 0x5a, 0x46, 0x3c, 0x3f, 0x79, 0xe8, 0x53, 0x5a, 0xcc, 0xcd, 0xb9, 0x79, 0xfd, 0x3b, 0x34, 0xa1, 0x24, 0x9b, 0x57, 0xea, 0x3, 0xe8, 0x1c, 0x4e, 0x1f, 0x8c, 0x84, 0xd4, 0x8f, 0xb4, 0x19}}
 	return a, nil
 }
 
-var _assetsDnsDaemonsetYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xcc\x58\x5d\x73\xdb\xb6\xd2\xbe\xf7\xaf\xd8\xd2\x9e\x38\x79\x6b\x4a\x72\x12\xa7\x79\x99\xb8\xa7\xaa\x2c\xd7\x9e\x46\xb6\xc6\x52\x9a\x0b\x8f\x47\x03\x81\x2b\x11\x47\x20\x80\x02\x20\x6d\x8d\xed\xff\x7e\x06\xa4\x28\xf1\x43\x51\x9a\x73\xa6\x33\xcd\x85\x22\x63\x77\x1f\xec\x2e\xf6\x53\x0b\x26\xc2\x00\xce\x08\xc6\x52\x8c\xd0\xee\x11\xc5\xfe\x40\x6d\x98\x14\x01\x10\xa5\x4c\x3b\x3d\xde\xdb\x07\x41\x62\x3c\xca\x3e\x8d\x22\x14\x81\x88\x10\x38\x99\x22\x37\x40\x34\x82\x41\x0b\xc4\x82\x4e\x84\x65\x31\xee\x19\x85\x34\xd8\x03\xb0\x18\x2b\x4e\x2c\xba\xef\x00\xc5\x69\xf6\x1d\x75\xca\x28\x76\x29\x95\x89\xb0\x57\x24\xc6\x00\x42\x61\x56\x54\xa5\x99\xd4\xcc\x2e\x7b\x9c\x18\x93\x13\xcd\xd2\x58\x8c\x7d\x21\x43\xf4\xa9\x66\x96\x51\xc2\x57\xdc\x54\x0a\x4b\x98\x40\x6d\x0a\x74\x3f\xd3\xb4\x8c\x08\xb0\x0f\x2c\x26\x73\x04\x66\xea\xda\x16\x1c\x19\x7d\x98\x70\x3e\x94\x9c\xd1\x65\x00\x97\xb3\x2b\x69\x87\x1a\x0d\x0a\xbb\xe6\xb2\xa8\x63\x26\x88\x65\x52\x0c\xd0\x18\x27\xb2\x62\x3f\x27\x9c\x4f\x09\x5d\x8c\xe5\x27\x39\x37\xd7\xa2\xaf\xb5\xd4\x6b\x39\x2a\xe3\x98\x38\x57\xdf\x82\x47\xa5\xc6\x50\x18\x0f\xee\xd6\x64\xa2\xe7\x26\xa3\xf9\x54\x8a\x99\x77\x04\x5e\x1b\x2d\x6d\xaf\x38\xdb\x3d\xa9\x71\xc6\x38\x96\x45\x52\xc9\x93\x18\x07\xce\x81\x6b\xcb\x37\xb6\x3b\x18\x36\xf7\x73\xa6\x35\x15\x20\x76\xfc\x43\x62\xa3\x00\xca\x37\x94\x38\x34\x92\xf0\x5a\xf0\x65\x00\x56\x27\x1b\x51\x25\x75\xf5\x9e\xb5\xdf\x87\x52\xdb\x00\x4e\xde\x9c\xbc\x29\xa1\x34\x5f\xc0\xbd\xab\xb4\x92\x4a\x1e\xc0\xe7\xb3\xe1\xf7\x23\xf9\x96\xaa\xad\x68\xe3\xde\x06\xcd\x69\xcf\x04\x1a\x33\xd4\x72\x8a\x41\x89\x3f\xb2\x56\xfd\x86\xb6\x7c\x04\xa0\x72\x4f\x44\x48\xb8\x8d\xaa\x94\x4c\x97\xf7\x9d\xf7\x9d\xca\xb1\xa1\x11\x3a\x7d\x2e\xc6\xe3\x61\x89\xc0\x04\xb3\x8c\xf0\x33\xe4\x64\x39\x42\x2a\x45\x68\x02\x38\x2e\x8b\x2a\xd4\x4c\x86\xdb\x69\x26\xa1\x14\x8d\x19\x47\x1a\x4d\x24\x79\x18\xc0\x71\x89\x3a\x23\x8c\x27\x1a\x4b\xd4\xb2\x7b\x5c\x0c\xcb\xc4\x6e\x03\xe6\x2c\xc5\x7f\x88\x2b\xde\x75\x76\xa8\x7c\xf2\x3f\xb8\xe2\xa4\xf4\xf2\x46\x26\x9a\xa2\x29\x9b\xc5\x59\xcc\xac\xa9\x1a\x1a\x63\x2c\xf5\x32\x80\x93\xe3\xd7\x03\x56\x09\xfc\x3f\x13\x34\x75\x6e\xaa\x92\x00\x4e\x3a\xf1\x56\x88\x9f\x3a\x6b\x84\x22\xf1\x16\xc9\x14\x7d\x3d\x25\xd4\x57\x5a\x3e\x2c\xbf\xa3\x00\x65\x35\xa0\x94\x16\xbe\xcf\xe5\xdc\x4a\x63\x43\xd4\xba\x72\x6e\x90\x26\x1a\x7d\xce\x8c\x45\xe1\x93\x30\xd4\x68\xcc\x69\xf0\xff\xc7\x27\x6f\x2b\x7c\x96\x1b\x9f\x32\x15\xa1\xf6\x4d\xc2\x2c\x9a\xd3\xf1\xa7\xd1\xa4\xdf\x3b\xbb\xe8\x4f\x6e\x46\xdd\xc9\x97\xcb\xf1\xc5\xa4\xdb\x1f\x4d\x8e\x5f\xbf\x9f\xfc\xd6\x1b\x4c\x46\x17\xdd\xd7\x27\xef\x8e\x36\x5c\xfd\xde\xd9\x37\xf8\x1a\x38\xbd\x5f\x7b\x7f\x09\x67\x2b\xdf\x0e\xb4\x8a\x65\x89\x32\x56\x23\x89\x4f\x5d\x28\x07\xed\xf6\xf1\xeb\x9f\x5a\x9d\x56\xa7\x75\xec\x9c\xf0\xa6\xdd\xf4\x02\x6a\xeb\xbb\x0a\x7a\x9a\x55\x3d\xcb\x4d\x5b\x69\x96\x12\x8b\xee\x7b\x8b\x6a\xdb\x10\x59\xd1\xfd\x05\x2e\x77\x48\x2e\x70\xf9\x97\x4b\x64\xe5\x7d\x8a\xc2\x16\xa3\xd5\x8c\x9a\xdd\x61\xbc\x23\x34\x8f\xbf\x12\x9a\x6f\x3b\xa5\xe0\xfe\x5a\xaf\xa8\x77\x83\x92\x75\x5f\x53\xd4\xf9\xe6\x5b\xdd\xa2\xd4\x80\xf3\x96\xed\x8c\xe2\x29\xea\x7f\x4c\x3b\xce\x32\xc8\x8d\x18\x52\x58\x7c\xa8\x54\x42\x67\x3f\xe3\x38\xc7\xb0\xd6\x01\x77\x37\xdc\x48\x1a\x6b\xb2\x40\xd9\xd1\x6d\x33\xa6\x92\x13\x50\xa4\x70\xd5\x1d\xf4\x47\xfd\x9b\x3f\xfa\x37\xd9\x58\xd5\xfb\xf4\x79\x34\xee\xdf\x4c\xce\xae\x07\xdd\xcb\xab\x6d\xe3\x55\x21\x8e\x22\x6d\xaa\xe1\x90\x2e\x7b\xfd\x51\x49\x89\x7d\xe8\xb9\xe1\x03\xa4\x86\x7c\x7a\x33\xa8\x88\x26\x16\x43\x70\x15\x04\xe4\xac\x98\xc7\x4c\x45\xea\xea\x7a\xdc\x0f\xe0\x5c\x6a\x10\xf2\xfe\x08\x50\x98\x44\x23\xd8\x08\x0d\x66\x6a\x69\xe4\xc4\xb2\x14\xf3\xb9\xf0\x03\xcc\xa4\x06\x24\x34\xaa\x12\x8e\x2a\x98\x44\x00\xe1\x8c\x18\xb8\x67\x36\x72\x58\x75\x7b\x4d\x32\x9b\xb1\x07\xb8\x67\x9c\x03\xe1\x46\xc2\x14\x81\x84\x21\x86\xad\x12\x4e\x4a\x78\x82\x01\x78\x59\x8c\xf8\x1a\xe7\xcc\x58\xbd\x6c\x49\x85\xc2\x44\x6c\x66\xfd\x1a\xc1\xa4\xd4\x6b\x4c\x62\x25\xd7\xb5\xa7\x4c\xb4\xa7\xc4\x44\xe5\x22\x40\x4b\x7f\x3c\x95\x8d\xf8\xa1\xc9\x0e\xd9\x1b\xf9\x89\x04\xc5\x14\xba\x2e\xb5\x57\xee\x77\x9a\x28\x38\xfc\xb7\x9c\x1a\xf0\x15\x3c\xc1\x83\xab\xf4\xb0\x70\x26\x3e\x3d\x65\x31\xf6\x01\xee\x09\xb3\x1f\x00\x1f\x98\x85\xce\x21\x8c\xfb\x37\x83\x32\xc2\xf5\xb0\x7f\x35\xba\xb8\x3c\x1f\x4f\x06\xdd\x9b\xdf\xfb\x37\xa7\xde\xc6\xd6\x39\x0a\xcc\x5e\xb3\x9a\x6a\x5e\x49\xfc\xe2\x7a\x34\x1e\x4d\xce\x2f\x3f\xf5\x4f\xbd\x4d\x1c\x96\x39\xc6\xfd\xc1\xb0\xc1\xd0\xb2\xb1\xf2\xca\x6a\x5c\x9e\x8f\x4e\x0f\x8f\xe0\x30\xcb\x7a\xf0\x35\xf8\x64\x1d\x3a\xf0\xf1\xe3\x47\xf0\x0e\x1e\x8b\x00\x7c\xae\x48\xee\xc3\x80\x2c\x10\x48\xb6\x13\x48\x4d\xf4\x12\x5c\xaa\x6c\xc2\x40\xf2\x30\x4f\xa1\xec\xfc\xd0\x00\xb1\x56\xb3\x69\x62\xd1\x94\x5f\x9e\x2a\xf0\x67\xe0\xfb\x1b\xaa\x2f\x05\x5f\xba\x8b\x37\x46\x3e\x7b\xee\xef\xb5\x49\x55\x4d\xee\x23\x77\x6f\xee\xf4\x50\x56\x4a\x67\x88\x94\xbb\xc0\xf6\xbb\x60\x52\x3a\x61\xca\x54\xc8\x2e\xbe\x4d\x4a\x81\x09\x07\x5f\xd8\x7d\xfb\xcb\xdd\xb3\xd7\x80\x72\x16\x9f\xa3\xa5\x51\xe1\x1f\xb8\x1c\xc2\x4c\xcb\x18\x28\x4f\x8c\x45\xed\x6a\x23\xb0\x19\xa8\xbc\xa0\xb5\xe0\x0b\x42\xec\x5c\x64\x30\x45\x4d\x38\x58\xcd\xd0\x34\x30\xad\x84\x50\x02\xb3\x01\x5c\x0e\xd3\xb7\x47\xee\xf3\x5d\xf6\xf9\x16\x64\x8a\xda\x8d\xc2\x59\x15\x71\xe7\xeb\x93\x16\x8c\x23\x04\x7b\x2f\x81\x13\x97\xef\x62\x0b\xb0\xb3\xdb\x19\x18\xa2\xe2\x72\x19\xa3\xb0\xab\x1c\xfd\x3d\xd1\x4b\x0d\x52\xb8\x17\x42\x0d\xd7\x0a\xc5\xc8\x12\xba\x80\x97\xd7\xa3\xe1\xf1\x9b\x57\xe0\x83\x8d\xa4\x41\xa7\x97\x90\xb6\x01\x6c\x12\xe5\xfa\xa2\x1b\xf9\x81\x4b\x12\x4e\x09\x27\x82\xa2\x36\x99\x9e\xae\xb1\xb1\xac\x96\x10\x1a\x31\x31\x87\xb3\xab\x11\xd8\x48\xcb\x64\x1e\x65\xaa\xd7\xf0\x68\x1c\x9a\xd3\x97\x87\x21\x9b\x83\x6f\xa1\x0b\xbf\x78\x07\x8f\x9b\x02\xfa\xec\xc1\x8f\x26\x72\xb7\xb9\x07\x4a\xe9\x73\xeb\xe0\xb1\x5a\x5f\x9e\xbd\xc3\x1a\x62\xfe\x6f\x8d\xd8\xed\xfe\x0d\xa0\xf0\xa3\xa5\xea\xef\xd1\xf5\xbf\x45\x7e\x55\x83\x76\x6f\xcf\x5c\x68\x1f\x3c\xfe\xe0\x9c\x7c\xfb\x7f\x77\xcf\x35\x96\x46\x88\x03\x30\x65\x4e\x5f\x1e\xbc\xc4\x94\x70\x77\x59\x26\xc8\xee\x9e\xbd\x57\x75\x78\x70\xb1\x7e\x7b\x0b\xde\xc1\xbf\x3c\xf0\xf1\x4f\xe8\xc0\x8b\x17\x4e\x64\x9f\xa9\x3c\x85\xc0\x17\x08\x1d\xb8\xbb\xfb\xe0\xea\x81\xd8\x62\xf9\x2a\x27\x6f\x57\x56\x79\x77\xa7\xde\xc1\x63\x21\xbe\x85\x7f\xaa\x91\x2c\x1a\xe7\x33\xd6\x30\x4b\xe0\x5e\xe3\xa0\x72\xb2\x0f\x9f\x55\x48\x2c\x96\x9a\x38\x64\x65\x87\xcd\xe0\x1e\x61\x8e\xd6\xb5\x24\x16\x96\x92\xdd\xd4\x00\xbe\x60\xde\xd3\x84\xb4\x90\x34\xc0\xee\x23\x14\xce\x6c\x9d\x4d\x44\xab\x9d\x7c\x8d\x26\x13\xeb\x66\x25\xa9\x81\x28\x06\x89\x20\x29\x61\x9c\x4c\x19\x67\x76\x59\xbb\x66\x64\x09\x47\x40\x91\x55\x0f\xa0\x32\xe1\xa1\x6b\x2a\xc6\xba\xa7\x2d\x5d\xc8\x66\x59\xd5\x2d\x6e\x60\x06\x42\xe4\x68\x31\xdc\xdb\xf6\x66\x8f\xfb\x85\xef\xbf\xfd\x52\xfb\xf0\x6b\xc2\x78\x08\x04\x04\xde\x97\x4a\x7a\x5e\xfd\xca\x36\xbb\xea\x22\x13\x0d\x34\x31\x56\xc6\x6b\xa5\x67\x8c\x5b\xd4\x18\x3a\xb3\x6b\xd8\x73\x8d\x0a\xfc\x14\xbc\x7d\x38\x78\xac\xf7\xc4\xbc\xea\x57\xba\xc0\xcf\x3b\xfa\x40\xae\x6b\x57\x29\xcc\xca\x50\xde\x34\x37\x4a\xb8\x5a\xdf\x1c\x8a\xa0\xd1\x06\x7e\x28\x7b\x66\x4b\x1b\x58\x65\x96\xca\x53\xab\x60\xce\x23\xf8\xee\x79\xab\x00\x00\xd2\x48\x42\x16\xdc\xcf\xb9\x50\xf1\x5f\x33\x93\xe1\x2b\xae\xf8\xb9\x61\x7b\xfd\x92\x46\xdc\x6f\x8b\x7c\xe7\xa3\xf1\xf5\xd9\x75\xb0\x25\x03\x88\x95\x31\xa3\x84\xf3\xa5\x6b\x4b\x24\x95\x2c\x04\x22\x96\xc0\x04\x95\xc2\x64\xbb\xa9\x85\x29\x46\x24\x65\xa5\xc9\xbb\x40\xbd\x41\xc5\xdd\x30\xba\x2d\x22\x62\x19\xb2\x19\xc3\x10\xd2\xfc\xa7\x48\x17\x88\x02\x31\xac\x85\xa7\x6b\x07\xaa\x66\x66\x23\x06\x9e\x9e\x56\x43\xc3\x6e\xbe\xa6\xd5\x05\xaf\x4b\x0e\x97\xb5\x1a\x63\x99\x62\xb8\xb1\x35\x8b\x6a\xaa\xd1\xad\x82\x79\xf6\x64\x2d\x6d\x33\x9a\x00\x95\x6a\x09\x34\x4a\x74\x35\x49\x6a\x25\xc8\x70\x44\x05\xef\x3a\xf0\x22\x9b\x02\x2b\xb4\x44\xb8\xc1\xb2\x39\x8d\x54\x1e\xef\x7b\x57\xc6\x93\x62\x63\x0c\x85\x29\xd6\xa5\x33\x9c\x91\x84\x17\x97\xbb\x49\x72\x84\x1c\xa9\x95\x7a\x03\xb0\x48\xa6\xa8\x05\xba\x91\x8c\xc9\xb6\x34\x01\x70\x26\x92\x87\x9c\xb8\xe2\xca\x97\xa4\xc6\x4f\xb1\xdb\x7f\x8e\xcc\x4f\x07\x44\x05\xa5\x9d\xe8\x8a\xc4\xbb\xf6\x42\x00\x66\x31\xae\x98\xe5\xc3\x02\x97\x01\x14\x3f\x92\x6e\xf9\x55\xab\x46\xda\xb1\xb3\xb9\xa3\x6c\x61\xdb\xab\x63\x6c\x59\xe0\x00\xec\x52\x61\x00\xe7\x4d\xe8\x6d\xdb\xf2\xbe\x5b\x3b\x35\xda\x9d\x16\x5a\xc9\xdd\x38\xcf\xa4\x58\xdb\xb8\x9f\x4d\x45\x2e\x01\x8c\x8b\x3e\x9d\x08\x70\x43\xe2\xf2\xde\x35\x8c\x16\x8c\x73\x09\x04\xc2\x39\x58\xc2\xc4\x5a\x43\x1f\xa4\x72\x24\xa9\x03\xe8\xbb\x2e\x60\xf6\xfe\x13\x00\x00\xff\xff\x6c\xee\x06\x32\xf0\x17\x00\x00")
+var _assetsDnsDaemonsetYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xb4\x5a\xef\x72\xdb\x38\x92\xff\xee\xa7\xe8\xa1\x9c\x38\xd9\x11\xa5\x38\x33\x9e\xbd\x63\xc6\x73\xab\x95\x95\xb5\x6b\xfd\x47\x65\x29\xb3\x75\x97\xf3\xa9\x20\xb2\x65\x62\x0d\x02\x0c\x00\x4a\x56\x39\x7a\xf7\xab\x06\x45\x8a\xa4\x64\x25\xbe\xb9\x2d\xb9\x54\x22\xba\xd1\xe8\x6e\xf4\xaf\xbb\x01\xfa\x81\xcb\x28\x80\x33\x86\x89\x92\x23\xb4\x07\x2c\xe5\xbf\xa3\x36\x5c\xc9\x00\x58\x9a\x9a\xee\xfc\xf8\xa0\x05\x92\x25\xd8\x76\xdf\x26\x65\x21\x02\x93\x11\x08\x36\x45\x61\x80\x69\x04\x83\x16\x98\x05\x9d\x49\xcb\x13\x3c\x30\x29\x86\xc1\x01\x80\xc5\x24\x15\xcc\x22\xfd\x06\x28\x46\xe9\x63\x50\xcf\x79\x88\xbd\x30\x54\x99\xb4\xd7\x2c\xc1\x00\x22\x69\xd6\xd4\x54\x73\xa5\xb9\x5d\xf6\x05\x33\x26\x27\x9a\xa5\xb1\x98\xf8\x52\x45\xe8\x87\x9a\x5b\x1e\x32\xb1\xe6\x0e\x95\xb4\x8c\x4b\xd4\xa6\x90\xee\x83\x6c\x48\x04\x68\x01\x4f\xd8\x3d\x02\x37\x4d\x6d\x0b\x0e\x47\x1f\x66\x42\x0c\x95\xe0\xe1\x32\x80\x8b\xd9\xb5\xb2\x43\x8d\x06\xa5\x2d\xb9\x2c\xea\x84\x4b\x66\xb9\x92\x57\x68\x0c\x4d\x59\xb3\x7f\x64\x42\x4c\x59\xf8\x30\x56\x97\xea\xde\xdc\xc8\x81\xd6\x4a\x97\xf3\x42\x95\x24\x8c\x5c\xfd\x19\xbc\x50\x69\x8c\xa4\xf1\xe0\xae\x24\x33\x7d\x6f\x1c\xcd\x0f\x95\x9c\x79\x6d\xf0\xba\x68\xc3\xee\x9a\xb3\xdb\x57\x1a\x67\x5c\x60\x75\xca\x5c\x89\x2c\xc1\x2b\x72\x60\x69\xf9\xc6\x76\x12\xc3\xef\xfd\x9c\xa9\xa4\x02\x24\xc4\x3f\x64\x36\x0e\xa0\xba\x42\x85\x43\x23\x8b\x6e\xa4\x58\x06\x60\x75\xb6\x99\x9a\x2a\x5d\x5f\xa7\xf4\xfb\x50\x69\x1b\xc0\xc9\x4f\x27\x3f\x95\x54\xd8\xb1\x03\x00\xa9\x56\x56\x85\x4a\x04\xf0\xe9\x6c\xf8\x72\x49\xbe\x0d\xd3\x9d\xd2\xc6\xfd\x8d\xb4\x16\xc4\xc8\x84\x8d\x5d\x88\x92\x29\xcb\x8d\xf8\xdc\x06\x47\x49\xb5\x9a\x62\xf1\xbc\x1d\xc1\x85\x34\x12\xc0\x25\x1a\x33\x24\xfe\x8d\xf5\x00\xb1\xb5\xe9\xdf\xd0\x56\x87\x00\xd2\xdc\xaf\x34\x6b\x59\x23\x98\x30\x46\xda\x95\xf3\xf1\x78\xa3\x2a\x00\x97\xdc\x72\x26\xce\x50\xb0\xe5\x08\x43\x25\x23\x13\xc0\xf1\xbb\x0a\x47\x8a\x9a\xab\x68\x37\xcd\x64\x61\x88\xc6\x8c\x63\x8d\x26\x56\x22\x0a\xe0\xb8\x42\x9d\x31\x2e\x32\x8d\x15\x6a\xd5\xa9\x84\x53\x95\xd9\x5d\x82\x05\x9f\xe3\x8b\x4d\xce\x9d\xfe\x7f\xb6\xf9\x97\xef\xb5\xb9\xa9\xf7\xc9\x1f\xf0\xc7\x66\xae\x46\xa3\x32\x1d\x62\x25\xbe\xc9\x0f\x09\xaf\x46\x3c\x7d\x12\x4c\x94\x5e\x06\x70\x72\xfc\xfe\x8a\x57\x28\x1a\xbf\x64\x68\x9a\xdc\x61\x9a\x05\x70\xf2\x2e\xd9\x29\xe2\xcf\xef\xae\x78\x23\x5f\x3d\x64\x53\xf4\xf5\x94\x85\x7e\xaa\xd5\xe3\xf2\x05\xb9\xcb\xa5\x8f\xf2\xc9\x07\xdf\x17\xea\xde\x2a\x63\x23\xd4\x9b\x1c\x44\xe3\x06\xc3\x4c\xa3\x2f\xb8\xb1\x28\x7d\x16\x45\x1a\x8d\x39\x0d\xfe\xfd\xf8\xe4\xe7\x1a\x9f\x15\xc6\x0f\x79\x1a\xa3\xf6\x4d\xc6\x2d\x9a\xd3\xf1\xe5\x68\x32\xe8\x9f\x9d\x0f\x26\xb7\xa3\xde\xe4\x1f\x17\xe3\xf3\x49\x6f\x30\x9a\x1c\xbf\xff\xb7\xc9\xdf\xfa\x57\x93\xd1\x79\xef\xfd\xc9\x2f\xed\x0d\xd7\xa0\x7f\xf6\x0d\xbe\x2d\x39\xfd\xbf\xf6\xbf\x4b\xce\x4e\xbe\x3d\xd2\x6a\x96\x65\xa9\xb1\x1a\x59\x72\x4a\x10\x0e\xba\xdd\xe3\xf7\x7f\xee\xbc\xeb\xbc\xeb\x1c\x93\x13\x7e\xea\x6e\x7b\x01\xb5\xf5\x29\xf9\x9e\xba\x84\x69\x85\xe9\xa6\x9a\xcf\x99\xc5\xae\x15\xa6\x13\x6a\xbb\x35\x65\x4d\xf7\x1f\x70\xb9\x67\xe6\x03\x2e\xbf\x3b\xbb\xd6\xf6\xa7\xc8\x89\x09\x5a\xcd\x43\xb3\x3f\x8c\xf7\x84\xe6\xf1\x33\xa1\xf9\xf3\x26\x34\x9f\x2f\x33\xcd\x42\x52\xb1\xee\x39\x45\xc9\x9d\xdf\x2a\x34\x05\x16\x22\x69\xf2\x6a\x4f\x46\x89\x39\xea\x17\xa0\xe1\x5f\x5b\xc9\x1d\x82\xa8\x3b\x51\xd2\xe2\x63\x2d\x1d\x92\xfd\x5c\xe0\x3d\x46\x8d\xe2\xb9\xbf\x56\xc7\xca\x58\xe3\x02\x65\x4f\xa1\x76\x4c\x25\xbd\x05\x28\xe7\x70\xdd\xbb\x1a\x8c\x06\xb7\xbf\x0f\x6e\xdb\xd0\xbf\xfc\x34\x1a\x0f\x6e\x27\x67\x37\x57\xbd\x8b\xeb\x36\x0c\x6f\x2e\x2f\x27\x17\xd7\xe3\xc1\xed\xef\xbd\xcb\x36\x9c\xdf\x8c\xc6\x93\xde\xe5\x45\x6f\x34\x18\xb5\x5d\x09\xb4\xf1\x46\xbf\x16\xe4\x6e\xee\x50\xdb\x00\x1a\x85\x62\x11\xcc\x99\xe6\x6c\x2a\x70\x6f\x85\x44\x39\xdf\x36\x88\x74\xba\xe8\x0f\x46\x25\x81\x56\xe8\x53\x07\x04\x4a\x43\xde\x42\x1a\x4c\x99\x66\x16\x23\xa0\x5c\x04\x6a\x56\x34\x85\xd5\x10\x69\xc1\xf5\xcd\x78\x10\xc0\x47\xa5\x01\x59\x18\x93\x6a\xcc\xf2\x39\xae\x3b\x52\x26\x81\x09\xce\x0c\x2c\xb8\x8d\xc1\xc6\xd8\x70\x03\x98\x6c\x36\xe3\x8f\x35\x89\x0b\x2e\x04\x30\x61\x14\x4c\x11\x58\x14\x61\xd4\x81\xde\xd4\x28\x91\xd9\x5c\xac\x81\x37\x28\x23\x2e\xef\x81\x4b\xf0\x3a\xde\x5b\x67\xff\x3a\x0e\x23\x60\xc6\xe7\xa6\x53\x13\xd9\x8b\x22\x4e\x1d\x21\x13\x6b\x01\x33\xad\x12\xa7\xce\xd9\xf5\xc8\x35\xbe\x4e\x04\x4b\x53\x94\x11\x46\x15\x3f\x56\xe5\xcc\x99\xc8\x30\x00\xcf\x85\xaf\xaf\xf1\x9e\x1b\xab\x97\x1d\x95\xa2\x34\x31\x9f\x59\xbf\x41\x30\xf3\xd0\xdb\xea\x2f\xcb\x01\x1f\xba\x53\x2e\xbb\x53\x66\xe2\xca\x98\x1f\x56\x1e\xbe\x96\xbf\x01\x5a\x3f\x6c\xb3\x53\xac\x5b\xf0\x33\x05\x29\x4f\x91\x1a\x8a\x83\x0a\xcd\x6a\x96\xc2\xd1\x3f\xd5\xd4\x80\x9f\xc2\x57\x78\xa4\x22\x04\x0f\xe4\xdd\xaf\x5f\x5d\xf8\x7f\x80\x05\xe3\xf6\x03\xe0\x23\xb7\xf0\xee\x08\xc6\x83\xdb\xab\xaa\x84\x9b\xe1\xe0\x7a\x74\x7e\xf1\x71\x3c\xb9\xea\xdd\xfe\x7d\x70\x7b\xea\x6d\x6c\xbd\x47\x89\x2e\x3c\xea\x59\x60\x63\x30\x80\x8b\xe6\x72\xea\xe1\x53\x53\xdc\xca\x77\xb1\x51\x9d\x42\x28\x18\x4d\x3e\x5e\x5c\x0e\x4e\xbd\x0d\xaa\xaa\x1c\xe3\xc1\xd5\x70\x8b\xa1\x63\x93\xd4\xab\x6a\x7e\xf1\x71\x74\x7a\xd4\x86\x23\x97\xc3\xc0\xd7\xe0\xb3\x32\x7c\xe1\xd7\x5f\x7f\x05\xef\xf0\xa9\x00\xc1\xaa\x36\xb3\x05\x57\xec\x01\x81\xb9\xc3\x91\xd2\x4c\x2f\x81\x80\xbf\x09\x60\x25\x22\x70\x8b\xba\xf1\x23\x03\xcc\x5a\xcd\xa7\x99\xc5\x5a\xd0\x85\x29\xf8\x33\xf0\xfd\x0d\xd5\x57\x52\x2c\x69\xe1\x8d\x91\x2b\x8f\x9e\x4b\x93\x9a\x9a\xdc\x62\xa8\x74\x04\x4c\x02\xce\x51\x5a\x50\x12\x6c\xcc\x0d\xa4\x2a\x02\x36\x55\x99\x05\x06\x61\xcc\xe4\x3d\x12\x3c\x49\x39\xda\x8b\x23\x53\x4d\x16\x55\x9d\x34\x52\x19\x9b\xe4\xd4\x09\x51\x27\xf9\xf4\x37\x6f\xe1\xa9\xc2\x07\x20\x54\x48\x58\x51\x8b\xda\xa8\x54\x8b\x53\xef\xf0\x4d\xc4\x2c\x82\x9f\xc1\x8f\xaf\xfe\xd3\x7f\x95\xf8\xaf\xa2\xf1\xab\xf3\xe0\xd5\x55\xf0\x6a\xf4\x5f\x6f\xab\x5b\x05\x90\x6a\x2e\xed\x0c\x8e\x9e\xbc\xcd\xb1\xd5\x0b\xbc\xf9\xb1\xd7\xf6\xe8\x60\xeb\x05\xde\x80\x2c\xf3\xda\x5e\x82\x96\x45\xcc\x32\x2f\x78\xf2\x8a\xd8\xa2\xa3\xa5\x17\x78\xaf\x4c\xc7\x6b\x7b\xe5\xe1\xd6\x8d\x78\xab\xb6\xc7\xe5\xdc\x21\xfe\x66\xfa\x4f\x0c\xad\x17\xec\x59\x65\xa8\xa2\xb5\x88\x7c\xf6\x2e\x71\x1a\x99\x71\x13\x3f\xad\x1b\x90\x5b\xe7\xa7\xbe\x92\xb3\xbe\xf3\x12\x89\x48\xf2\x4a\x54\x48\xb1\xcb\x94\x04\x5c\x2b\x9d\x30\xe1\xb5\xbd\xbc\x4d\x25\x55\x42\x95\xa4\x4a\x92\x6d\x81\xb7\x55\x2b\xbd\xb6\x47\x21\x54\x2e\x3d\xe3\xda\xd8\x31\x4f\xd0\x58\x96\xa4\x85\x70\xc1\x76\x0c\xba\x03\xb9\x17\x1c\xaf\x8e\xe0\xbf\x6b\xbe\x06\x0a\xa5\xe1\xcd\xd9\x84\x8a\xce\xca\xab\x3e\x8d\x86\xbd\x7e\x63\xe8\x39\x86\x63\xfa\x7a\xba\xbe\x39\x1b\x54\xd8\xa4\x5a\x54\x7f\x54\xd3\x12\x7d\x54\x08\xa1\x46\x17\x14\xfe\xba\x9b\xf1\xd7\xe7\x80\xd3\x13\xe3\x60\x00\xbf\x75\x23\x9c\x77\x65\xb6\x49\x3e\x15\x21\xab\x6a\xd8\x2f\x62\x82\x1b\x71\x7c\x80\x48\x55\x08\x00\x11\x86\x82\xf2\xb4\xdf\x03\x33\x0f\x27\x3c\x35\xa7\x6f\xde\xd6\x38\x66\x54\xbe\xe6\xa1\x2b\x0c\x87\x4f\x05\xe2\x3f\xff\xe5\x6e\xe5\x6d\x49\x23\x84\x35\xea\xca\x76\x1d\xf9\x50\xaf\x68\x75\x96\x2d\x71\x9c\x00\x8a\x10\x8a\xcc\x58\xd4\x10\xa9\x84\x71\x59\x45\x20\x7d\xf8\x0c\x3e\x7f\x26\x6f\x9a\x79\xb8\xf2\xe0\xf4\x14\xfe\xd4\x81\xbb\xbb\x0f\x34\x55\x36\x78\x01\x66\x5f\x22\x79\x5a\x30\x37\xa8\x28\x0c\xee\x9d\xd0\x39\x7c\xaa\xd7\xdb\x2d\x11\x33\xde\x18\x68\xc1\x47\xb4\x61\x5c\x64\x4b\xb8\x18\xe6\xc5\xb2\xb4\x49\x1a\xe0\x33\x48\xf3\x6b\x97\x0e\xfc\x03\x21\xa1\x84\x69\x70\x8e\x9a\x09\xb0\x9a\xd7\x3a\x04\xfa\x6b\x81\x55\x10\x29\xe0\x36\x80\x8b\xe1\xfc\xe7\x36\x7d\xff\xe2\xbe\x7f\x06\x35\x47\x0d\xe3\xfe\xd0\xf5\x3c\x34\x5e\x8e\x74\x60\x1c\x23\xd8\x85\x02\x42\x02\x28\xb9\x43\x30\x6d\x07\x6d\x7a\x84\xa9\x50\xcb\x04\xa5\x5d\xf7\x1a\x7f\xcf\xf4\x52\x53\xc6\x54\x22\x42\x0d\x37\x29\xca\x91\x65\xe1\x03\xbc\xb9\x19\x0d\x8f\x7f\x7a\x0b\x3e\xd8\x58\x19\x24\xbd\xa4\xb2\x5b\x82\x4d\x96\x52\xb2\xa4\x9b\x10\xa0\x6e\x6b\xca\x04\x93\x21\x6a\xb3\xbe\xb8\xf8\x92\x71\x17\x2c\x2c\x8c\xa9\x13\xa1\x3e\xc2\xc6\x5a\x65\xf7\x31\x19\xd3\xdc\xf3\x30\x89\xcc\xe9\x9b\xa3\x88\xdf\x83\x6f\xa1\x07\x7f\x21\x90\x95\xcd\xe1\xca\x83\x1f\x4d\x4c\xab\x79\x87\x4f\xb4\x7f\x2b\xef\xa8\x21\x20\xff\x2b\x05\xf4\x7a\x7f\x5c\x06\xfc\x68\xc3\xf4\xff\x45\x93\xef\x14\x54\x87\x2a\xb8\x7d\xe3\x04\xd5\xc3\xa7\x1f\xc8\x41\x9f\xff\x74\xb7\x6a\xb0\x6c\x41\x16\xc0\xa1\xfe\xf0\x0d\xce\x99\x20\xd9\x6e\x22\xbf\x5b\x79\x6f\x9b\xe2\x37\x48\xfb\x0f\x0f\x7c\xfc\x02\xef\xe0\xf5\x6b\x9a\xd2\xe2\x69\x9e\x12\xc0\x97\x08\xef\x9e\xc7\x1e\x14\x69\xe6\x73\x01\xc0\x3b\x42\x56\x31\x7d\x07\xff\x54\x23\x7b\xd8\x1a\xdf\x02\x59\xa4\x64\x1d\xb8\x6e\xa0\x36\xd2\x82\x4f\xa9\x2b\xb4\x05\x10\x51\x3a\x68\x91\xbf\x36\x8d\x0f\xb8\x9e\x82\xcf\x60\x81\x70\x8f\x16\xe6\x4c\xf0\xa8\x82\xdd\x3a\x60\x5a\x84\x56\xd7\x5e\x4b\x65\x21\xcb\xe5\xdb\x18\x13\x58\xc4\xe8\x52\x97\x76\xc7\xb6\xf5\x9d\x63\x29\x47\x65\x96\x0e\x74\x4a\x03\x4b\x39\x64\x92\xcd\x19\x17\x6c\xca\x05\xb7\x9b\x13\x32\x7d\x5a\x30\xb2\x4c\x6c\x74\x0d\x55\x26\x22\x6a\x2f\x8d\x6d\xe8\xcd\xf3\x7e\xa5\x58\x81\x1b\x88\x50\xa0\x6d\xe4\xd4\x16\x9c\x2b\x63\xf3\x23\xc4\x3a\xf7\x1a\xcb\x2c\x0f\x1d\x08\x09\xfb\x4c\x2c\xd8\xd2\xac\x6d\x89\x0e\x76\xed\xfe\x53\xab\xd8\xc5\x6f\xef\x79\x0b\xfe\x9a\x71\x11\x01\x03\x89\x8b\x4a\x9b\x97\xe7\xc0\x8a\xfe\x2e\xc7\xa8\x4c\x43\x98\x19\xab\x92\xd2\xe2\x19\x17\x16\x35\x46\xa0\xb2\x66\x4e\xb9\xd7\x98\x82\x3f\x07\xaf\x05\x3b\x7a\x61\x6f\xab\x33\xfc\x6d\x4f\x6f\xb8\xae\x5e\xee\xbc\x52\x94\x23\xbd\x51\x42\xe9\xb2\xdb\x6d\x4c\xaa\x17\xc8\x1f\xaa\x9e\xd9\x51\x20\x5f\x5a\xac\xf2\x8b\x0f\xb3\x2e\x3f\xaf\x3a\x3b\x30\xb2\xb3\x66\xd5\xe7\xad\xe0\x7b\xab\xd7\x0e\x68\xad\xd3\x4a\x4a\xf1\xe6\xc4\x38\xfc\xba\x5f\xab\xbb\xd5\x4e\x1b\x01\x30\x8c\x15\xf9\x83\xa7\xb4\xb6\xd3\x65\x05\xcf\x6c\xd3\x6f\x5b\xfb\x52\x48\x79\x16\xdd\x3b\x86\x76\xb8\xe1\x85\xb1\x47\xc0\xa7\x00\x8c\xab\x08\xf9\xde\xf0\xab\x1e\xcd\xbe\x27\xf4\x1a\x2e\x3f\xd8\x19\x87\x55\x4d\xda\x54\xae\x21\x45\x0d\x82\x4b\x77\x3c\xa9\x5e\x70\xd4\xe6\xe7\x0d\x5f\x71\x50\x73\x58\xdf\xb1\x4d\x79\x20\xfa\xae\xaf\x73\x3c\x2b\xef\xf9\x38\x2c\xf6\x33\x67\x84\x1d\x16\x7f\x6b\x17\x1b\x71\x45\xfb\x57\x9c\x1b\xab\x86\x04\x7e\x13\x97\x2d\x18\xdf\x9c\xdd\x04\x45\xf6\xae\x6c\x1b\xb3\x2a\xa1\xd7\x62\x62\x49\xed\x10\x9b\x2b\x4e\x47\xbb\x25\x70\x19\x2a\x69\xdc\x7d\xaf\x85\x29\xc6\x6c\xce\x2b\xb7\x59\xc5\x49\x30\x15\x2c\xac\x89\x2b\x33\x50\xa2\x22\x3e\xe3\x18\xc1\x3c\x3f\xfa\x10\x66\x25\x62\xd4\xc8\xa5\x61\x92\x36\x4c\xde\xda\xf6\xaf\x5f\xd7\xc7\xd6\xfd\x7c\x0d\xdd\x4a\x4e\x2a\x1d\x54\x58\x34\x26\x6a\x8e\xd1\xc6\x4a\x17\xc5\xf9\x11\xa1\x9b\xa7\xf9\x3c\x7f\x97\x47\x63\x08\x55\xba\x84\x30\xce\xb4\x6c\x7a\xb3\x4f\x94\xdd\x47\x5b\x87\x74\x7a\xf3\x46\xcd\x97\xab\x60\xdc\xae\xcf\xc3\xa6\x4d\xc4\x86\x28\x7c\x64\x49\x2a\x10\xd8\x8c\x1a\x59\x06\x67\xe7\xfd\x21\x68\x94\xb8\x60\x22\xbf\x72\x73\x9d\x6c\x21\x71\x7d\xcb\x66\x14\xd8\x98\x59\xe0\x75\x40\xb5\x68\x81\x05\xd3\x91\x21\x3b\x9d\x82\xb8\x80\xe2\xee\xba\xcc\xc9\xb5\x3b\x81\x7a\x14\x7f\x1a\x8e\xc6\xb7\x83\xde\xd5\xe4\x76\x30\xba\xb9\xfc\x7d\xd2\xbf\xb9\xfe\x48\xf1\x04\x77\x77\xf0\xfa\x35\xfc\xe0\x76\xcc\x37\x85\xf7\xab\x6c\x2b\xef\x39\x01\x2b\x6f\x27\x24\xf8\x6c\xb3\xb3\x2f\x10\xe6\xee\x55\x48\x99\x64\xbe\x9e\xbc\x87\xed\x65\x1a\xad\x53\x3e\x6a\x72\x12\xdd\x2a\xb0\xc5\x03\x1c\x75\xff\x67\x33\xda\x85\x27\x77\x7f\x00\x87\xef\x57\x47\xcf\xae\xed\x15\x37\x5b\x6f\xbd\x67\xd2\x00\x05\x4e\x2d\x6c\xf2\x10\x89\x3e\xac\x77\x98\xda\xf7\x32\x8a\xb8\x8d\xab\x8a\x05\x45\x39\xc8\x1f\xb7\xd2\x04\x40\xea\x2e\xd4\xfc\x4f\xa3\xdb\x63\xf0\x1f\x61\xfb\x75\xed\x37\xae\x5e\xc0\xbb\x75\x71\x86\x51\xa9\x44\x1e\x9f\x0e\xea\x55\xb5\xd5\x0c\x9c\x29\xd5\xe3\xf9\x3a\xdc\xa3\x0f\x2f\x51\xba\x91\xdb\x1a\x8f\x46\x20\xba\x4c\x51\xbb\xa0\x5e\x79\xf0\xda\xdd\x16\xd6\x78\x33\x49\x17\x90\xeb\x22\x7b\xf0\x4c\xb9\x7b\xe9\x5b\x8f\x93\xe2\xa5\x47\x24\x4d\x71\xe3\x7f\x86\x33\x96\x89\x02\x81\xe4\x86\x11\x0a\x0c\xad\xd2\x1b\x01\xf4\x76\x4e\x4b\xb4\x68\x3a\x5c\x75\x95\x09\xa8\xf4\x64\x8f\x44\x02\x58\x73\xe5\xf7\xfc\xe5\xaa\xfb\x5f\xc6\xe7\xa3\x57\x2c\xdd\xac\xd1\x02\xba\x93\xda\xf3\x6a\x03\x80\x5b\x4c\x6a\x66\xf9\xf0\x80\xcb\x00\x8a\x7f\x11\xd8\xf1\x76\xb6\x41\xda\xf3\xda\x81\x86\x86\x34\xe7\xa0\x29\x63\x53\x19\x2a\x24\xba\x9e\x0a\xe0\xe3\xb6\xe8\x5d\x2f\x7c\x5a\x60\x30\xd4\x68\xf7\x5a\x68\x95\xa0\x6b\x5f\xae\x64\x69\x63\x0b\x08\x39\x54\x71\x5c\x2a\xd4\x99\xbb\xae\xd4\xcb\x45\x8c\x1a\x3b\x30\xce\x67\x50\xab\x2e\x80\x5e\x99\x95\x1a\xfa\xa0\x52\x22\x29\x1d\xc0\xe0\x91\x1b\x6b\x0e\xfe\x77\x00\x2e\xc7\x0a\xe8\xee\x22\x00\x00")
 
 func assetsDnsDaemonsetYamlBytes() ([]byte, error) {
 	return bindataRead(
@@ -133,12 +136,12 @@ func assetsDnsDaemonsetYaml() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "assets/dns/daemonset.yaml", size: 6128, mode: os.FileMode(420), modTime: time.Unix(1, 0)}
-	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x3a, 0x10, 0x64, 0xe4, 0x12, 0x1e, 0x52, 0xa5, 0xeb, 0x20, 0xbe, 0x53, 0xbd, 0xad, 0x9b, 0xaa, 0xce, 0x29, 0x63, 0xe4, 0x2, 0xed, 0x4b, 0x75, 0xb2, 0xe8, 0x60, 0x30, 0xac, 0x25, 0xce, 0xd2}}
+	info := bindataFileInfo{name: "assets/dns/daemonset.yaml", size: 8942, mode: os.FileMode(420), modTime: time.Unix(1, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0xbc, 0x76, 0xda, 0xd4, 0x59, 0xc0, 0x1f, 0x17, 0xae, 0x3e, 0x9d, 0x34, 0x26, 0x37, 0x8b, 0x8d, 0x1f, 0x4a, 0x96, 0xb9, 0xcc, 0xe7, 0x1b, 0xe1, 0x94, 0x48, 0x2e, 0x98, 0xb8, 0xf2, 0xdc, 0x93}}
 	return a, nil
 }
 
-var _assetsDnsMetricsClusterRoleBindingYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x7c\x8f\xb1\x4a\x04\x41\x0c\x86\xfb\x79\x8a\xbc\xc0\xae\xd8\x1d\xd3\xa9\x85\xfd\x09\xf6\xb9\x99\x9c\x1b\x77\x27\x19\x92\xcc\x16\x3e\xbd\x2c\x8a\x08\xe2\xb5\x81\x7c\xdf\xff\xad\x2c\x35\xc3\xd3\x36\x3c\xc8\xce\xba\xd1\x23\x4b\x65\x79\x4b\xd8\xf9\x95\xcc\x59\x25\x83\x5d\xb0\xcc\x38\x62\x51\xe3\x0f\x0c\x56\x99\xd7\x93\xcf\xac\x77\xfb\x7d\x6a\x14\x58\x31\x30\x27\x00\xc1\x46\x19\xaa\xf8\xd4\x54\x38\xd4\x0e\x92\x8f\xcb\x3b\x95\xf0\x9c\x26\xf8\xd2\xbd\x90\xed\x5c\xe8\xa1\x14\x1d\x12\x3f\x7f\xdd\xb4\x51\x2c\x34\x7c\x5a\x4f\xfe\x7d\xf6\x8e\x85\x32\x68\x27\xf1\x85\xaf\xf1\x9b\x6c\xba\xd1\x99\xae\x87\xf9\x4f\xc7\x7f\x6b\x00\xb0\xf3\xb3\xe9\xe8\x37\xba\xd2\x67\x00\x00\x00\xff\xff\x5b\x52\x00\xaa\x17\x01\x00\x00")
+var _assetsDnsMetricsClusterRoleBindingYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x7c\x8f\xb1\x4a\x04\x41\x0c\x86\xfb\x79\x8a\xbc\xc0\xae\xd8\x1d\xd3\xa9\x85\xfd\x09\xf6\xb9\x99\x9c\x1b\x77\x27\x19\x92\xcc\x16\x3e\xbd\x2c\x8a\x08\xe2\xb5\x81\x7c\xdf\xff\xad\x2c\x35\xc3\xd3\x36\x3c\xc8\xce\xba\xd1\x23\x4b\x65\x79\x4b\xd8\xf9\x95\xcc\x59\x25\x83\x5d\xb0\xcc\x38\x62\x51\xe3\x0f\x0c\x56\x99\xd7\x93\xcf\xac\x77\xfb\x7d\x6a\x14\x58\x31\x30\x27\x00\xc1\x46\x19\xaa\xf8\xd4\x54\x38\xd4\x0e\x92\x8f\xcb\x3b\x95\xf0\x9c\x26\xf8\xd2\xbd\x90\xed\x5c\xe8\xa1\x14\x1d\x12\x3f\x7f\xdd\xb4\x51\x2c\x34\x7c\x5a\x4f\xfe\x7d\xf6\x8e\x85\x32\x68\x27\xf1\x85\xaf\xf1\x9b\x6c\xba\xd1\x99\xae\x87\xf9\x4f\xc7\x7f\x6b\x00\xb0\xf3\xb3\xe9\xe8\x37\xba\xd2\xe7\x00\x5b\x52\x00\xaa\x17\x01\x00\x00")
 
 func assetsDnsMetricsClusterRoleBindingYamlBytes() ([]byte, error) {
 	return bindataRead(
@@ -158,7 +161,7 @@ func assetsDnsMetricsClusterRoleBindingYaml() (*asset, error) {
 	return a, nil
 }
 
-var _assetsDnsMetricsClusterRoleYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x34\xcd\x31\x4b\x34\x41\x0c\x87\xf1\x7e\x3e\x45\xe0\xad\x77\x5f\xec\x64\x5a\x05\x3b\x0b\x05\xfb\xec\xce\xdf\xdb\x70\x3b\xc9\x90\x64\x0e\xf4\xd3\x8b\x70\xb6\x0f\x3f\x78\xfe\xd1\xd3\x39\x23\xe1\xe4\x76\x22\x48\x81\x86\x46\xdb\x17\x0d\xb7\x8e\x3c\x30\x83\xd2\x28\x76\xe7\x01\x7a\x7e\x7d\xa7\x8e\x74\xd9\x83\xa0\x6d\x98\x68\x16\x1e\xf2\x01\x0f\x31\xad\xe4\x1b\xef\x2b\xcf\x3c\xcc\xe5\x9b\x53\x4c\xd7\xeb\x63\xac\x62\xff\x6f\x0f\xe5\x2a\xda\xea\xdf\xf0\xcd\x4e\x94\x8e\xe4\xc6\xc9\xb5\x10\x29\x77\x54\x6a\x1a\x4b\x37\x95\x34\x17\xbd\x14\x9f\x27\xa2\x96\x85\x78\xc8\x8b\xdb\x1c\xf1\x4b\x17\xb2\x01\xe7\x34\x5f\x6d\x40\xe3\x90\xcf\x5c\xc5\x0a\x91\x23\x6c\xfa\x8e\x3b\x6b\x1a\x88\x42\x74\x83\x6f\xf7\x74\x41\x96\x9f\x00\x00\x00\xff\xff\x9f\xa8\x4d\x6c\xf6\x00\x00\x00")
+var _assetsDnsMetricsClusterRoleYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x34\xcd\xb1\x4a\x04\x31\x10\x06\xe0\x3e\x4f\xf1\x83\xf5\xae\xd8\x49\x5a\x05\x3b\x0b\x05\xfb\xdc\xe6\xf7\x6e\xb8\xdd\x99\x30\x33\x39\xd0\xa7\x17\x41\xfb\x0f\xbe\x3b\x3c\xed\x33\x92\x0e\xb7\x9d\x01\x25\x3b\x3b\x4e\x5f\x18\x6e\x07\xf3\xc2\x19\x48\x43\x6c\xde\x06\xf1\xfc\xfa\x8e\x83\xe9\xb2\x05\xa8\x7d\x98\x68\x96\x36\xe4\x83\x1e\x62\x5a\xe1\xa7\xb6\xad\x6d\xe6\xc5\x5c\xbe\x5b\x8a\xe9\x7a\x7d\x8c\x55\xec\xfe\xf6\x50\xae\xa2\xbd\xfe\x87\x6f\xb6\xb3\x1c\xcc\xd6\x5b\xb6\x5a\x00\x6d\x07\x2b\xba\xc6\x72\x98\x4a\x9a\x8b\x9e\x8b\xcf\x9d\x51\xcb\x82\x36\xe4\xc5\x6d\x8e\xf8\xa5\x0b\x6c\xd0\x5b\x9a\xaf\x36\xa8\x71\x91\xcf\x5c\xc5\x0a\xe0\x0c\x9b\xbe\xf1\x8f\x75\x0d\x46\x01\x6e\xf4\x53\xd4\x02\x2c\x38\x33\xcb\xcf\x00\x9f\xa8\x4d\x6c\xf6\x00\x00\x00")
 
 func assetsDnsMetricsClusterRoleYamlBytes() ([]byte, error) {
 	return bindataRead(
@@ -178,7 +181,7 @@ func assetsDnsMetricsClusterRoleYaml() (*asset, error) {
 	return a, nil
 }
 
-var _assetsDnsMetricsRoleBindingYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x94\xce\xb1\x4e\xc4\x40\x0c\x04\xd0\x7e\xbf\xc2\x3f\x90\x20\xba\xd3\x76\xd0\xd0\x1f\x12\xbd\x6f\xd7\x97\x98\x64\xed\x95\xed\x4d\xc1\xd7\x23\xa4\x48\x54\x20\x5d\x3b\x9a\xd1\x1b\xec\xfc\x41\xe6\xac\x92\xc1\x6e\x58\x66\x1c\xb1\xaa\xf1\x17\x06\xab\xcc\xdb\xc5\x67\xd6\xa7\xe3\x39\x6d\x2c\x35\xc3\x55\x77\x7a\x65\xa9\x2c\x4b\x6a\x14\x58\x31\x30\x27\x00\xc1\x46\x19\xba\x69\xa3\x58\x69\xf8\xb4\x5d\xfc\x8c\xbd\x63\xa1\x0c\xda\x49\x7c\xe5\x7b\x4c\x55\x3c\x99\xee\x74\xa5\xfb\xcf\x14\x3b\xbf\x99\x8e\xfe\x8f\x9f\x00\x7e\xf9\xbf\x34\x1f\xb7\x4f\x2a\xe1\x39\x4d\x67\xfb\x9d\xec\xe0\x42\x2f\xa5\xe8\x90\x78\xf0\x65\x53\xe1\x50\x63\x59\x20\x7d\x07\x00\x00\xff\xff\xb9\xd9\xab\x8d\x25\x01\x00\x00")
+var _assetsDnsMetricsRoleBindingYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x94\xce\xb1\x4e\xc4\x40\x0c\x04\xd0\x7e\xbf\xc2\x3f\x90\x20\xba\xd3\x76\xd0\xd0\x1f\x12\xbd\x6f\xd7\x97\x98\x64\xed\x95\xed\x4d\xc1\xd7\x23\xa4\x48\x54\x20\x5d\x3b\x9a\xd1\x1b\xec\xfc\x41\xe6\xac\x92\xc1\x6e\x58\x66\x1c\xb1\xaa\xf1\x17\x06\xab\xcc\xdb\xc5\x67\xd6\xa7\xe3\x39\x6d\x2c\x35\xc3\x55\x77\x7a\x65\xa9\x2c\x4b\x6a\x14\x58\x31\x30\x27\x00\xc1\x46\x19\xba\x69\xa3\x58\x69\xf8\xb4\x5d\xfc\x8c\xbd\x63\xa1\x0c\xda\x49\x7c\xe5\x7b\x4c\x55\x3c\x99\xee\x74\xa5\xfb\xcf\x14\x3b\xbf\x99\x8e\xfe\x8f\x9f\x00\x7e\xf9\xbf\x34\x1f\xb7\x4f\x2a\xe1\x39\x4d\x67\xfb\x9d\xec\xe0\x42\x2f\xa5\xe8\x90\x78\xf0\x65\x53\xe1\x50\x63\x59\x20\x7d\x0f\x00\xb9\xd9\xab\x8d\x25\x01\x00\x00")
 
 func assetsDnsMetricsRoleBindingYamlBytes() ([]byte, error) {
 	return bindataRead(
@@ -198,7 +201,7 @@ func assetsDnsMetricsRoleBindingYaml() (*asset, error) {
 	return a, nil
 }
 
-var _assetsDnsMetricsRoleYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x4c\x8e\xb1\x4e\xec\x40\x0c\x45\xfb\xf9\x0a\x6b\x5f\x9d\x7d\xa2\x5b\x4d\x8d\x44\x47\x01\x12\xbd\x77\xe6\x42\xac\x24\xe3\x91\xed\x04\xc1\xd7\xa3\xcd\x06\x89\xca\xf7\x1e\x59\x3e\xfe\x47\x2f\x3a\xc3\xa9\x01\x15\x95\xae\x5f\xd4\x4d\x17\xc4\x88\xd5\x29\x94\xbc\x18\x77\xd0\xe3\xf3\x2b\x2d\x08\x93\xe2\x84\x56\xbb\x4a\x8b\xc4\x5d\xde\x60\x2e\xda\x32\xd9\x95\xcb\x99\xd7\x18\xd5\xe4\x9b\x43\xb4\x9d\xa7\x8b\x9f\x45\xff\x6f\x0f\x69\x92\x56\xf3\x2e\x4a\x0b\x82\x2b\x07\xe7\x44\xd4\x78\x41\xfe\xe3\x1b\xa6\x8b\x1f\xd8\x3b\x17\x64\xd2\x8e\xe6\xa3\xbc\xc7\x50\x9b\x27\x5b\x67\x78\x4e\x03\x71\x97\x27\xd3\xb5\xfb\xed\xca\x40\xa7\x53\x22\x32\xb8\xae\x56\x70\x30\x87\x6d\x52\xe0\x7b\xf9\xfd\xf8\xde\xba\xd6\x5b\xd8\x60\xd7\x63\xf9\x03\xb1\xcf\x59\xfc\x1e\x3e\x39\xca\x98\x7e\x02\x00\x00\xff\xff\x29\x39\xda\x05\x1c\x01\x00\x00")
+var _assetsDnsMetricsRoleYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x4c\x8e\xb1\x4e\xec\x40\x0c\x45\xfb\xf9\x0a\x6b\x5f\x9d\x7d\xa2\x5b\x4d\x8d\x44\x47\x01\x12\xbd\x77\xe6\x42\xac\x24\xe3\x91\xed\x04\xc1\xd7\xa3\xec\x46\x88\xca\xd7\x57\xd6\x39\xfe\x47\x2f\x3a\xc3\xa9\x01\x15\x95\xae\x5f\xd4\x4d\x17\xc4\x88\xd5\x29\x94\xbc\x18\x77\xd0\xe3\xf3\x2b\x2d\x08\x93\xe2\x84\x56\xbb\x4a\x8b\xc4\x5d\xde\x60\x2e\xda\x32\xd9\x95\xcb\x99\xd7\x18\xd5\xe4\x9b\x43\xb4\x9d\xa7\x8b\x9f\x45\xff\x6f\x0f\x69\x92\x56\xf3\x4d\x94\x16\x04\x57\x0e\xce\x89\xa8\xf1\x82\xfc\xc7\x37\x4c\x17\x3f\x6a\xef\x5c\x90\x49\x3b\x9a\x8f\xf2\x1e\x43\x6d\x9e\x6c\x9d\xe1\x39\x0d\xc4\x5d\x9e\x4c\xd7\xee\x3b\x65\xa0\xd3\x29\x11\x19\x5c\x57\x2b\x38\x3a\x87\x6d\x52\xb0\xf3\x86\xdf\x8f\xef\x5b\xd7\xba\x87\x0d\x76\x3d\x8e\x3f\x10\xb7\x39\x8b\xdf\xc3\x27\x47\x19\xd3\xcf\x00\x29\x39\xda\x05\x1c\x01\x00\x00")
 
 func assetsDnsMetricsRoleYamlBytes() ([]byte, error) {
 	return bindataRead(
@@ -218,7 +221,7 @@ func assetsDnsMetricsRoleYaml() (*asset, error) {
 	return a, nil
 }
 
-var _assetsDnsNamespaceYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x64\x90\xcd\x4e\xc4\x30\x0c\x84\xef\x79\x8a\x51\x38\x2f\x3f\xd7\xbc\x03\x5c\x90\xb8\xbb\x8d\x97\x35\x4d\xed\x2a\x76\xcb\xeb\xa3\xb2\x15\xac\xb4\xc7\x68\x46\xf3\x7d\xf1\x24\x5a\x0b\xde\x68\x66\x5f\x68\xe4\x44\x8b\x7c\x70\x77\x31\x2d\xd8\x5e\xd2\xcc\x41\x95\x82\x4a\x02\x48\xd5\x82\x42\x4c\x7d\x7f\x02\xb6\xb0\xfa\x45\xce\xf1\x28\xf6\xa4\x56\xf9\xe4\xdc\x78\x0c\xeb\x05\x39\x27\x40\x69\xe6\xf2\x5f\x3b\x55\xf5\x04\x34\x1a\xb8\x1d\x13\x0f\x70\x0e\x6c\xd4\x56\x46\x18\x68\x33\xa9\xa8\xbc\xb0\x56\xd1\x4f\x98\x62\x5a\x07\x06\xd5\x59\x7c\x97\x42\x5c\x28\x8e\x82\xef\xf1\xdf\x38\x68\x11\xbf\xd7\xea\xab\x9e\x1a\x6f\xdc\x0a\xf2\x73\x3e\x98\xd4\x9a\x7d\xdf\x78\xcd\xa6\x12\xd6\x77\x62\x18\x9a\xd9\x84\xb3\x75\xbc\x73\xdf\x64\xe4\xd7\x6b\x0a\x1b\xbe\x78\x0c\x87\xec\x16\xe2\xbf\xbf\xbb\x1e\xed\x8e\x3a\xb6\xd5\x83\xfb\xcd\x70\x41\x8e\xbe\x72\x4e\x3f\x01\x00\x00\xff\xff\x82\x6d\x29\x03\x71\x01\x00\x00")
+var _assetsDnsNamespaceYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x94\x92\xc1\x8e\xdb\x3c\x0c\x84\xef\x7e\x8a\x81\xff\xeb\x3a\x7f\x7b\xf5\x33\xb4\xbd\x04\xe8\x9d\x91\x98\x58\x8d\x4c\x1a\x22\xe5\x20\x6f\x5f\xc8\xf1\xee\xa6\xc8\xa1\xe8\xcd\x16\x3f\xcd\x0c\x49\x5d\x93\xc4\x11\x3f\x68\x66\x5b\x28\x70\x47\x4b\xfa\xc9\xc5\x92\xca\x88\xf5\x6b\x37\xb3\x53\x24\xa7\xb1\x03\x48\x44\x9d\x3c\xa9\x58\xfb\x05\x74\x61\xb1\x29\x9d\xfd\x90\xf4\x7f\xd1\xc8\x83\x71\xe6\xe0\x5a\x46\xf4\x7d\x07\x08\xcd\x3c\x7e\x62\x43\x14\xeb\x80\x4c\x27\xce\xbb\xc4\x7f\x30\x76\xac\x94\x2b\xc3\x15\xb4\x6a\x8a\x88\xbc\xb0\xc4\x24\x17\xa8\xe0\x5a\x4f\x0c\x8a\x73\xb2\x16\x0a\x3e\x91\xef\x80\xb5\xf2\x87\x38\x68\x49\xf6\x1a\xab\x54\x19\x32\xaf\x9c\x47\xf4\x5f\xfa\xdd\x93\x72\xd6\xdb\x27\x37\xcc\x2a\xc9\xb5\x34\x47\x57\x64\xd5\x2b\xce\x5a\x70\xe4\xb2\xa6\xc0\xdf\x1f\x55\xe8\xe9\x17\x07\x37\x24\x81\x4f\xc9\xb6\xee\x1e\x43\x7b\x71\x0d\xb9\x9a\x73\x79\x12\x1e\xd1\x7b\xa9\xfc\x9e\xc0\x27\x46\x14\xc3\xa2\xd1\x50\x8d\xb7\x83\x49\xcd\x21\xec\x37\x2d\x57\x90\xc4\xed\xb0\xcd\x15\x85\x4d\xf3\xca\x05\xc9\xb0\x94\xb4\xa6\xcc\x17\x8e\x6f\xbb\x98\xe9\x36\x20\x6f\xfc\x8c\x2a\x91\x4b\xfb\x7c\x22\x9b\x0f\x8c\x43\x2d\xc9\xef\x30\x27\x89\x54\xe2\xdb\x66\x12\x15\xa2\xbe\x4b\x65\xde\x54\x1e\x3b\x82\xdd\x25\x70\x41\x98\x48\x2e\x8f\x88\xdb\x28\x37\x76\xd1\x38\xbc\x2b\x1e\xda\x92\x8a\xb0\xb3\xb5\xa7\xc0\x72\xd6\x12\x78\x7c\x0a\xf0\xb7\x2b\x54\x63\xf2\x7f\xb9\x70\xa3\x22\x2f\xfc\x07\xfb\xc7\x32\x2c\x84\xc3\xa2\xf1\xb8\x17\xbf\xb5\xd6\x8e\x77\x09\x23\xfa\x33\x65\xe3\xbe\xfb\x3d\x00\x4e\xd3\x92\x5f\x05\x03\x00\x00")
 
 func assetsDnsNamespaceYamlBytes() ([]byte, error) {
 	return bindataRead(
@@ -233,12 +236,12 @@ func assetsDnsNamespaceYaml() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "assets/dns/namespace.yaml", size: 369, mode: os.FileMode(420), modTime: time.Unix(1, 0)}
-	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0xe, 0xab, 0x50, 0x84, 0x61, 0x5f, 0x41, 0xf4, 0x17, 0x3b, 0x6, 0x84, 0xc0, 0x5f, 0x4f, 0xbb, 0xd8, 0x1d, 0xae, 0x26, 0x3e, 0x1f, 0x29, 0x2c, 0x84, 0x6d, 0x5e, 0xc1, 0x87, 0x97, 0x5f, 0xc9}}
+	info := bindataFileInfo{name: "assets/dns/namespace.yaml", size: 773, mode: os.FileMode(420), modTime: time.Unix(1, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0xb6, 0x90, 0xc0, 0x27, 0x2b, 0x3d, 0x75, 0x59, 0x7a, 0x3b, 0x65, 0x30, 0xef, 0xae, 0xcc, 0x39, 0xe3, 0x29, 0xe4, 0x99, 0xf0, 0xa2, 0x41, 0xfe, 0xac, 0x9c, 0xc1, 0x18, 0x43, 0x4f, 0x21, 0x56}}
 	return a, nil
 }
 
-var _assetsDnsServiceAccountYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x2c\xc9\xb1\x09\xc4\x30\x0c\x05\xd0\xde\x53\x68\x81\x2b\xae\x55\x77\x33\x1c\xa4\x17\xf2\x0f\x11\xc1\xb2\xb1\x14\xcf\x1f\x02\xe9\x1e\xbc\xd3\xbc\x32\xfd\x31\x97\x29\x7e\xaa\xfd\xf2\x2c\x32\x6c\xc3\x0c\xeb\xce\xb4\xbe\xa5\x21\xa5\x4a\x0a\x17\x22\x97\x06\xa6\xea\xf1\x3a\x86\x28\x98\xfa\x80\xc7\x61\x7b\x7e\x9e\xba\x03\x00\x00\xff\xff\x8e\x2c\xf1\x2e\x55\x00\x00\x00")
+var _assetsDnsServiceAccountYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x00\x55\x00\xaa\xff\x6b\x69\x6e\x64\x3a\x20\x53\x65\x72\x76\x69\x63\x65\x41\x63\x63\x6f\x75\x6e\x74\x0a\x61\x70\x69\x56\x65\x72\x73\x69\x6f\x6e\x3a\x20\x76\x31\x0a\x6d\x65\x74\x61\x64\x61\x74\x61\x3a\x0a\x20\x20\x6e\x61\x6d\x65\x3a\x20\x64\x6e\x73\x0a\x20\x20\x6e\x61\x6d\x65\x73\x70\x61\x63\x65\x3a\x20\x6f\x70\x65\x6e\x73\x68\x69\x66\x74\x2d\x64\x6e\x73\x0a\x03\x00\x8e\x2c\xf1\x2e\x55\x00\x00\x00")
 
 func assetsDnsServiceAccountYamlBytes() ([]byte, error) {
 	return bindataRead(
@@ -258,7 +261,7 @@ func assetsDnsServiceAccountYaml() (*asset, error) {
 	return a, nil
 }
 
-var _assetsDnsServiceYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x84\x91\x31\x6f\xe2\x40\x10\x85\x7b\xff\x8a\x27\xe8\x4e\xc0\x09\xdd\x51\x9c\xdb\xa3\x89\x52\x80\x14\x48\x3f\x5e\x4f\xcc\x8a\xf5\x8c\xb5\x33\x06\xf1\xef\x23\x4c\x42\x80\x14\x69\x56\xda\x7d\x9f\x3e\x3d\xbd\xdd\x47\xa9\x4b\xbc\x70\x3e\xc4\xc0\x05\x75\xf1\x95\xb3\x45\x95\x12\x87\x79\x31\x86\x50\xcb\x93\xe1\xb4\x8e\x02\x4f\x12\x55\x9c\x0c\x24\x35\x48\x44\x9d\x3c\xaa\x18\x28\x33\x8c\x1d\xe4\xc8\xbd\x78\x6c\xb9\xb0\x8e\x43\x59\x00\x63\x84\xd4\x9b\x73\x7e\x5a\xe3\x18\x53\x42\xc5\xa0\xde\xb5\x25\x8f\x81\x52\x3a\xa1\x25\xa1\x86\xeb\xd9\x00\x1b\x27\x0e\xae\x19\xd1\x1e\x8d\x40\xa7\xd9\xed\x2c\x9d\x0e\x95\x4a\xd4\x62\x05\x70\x09\x4a\x2c\xfe\x0c\x17\xa7\xdc\xb0\xaf\x87\xa7\x2b\x90\xd5\x35\x68\x2a\xb1\x5d\xae\xef\x05\x53\x0f\xdd\x8f\x92\x2f\xe8\x2a\xda\xfc\xbf\x15\xb5\xec\x39\x86\xdb\x36\xff\xe6\x8b\xbf\xdf\x54\x77\xd8\x83\x6a\x8c\xcd\x6a\xb9\x2a\xb1\x95\xa0\x6d\xcb\xe2\x38\xee\x58\x60\x97\xbf\x81\x6b\xa7\x49\x9b\x13\xde\x98\xbc\xcf\x8c\x86\x9c\xcf\x33\xb1\x50\x95\x3e\xf6\xfb\x84\x9e\xf9\x64\x97\xf5\x31\xc5\x68\xdf\x57\x9c\x85\x9d\x6d\x16\xf5\xf7\x4e\xcd\xcf\xa5\x47\xd7\xfc\xd7\xa8\x78\x0f\x00\x00\xff\xff\x82\x42\x75\xa4\x08\x02\x00\x00")
+var _assetsDnsServiceYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x84\x91\x31\x6f\xe2\x40\x10\x85\x7b\xff\x8a\x27\xdc\x9d\x80\x13\xba\xa3\x38\xb7\x47\x13\xa5\x00\x29\x90\x7e\xbc\x9e\x98\x15\xbb\x33\xd6\xee\x18\xc4\xbf\x8f\x6c\x12\x02\xa4\x48\xb3\xd2\xea\x7d\xfa\xf4\xf4\xe6\xe0\xa5\xa9\xf0\xc2\xe9\xe8\x1d\x17\xd4\xf9\x57\x4e\xd9\xab\x54\x38\x2e\x8a\x12\x42\x91\xa7\xe3\x9b\x3b\x72\x3c\x0d\x54\x73\xc8\x20\x69\x40\x22\x6a\x64\x5e\x25\x83\x12\x23\xb3\x81\x0c\xa9\x17\xf3\x91\x8b\xdc\xb1\xab\x0a\xa0\x84\x0b\x7d\x36\x4e\x4f\x1b\x9c\x7c\x08\xa8\x19\xd4\x9b\x46\x32\xef\x28\x84\x33\x22\x09\xb5\xdc\xcc\x47\x38\x73\x60\x67\x9a\xe0\xf3\xa3\x11\xe8\x34\x59\x1e\xa4\xb3\xb1\x52\x85\x46\x72\x01\x5c\x82\x0a\xcb\x3f\xe3\xc7\x28\xb5\x6c\x1b\x4d\x76\x03\x24\x35\x75\x1a\x2a\xec\x56\x9b\x7b\xc1\xcc\x5c\xf7\xa3\xe4\x0b\xba\x8a\xb6\xff\x6f\x45\x91\x2d\x79\x77\xdb\xe6\xdf\x62\xf9\xf7\x9b\xea\x0e\x7b\x50\x95\xd8\xae\x57\xeb\x0a\x3b\x71\x1a\x23\x8b\xe1\xb4\x67\x41\xbe\xdc\x06\xa6\x9d\x06\x6d\xcf\x78\x63\xb2\x3e\x31\x5a\x32\x1e\x66\x62\xa1\x3a\x7c\xec\xf7\x09\x3d\xf3\x79\x1c\xaa\x1c\x1a\x4e\x0e\x7d\xcd\x49\xd8\x38\xcf\xbd\xfe\xde\x6b\xb6\xa1\xf4\xe4\x9a\xff\x9a\x14\xef\x03\x00\x82\x42\x75\xa4\x08\x02\x00\x00")
 
 func assetsDnsServiceYamlBytes() ([]byte, error) {
 	return bindataRead(
@@ -278,6 +281,66 @@ func assetsDnsServiceYaml() (*asset, error) {
 	return a, nil
 }
 
+var _assetsDnsViewerClusterRoleYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xa4\x90\xcd\x8a\x15\x41\x0c\x85\xf7\xf5\x14\x87\xb9\xeb\xee\xc1\x9d\xf4\x56\x41\xdc\xb8\x50\x70\x9f\xae\xce\x74\x87\xa9\x49\x9a\x24\x75\x2f\xfa\xf4\xd2\xf7\x47\x10\xc4\x8d\xab\x2a\xea\x84\xef\xcb\xa9\x13\x3e\xb4\x1e\xc9\x0e\xb7\xc6\xc8\x8d\x12\xab\x93\x66\xc0\x99\x96\xc1\xb4\xfd\x00\xd5\xca\x11\x48\x43\x6e\x8c\x8f\x5f\xbe\xa1\x9a\xbe\xc8\xda\x9d\x52\x4c\x47\xe0\x73\x42\xa2\x9c\x40\xeb\xea\xbc\x52\xf2\xf2\x98\xae\x37\xfc\x70\xd0\x1e\x96\x38\x22\xca\x47\x86\x5b\x16\x20\x5d\xae\x86\x6a\x1a\xd6\xb8\x9c\x50\x49\x21\x1a\x3b\xd7\xbc\x7a\x2f\x92\x9b\xf5\xc4\xcc\xa2\x2b\x68\x3e\x56\x36\xd4\x8d\x74\x65\x48\x8e\x85\x76\xf9\xce\x1e\x62\x3a\xc1\x67\xaa\x23\xf5\xdc\xcc\xe5\xe7\x6d\xd3\xd7\xf7\x31\x8a\x3d\x9f\xdf\x95\x57\xd1\x65\x7a\x94\xff\x7a\xd8\xde\x38\x69\xa1\xa4\xa9\x00\x4a\x6f\x3c\x61\xd1\x18\xce\xc2\x17\xf6\x02\x34\x9a\xb9\xc5\x11\xe2\x1f\xe4\xdf\x1f\x30\xa4\x0d\x7f\x76\x9f\xf0\x94\xde\xf9\xa9\x78\x6f\x1c\x53\x19\x40\xbb\x7c\x72\xeb\xfb\x95\x3a\xc0\x76\x76\x4a\xf3\xd1\x76\xd6\xd8\xe4\x25\x47\xb1\x02\x38\x87\x75\xaf\x7c\x1f\x5b\x34\x38\x0a\x70\x66\x9f\xef\x4f\x2b\xe7\xf5\x6c\x12\xb7\xcb\x85\xb2\x6e\xff\x67\x78\x8e\xa4\xec\x7f\x11\xfd\x1a\x00\xf0\xe3\x44\xfb\x33\x02\x00\x00")
+
+func assetsDnsViewerClusterRoleYamlBytes() ([]byte, error) {
+	return bindataRead(
+		_assetsDnsViewerClusterRoleYaml,
+		"assets/dns/viewer/cluster-role.yaml",
+	)
+}
+
+func assetsDnsViewerClusterRoleYaml() (*asset, error) {
+	bytes, err := assetsDnsViewerClusterRoleYamlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "assets/dns/viewer/cluster-role.yaml", size: 563, mode: os.FileMode(420), modTime: time.Unix(1, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x91, 0xb7, 0x3, 0x19, 0x55, 0x17, 0x7e, 0x3, 0x4c, 0xae, 0xaf, 0x59, 0x7c, 0xf2, 0x78, 0x7f, 0x40, 0x27, 0xb9, 0x78, 0xb, 0x58, 0xa5, 0x7b, 0xe6, 0x82, 0x23, 0xa1, 0xfa, 0xcc, 0x4d, 0x13}}
+	return a, nil
+}
+
+var _assetsDnsViewerRoleBindingYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x94\x90\xb1\x8a\xdc\x40\x0c\x86\xfb\x79\x0a\xc1\xb6\xb1\x43\xba\xe0\x32\x04\xd2\xa5\xd8\x40\x7a\x79\x46\x1e\x2b\x6b\x4b\x46\x92\x77\xd9\x3c\xfd\x61\x7b\xb9\xe5\xe0\x38\xb8\x6a\x86\x9f\x99\xef\xff\xa4\x13\x9c\x75\x22\xe8\x59\x0a\x4b\x85\x18\x31\xa0\x1a\x4a\x38\xe4\x69\xf5\x20\x03\x23\x2c\x64\xbe\x9f\x8d\xca\x74\x07\xcc\x99\xdc\x21\x14\x62\x24\xc8\x2a\x03\xd7\x19\x17\x4f\xa7\x03\xb0\xa5\xba\x90\x61\xa8\xc1\x8c\x82\x95\x1c\x06\x35\xf8\xf9\xfb\x4f\x0b\x7b\xa3\x43\x46\x11\x0d\xe8\x09\xb0\x56\xa3\x8a\x41\xe5\x0b\xf8\xc1\xac\xa6\xeb\xb2\xe1\x14\x6e\x23\xe7\x71\x0f\x1f\x42\xcd\x21\x04\xb6\x89\xb3\x43\xaf\xab\x94\xe7\xa5\xb0\x51\x8e\xe9\xde\x26\x5c\xf8\x2f\x99\xb3\x4a\x07\xd6\x63\x6e\x71\x8d\x51\x8d\xff\x63\xb0\x4a\x7b\xf9\xee\x2d\xeb\xd7\xeb\xb7\x74\x61\x29\xdd\xae\xf5\xe3\xd8\x43\x9a\x29\xb0\x60\x60\x97\x00\x04\x67\xea\xa0\x88\x37\x57\xa6\x1b\xd9\x23\xf2\x05\x33\x75\xa0\x0b\x89\x8f\x3c\x44\x53\xc4\xd3\xe6\x74\xa6\x61\xfb\x86\x0b\xff\xda\xa6\xf8\xa0\x3b\x01\x3c\xab\xdf\x6b\xf2\xb5\xff\x47\x39\xbc\x4b\xcd\xa7\x78\xfb\xc3\x57\xa0\xdf\x3d\x68\xee\xde\xae\xcf\xd3\xcb\x00\xd0\xa0\xa0\x8f\xfb\x01\x00\x00")
+
+func assetsDnsViewerRoleBindingYamlBytes() ([]byte, error) {
+	return bindataRead(
+		_assetsDnsViewerRoleBindingYaml,
+		"assets/dns/viewer/role-binding.yaml",
+	)
+}
+
+func assetsDnsViewerRoleBindingYaml() (*asset, error) {
+	bytes, err := assetsDnsViewerRoleBindingYamlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "assets/dns/viewer/role-binding.yaml", size: 507, mode: os.FileMode(420), modTime: time.Unix(1, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x5c, 0xb9, 0x7d, 0x82, 0xe8, 0xe5, 0xed, 0x62, 0xe4, 0x9f, 0x66, 0x8d, 0x58, 0xf7, 0x3c, 0xc2, 0x6b, 0x71, 0xa0, 0x49, 0x31, 0x44, 0x43, 0x46, 0x27, 0x2d, 0xd7, 0x58, 0xb0, 0x36, 0x1e, 0xa1}}
+	return a, nil
+}
+
+var _assetsDnsViewerRoleYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x44\xcf\x31\x6b\xfb\x40\x0c\x05\xf0\xfd\x3e\xc5\x23\x59\xff\xf6\x9f\x6e\xc5\x6b\x0b\xdd\x3a\xb4\xd0\x5d\x39\xcb\x3e\x11\xfb\x74\x48\x72\x42\xfb\xe9\x4b\x12\x43\x27\x89\x1f\x0f\xa1\x77\xc4\x87\x2e\x8c\x28\x14\x98\x8d\x6a\x38\x8c\x69\xec\xb4\x2e\xdf\xa0\x9c\xd9\x1d\xa1\x88\xc2\xc8\x5a\x27\x99\x57\x6a\xfe\x88\xdf\x4c\x1b\x1b\x85\x1a\x56\xaa\x34\xb3\xa7\x23\x26\x35\xbc\xbe\x7f\xfe\x83\x6f\xb9\x80\x6e\x61\xc6\x8b\x1a\x4f\xb2\x70\x9f\xa8\xc9\x17\x9b\x8b\xd6\x01\x76\xa2\xdc\xd3\x16\x45\x4d\x7e\x28\x44\x6b\x7f\x7e\xf6\x5e\xf4\xff\xe5\x29\x9d\xa5\x8e\xc3\xfd\xbb\xb4\x72\xd0\x48\x41\x43\x02\x2a\xad\x3c\x60\xac\xde\x5d\x84\xaf\x6c\x3b\x79\xa3\xcc\x03\xb4\x71\xf5\x22\x53\x74\x63\xf5\x64\xdb\xc2\x3e\xa4\x0e\xd4\xe4\xcd\x74\x6b\x7e\xbb\xd0\xe1\x70\x48\x80\xb1\xeb\x66\x99\x77\xfb\x6b\x97\x80\x0b\xdb\x69\xf7\x99\xe3\x3e\x17\xf1\xc7\x72\xa5\xc8\x25\xfd\x0e\x00\x2d\x56\xdd\xad\x38\x01\x00\x00")
+
+func assetsDnsViewerRoleYamlBytes() ([]byte, error) {
+	return bindataRead(
+		_assetsDnsViewerRoleYaml,
+		"assets/dns/viewer/role.yaml",
+	)
+}
+
+func assetsDnsViewerRoleYaml() (*asset, error) {
+	bytes, err := assetsDnsViewerRoleYamlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "assets/dns/viewer/role.yaml", size: 312, mode: os.FileMode(420), modTime: time.Unix(1, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x7b, 0xd9, 0x7b, 0xff, 0xa5, 0xdb, 0xfa, 0xac, 0xaa, 0x46, 0xe6, 0xca, 0x60, 0x59, 0x13, 0x73, 0xc0, 0x99, 0xa6, 0x46, 0x5a, 0xf5, 0x58, 0x93, 0x3, 0x5, 0x54, 0xaa, 0xa7, 0x8, 0xd7, 0x8e}}
+	return a, nil
+}
+
 // Asset loads and returns the asset for the given name.
 // It returns an error if the asset could not be found or
 // could not be loaded.
@@ -388,17 +451,25 @@ var _bindata = map[string]func() (*asset, error){
 	"assets/dns/service-account.yaml": assetsDnsServiceAccountYaml,
 
 	"assets/dns/service.yaml": assetsDnsServiceYaml,
+
+	"assets/dns/viewer/cluster-role.yaml": assetsDnsViewerClusterRoleYaml,
+
+	"assets/dns/viewer/role-binding.yaml": assetsDnsViewerRoleBindingYaml,
+
+	"assets/dns/viewer/role.yaml": assetsDnsViewerRoleYaml,
 }
 
 // AssetDir returns the file names below a certain
 // directory embedded in the file by go-bindata.
 // For example if you run go-bindata on data/... and data contains the
 // following hierarchy:
-//     data/
-//       foo.txt
-//       img/
-//         a.png
-//         b.png
+//
+//	data/
+//	  foo.txt
+//	  img/
+//	    a.png
+//	    b.png
+//
 // then AssetDir("data") would return []string{"foo.txt", "img"},
 // AssetDir("data/img") would return []string{"a.png", "b.png"},
 // AssetDir("foo.txt") and AssetDir("notexist") would return an error, and
@@ -445,6 +516,11 @@ var _bintree = &bintree{nil, map[string]*bintree{
 			"namespace.yaml":       {assetsDnsNamespaceYaml, map[string]*bintree{}},
 			"service-account.yaml": {assetsDnsServiceAccountYaml, map[string]*bintree{}},
 			"service.yaml":         {assetsDnsServiceYaml, map[string]*bintree{}},
+			"viewer": {nil, map[string]*bintree{
+				"cluster-role.yaml": {assetsDnsViewerClusterRoleYaml, map[string]*bintree{}},
+				"role-binding.yaml": {assetsDnsViewerRoleBindingYaml, map[string]*bintree{}},
+				"role.yaml":         {assetsDnsViewerRoleYaml, map[string]*bintree{}},
+			}},
 		}},
 	}},
 }}
@@ -0,0 +1,148 @@
+// Package bootstrap renders the cluster DNS manifests that an installer
+// applies before the DNS operator is running, so that installers do not need
+// to keep copies of the daemonset, configmap, and other manifests in sync with
+// the operator, and the static pod that serves cluster DNS on the bootstrap
+// node until the operator has rolled out the dns daemonset.
+package bootstrap
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	operatorclient "github.com/openshift/cluster-dns-operator/pkg/operator/client"
+	operatorcontroller "github.com/openshift/cluster-dns-operator/pkg/operator/controller"
+
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
+	"sigs.k8s.io/yaml"
+)
+
+// Options is the configuration for rendering the bootstrap manifests.
+type Options struct {
+	// CoreDNSImage is the CoreDNS image.
+	CoreDNSImage string
+	// CoreDNSVersion is the version of CoreDNS in CoreDNSImage.  Optional
+	// features that it does not support are left out of the Corefile.  If
+	// it is empty or invalid, the oldest version that the operator
+	// supports is assumed.
+	CoreDNSVersion string
+	// OpenshiftCLIImage is the openshift client image, which runs the node
+	// resolver.
+	OpenshiftCLIImage string
+	// KubeRBACProxyImage is the kube-rbac-proxy image that secures the
+	// metrics endpoint.
+	KubeRBACProxyImage string
+	// ClusterIP is the cluster IP of the dns service.
+	ClusterIP string
+	// ClusterDomain is the cluster domain.  If it is empty, "cluster.local"
+	// is used.
+	ClusterDomain string
+	// Kubeconfig is the path of the kubeconfig on the bootstrap node with
+	// which the bootstrap dns pod reaches the API.  If it is empty,
+	// DefaultKubeconfig is used.
+	Kubeconfig string
+}
+
+// DefaultKubeconfig is the default path of the kubeconfig on the bootstrap
+// node.
+const DefaultKubeconfig = "/etc/kubernetes/kubeconfig"
+
+// Manifest is a rendered manifest.
+type Manifest struct {
+	// Filename is a file name for the manifest.  Sorting the manifests by
+	// file name yields the order in which they should be created.
+	Filename string
+	// Object is the resource.
+	Object runtime.Object
+	// Data is the YAML representation of the resource.
+	Data []byte
+}
+
+// StaticPod is the rendered bootstrap dns static pod.
+type StaticPod struct {
+	// Pod is the manifest of the static pod, which is to be written to
+	// operatorcontroller.BootstrapDNSManifest on the bootstrap node.
+	Pod Manifest
+	// Corefile is the Corefile of the static pod, which is to be written
+	// to the Corefile file in operatorcontroller.BootstrapDNSDirectory on
+	// the bootstrap node.
+	Corefile []byte
+}
+
+// Render returns the bootstrap manifests for the default dns in the order in
+// which they should be created.
+func Render(opts Options) ([]Manifest, error) {
+	if len(opts.CoreDNSImage) == 0 || len(opts.OpenshiftCLIImage) == 0 || len(opts.KubeRBACProxyImage) == 0 {
+		return nil, fmt.Errorf("the CoreDNS, openshift client, and kube-rbac-proxy images are required")
+	}
+	if len(opts.ClusterIP) == 0 {
+		return nil, fmt.Errorf("the cluster IP is required")
+	}
+	objects, err := operatorcontroller.BootstrapManifests(controllerConfig(opts), opts.ClusterIP, opts.ClusterDomain)
+	if err != nil {
+		return nil, err
+	}
+
+	manifests := []Manifest{}
+	for i, obj := range objects {
+		m, err := encode(obj)
+		if err != nil {
+			return nil, err
+		}
+		m.Filename = fmt.Sprintf("cluster-dns-%02d-%s.yaml", i, strings.ToLower(obj.GetObjectKind().GroupVersionKind().Kind))
+		manifests = append(manifests, m)
+	}
+	return manifests, nil
+}
+
+// RenderStaticPod returns the static pod that serves cluster DNS on the
+// bootstrap node until the dns daemonset that the operator rolls out is
+// available.  The dns service from Render selects the pod, so the pod must be
+// started along with the manifests from Render.
+func RenderStaticPod(opts Options) (*StaticPod, error) {
+	if len(opts.CoreDNSImage) == 0 || len(opts.OpenshiftCLIImage) == 0 {
+		return nil, fmt.Errorf("the CoreDNS and openshift client images are required")
+	}
+	kubeconfig := opts.Kubeconfig
+	if len(kubeconfig) == 0 {
+		kubeconfig = DefaultKubeconfig
+	}
+	pod, corefile, err := operatorcontroller.BootstrapDNSPod(controllerConfig(opts), opts.ClusterDomain, kubeconfig)
+	if err != nil {
+		return nil, err
+	}
+	m, err := encode(pod)
+	if err != nil {
+		return nil, err
+	}
+	m.Filename = filepath.Base(operatorcontroller.BootstrapDNSManifest)
+	return &StaticPod{Pod: m, Corefile: []byte(corefile)}, nil
+}
+
+// controllerConfig returns the operator controller config with the images and
+// CoreDNS version in the given options.
+func controllerConfig(opts Options) operatorcontroller.Config {
+	return operatorcontroller.Config{
+		CoreDNSImage:       opts.CoreDNSImage,
+		CoreDNSVersion:     opts.CoreDNSVersion,
+		OpenshiftCLIImage:  opts.OpenshiftCLIImage,
+		KubeRBACProxyImage: opts.KubeRBACProxyImage,
+	}
+}
+
+// encode sets the group, version, and kind of the given resource and returns
+// a manifest, without a file name, for it.
+func encode(obj runtime.Object) (Manifest, error) {
+	gvk, err := apiutil.GVKForObject(obj, operatorclient.GetScheme())
+	if err != nil {
+		return Manifest{}, err
+	}
+	obj.GetObjectKind().SetGroupVersionKind(gvk)
+	data, err := yaml.Marshal(obj)
+	if err != nil {
+		return Manifest{}, fmt.Errorf("failed to encode %s: %v", gvk.Kind, err)
+	}
+	return Manifest{Object: obj, Data: data}, nil
+}
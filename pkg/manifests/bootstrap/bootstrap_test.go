@@ -0,0 +1,184 @@
+package bootstrap
+
+import (
+	"sort"
+	"strings"
+	"testing"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+
+	"k8s.io/apimachinery/pkg/util/intstr"
+)
+
+func TestRender(t *testing.T) {
+	opts := Options{
+		CoreDNSImage:       "coredns:test",
+		OpenshiftCLIImage:  "cli:test",
+		KubeRBACProxyImage: "kube-rbac-proxy:test",
+		ClusterIP:          "172.30.0.10",
+	}
+	manifests, err := Render(opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := []string{
+		"cluster-dns-00-namespace.yaml",
+		"cluster-dns-01-clusterrole.yaml",
+		"cluster-dns-02-clusterrolebinding.yaml",
+		"cluster-dns-03-serviceaccount.yaml",
+		"cluster-dns-04-configmap.yaml",
+		"cluster-dns-05-daemonset.yaml",
+		"cluster-dns-06-service.yaml",
+	}
+	filenames := []string{}
+	for _, m := range manifests {
+		filenames = append(filenames, m.Filename)
+		if !strings.Contains(string(m.Data), "apiVersion: ") || !strings.Contains(string(m.Data), "kind: ") {
+			t.Errorf("%s: missing apiVersion or kind:\n%s", m.Filename, m.Data)
+		}
+		if strings.Contains(string(m.Data), "ownerReferences") {
+			t.Errorf("%s: unexpected owner references:\n%s", m.Filename, m.Data)
+		}
+	}
+	if !sort.StringsAreSorted(filenames) || strings.Join(filenames, ",") != strings.Join(expected, ",") {
+		t.Fatalf("expected manifests %v, got %v", expected, filenames)
+	}
+
+	cm := manifests[4].Object.(*corev1.ConfigMap)
+	if !strings.Contains(cm.Data["Corefile"], "kubernetes cluster.local in-addr.arpa ip6.arpa") {
+		t.Errorf("expected Corefile for cluster.local, got:\n%s", cm.Data["Corefile"])
+	}
+	ds := manifests[5].Object.(*appsv1.DaemonSet)
+	images := map[string]string{}
+	for _, c := range ds.Spec.Template.Spec.Containers {
+		images[c.Name] = c.Image
+	}
+	if images["dns"] != opts.CoreDNSImage || images["dns-node-resolver"] != opts.OpenshiftCLIImage || images["kube-rbac-proxy"] != opts.KubeRBACProxyImage {
+		t.Errorf("unexpected daemonset images: %v", images)
+	}
+	svc := manifests[6].Object.(*corev1.Service)
+	if svc.Spec.ClusterIP != opts.ClusterIP {
+		t.Errorf("expected service cluster IP %s, got %s", opts.ClusterIP, svc.Spec.ClusterIP)
+	}
+
+	if _, err := Render(Options{ClusterIP: "172.30.0.10"}); err == nil {
+		t.Error("expected an error without images")
+	}
+}
+
+func TestRenderStaticPod(t *testing.T) {
+	opts := Options{
+		CoreDNSImage:      "coredns:test",
+		OpenshiftCLIImage: "cli:test",
+		ClusterDomain:     "example.local",
+	}
+	staticPod, err := RenderStaticPod(opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if staticPod.Pod.Filename != "bootstrap-dns.yaml" {
+		t.Errorf("expected file name bootstrap-dns.yaml, got %s", staticPod.Pod.Filename)
+	}
+	if !strings.Contains(string(staticPod.Pod.Data), "apiVersion: v1") || !strings.Contains(string(staticPod.Pod.Data), "kind: Pod") {
+		t.Errorf("missing apiVersion or kind:\n%s", staticPod.Pod.Data)
+	}
+	corefile := string(staticPod.Corefile)
+	for _, s := range []string{"kubernetes example.local in-addr.arpa ip6.arpa", "kubeconfig " + DefaultKubeconfig, "lameduck"} {
+		if !strings.Contains(corefile, s) {
+			t.Errorf("expected Corefile to contain %q, got:\n%s", s, corefile)
+		}
+	}
+
+	// The dns service from Render must select the static pod so that it
+	// serves cluster DNS until the dns daemonset is available.
+	manifests, err := Render(Options{CoreDNSImage: "coredns:test", OpenshiftCLIImage: "cli:test", KubeRBACProxyImage: "kube-rbac-proxy:test", ClusterIP: "172.30.0.10"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	svc := manifests[6].Object.(*corev1.Service)
+	pod := staticPod.Pod.Object.(*corev1.Pod)
+	for key, value := range svc.Spec.Selector {
+		if pod.Labels[key] != value {
+			t.Errorf("expected static pod labels %v to match service selector %v", pod.Labels, svc.Spec.Selector)
+		}
+	}
+	ports := map[string]struct{}{}
+	for _, c := range pod.Spec.Containers {
+		for _, p := range c.Ports {
+			ports[p.Name] = struct{}{}
+		}
+	}
+	for _, p := range svc.Spec.Ports {
+		if p.TargetPort.Type != intstr.String {
+			continue
+		}
+		if _, ok := ports[p.TargetPort.StrVal]; !ok && p.TargetPort.StrVal != "metrics" {
+			t.Errorf("expected static pod to have port %s of the dns service", p.TargetPort.StrVal)
+		}
+	}
+	if !pod.Spec.HostNetwork {
+		t.Error("expected static pod to use the host network")
+	}
+
+	if _, err := RenderStaticPod(Options{}); err == nil {
+		t.Error("expected an error without images")
+	}
+}
+
+func TestRenderProfiles(t *testing.T) {
+	opts := Options{
+		CoreDNSImage:       "coredns:test",
+		OpenshiftCLIImage:  "cli:test",
+		KubeRBACProxyImage: "kube-rbac-proxy:test",
+		ClusterIP:          "172.30.0.10",
+	}
+	profiles, err := RenderProfiles(opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := map[string]struct {
+		containers     []string
+		serviceMonitor bool
+	}{
+		"default":                            {[]string{"dns", "kube-rbac-proxy", "dns-node-resolver"}, true},
+		"without-dnsnoderesolver":            {[]string{"dns", "kube-rbac-proxy"}, true},
+		"without-dnsmetrics":                 {[]string{"dns", "dns-node-resolver"}, false},
+		"without-dnsnoderesolver-dnsmetrics": {[]string{"dns"}, false},
+	}
+	if len(profiles) != len(expected) || profiles[0].Name != DefaultProfile {
+		t.Fatalf("expected %d profiles starting with %s, got %d", len(expected), DefaultProfile, len(profiles))
+	}
+	for _, profile := range profiles {
+		e, ok := expected[profile.Name]
+		if !ok {
+			t.Errorf("unexpected profile %s", profile.Name)
+			continue
+		}
+		filenames := map[string]bool{}
+		serviceMonitor := false
+		for _, m := range profile.Manifests {
+			if filenames[m.Filename] {
+				t.Errorf("%s: duplicate manifest %s", profile.Name, m.Filename)
+			}
+			filenames[m.Filename] = true
+			if strings.HasSuffix(m.Filename, "-servicemonitor-dns-default.yaml") {
+				serviceMonitor = true
+			}
+			ds, ok := m.Object.(*appsv1.DaemonSet)
+			if !ok {
+				continue
+			}
+			containers := []string{}
+			for _, c := range ds.Spec.Template.Spec.Containers {
+				containers = append(containers, c.Name)
+			}
+			if strings.Join(containers, ",") != strings.Join(e.containers, ",") {
+				t.Errorf("%s: expected daemonset containers %v, got %v", profile.Name, e.containers, containers)
+			}
+		}
+		if serviceMonitor != e.serviceMonitor {
+			t.Errorf("%s: expected servicemonitor %t, got %t", profile.Name, e.serviceMonitor, serviceMonitor)
+		}
+	}
+}
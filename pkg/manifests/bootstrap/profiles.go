@@ -0,0 +1,79 @@
+package bootstrap
+
+import (
+	"fmt"
+	"strings"
+
+	operatorcontroller "github.com/openshift/cluster-dns-operator/pkg/operator/controller"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// DefaultProfile is the name of the profile in which all cluster capabilities
+// are enabled.
+const DefaultProfile = "default"
+
+// Profile is the set of manifests that the operator deploys for one
+// combination of cluster capabilities.
+type Profile struct {
+	// Name names the profile by the capabilities that it disables, so that
+	// it can be used as a directory name.
+	Name string
+	// DisabledCapabilities lists the cluster capabilities that are
+	// disabled in the profile.
+	DisabledCapabilities []string
+	// Manifests are the manifests of the resources that the operator
+	// deploys in the profile.
+	Manifests []Manifest
+}
+
+// RenderProfiles returns the manifests that the operator deploys for the
+// default dns for every combination of the optional cluster capabilities,
+// starting with the default profile, so that changes to any of them can be
+// found by comparing the output of two versions of the operator.
+func RenderProfiles(opts Options) ([]Profile, error) {
+	if len(opts.CoreDNSImage) == 0 || len(opts.OpenshiftCLIImage) == 0 || len(opts.KubeRBACProxyImage) == 0 {
+		return nil, fmt.Errorf("the CoreDNS, openshift client, and kube-rbac-proxy images are required")
+	}
+	if len(opts.ClusterIP) == 0 {
+		return nil, fmt.Errorf("the cluster IP is required")
+	}
+	capabilities := operatorcontroller.OptionalCapabilities()
+	profiles := []Profile{}
+	for mask := 0; mask < 1<<uint(len(capabilities)); mask++ {
+		disabled := []string{}
+		for i, c := range capabilities {
+			if mask&(1<<uint(i)) != 0 {
+				disabled = append(disabled, c)
+			}
+		}
+		objects, err := operatorcontroller.ProfileManifests(controllerConfig(opts), opts.ClusterIP, opts.ClusterDomain, disabled)
+		if err != nil {
+			return nil, fmt.Errorf("failed to render profile without capabilities %v: %v", disabled, err)
+		}
+		profile := Profile{Name: profileName(disabled), DisabledCapabilities: disabled}
+		for i, obj := range objects {
+			m, err := encode(obj)
+			if err != nil {
+				return nil, err
+			}
+			name := ""
+			if o, ok := obj.(metav1.Object); ok {
+				name = o.GetName()
+			}
+			m.Filename = fmt.Sprintf("%02d-%s-%s.yaml", i, strings.ToLower(obj.GetObjectKind().GroupVersionKind().Kind), name)
+			profile.Manifests = append(profile.Manifests, m)
+		}
+		profiles = append(profiles, profile)
+	}
+	return profiles, nil
+}
+
+// profileName returns the name of the profile that disables the given
+// capabilities.
+func profileName(disabled []string) string {
+	if len(disabled) == 0 {
+		return DefaultProfile
+	}
+	return "without-" + strings.ToLower(strings.Join(disabled, "-"))
+}
@@ -24,10 +24,39 @@ const (
 	MetricsRoleAsset               = "assets/dns/metrics/role.yaml"
 	MetricsRoleBindingAsset        = "assets/dns/metrics/role-binding.yaml"
 
+	ViewerClusterRoleAsset = "assets/dns/viewer/cluster-role.yaml"
+	ViewerRoleAsset        = "assets/dns/viewer/role.yaml"
+	ViewerRoleBindingAsset = "assets/dns/viewer/role-binding.yaml"
+
 	// OwningDNSLabel should be applied to any objects "owned by" a
 	// dns to aid in selection (especially in cases where an ownerref
 	// can't be established due to namespace boundaries).
 	OwningDNSLabel = "dns.operator.openshift.io/owning-dns"
+
+	// PauseReconciliationAnnotation may be set to "true" on a resource
+	// that is managed for a dns to stop the operator from updating that
+	// resource while it continues to manage the others, for example to
+	// try out a change to the daemonset.
+	PauseReconciliationAnnotation = "dns.operator.openshift.io/pause-reconciliation"
+
+	// PauseReconciliationUntilAnnotation may be set on a dns to a time in
+	// RFC 3339 format to stop the operator from updating any of the
+	// resources of the dns until that time, for example while the cluster
+	// is restored from an etcd backup.  Reconciliation resumes with a full
+	// resync once the time passes or the annotation is removed.
+	PauseReconciliationUntilAnnotation = "dns.operator.openshift.io/pause-reconciliation-until"
+
+	// ProfileNodeAnnotation may be set on a dns to the name of a node,
+	// along with ProfileUntilAnnotation, to enable the pprof plugin of
+	// CoreDNS and have the operator collect profiles from the dns pod on
+	// that node.  The operator removes both annotations once it has
+	// collected the profiles or the time has passed.
+	ProfileNodeAnnotation = "dns.operator.openshift.io/profile-node"
+
+	// ProfileUntilAnnotation is set on a dns to a time in RFC 3339 format
+	// until which the pprof plugin of CoreDNS is enabled for the node in
+	// ProfileNodeAnnotation.
+	ProfileUntilAnnotation = "dns.operator.openshift.io/profile-until"
 )
 
 func MustAssetReader(asset string) io.Reader {
@@ -114,6 +143,30 @@ func MetricsRoleBinding() *rbacv1.RoleBinding {
 	return rb
 }
 
+func ViewerClusterRole() *rbacv1.ClusterRole {
+	cr, err := NewClusterRole(MustAssetReader(ViewerClusterRoleAsset))
+	if err != nil {
+		panic(err)
+	}
+	return cr
+}
+
+func ViewerRole() *rbacv1.Role {
+	r, err := NewRole(MustAssetReader(ViewerRoleAsset))
+	if err != nil {
+		panic(err)
+	}
+	return r
+}
+
+func ViewerRoleBinding() *rbacv1.RoleBinding {
+	rb, err := NewRoleBinding(MustAssetReader(ViewerRoleBindingAsset))
+	if err != nil {
+		panic(err)
+	}
+	return rb
+}
+
 func NewServiceAccount(manifest io.Reader) (*corev1.ServiceAccount, error) {
 	sa := corev1.ServiceAccount{}
 	if err := yaml.NewYAMLOrJSONDecoder(manifest, 100).Decode(&sa); err != nil {
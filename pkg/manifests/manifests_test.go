@@ -16,4 +16,8 @@ func TestManifests(t *testing.T) {
 	MetricsClusterRoleBinding()
 	MetricsRole()
 	MetricsRoleBinding()
+
+	ViewerClusterRole()
+	ViewerRole()
+	ViewerRoleBinding()
 }
@@ -1,18 +1,195 @@
 package config
 
+import (
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"strings"
+
+	utilerrors "k8s.io/apimachinery/pkg/util/errors"
+	"k8s.io/apimachinery/pkg/util/yaml"
+)
+
 // Config is configuration for the operator and should include things like
 // operated images, release version, etc.
 type Config struct {
 	// OperatorReleaseVersion is the current version of the operator.
-	OperatorReleaseVersion string
+	OperatorReleaseVersion string `json:"operatorReleaseVersion,omitempty"`
 
 	// CoreDNSImage is the CoreDNS image to manage.
-	CoreDNSImage string
+	CoreDNSImage string `json:"coreDNSImage,omitempty"`
+
+	// CoreDNSVersion is the version of CoreDNS in CoreDNSImage.  If it is
+	// empty or invalid, the oldest version that the operator supports is
+	// assumed.
+	CoreDNSVersion string `json:"coreDNSVersion,omitempty"`
+
+	// CoreDNSExternalPlugins is a comma-separated list of the external
+	// plugins, such as rrl, that are compiled into CoreDNSImage.  Features
+	// that need an external plugin that is not listed are left out of the
+	// Corefile.
+	CoreDNSExternalPlugins string `json:"coreDNSExternalPlugins,omitempty"`
 
 	// OpenshiftCLIImage is the openshift client image to manage.
-	OpenshiftCLIImage string
+	OpenshiftCLIImage string `json:"openshiftCLIImage,omitempty"`
 
 	// KubeRBACProxyImage is the kube-rbac-proxy image to to use
 	// to secure the metrics endpoint.
-	KubeRBACProxyImage string
+	KubeRBACProxyImage string `json:"kubeRBACProxyImage,omitempty"`
+}
+
+// setting describes how a field of Config is set from a command-line flag and
+// an environment variable.
+type setting struct {
+	// flag is the name of the command-line flag.
+	flag string
+	// env is the name of the environment variable.
+	env string
+	// usage describes the setting in the command-line help.
+	usage string
+	// required indicates whether the setting must be set.
+	required bool
+	// field returns the field of the given config that the setting sets.
+	field func(*Config) *string
+}
+
+// settings lists the settings of Config.  To add a setting, such as another
+// operated image, add a field to Config and an entry here.
+var settings = []setting{{
+	flag:  "release-version",
+	env:   "RELEASE_VERSION",
+	usage: "the current version of the operator",
+	field: func(c *Config) *string { return &c.OperatorReleaseVersion },
+}, {
+	flag:     "coredns-image",
+	env:      "IMAGE",
+	usage:    "the CoreDNS image to manage",
+	required: true,
+	field:    func(c *Config) *string { return &c.CoreDNSImage },
+}, {
+	flag:  "coredns-version",
+	env:   "COREDNS_VERSION",
+	usage: "the version of CoreDNS in the CoreDNS image",
+	field: func(c *Config) *string { return &c.CoreDNSVersion },
+}, {
+	flag:  "coredns-external-plugins",
+	env:   "COREDNS_EXTERNAL_PLUGINS",
+	usage: "comma-separated external plugins, such as rrl, that are compiled into the CoreDNS image",
+	field: func(c *Config) *string { return &c.CoreDNSExternalPlugins },
+}, {
+	flag:     "openshift-cli-image",
+	env:      "OPENSHIFT_CLI_IMAGE",
+	usage:    "the openshift client image to manage",
+	required: true,
+	field:    func(c *Config) *string { return &c.OpenshiftCLIImage },
+}, {
+	flag:     "kube-rbac-proxy-image",
+	env:      "KUBE_RBAC_PROXY_IMAGE",
+	usage:    "the kube-rbac-proxy image to use to secure the metrics endpoint",
+	required: true,
+	field:    func(c *Config) *string { return &c.KubeRBACProxyImage },
+}}
+
+// Load parses the operator configuration from the given command-line
+// arguments, environment, and optional config file, which is named by the
+// --config flag and is a YAML or JSON representation of Config.  A flag takes
+// precedence over an environment variable, which takes precedence over the
+// config file.  The environment is read with getenv.  The resulting
+// configuration is validated.
+func Load(args []string, getenv func(string) string) (*Config, error) {
+	fs := flag.NewFlagSet("dns-operator", flag.ContinueOnError)
+	configFile := fs.String("config", "", "path to a YAML or JSON operator config file")
+	flags := map[string]*string{}
+	for _, s := range settings {
+		flags[s.flag] = fs.String(s.flag, "", fmt.Sprintf("%s (overrides $%s)", s.usage, s.env))
+	}
+	if err := fs.Parse(args); err != nil {
+		return nil, err
+	}
+	if fs.NArg() != 0 {
+		return nil, fmt.Errorf("unexpected arguments: %s", strings.Join(fs.Args(), " "))
+	}
+
+	config := &Config{}
+	if len(*configFile) != 0 {
+		data, err := ioutil.ReadFile(*configFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read config file: %v", err)
+		}
+		if err := yaml.NewYAMLOrJSONDecoder(strings.NewReader(string(data)), len(data)).Decode(config); err != nil {
+			return nil, fmt.Errorf("failed to parse config file %s: %v", *configFile, err)
+		}
+	}
+	for _, s := range settings {
+		if v := getenv(s.env); len(v) != 0 {
+			*s.field(config) = v
+		}
+	}
+	set := map[string]bool{}
+	fs.Visit(func(f *flag.Flag) { set[f.Name] = true })
+	for _, s := range settings {
+		if set[s.flag] {
+			*s.field(config) = *flags[s.flag]
+		}
+	}
+	if err := config.Validate(); err != nil {
+		return nil, err
+	}
+	return config, nil
+}
+
+// LoadFromEnvironment is Load with the process's command-line arguments and
+// environment.
+func LoadFromEnvironment() (*Config, error) {
+	return Load(os.Args[1:], os.Getenv)
+}
+
+// Validate returns an error listing every required setting that is missing.
+func (c *Config) Validate() error {
+	errs := []error{}
+	for _, s := range settings {
+		if s.required && len(*s.field(c)) == 0 {
+			errs = append(errs, fmt.Errorf("%s is required: set the --%s flag or the %s environment variable", s.flag, s.flag, s.env))
+		}
+	}
+	return utilerrors.NewAggregate(errs)
+}
+
+// TagReferencedImages returns the flag names of the configured images that are
+// referenced by tag rather than by digest.  Image mirror sets only apply to
+// images that are pulled by digest, so such images cannot be pulled from a
+// mirror on a disconnected cluster.
+func (c *Config) TagReferencedImages() []string {
+	images := []string{}
+	for _, s := range settings {
+		if !strings.HasSuffix(s.flag, "-image") {
+			continue
+		}
+		if image := *s.field(c); len(image) != 0 && !strings.Contains(image, "@sha256:") {
+			images = append(images, s.flag)
+		}
+	}
+	return images
+}
+
+// Reload returns a copy of the configuration in which each setting whose
+// environment variable has a different, non-empty value in getenv than in
+// previous is set to the value in getenv, along with the names of the
+// environment variables that changed.  Settings whose environment variables
+// did not change keep their values, which may have been set by a flag or the
+// config file.  The resulting configuration is validated.
+func (c *Config) Reload(previous, getenv func(string) string) (*Config, []string, error) {
+	config := *c
+	changed := []string{}
+	for _, s := range settings {
+		if v := getenv(s.env); len(v) != 0 && v != previous(s.env) {
+			*s.field(&config) = v
+			changed = append(changed, s.env)
+		}
+	}
+	if err := config.Validate(); err != nil {
+		return nil, nil, err
+	}
+	return &config, changed, nil
 }
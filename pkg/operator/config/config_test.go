@@ -0,0 +1,166 @@
+package config
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestLoad(t *testing.T) {
+	dir, err := ioutil.TempDir("", "dns-operator-config")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	configFile := filepath.Join(dir, "config.yaml")
+	if err := ioutil.WriteFile(configFile, []byte(`coreDNSImage: file-coredns
+openshiftCLIImage: file-cli
+kubeRBACProxyImage: file-proxy
+coreDNSVersion: 1.6.6
+`), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	completeEnv := map[string]string{
+		"RELEASE_VERSION":       "4.6.0",
+		"IMAGE":                 "env-coredns",
+		"OPENSHIFT_CLI_IMAGE":   "env-cli",
+		"KUBE_RBAC_PROXY_IMAGE": "env-proxy",
+	}
+	testCases := []struct {
+		description string
+		args        []string
+		env         map[string]string
+		expected    Config
+		expectErr   string
+	}{
+		{
+			description: "environment only",
+			env:         completeEnv,
+			expected: Config{
+				OperatorReleaseVersion: "4.6.0",
+				CoreDNSImage:           "env-coredns",
+				OpenshiftCLIImage:      "env-cli",
+				KubeRBACProxyImage:     "env-proxy",
+			},
+		},
+		{
+			description: "flags override the environment",
+			args:        []string{"--coredns-image=flag-coredns", "--coredns-version", "1.7.0", "--coredns-external-plugins=rrl"},
+			env:         completeEnv,
+			expected: Config{
+				OperatorReleaseVersion: "4.6.0",
+				CoreDNSImage:           "flag-coredns",
+				CoreDNSVersion:         "1.7.0",
+				CoreDNSExternalPlugins: "rrl",
+				OpenshiftCLIImage:      "env-cli",
+				KubeRBACProxyImage:     "env-proxy",
+			},
+		},
+		{
+			description: "environment overrides the config file",
+			args:        []string{"--config", configFile},
+			env:         map[string]string{"IMAGE": "env-coredns"},
+			expected: Config{
+				CoreDNSImage:       "env-coredns",
+				CoreDNSVersion:     "1.6.6",
+				OpenshiftCLIImage:  "file-cli",
+				KubeRBACProxyImage: "file-proxy",
+			},
+		},
+		{
+			description: "missing required settings",
+			env:         map[string]string{"IMAGE": "env-coredns"},
+			expectErr:   "openshift-cli-image is required",
+		},
+		{
+			description: "missing config file",
+			args:        []string{"--config", filepath.Join(dir, "missing.yaml")},
+			env:         completeEnv,
+			expectErr:   "failed to read config file",
+		},
+		{
+			description: "unknown flag",
+			args:        []string{"--image=foo"},
+			env:         completeEnv,
+			expectErr:   "flag provided but not defined",
+		},
+		{
+			description: "positional argument",
+			args:        []string{"foo"},
+			env:         completeEnv,
+			expectErr:   "unexpected arguments: foo",
+		},
+	}
+	for _, tc := range testCases {
+		getenv := func(name string) string { return tc.env[name] }
+		actual, err := Load(tc.args, getenv)
+		switch {
+		case len(tc.expectErr) != 0 && err == nil:
+			t.Errorf("%q: expected error containing %q, got %#v", tc.description, tc.expectErr, actual)
+		case len(tc.expectErr) != 0 && !strings.Contains(err.Error(), tc.expectErr):
+			t.Errorf("%q: expected error containing %q, got %v", tc.description, tc.expectErr, err)
+		case len(tc.expectErr) == 0 && err != nil:
+			t.Errorf("%q: unexpected error: %v", tc.description, err)
+		case len(tc.expectErr) == 0 && *actual != tc.expected:
+			t.Errorf("%q: expected %#v, got %#v", tc.description, tc.expected, *actual)
+		}
+	}
+}
+
+func TestTagReferencedImages(t *testing.T) {
+	config := &Config{
+		CoreDNSImage:       "quay.io/openshift/origin-coredns@sha256:0123456789abcdef0123456789abcdef0123456789abcdef0123456789abcdef",
+		OpenshiftCLIImage:  "quay.io/openshift/origin-cli:latest",
+		KubeRBACProxyImage: "quay.io/openshift/origin-kube-rbac-proxy",
+		CoreDNSVersion:     "1.6.6",
+	}
+	expected := "openshift-cli-image,kube-rbac-proxy-image"
+	if actual := strings.Join(config.TagReferencedImages(), ","); actual != expected {
+		t.Errorf("expected %q, got %q", expected, actual)
+	}
+}
+
+func TestReload(t *testing.T) {
+	config := &Config{
+		OperatorReleaseVersion: "4.6.0",
+		CoreDNSImage:           "flag-coredns",
+		OpenshiftCLIImage:      "env-cli",
+		KubeRBACProxyImage:     "env-proxy",
+	}
+	previous := map[string]string{
+		"RELEASE_VERSION":       "4.6.0",
+		"IMAGE":                 "env-coredns",
+		"OPENSHIFT_CLI_IMAGE":   "env-cli",
+		"KUBE_RBAC_PROXY_IMAGE": "env-proxy",
+	}
+	current := map[string]string{
+		"RELEASE_VERSION":       "4.7.0",
+		"IMAGE":                 "env-coredns",
+		"OPENSHIFT_CLI_IMAGE":   "new-cli",
+		"KUBE_RBAC_PROXY_IMAGE": "",
+	}
+	actual, changed, err := config.Reload(func(name string) string { return previous[name] }, func(name string) string { return current[name] })
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	// The flag keeps its value because its environment variable did not
+	// change, and removing a variable does not unset its setting.
+	expected := Config{
+		OperatorReleaseVersion: "4.7.0",
+		CoreDNSImage:           "flag-coredns",
+		OpenshiftCLIImage:      "new-cli",
+		KubeRBACProxyImage:     "env-proxy",
+	}
+	if *actual != expected {
+		t.Errorf("expected %#v, got %#v", expected, *actual)
+	}
+	if actual := strings.Join(changed, ","); actual != "RELEASE_VERSION,OPENSHIFT_CLI_IMAGE" {
+		t.Errorf("unexpected changed variables %q", actual)
+	}
+	if config.OperatorReleaseVersion != "4.6.0" {
+		t.Errorf("expected the original config to be unchanged, got %#v", *config)
+	}
+}
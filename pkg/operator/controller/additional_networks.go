@@ -0,0 +1,138 @@
+package controller
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	operatorv1 "github.com/openshift/api/operator/v1"
+
+	"github.com/sirupsen/logrus"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+
+	"k8s.io/apimachinery/pkg/util/validation"
+)
+
+const (
+	// multusNetworksAnnotation is the pod annotation that lists the
+	// secondary networks that the multus CNI plugin attaches the pod to.
+	multusNetworksAnnotation = "k8s.v1.cni.cncf.io/networks"
+
+	// additionalNetworkDNSPort is the port on which CoreDNS listens on the
+	// interface of each additional network.  Workloads on a secondary
+	// network reach the dns pods directly rather than through the dns
+	// service, so CoreDNS listens on the standard port there.
+	additionalNetworkDNSPort = int32(53)
+)
+
+// multusNetworkSelection is an element of the value of
+// multusNetworksAnnotation.
+type multusNetworkSelection struct {
+	Name      string `json:"name"`
+	Namespace string `json:"namespace"`
+	Interface string `json:"interface"`
+}
+
+// dnsAdditionalNetworks returns the additional networks of the given dns that
+// the dns pods are attached to, with the namespace of the dns pods filled in
+// where unset.  A network whose name or namespace is invalid, or that is
+// listed earlier, is ignored.
+func dnsAdditionalNetworks(dns *operatorv1.DNS) []operatorv1.DNSAdditionalNetwork {
+	networks := []operatorv1.DNSAdditionalNetwork{}
+	seen := map[operatorv1.DNSAdditionalNetwork]struct{}{}
+	for _, network := range dns.Spec.AdditionalNetworks {
+		if len(network.Namespace) == 0 {
+			network.Namespace = DNSDaemonSetName(dns).Namespace
+		}
+		if msgs := validation.IsDNS1123Subdomain(network.Name); len(msgs) != 0 {
+			logrus.Warningf("ignoring additional network %q of dns %s: invalid name: %s", network.Name, dns.Name, strings.Join(msgs, ", "))
+			continue
+		}
+		if msgs := validation.IsDNS1123Label(network.Namespace); len(msgs) != 0 {
+			logrus.Warningf("ignoring additional network %s of dns %s: invalid namespace %q: %s", network.Name, dns.Name, network.Namespace, strings.Join(msgs, ", "))
+			continue
+		}
+		if _, ok := seen[network]; ok {
+			logrus.Warningf("ignoring additional network %s/%s of dns %s: network is already attached", network.Namespace, network.Name, dns.Name)
+			continue
+		}
+		seen[network] = struct{}{}
+		networks = append(networks, network)
+	}
+	return networks
+}
+
+// additionalNetworkInterface returns the name of the interface of the
+// additional network at the given index in the dns pods.
+func additionalNetworkInterface(i int) string {
+	return fmt.Sprintf("dnsnet%d", i)
+}
+
+// additionalNetworkInterfaces returns the names of the interfaces of the
+// additional networks of the given dns in the dns pods.
+func additionalNetworkInterfaces(dns *operatorv1.DNS) []string {
+	interfaces := []string{}
+	for i := range dnsAdditionalNetworks(dns) {
+		interfaces = append(interfaces, additionalNetworkInterface(i))
+	}
+	return interfaces
+}
+
+// multusNetworks returns the value of multusNetworksAnnotation that attaches
+// the dns pods to the given additional networks.
+func multusNetworks(networks []operatorv1.DNSAdditionalNetwork) (string, error) {
+	selections := []multusNetworkSelection{}
+	for i, network := range networks {
+		selections = append(selections, multusNetworkSelection{
+			Name:      network.Name,
+			Namespace: network.Namespace,
+			Interface: additionalNetworkInterface(i),
+		})
+	}
+	value, err := json.Marshal(selections)
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal additional networks: %v", err)
+	}
+	return string(value), nil
+}
+
+// setDNSAdditionalNetworks attaches the pods of the given dns daemonset to the
+// additional networks of the given dns.  CoreDNS listens on a privileged port
+// on those networks, so the dns container is given the capability to bind it.
+func setDNSAdditionalNetworks(daemonset *appsv1.DaemonSet, dns *operatorv1.DNS) error {
+	networks := dnsAdditionalNetworks(dns)
+	if len(networks) == 0 {
+		return nil
+	}
+	value, err := multusNetworks(networks)
+	if err != nil {
+		return err
+	}
+	if daemonset.Spec.Template.Annotations == nil {
+		daemonset.Spec.Template.Annotations = map[string]string{}
+	}
+	daemonset.Spec.Template.Annotations[multusNetworksAnnotation] = value
+	for i, c := range daemonset.Spec.Template.Spec.Containers {
+		if c.Name != "dns" {
+			continue
+		}
+		if c.SecurityContext == nil {
+			daemonset.Spec.Template.Spec.Containers[i].SecurityContext = &corev1.SecurityContext{}
+		}
+		daemonset.Spec.Template.Spec.Containers[i].SecurityContext.Capabilities = &corev1.Capabilities{
+			Add: []corev1.Capability{"NET_BIND_SERVICE"},
+		}
+	}
+	return nil
+}
+
+// containerCapabilities returns the capabilities of the given container, or
+// nil if it has none.
+func containerCapabilities(c corev1.Container) *corev1.Capabilities {
+	if c.SecurityContext == nil {
+		return nil
+	}
+	return c.SecurityContext.Capabilities
+}
@@ -0,0 +1,120 @@
+package controller
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	operatorv1 "github.com/openshift/api/operator/v1"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestDNSAdditionalNetworks(t *testing.T) {
+	dns := &operatorv1.DNS{
+		ObjectMeta: metav1.ObjectMeta{Name: DefaultDNSController},
+		Spec: operatorv1.DNSSpec{
+			AdditionalNetworks: []operatorv1.DNSAdditionalNetwork{
+				{Name: "storage"},
+				{Name: "Invalid_Name"},
+				{Name: "storage", Namespace: "openshift-dns"},
+				{Name: "tenant", Namespace: "not valid"},
+				{Name: "tenant", Namespace: "tenant-a"},
+			},
+		},
+	}
+	expected := []operatorv1.DNSAdditionalNetwork{
+		{Name: "storage", Namespace: "openshift-dns"},
+		{Name: "tenant", Namespace: "tenant-a"},
+	}
+	if actual := dnsAdditionalNetworks(dns); !cmp.Equal(actual, expected) {
+		t.Errorf("expected %+v, got %+v", expected, actual)
+	}
+}
+
+func TestDesiredDNSDaemonSetAdditionalNetworks(t *testing.T) {
+	dns := &operatorv1.DNS{
+		ObjectMeta: metav1.ObjectMeta{Name: DefaultDNSController},
+	}
+	current, err := desiredDNSDaemonSet(dns, "172.30.0.10", "cluster.local", "coredns", "cli", "proxy", false, nil)
+	if err != nil {
+		t.Fatalf("invalid dns daemonset: %v", err)
+	}
+	if _, ok := current.Spec.Template.Annotations[multusNetworksAnnotation]; ok {
+		t.Errorf("expected no additional networks, got %q", current.Spec.Template.Annotations[multusNetworksAnnotation])
+	}
+
+	dns.Spec.AdditionalNetworks = []operatorv1.DNSAdditionalNetwork{
+		{Name: "storage"},
+		{Name: "tenant", Namespace: "tenant-a"},
+	}
+	desired, err := desiredDNSDaemonSet(dns, "172.30.0.10", "cluster.local", "coredns", "cli", "proxy", false, nil)
+	if err != nil {
+		t.Fatalf("invalid dns daemonset: %v", err)
+	}
+	expected := `[{"name":"storage","namespace":"openshift-dns","interface":"dnsnet0"},{"name":"tenant","namespace":"tenant-a","interface":"dnsnet1"}]`
+	if actual := desired.Spec.Template.Annotations[multusNetworksAnnotation]; actual != expected {
+		t.Errorf("expected annotation %s, got %s", expected, actual)
+	}
+	for _, c := range desired.Spec.Template.Spec.Containers {
+		if c.Name != "dns" {
+			continue
+		}
+		expectedCapabilities := &corev1.Capabilities{Add: []corev1.Capability{"NET_BIND_SERVICE"}}
+		if actual := containerCapabilities(c); !cmp.Equal(actual, expectedCapabilities) {
+			t.Errorf("expected capabilities %+v, got %+v", expectedCapabilities, actual)
+		}
+	}
+
+	changed, updated := daemonsetConfigChanged(current, desired)
+	if !changed {
+		t.Fatal("expected adding additional networks to change the daemonset")
+	}
+	if actual := updated.Spec.Template.Annotations[multusNetworksAnnotation]; actual != expected {
+		t.Errorf("expected updated annotation %s, got %s", expected, actual)
+	}
+	if changed, _ := daemonsetConfigChanged(updated, desired); changed {
+		t.Error("expected no change after update")
+	}
+	if changed, updated := daemonsetConfigChanged(desired, current); !changed {
+		t.Error("expected removing additional networks to change the daemonset")
+	} else if _, ok := updated.Spec.Template.Annotations[multusNetworksAnnotation]; ok {
+		t.Errorf("expected annotation to be removed, got %q", updated.Spec.Template.Annotations[multusNetworksAnnotation])
+	}
+}
+
+func TestDesiredDNSConfigMapAdditionalNetworks(t *testing.T) {
+	dns := &operatorv1.DNS{
+		ObjectMeta: metav1.ObjectMeta{Name: DefaultDNSController},
+		Spec: operatorv1.DNSSpec{
+			Servers: []operatorv1.Server{{
+				Name:          "corp",
+				Zones:         []string{"corp.example.com"},
+				ForwardPlugin: operatorv1.ForwardPlugin{Upstreams: []string{"10.0.0.1"}},
+			}},
+			AdditionalNetworks: []operatorv1.DNSAdditionalNetwork{{Name: "storage"}},
+		},
+	}
+	cm, err := desiredDNSConfigMap(dns, "cluster.local", nil, nil, nil, nil, nil, "")
+	if err != nil {
+		t.Fatalf("invalid dns configmap: %v", err)
+	}
+	corefile := cm.Data["Corefile"]
+	for _, s := range []string{
+		"corp.example.com:5353 {\n    forward . 10.0.0.1",
+		"corp.example.com:53 {\n    bind dnsnet0\n    forward . 10.0.0.1",
+		".:5353 {\n    errors",
+		".:53 {\n    bind dnsnet0\n    errors",
+	} {
+		if !strings.Contains(corefile, s) {
+			t.Errorf("expected Corefile to contain %q, got:\n%s", s, corefile)
+		}
+	}
+	for _, s := range []string{"health :", "ready :", "reload"} {
+		if n := strings.Count(corefile, s); n != 1 {
+			t.Errorf("expected Corefile to contain %q once, got %d times:\n%s", s, n, corefile)
+		}
+	}
+}
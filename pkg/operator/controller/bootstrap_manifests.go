@@ -0,0 +1,249 @@
+package controller
+
+import (
+	"bytes"
+	"fmt"
+	"path/filepath"
+	"text/template"
+
+	operatorv1 "github.com/openshift/api/operator/v1"
+
+	"github.com/openshift/cluster-dns-operator/pkg/manifests"
+
+	corev1 "k8s.io/api/core/v1"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/intstr"
+)
+
+// BootstrapManifests returns the resources that the operator creates for the
+// default dns with an empty spec, rendered with the images and CoreDNS version
+// in the given config and the given cluster IP and cluster domain, in the order
+// in which they should be created.  They are meant for installers that need cluster DNS
+// before the operator is running.
+//
+// The dns resources carry neither the owning-dns label nor an owner reference
+// because the default dns does not exist yet; the operator adopts them once it
+// creates the default dns.
+func BootstrapManifests(config Config, clusterIP, clusterDomain string) ([]runtime.Object, error) {
+	dns := &operatorv1.DNS{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: DefaultDNSController,
+		},
+	}
+	supported, _ := withoutUnsupportedFeatures(dns, config.CoreDNSVersion, config.CoreDNSExternalPlugins)
+	cm, err := desiredDNSConfigMap(supported, clusterDomain, nil, nil, nil, nil, nil, config.CoreDNSVersion)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build configmap: %v", err)
+	}
+	ds, err := desiredDNSDaemonSet(dns, clusterIP, clusterDomain, config.CoreDNSImage, config.OpenshiftCLIImage, config.KubeRBACProxyImage, false, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build daemonset: %v", err)
+	}
+	svc := desiredDNSService(dns, clusterIP, metav1.OwnerReference{})
+	for _, obj := range []metav1.Object{cm, ds, svc} {
+		obj.SetOwnerReferences(nil)
+		labels := obj.GetLabels()
+		delete(labels, manifests.OwningDNSLabel)
+		if len(labels) == 0 {
+			labels = nil
+		}
+		obj.SetLabels(labels)
+	}
+	return []runtime.Object{
+		manifests.DNSNamespace(),
+		desiredDNSClusterRole(),
+		manifests.DNSClusterRoleBinding(),
+		manifests.DNSServiceAccount(),
+		cm,
+		ds,
+		svc,
+	}, nil
+}
+
+const (
+	// BootstrapDNSDirectory is the directory on the bootstrap node from
+	// which the bootstrap dns pod loads its Corefile.
+	BootstrapDNSDirectory = "/etc/kubernetes/bootstrap-dns"
+
+	// BootstrapDNSManifest is the path of the manifest of the bootstrap dns
+	// static pod on the bootstrap node.  The pod removes it, and so stops
+	// itself, once the dns daemonset is available.
+	BootstrapDNSManifest = "/etc/kubernetes/manifests/bootstrap-dns.yaml"
+
+	// bootstrapDNSPort, bootstrapHealthPort, and bootstrapReadyPort are
+	// the ports of the bootstrap dns pod.  It uses the host network, so
+	// they must not collide with the ports of the components that run on
+	// the bootstrap node.
+	bootstrapDNSPort    = 15353
+	bootstrapHealthPort = 18080
+	bootstrapReadyPort  = 18181
+)
+
+// bootstrapCorefileTemplate is the Corefile of the bootstrap dns pod.  It
+// serves only the cluster domain and forwards everything else to the upstream
+// resolvers of the node; the operator renders the full Corefile once it runs.
+var bootstrapCorefileTemplate = template.Must(template.New("Corefile").Parse(`.:{{.Port}} {
+    errors
+    health :{{.HealthPort}} {
+        lameduck 5s
+    }
+    ready :{{.ReadyPort}}
+    kubernetes {{.ClusterDomain}} in-addr.arpa ip6.arpa {
+        kubeconfig {{.Kubeconfig}}
+        pods insecure
+        fallthrough in-addr.arpa ip6.arpa
+    }
+    forward . /etc/resolv.conf {
+        policy sequential
+    }
+    cache 30
+    reload
+}
+`))
+
+// bootstrapHandoffScript waits until every pod of the dns daemonset is
+// available and then removes the manifest of the bootstrap dns pod, so that
+// the kubelet stops it only once the dns service has other endpoints.
+const bootstrapHandoffScript = `#!/bin/bash
+set -uo pipefail
+
+while true; do
+  status="$(oc --kubeconfig=%[1]s get daemonset %[2]s -n %[3]s -o 'jsonpath={.status.desiredNumberScheduled} {.status.numberAvailable}' 2>/dev/null)"
+  read -r desired available <<< "${status}"
+  if [[ "${desired:-0}" -gt 0 && "${available:-0}" -ge "${desired}" ]]; then
+    echo "dns daemonset %[3]s/%[2]s is available, removing %[4]s"
+    rm -f "%[4]s"
+  fi
+  sleep 10
+done
+`
+
+// BootstrapDNSPod returns the static pod that runs a minimal CoreDNS on the
+// bootstrap node before the operator is running, rendered with the images in
+// the given config, the given cluster domain, and the kubeconfig at the given
+// path on the node, together with the Corefile that the pod loads from
+// BootstrapDNSDirectory.
+//
+// The pod carries the label of the pods of the default dns so that the dns
+// service in the manifests from BootstrapManifests selects it.  Once the
+// operator has rolled out the dns daemonset and all of its pods are
+// available, the pod removes its own manifest, so that resolution through the
+// dns service never has a gap.
+func BootstrapDNSPod(config Config, clusterDomain, kubeconfig string) (*corev1.Pod, string, error) {
+	if len(clusterDomain) == 0 {
+		clusterDomain = "cluster.local"
+	}
+	dns := &operatorv1.DNS{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: DefaultDNSController,
+		},
+	}
+	corefile := &bytes.Buffer{}
+	if err := bootstrapCorefileTemplate.Execute(corefile, struct {
+		Port, HealthPort, ReadyPort int
+		ClusterDomain, Kubeconfig   string
+	}{
+		Port:          bootstrapDNSPort,
+		HealthPort:    bootstrapHealthPort,
+		ReadyPort:     bootstrapReadyPort,
+		ClusterDomain: clusterDomain,
+		Kubeconfig:    kubeconfig,
+	}); err != nil {
+		return nil, "", fmt.Errorf("failed to render bootstrap Corefile: %v", err)
+	}
+
+	name := BootstrapDNSPodName()
+	labels := map[string]string{BootstrapDNSPodLabel: "true"}
+	for key, value := range DNSDaemonSetPodSelector(dns).MatchLabels {
+		labels[key] = value
+	}
+	daemonset := DNSDaemonSetName(dns)
+	hostPathFile := corev1.HostPathFile
+	hostPathDirectory := corev1.HostPathDirectory
+	pod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name.Name,
+			Namespace: name.Namespace,
+			Labels:    labels,
+		},
+		Spec: corev1.PodSpec{
+			HostNetwork:       true,
+			DNSPolicy:         corev1.DNSDefault,
+			PriorityClassName: "system-node-critical",
+			Containers: []corev1.Container{
+				{
+					Name:    "dns",
+					Image:   config.CoreDNSImage,
+					Command: []string{"coredns"},
+					Args:    []string{"-conf", BootstrapDNSDirectory + "/Corefile"},
+					Ports: []corev1.ContainerPort{
+						{Name: "dns", ContainerPort: bootstrapDNSPort, Protocol: corev1.ProtocolUDP},
+						{Name: "dns-tcp", ContainerPort: bootstrapDNSPort, Protocol: corev1.ProtocolTCP},
+					},
+					LivenessProbe: &corev1.Probe{
+						Handler: corev1.Handler{
+							HTTPGet: &corev1.HTTPGetAction{
+								Path:   "/health",
+								Port:   intstr.FromInt(bootstrapHealthPort),
+								Scheme: corev1.URISchemeHTTP,
+							},
+						},
+						InitialDelaySeconds: 60,
+						TimeoutSeconds:      5,
+						FailureThreshold:    5,
+					},
+					ReadinessProbe: &corev1.Probe{
+						Handler: corev1.Handler{
+							HTTPGet: &corev1.HTTPGetAction{
+								Path:   "/ready",
+								Port:   intstr.FromInt(bootstrapReadyPort),
+								Scheme: corev1.URISchemeHTTP,
+							},
+						},
+						PeriodSeconds:  3,
+						TimeoutSeconds: 3,
+					},
+					VolumeMounts: []corev1.VolumeMount{
+						{Name: "config", MountPath: BootstrapDNSDirectory, ReadOnly: true},
+						{Name: "kubeconfig", MountPath: kubeconfig, ReadOnly: true},
+					},
+					TerminationMessagePolicy: corev1.TerminationMessageFallbackToLogsOnError,
+				},
+				{
+					Name:    "handoff",
+					Image:   config.OpenshiftCLIImage,
+					Command: []string{"/bin/bash", "-c", fmt.Sprintf(bootstrapHandoffScript, kubeconfig, daemonset.Name, daemonset.Namespace, BootstrapDNSManifest)},
+					VolumeMounts: []corev1.VolumeMount{
+						{Name: "kubeconfig", MountPath: kubeconfig, ReadOnly: true},
+						{Name: "manifests", MountPath: filepath.Dir(BootstrapDNSManifest)},
+					},
+					TerminationMessagePolicy: corev1.TerminationMessageFallbackToLogsOnError,
+				},
+			},
+			Volumes: []corev1.Volume{
+				{
+					Name: "config",
+					VolumeSource: corev1.VolumeSource{
+						HostPath: &corev1.HostPathVolumeSource{Path: BootstrapDNSDirectory, Type: &hostPathDirectory},
+					},
+				},
+				{
+					Name: "kubeconfig",
+					VolumeSource: corev1.VolumeSource{
+						HostPath: &corev1.HostPathVolumeSource{Path: kubeconfig, Type: &hostPathFile},
+					},
+				},
+				{
+					Name: "manifests",
+					VolumeSource: corev1.VolumeSource{
+						HostPath: &corev1.HostPathVolumeSource{Path: filepath.Dir(BootstrapDNSManifest), Type: &hostPathDirectory},
+					},
+				},
+			},
+			Tolerations: []corev1.Toleration{{Operator: corev1.TolerationOpExists}},
+		},
+	}
+	return pod, corefile.String(), nil
+}
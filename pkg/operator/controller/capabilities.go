@@ -0,0 +1,76 @@
+package controller
+
+import (
+	"context"
+	"fmt"
+
+	configv1 "github.com/openshift/api/config/v1"
+
+	"github.com/openshift/cluster-dns-operator/pkg/util/slice"
+
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+const (
+	// NodeResolverCapability is the cluster capability for the
+	// node-resolver, which maintains /etc/hosts entries on each node.
+	NodeResolverCapability configv1.ClusterVersionCapability = "DNSNodeResolver"
+
+	// MetricsCapability is the cluster capability for the secured dns
+	// metrics endpoint (the kube-rbac-proxy sidecar) and its integration
+	// with openshift-monitoring.
+	MetricsCapability configv1.ClusterVersionCapability = "DNSMetrics"
+
+	// clusterVersionName is the name of the cluster's clusterversion.
+	clusterVersionName = "version"
+)
+
+// dnsCapabilities is the list of cluster capabilities for optional dns
+// components.
+var dnsCapabilities = []configv1.ClusterVersionCapability{
+	NodeResolverCapability,
+	MetricsCapability,
+}
+
+// getDisabledDNSCapabilities returns the cluster capabilities for optional dns
+// components that are disabled on the cluster.
+func (r *reconciler) getDisabledDNSCapabilities() ([]string, error) {
+	cv := &configv1.ClusterVersion{}
+	if err := r.client.Get(context.TODO(), types.NamespacedName{Name: clusterVersionName}, cv); err != nil {
+		if errors.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to get clusterversion %s: %v", clusterVersionName, err)
+	}
+	return disabledDNSCapabilities(cv.Status.Capabilities), nil
+}
+
+// disabledDNSCapabilities returns the cluster capabilities for optional dns
+// components that the given capabilities status reports as known but not
+// enabled.  Capabilities that are unknown to the cluster are considered
+// enabled.
+func disabledDNSCapabilities(status configv1.ClusterVersionCapabilitiesStatus) []string {
+	var disabled []string
+	for _, c := range dnsCapabilities {
+		if containsCapability(status.KnownCapabilities, c) && !containsCapability(status.EnabledCapabilities, c) {
+			disabled = append(disabled, string(c))
+		}
+	}
+	return disabled
+}
+
+// capabilityDisabled returns a Boolean indicating whether the given
+// capability is in the given list of disabled capabilities.
+func capabilityDisabled(disabled []string, c configv1.ClusterVersionCapability) bool {
+	return slice.ContainsString(disabled, string(c))
+}
+
+func containsCapability(capabilities []configv1.ClusterVersionCapability, c configv1.ClusterVersionCapability) bool {
+	for _, capability := range capabilities {
+		if capability == c {
+			return true
+		}
+	}
+	return false
+}
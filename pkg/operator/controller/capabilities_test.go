@@ -0,0 +1,54 @@
+package controller
+
+import (
+	"reflect"
+	"testing"
+
+	configv1 "github.com/openshift/api/config/v1"
+)
+
+func TestDisabledDNSCapabilities(t *testing.T) {
+	testCases := []struct {
+		description string
+		status      configv1.ClusterVersionCapabilitiesStatus
+		expected    []string
+	}{
+		{
+			description: "no capabilities reported",
+		},
+		{
+			description: "all capabilities known and enabled",
+			status: configv1.ClusterVersionCapabilitiesStatus{
+				EnabledCapabilities: []configv1.ClusterVersionCapability{NodeResolverCapability, MetricsCapability},
+				KnownCapabilities:   []configv1.ClusterVersionCapability{NodeResolverCapability, MetricsCapability},
+			},
+		},
+		{
+			description: "capabilities unknown to the cluster",
+			status: configv1.ClusterVersionCapabilitiesStatus{
+				EnabledCapabilities: []configv1.ClusterVersionCapability{"baremetal"},
+				KnownCapabilities:   []configv1.ClusterVersionCapability{"baremetal"},
+			},
+		},
+		{
+			description: "node-resolver known but not enabled",
+			status: configv1.ClusterVersionCapabilitiesStatus{
+				EnabledCapabilities: []configv1.ClusterVersionCapability{MetricsCapability},
+				KnownCapabilities:   []configv1.ClusterVersionCapability{NodeResolverCapability, MetricsCapability},
+			},
+			expected: []string{string(NodeResolverCapability)},
+		},
+		{
+			description: "all known but none enabled",
+			status: configv1.ClusterVersionCapabilitiesStatus{
+				KnownCapabilities: []configv1.ClusterVersionCapability{NodeResolverCapability, MetricsCapability},
+			},
+			expected: []string{string(NodeResolverCapability), string(MetricsCapability)},
+		},
+	}
+	for _, tc := range testCases {
+		if actual := disabledDNSCapabilities(tc.status); !reflect.DeepEqual(actual, tc.expected) {
+			t.Errorf("%s: expected %v, got %v", tc.description, tc.expected, actual)
+		}
+	}
+}
@@ -4,17 +4,23 @@ import (
 	"context"
 	"fmt"
 	"net"
+	"strings"
+	"time"
 
 	configv1 "github.com/openshift/api/config/v1"
 	operatorv1 "github.com/openshift/api/operator/v1"
 
+	"github.com/openshift/cluster-dns-operator/pkg/dnsstatus"
 	"github.com/openshift/cluster-dns-operator/pkg/manifests"
+	operatorconfig "github.com/openshift/cluster-dns-operator/pkg/operator/config"
+	"github.com/openshift/cluster-dns-operator/pkg/util/conditions"
 	"github.com/openshift/cluster-dns-operator/pkg/util/slice"
 
 	"github.com/sirupsen/logrus"
 
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
+	policyv1beta1 "k8s.io/api/policy/v1beta1"
 
 	"github.com/apparentlymart/go-cidr/cidr"
 
@@ -23,13 +29,14 @@ import (
 	"k8s.io/apimachinery/pkg/types"
 	utilerrors "k8s.io/apimachinery/pkg/util/errors"
 
-	"sigs.k8s.io/controller-runtime/pkg/cache"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/record"
+
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller"
 	"sigs.k8s.io/controller-runtime/pkg/handler"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
-	"sigs.k8s.io/controller-runtime/pkg/source"
 )
 
 const (
@@ -49,36 +56,109 @@ const (
 //
 // The controller will be pre-configured to watch for DNS resources.
 func New(mgr manager.Manager, config Config) (controller.Controller, error) {
-	reconciler := &reconciler{
-		Config: config,
-		client: mgr.GetClient(),
-		cache:  mgr.GetCache(),
+	kubeClient, err := kubernetes.NewForConfig(mgr.GetConfig())
+	if err != nil {
+		return nil, fmt.Errorf("failed to create kube client: %v", err)
 	}
-	c, err := controller.New(controllerName, mgr, controller.Options{Reconciler: reconciler})
+	reconciler := &reconciler{
+		Config:            config,
+		client:            mgr.GetClient(),
+		kubeClient:        kubeClient,
+		informers:         newSharedInformers(mgr),
+		recorder:          mgr.GetEventRecorderFor(controllerName),
+		cpuThrottling:     &cpuThrottlingTracker{},
+		cacheHitRatio:     &cacheHitRatioTracker{},
+		forwarderStats:    &forwarderStatsTracker{},
+		udpQueries:        &udpQueryTracker{},
+		reconcileFailures: &reconcileFailureTracker{},
+		references:        newReferenceIndex(),
+		history:           &dnsHistoryRecorder{},
+		unavailability:    &unavailabilityTracker{},
+		updateConflicts:   &updateConflictTracker{},
+		updateRejections:  &daemonsetRejectionTracker{},
+		profiles:          &profileCollectionTracker{},
+	}
+	c, err := controller.New(controllerName, mgr, controller.Options{Reconciler: newRecoveringReconciler(controllerName, reconciler, reconciler.reportRecurringPanics)})
 	if err != nil {
 		return nil, err
 	}
-	if err := c.Watch(&source.Kind{Type: &operatorv1.DNS{}}, &handler.EnqueueRequestForObject{}); err != nil {
+	reconciler.ingressWatcher = &ingressWatcher{informers: reconciler.informers, ctrl: c}
+	// The operand namespace is the namespace of the manager's cache, which
+	// also holds the cluster-scoped resources that the operator watches.
+	operandNamespace := manifests.DNSNamespace().Name
+	if err := reconciler.informers.watch(c, operandNamespace, &operatorv1.DNS{}, &handler.EnqueueRequestForObject{}); err != nil {
+		return nil, err
+	}
+	if err := reconciler.informers.watch(c, operandNamespace, &appsv1.DaemonSet{}, &handler.EnqueueRequestForOwner{OwnerType: &operatorv1.DNS{}}); err != nil {
+		return nil, err
+	}
+	if err := reconciler.informers.watch(c, operandNamespace, &corev1.Service{}, &handler.EnqueueRequestForOwner{OwnerType: &operatorv1.DNS{}}); err != nil {
+		return nil, err
+	}
+	if err := reconciler.informers.watch(c, operandNamespace, &corev1.ConfigMap{}, &handler.EnqueueRequestForOwner{OwnerType: &operatorv1.DNS{}}); err != nil {
+		return nil, err
+	}
+	if err := reconciler.informers.watch(c, operandNamespace, &policyv1beta1.PodDisruptionBudget{}, &handler.EnqueueRequestForOwner{OwnerType: &operatorv1.DNS{}}); err != nil {
+		return nil, err
+	}
+	// Configmaps and secrets that a dns refers to are not owned by the
+	// dns, so map them to the dnses that refer to them.
+	if err := reconciler.informers.watch(c, operandNamespace, &corev1.ConfigMap{}, reconciler.enqueueReferrers("configmap")); err != nil {
 		return nil, err
 	}
-	if err := c.Watch(&source.Kind{Type: &appsv1.DaemonSet{}}, &handler.EnqueueRequestForOwner{OwnerType: &operatorv1.DNS{}}); err != nil {
+	if err := reconciler.informers.watch(c, operandNamespace, &corev1.Secret{}, reconciler.enqueueReferrers("secret")); err != nil {
 		return nil, err
 	}
-	if err := c.Watch(&source.Kind{Type: &corev1.Service{}}, &handler.EnqueueRequestForOwner{OwnerType: &operatorv1.DNS{}}); err != nil {
+	// Restore the labels of the dns namespace if they are removed.
+	if err := reconciler.informers.watch(c, operandNamespace, &corev1.Namespace{}, &handler.EnqueueRequestsFromMapFunc{
+		ToRequests: handler.ToRequestsFunc(func(o handler.MapObject) []reconcile.Request {
+			if o.Meta.GetName() != manifests.DNSNamespace().Name {
+				return nil
+			}
+			return []reconcile.Request{{NamespacedName: types.NamespacedName{Name: DefaultDNSController}}}
+		}),
+	}); err != nil {
+		return nil, err
+	}
+	// Changes to the cluster's capabilities affect which components the
+	// default dns deploys.
+	if err := reconciler.informers.watch(c, operandNamespace, &configv1.ClusterVersion{}, &handler.EnqueueRequestsFromMapFunc{
+		ToRequests: handler.ToRequestsFunc(func(_ handler.MapObject) []reconcile.Request {
+			return []reconcile.Request{{NamespacedName: types.NamespacedName{Name: DefaultDNSController}}}
+		}),
+	}); err != nil {
 		return nil, err
 	}
-	if err := c.Watch(&source.Kind{Type: &corev1.ConfigMap{}}, &handler.EnqueueRequestForOwner{OwnerType: &operatorv1.DNS{}}); err != nil {
+	// Rollouts of the dns daemonset may be deferred while machine config
+	// pools update, so resume them once the pools finish.
+	if err := watchMachineConfigPools(mgr, c, reconciler.informers); err != nil {
 		return nil, err
 	}
+	// An upgrade changes the operand images in the operator's deployment,
+	// so roll them out without waiting for the operator to restart.
+	if config.OperatorConfig != nil {
+		reconciler.operatorConfig = newOperatorConfigWatcher(config)
+		if err := watchOperatorDeployment(c, reconciler.informers, reconciler.operatorConfig); err != nil {
+			return nil, err
+		}
+	}
 	return c, nil
 }
 
 // Config holds all the things necessary for the controller to run.
 type Config struct {
 	CoreDNSImage           string
+	CoreDNSVersion         string
+	CoreDNSExternalPlugins string
 	OpenshiftCLIImage      string
 	OperatorReleaseVersion string
 	KubeRBACProxyImage     string
+
+	// OperatorConfig is the operator configuration from which the fields
+	// above were set.  If it is not nil, the operator reloads the fields
+	// when the environment of the operator container in the operator's
+	// deployment changes.
+	OperatorConfig *operatorconfig.Config
 }
 
 // reconciler handles the actual dns reconciliation logic in response to
@@ -86,8 +166,53 @@ type Config struct {
 type reconciler struct {
 	Config
 
-	client client.Client
-	cache  cache.Cache
+	client     client.Client
+	kubeClient kubernetes.Interface
+	recorder   record.EventRecorder
+
+	// informers is the set of caches from which the operator's watches
+	// and cached reads are served.
+	informers *sharedInformers
+
+	// cpuThrottling tracks the CPU throttling of the dns pods between
+	// samples.
+	cpuThrottling *cpuThrottlingTracker
+	// cacheHitRatio tracks the cache counters of the dns pods between
+	// samples.
+	cacheHitRatio *cacheHitRatioTracker
+	// forwarderStats tracks the forward plugin counters of the dns pods
+	// between samples.
+	forwarderStats *forwarderStatsTracker
+	// udpQueries tracks the UDP query counters of the dns pods between
+	// samples.
+	udpQueries *udpQueryTracker
+	// ingressWatcher watches routes and ingresses once a dns enables
+	// ingress split-horizon.
+	ingressWatcher *ingressWatcher
+	// reconcileFailures summarizes repeated failures to reconcile the
+	// dns.
+	reconcileFailures *reconcileFailureTracker
+	// references records the configmaps and secrets that each dns refers
+	// to.
+	references *referenceIndex
+	// history holds significant actions that have not yet been recorded
+	// in the status of the dns.
+	history *dnsHistoryRecorder
+	// unavailability tracks since when too many dns pods have been
+	// unavailable or enough have been available.
+	unavailability *unavailabilityTracker
+	// updateConflicts tracks conflicts with other actors over the
+	// resources that the operator updates.
+	updateConflicts *updateConflictTracker
+	// updateRejections tracks the daemonsets whose updates the API server
+	// rejects.
+	updateRejections *daemonsetRejectionTracker
+	// operatorConfig reloads the configuration from the operator's
+	// deployment, or is nil if the configuration is fixed.
+	operatorConfig *operatorConfigWatcher
+	// profiles tracks the collection of CoreDNS profiles that runs in the
+	// background.
+	profiles *profileCollectionTracker
 }
 
 // Reconcile expects request to refer to a dns and will do all the work
@@ -98,6 +223,10 @@ func (r *reconciler) Reconcile(request reconcile.Request) (reconcile.Result, err
 
 	logrus.Infof("reconciling request: %v", request)
 
+	if r.operatorConfig != nil {
+		r.Config = r.operatorConfig.config()
+	}
+
 	if request.NamespacedName.Name != DefaultDNSController {
 		// Return a nil error value to avoid re-triggering the event.
 		logrus.Errorf("skipping unexpected dns %s", request.NamespacedName.Name)
@@ -116,8 +245,45 @@ func (r *reconciler) Reconcile(request reconcile.Request) (reconcile.Result, err
 		}
 		dns = nil
 	}
-
 	if dns != nil {
+		setOperatorLogLevel(dns)
+	}
+
+	namespaceTerminating := false
+	if dns != nil && dns.DeletionTimestamp == nil {
+		// Nothing can be created in the dns namespace while it is being
+		// deleted, so report the recovery and check again shortly.  Once
+		// the namespace is gone, it is recreated along with the resources
+		// in it.
+		if ns, err := r.terminatingDNSNamespace(); err != nil {
+			errs = append(errs, fmt.Errorf("failed to get dns namespace: %v", err))
+		} else if ns != nil {
+			namespaceTerminating = true
+			logrus.Infof("waiting for terminating dns namespace %s to be deleted", ns.Name)
+			if err := r.syncDNSNamespaceTerminatingStatus(dns, ns); err != nil {
+				errs = append(errs, fmt.Errorf("failed to sync status of dns %s: %v", dns.Name, err))
+			}
+			result.RequeueAfter = namespaceRecoveryCheckInterval
+		}
+	}
+
+	// Other components can pause reconciliation of the whole dns for a
+	// while, for example while the cluster is restored from a backup.
+	quiesced := false
+	if dns != nil && dns.DeletionTimestamp == nil && !namespaceTerminating {
+		if until, paused := reconciliationPausedUntil(dns, time.Now()); paused {
+			quiesced = true
+			logrus.Infof("reconciliation of dns %s is paused until %s", dns.Name, until.UTC().Format(time.RFC3339))
+			if err := r.syncDNSQuiescedStatus(dns, until); err != nil {
+				errs = append(errs, fmt.Errorf("failed to sync status of dns %s: %v", dns.Name, err))
+			}
+			result.RequeueAfter = time.Until(until)
+		} else if reconciliationQuiesced(dns) {
+			r.resumeReconciliation(dns)
+		}
+	}
+
+	if dns != nil && !namespaceTerminating && !quiesced {
 		// Ensure we have all the necessary scaffolding on which to place dns instances.
 		if err := r.ensureDNSNamespace(); err != nil {
 			errs = append(errs, fmt.Errorf("failed to ensure dns namespace: %v", err))
@@ -131,6 +297,11 @@ func (r *reconciler) Reconcile(request reconcile.Request) (reconcile.Result, err
 			if err := r.ensureDNSDeleted(dns); err != nil {
 				errs = append(errs, fmt.Errorf("failed to ensure deletion for dns %s: %v", dns.Name, err))
 			}
+			r.references.forget(dns.Name)
+			r.forwarderStats.forget(dns.Name)
+			r.udpQueries.forget(dns.Name)
+			r.cacheHitRatio.forget(dns.Name)
+			r.updateRejections.forget(dns.Name)
 
 			if len(errs) == 0 {
 				// Clean up the finalizer to allow the dns to be deleted.
@@ -146,10 +317,18 @@ func (r *reconciler) Reconcile(request reconcile.Request) (reconcile.Result, err
 			errs = append(errs, fmt.Errorf("failed to enforce finalizer for dns %s: %v", dns.Name, err))
 		} else {
 			// Handle everything else.
-			if err := r.ensureDNS(dns); err != nil {
+			if requeueAfter, err := r.ensureDNS(dns); err != nil {
 				errs = append(errs, fmt.Errorf("failed to ensure dns %s: %v", dns.Name, err))
 			} else if err := r.ensureExternalNameForOpenshiftService(); err != nil {
 				errs = append(errs, fmt.Errorf("failed to ensure external name for openshift service: %v", err))
+			} else {
+				// Requeue so that the dns pods are sampled periodically
+				// even if nothing else changes.
+				result.RequeueAfter = requeueAfter
+				// Check on a profiling request more often.
+				if interval := r.syncDNSProfiling(dns); interval != 0 && (result.RequeueAfter == 0 || interval < result.RequeueAfter) {
+					result.RequeueAfter = interval
+				}
 			}
 		}
 	}
@@ -163,6 +342,17 @@ func (r *reconciler) Reconcile(request reconcile.Request) (reconcile.Result, err
 	if len(errs) > 0 {
 		logrus.Errorf("failed to reconcile request %s: %v", request, utilerrors.NewAggregate(errs))
 	}
+	// Summarize repeated failures in events on the dns rather than
+	// emitting an event for every retry.
+	if dns != nil && dns.DeletionTimestamp == nil {
+		if len(errs) > 0 {
+			if err := r.reportReconcileFailure(dns, utilerrors.NewAggregate(errs)); err != nil {
+				logrus.Errorf("failed to report reconcile failure for dns %s: %v", dns.Name, err)
+			}
+		} else {
+			r.reconcileFailures.reset()
+		}
+	}
 	return result, utilerrors.NewAggregate(errs)
 }
 
@@ -252,12 +442,33 @@ func (r *reconciler) ensureDNSNamespace() error {
 			return fmt.Errorf("failed to create dns namespace %s: %v", ns.Name, err)
 		}
 		logrus.Infof("created dns namespace: %s", ns.Name)
+	} else if changed, updated := namespaceLabelsChanged(ns, manifests.DNSNamespace()); changed {
+		// Without its labels, the namespace is not scraped by
+		// openshift-monitoring and the dns pods may not be admitted, so
+		// restore them.
+		if err := r.client.Update(context.TODO(), updated); err != nil {
+			return fmt.Errorf("failed to update dns namespace %s: %v", ns.Name, err)
+		}
+		logrus.Infof("restored labels of dns namespace: %s", ns.Name)
 	}
 
-	if _, _, err := r.ensureDNSClusterRole(); err != nil {
-		return fmt.Errorf("failed to ensure dns cluster role for %s: %v", manifests.DNSClusterRole().Name, err)
-	}
+	// The RBAC resources and the service account are independent of each
+	// other, so ensure them concurrently.
+	return runConcurrently(
+		func() error {
+			if _, _, err := r.ensureDNSClusterRole(); err != nil {
+				return fmt.Errorf("failed to ensure dns cluster role for %s: %v", manifests.DNSClusterRole().Name, err)
+			}
+			return nil
+		},
+		r.ensureDNSClusterRoleBinding,
+		r.ensureDNSServiceAccount,
+		r.ensureViewerRBAC,
+	)
+}
 
+// ensureDNSClusterRoleBinding ensures that the dns cluster role binding exists.
+func (r *reconciler) ensureDNSClusterRoleBinding() error {
 	crb := manifests.DNSClusterRoleBinding()
 	if err := r.client.Get(context.TODO(), types.NamespacedName{Name: crb.Name}, crb); err != nil {
 		if !errors.IsNotFound(err) {
@@ -268,7 +479,11 @@ func (r *reconciler) ensureDNSNamespace() error {
 		}
 		logrus.Infof("created dns cluster role binding: %s", crb.Name)
 	}
+	return nil
+}
 
+// ensureDNSServiceAccount ensures that the dns service account exists.
+func (r *reconciler) ensureDNSServiceAccount() error {
 	sa := manifests.DNSServiceAccount()
 	if err := r.client.Get(context.TODO(), types.NamespacedName{Namespace: sa.Namespace, Name: sa.Name}, sa); err != nil {
 		if !errors.IsNotFound(err) {
@@ -285,6 +500,22 @@ func (r *reconciler) ensureDNSNamespace() error {
 
 // ensureMetricsIntegration ensures that dns prometheus metrics are integrated with openshift-monitoring for the given DNS.
 func (r *reconciler) ensureMetricsIntegration(dns *operatorv1.DNS, svc *corev1.Service, daemonsetRef metav1.OwnerReference) error {
+	return runConcurrently(
+		r.ensureMetricsClusterRole,
+		r.ensureMetricsClusterRoleBinding,
+		r.ensureMetricsRole,
+		r.ensureMetricsRoleBinding,
+		func() error {
+			if _, _, err := r.ensureServiceMonitor(dns, svc, daemonsetRef); err != nil {
+				return fmt.Errorf("failed to ensure servicemonitor for %s: %v", dns.Name, err)
+			}
+			return nil
+		},
+	)
+}
+
+// ensureMetricsClusterRole ensures that the dns metrics cluster role exists.
+func (r *reconciler) ensureMetricsClusterRole() error {
 	cr := manifests.MetricsClusterRole()
 	if err := r.client.Get(context.TODO(), types.NamespacedName{Name: cr.Name}, cr); err != nil {
 		if !errors.IsNotFound(err) {
@@ -295,7 +526,12 @@ func (r *reconciler) ensureMetricsIntegration(dns *operatorv1.DNS, svc *corev1.S
 		}
 		logrus.Infof("created dns metrics cluster role %s", cr.Name)
 	}
+	return nil
+}
 
+// ensureMetricsClusterRoleBinding ensures that the dns metrics cluster role
+// binding exists.
+func (r *reconciler) ensureMetricsClusterRoleBinding() error {
 	crb := manifests.MetricsClusterRoleBinding()
 	if err := r.client.Get(context.TODO(), types.NamespacedName{Name: crb.Name}, crb); err != nil {
 		if !errors.IsNotFound(err) {
@@ -306,7 +542,11 @@ func (r *reconciler) ensureMetricsIntegration(dns *operatorv1.DNS, svc *corev1.S
 		}
 		logrus.Infof("created dns metrics cluster role binding %s", crb.Name)
 	}
+	return nil
+}
 
+// ensureMetricsRole ensures that the dns metrics role exists.
+func (r *reconciler) ensureMetricsRole() error {
 	mr := manifests.MetricsRole()
 	if err := r.client.Get(context.TODO(), types.NamespacedName{Namespace: mr.Namespace, Name: mr.Name}, mr); err != nil {
 		if !errors.IsNotFound(err) {
@@ -317,7 +557,11 @@ func (r *reconciler) ensureMetricsIntegration(dns *operatorv1.DNS, svc *corev1.S
 		}
 		logrus.Infof("created dns metrics role %s/%s", mr.Namespace, mr.Name)
 	}
+	return nil
+}
 
+// ensureMetricsRoleBinding ensures that the dns metrics role binding exists.
+func (r *reconciler) ensureMetricsRoleBinding() error {
 	mrb := manifests.MetricsRoleBinding()
 	if err := r.client.Get(context.TODO(), types.NamespacedName{Namespace: mrb.Namespace, Name: mrb.Name}, mrb); err != nil {
 		if !errors.IsNotFound(err) {
@@ -328,29 +572,204 @@ func (r *reconciler) ensureMetricsIntegration(dns *operatorv1.DNS, svc *corev1.S
 		}
 		logrus.Infof("created dns metrics role binding %s/%s", mrb.Namespace, mrb.Name)
 	}
-
-	if _, _, err := r.ensureServiceMonitor(dns, svc, daemonsetRef); err != nil {
-		return fmt.Errorf("failed to ensure servicemonitor for %s: %v", dns.Name, err)
-	}
-
 	return nil
 }
 
-// ensureDNS ensures all necessary dns resources exist for a given dns.
-func (r *reconciler) ensureDNS(dns *operatorv1.DNS) error {
+// ensureDNS ensures all necessary dns resources exist for a given dns.  It
+// returns the interval after which the dns pods should be sampled again.
+func (r *reconciler) ensureDNS(dns *operatorv1.DNS) (time.Duration, error) {
 	// TODO: fetch this from higher level openshift resource when it is exposed
-	clusterDomain := "cluster.local"
-	clusterIP, err := r.getClusterIPFromNetworkConfig()
+	clusterDomain := dnsstatus.DefaultClusterDomain
+	if err := validateDNSProbePorts(dns); err != nil {
+		return 0, fmt.Errorf("invalid probe ports: %v", err)
+	}
+	// Record the configmaps and secrets that the dns refers to during
+	// this reconcile so that changes to them requeue the dns.
+	r.references.begin(dns.Name)
+	defer r.references.end(dns.Name)
+	// Adopt pre-existing resources, and leave alone any that are owned by
+	// something else.
+	conflicts, paused, err := r.ensureDNSOwnership(dns)
 	if err != nil {
-		return fmt.Errorf("failed to get cluster IP from network config: %v", err)
+		return 0, fmt.Errorf("failed to ensure ownership of resources for dns %s: %v", dns.Name, err)
+	}
+	// skip returns a Boolean indicating whether the named resource must
+	// not be updated.
+	skip := func(name types.NamespacedName) bool {
+		return conflicts.has(name) || paused.has(name)
+	}
+
+	// The cluster IP, the disabled capabilities, the trusted CA configmap,
+	// the Corefile configmap, and the kube-dns alias service do not depend
+	// on one another, so look them up and ensure them concurrently.  The
+	// daemonset depends on all of them but the Corefile configmap and the
+	// alias service.
+	var (
+		clusterIP, hash               string
+		overrideHashes                []string
+		clusterIPErr, capabilitiesErr error
+		disabledCapabilities          []string
+		haveTrustedCA                 bool
+		corefileCompatibility         *corefileCompatibility
+		servedZones                   []string
+	)
+	err = runConcurrently(
+		func() error {
+			clusterIP, clusterIPErr = r.getClusterIPFromNetworkConfig()
+			return nil
+		},
+		func() error {
+			disabledCapabilities, capabilitiesErr = r.getDisabledDNSCapabilities()
+			return nil
+		},
+		func() error {
+			// Mount the trusted CA bundle into the dns pods only once it
+			// has been injected so that the daemonset is not rolled out
+			// twice.
+			// If reconciliation of the configmap is paused, keep
+			// mounting the bundle if it has been injected.
+			var (
+				cm  *corev1.ConfigMap
+				err error
+			)
+			switch name := DNSTrustedCAConfigMapName(dns); {
+			case conflicts.has(name):
+				return nil
+			case paused.has(name):
+				_, cm, err = r.currentTrustedCAConfigMap(dns)
+			default:
+				_, cm, err = r.ensureTrustedCAConfigMap(dns)
+			}
+			if err != nil {
+				return fmt.Errorf("failed to ensure trusted ca configmap for dns %s: %v", dns.Name, err)
+			}
+			haveTrustedCA = trustedCABundleInjected(cm)
+			return nil
+		},
+		func() error {
+			// If reconciliation of the configmap is paused, track the
+			// rollout of the Corefile that it has.
+			var (
+				haveCM bool
+				cm     *corev1.ConfigMap
+				err    error
+			)
+			if conflicts.has(DNSConfigMapName(dns)) {
+				return nil
+			}
+			extensions, extensionsErr := r.getExtensionServers(dns)
+			if extensionsErr != nil {
+				return fmt.Errorf("failed to get extension servers for dns %s: %v", dns.Name, extensionsErr)
+			}
+			servedZones = corefileServedZones(dns, clusterDomain, extensions)
+			extraConfigs, extraConfigsErr := r.getExtraConfigs(dns)
+			if extraConfigsErr != nil {
+				return fmt.Errorf("failed to get extra configs for dns %s: %v", dns.Name, extraConfigsErr)
+			}
+			if paused.has(DNSConfigMapName(dns)) {
+				haveCM, cm, err = r.currentDNSConfigMap(dns)
+			} else {
+				ingressHosts, hostsErr := r.getIngressHosts(dns)
+				if hostsErr != nil {
+					return fmt.Errorf("failed to get ingress host names for dns %s: %v", dns.Name, hostsErr)
+				}
+				listenAddresses, listenErr := r.getListenAddresses(dns)
+				if listenErr != nil {
+					return fmt.Errorf("failed to get listen addresses for dns %s: %v", dns.Name, listenErr)
+				}
+				caBundles, caBundlesErr := r.getForwardCABundles(dns)
+				if caBundlesErr != nil {
+					return fmt.Errorf("failed to get forward CA bundles for dns %s: %v", dns.Name, caBundlesErr)
+				}
+				if err := r.ensureDNSPprofConfigMap(dns); err != nil {
+					return fmt.Errorf("failed to ensure pprof configmap for dns %s: %v", dns.Name, err)
+				}
+				haveCM, cm, corefileCompatibility, err = r.ensureDNSConfigMap(dns, clusterDomain, ingressHosts, extensions, extraConfigs, listenAddresses, caBundles)
+			}
+			if err != nil {
+				return fmt.Errorf("failed to create configmap for dns %s: %v", dns.Name, err)
+			}
+			if haveCM {
+				hash = corefileHash(cm.Data["Corefile"])
+				overrideHashes = nodeOverrideCorefileHashes(cm)
+			}
+			return nil
+		},
+		func() error {
+			if skip(KubeDNSServiceName(dns)) {
+				return nil
+			}
+			if _, _, err := r.ensureKubeDNSService(dns); err != nil {
+				return fmt.Errorf("failed to ensure kube-dns service for dns %s: %v", dns.Name, err)
+			}
+			return nil
+		},
+	)
+	if clusterIPErr != nil {
+		return 0, fmt.Errorf("failed to get cluster IP from network config: %v", clusterIPErr)
+	}
+	if capabilitiesErr != nil {
+		return 0, fmt.Errorf("failed to get disabled capabilities: %v", capabilitiesErr)
 	}
 
+	requeueAfter := cacheStatsSampleInterval
 	errs := []error{}
-	if haveDS, daemonset, err := r.ensureDNSDaemonSet(dns, clusterIP, clusterDomain); err != nil {
+	if err != nil {
+		errs = append(errs, err)
+	}
+	// The service mesh configmap publishes the cluster IP and the zones of
+	// the Corefile, so ensure it once both are known.
+	if !skip(DNSServiceMeshConfigMapName(dns)) && servedZones != nil {
+		if _, _, err := r.ensureServiceMeshConfigMap(dns, clusterIP, clusterDomain, servedZones); err != nil {
+			errs = append(errs, fmt.Errorf("failed to ensure service mesh configmap for dns %s: %v", dns.Name, err))
+		}
+	}
+	// The client settings configmap publishes the cluster IP, so ensure it
+	// once the cluster IP is known.
+	if !skip(DNSClientSettingsConfigMapName(dns)) && len(clusterIP) != 0 {
+		if _, _, err := r.ensureClientSettingsConfigMap(dns, clusterIP, clusterDomain); err != nil {
+			errs = append(errs, fmt.Errorf("failed to ensure client settings configmap for dns %s: %v", dns.Name, err))
+		}
+	}
+	for _, message := range conflicts.messages() {
+		errs = append(errs, fmt.Errorf("%s", message))
+	}
+	if len(disabledCapabilities) != 0 {
+		logrus.Infof("skipping components for disabled capabilities: %v", disabledCapabilities)
+	}
+
+	if conflicts.has(DNSDaemonSetName(dns)) {
+		// Report the conflict even though there is no daemonset of the
+		// dns to report on.
+		if _, err := r.syncDNSStatus(dns, clusterIP, clusterDomain, &appsv1.DaemonSet{}, dnsStatusSamples{
+			corefileStatus:        dns.Status.CorefileStatus,
+			corefileCompatibility: corefileCompatibility,
+			podVersions:           dns.Status.PodVersions,
+		}, disabledCapabilities, conflicts.messages(), paused.messages()); err != nil {
+			errs = append(errs, fmt.Errorf("failed to sync status of dns %s: %v", dns.Name, err))
+		}
+	} else if haveDS, daemonset, rolloutDeferral, err := r.ensureDNSDaemonSetUnlessPaused(dns, paused, clusterIP, clusterDomain, haveTrustedCA, disabledCapabilities); err != nil {
 		errs = append(errs, fmt.Errorf("failed to ensure daemonset for dns %s: %v", dns.Name, err))
 	} else if !haveDS {
 		errs = append(errs, fmt.Errorf("failed to get daemonset for dns %s", dns.Name))
 	} else {
+		// The daemonsets of node overrides follow the dns daemonset,
+		// including when its reconciliation is paused.
+		if !paused.has(DNSDaemonSetName(dns)) {
+			if err := r.ensureNodeOverrideDaemonSets(dns, clusterIP, clusterDomain, haveTrustedCA, disabledCapabilities); err != nil {
+				errs = append(errs, fmt.Errorf("failed to ensure node override daemonsets for dns %s: %v", dns.Name, err))
+			}
+		}
+		// The budget follows the number of pods that the daemonsets
+		// schedule, which changes as nodes come and go.
+		if !skip(DNSPodDisruptionBudgetName(dns)) {
+			if daemonsets, err := r.listDNSDaemonSets(dns); err != nil {
+				errs = append(errs, err)
+			} else if err := r.ensureDNSPodDisruptionBudget(dns, daemonsets); err != nil {
+				errs = append(errs, fmt.Errorf("failed to ensure pod disruption budget for dns %s: %v", dns.Name, err))
+			}
+		}
+
 		trueVar := true
 		daemonsetRef := metav1.OwnerReference{
 			APIVersion: "apps/v1",
@@ -360,26 +779,166 @@ func (r *reconciler) ensureDNS(dns *operatorv1.DNS) error {
 			Controller: &trueVar,
 		}
 
-		if _, _, err := r.ensureDNSConfigMap(dns, clusterDomain); err != nil {
-			errs = append(errs, fmt.Errorf("failed to create configmap for dns %s: %v", dns.Name, err))
+		// The service and metrics integration are owned by the daemonset
+		// but are independent of sampling the dns pods, so do both
+		// concurrently.
+		var (
+			cacheStats     *operatorv1.DNSCacheStats
+			forwarderStats *operatorv1.DNSForwarderStats
+			cpuThrottling  *cpuThrottlingSample
+			cacheHitRatio  *cacheHitRatioSample
+		)
+		// CoreDNS versions before corefileHashMinVersion do not report
+		// the Corefile that they have loaded, so the status only says
+		// that it cannot be verified.
+		corefileStatus := dns.Status.CorefileStatus
+		verifyCorefile := len(hash) != 0 && corefileHashReported(r.CoreDNSVersion)
+		if len(hash) != 0 && !verifyCorefile {
+			corefileStatus = unverifiedCorefileStatus(corefileStatus, hash, r.CoreDNSVersion, metav1.Now())
+		}
+		if err := runConcurrently(
+			func() error {
+				if skip(DNSServiceName(dns)) {
+					return nil
+				} else if haveSvc, svc, err := r.ensureDNSService(dns, clusterIP, daemonsetRef); err != nil {
+					// Set clusterIP to an empty string to cause
+					// ClusterOperator to report Available=False and
+					// Degraded=True.
+					clusterIP = ""
+					return fmt.Errorf("failed to create service for dns %s: %v", dns.Name, err)
+				} else if !haveSvc {
+					return fmt.Errorf("failed to get service for dns %s", dns.Name)
+				} else if capabilityDisabled(disabledCapabilities, MetricsCapability) {
+					logrus.Infof("skipping metrics integration for dns %s: capability %s is disabled", dns.Name, MetricsCapability)
+				} else if err := r.ensureMetricsIntegration(dns, svc, daemonsetRef); err != nil {
+					return fmt.Errorf("failed to integrate metrics with openshift-monitoring for dns %s: %v", dns.Name, err)
+				}
+				return nil
+			},
+			func() error {
+				// Sample the dns pods for cache statistics and for the
+				// Corefile that they have loaded.  Configuration changes
+				// are applied by CoreDNS reloading the Corefile, so sample
+				// more frequently until all pods have loaded the current
+				// Corefile.
+				corefileStale := verifyCorefile && corefileStatusStale(corefileStatus, hash)
+				statsDue := cacheStatsDue(dns, time.Now())
+				if !statsDue && !corefileStale {
+					return nil
+				}
+				// Heavy CPU throttling is a common hidden cause of
+				// latency spikes, so check for it along with the cache
+				// statistics.
+				if statsDue {
+					if sample, err := r.sampleDNSCPUThrottling(dns); err != nil {
+						logrus.Errorf("failed to sample cpu throttling for dns %s: %v", dns.Name, err)
+					} else {
+						cpuThrottling = sample
+						if old := conditions.FindOperatorCondition(dns.Status.Conditions, DNSCPUThrottledConditionType); len(sample.throttledPods) != 0 && (old == nil || old.Status != operatorv1.ConditionTrue) {
+							r.recorder.Eventf(dns, corev1.EventTypeWarning, "CPUThrottled", "CoreDNS is heavily CPU throttled in %d of %d pods", len(sample.throttledPods), sample.sampledPods)
+						}
+					}
+				}
+				if podMetrics, err := r.scrapeDNSPodMetrics(dns); err != nil {
+					logrus.Errorf("failed to sample pods for dns %s: %v", dns.Name, err)
+				} else if len(podMetrics) > 0 {
+					now := metav1.Now()
+					samples := podMetricsSamples(podMetrics)
+					cacheStats = summarizeCacheStats(samples, now)
+					recordCacheStatsMetrics(dns.Name, cacheStats)
+					forwarderStats = r.sampleForwarderStats(dns, podMetrics, now)
+					r.sampleUDPConntrackUsage(dns.Name, podMetrics, now.Time)
+					if statsDue {
+						if sample, err := r.sampleCacheHitRatio(dns, podMetrics); err != nil {
+							logrus.Errorf("failed to sample cache hit ratio for dns %s: %v", dns.Name, err)
+						} else {
+							cacheHitRatio = sample
+							if old := conditions.FindOperatorCondition(dns.Status.Conditions, DNSCacheHitRatioLowConditionType); sample.collapsed() && (old == nil || old.Status != operatorv1.ConditionTrue) {
+								r.recorder.Eventf(dns, corev1.EventTypeWarning, "CacheHitRatioLow", "The CoreDNS cache hit ratio across the cluster is %d%%; the nodes with the lowest hit ratio are: %s", sample.percent, strings.Join(sample.lowestNodes(), ", "))
+							}
+						}
+					}
+					if verifyCorefile {
+						corefileStatus = computeCorefileStatus(samples, hash, overrideHashes, now)
+					}
+				}
+				return nil
+			},
+		); err != nil {
+			errs = append(errs, err)
+		}
+		if verifyCorefile && corefileStatusStale(corefileStatus, hash) {
+			requeueAfter = corefileRolloutCheckInterval
+		}
+
+		// Unavailable dns pods on unschedulable or NotReady nodes are
+		// not counted against the dns so that a broken node does not make
+		// the dns degraded.
+		var unhealthyNodePods int32
+		if daemonset.Status.NumberAvailable < daemonset.Status.DesiredNumberScheduled {
+			if n, err := r.countUnavailableDNSPodsOnUnhealthyNodes(dns); err != nil {
+				logrus.Errorf("failed to count dns pods on unhealthy nodes for dns %s: %v", dns.Name, err)
+			} else {
+				unhealthyNodePods = n
+			}
+		}
+
+		// A partial rollout of kubelet configuration can leave nodes
+		// pointing pods at an address other than the dns service, so
+		// check the kubelets along with the cache statistics.
+		var kubeletClusterDNS *kubeletClusterDNSSample
+		if len(clusterIP) != 0 && cacheStatsDue(dns, time.Now()) {
+			if sample, err := r.sampleKubeletClusterDNS(clusterIP); err != nil {
+				logrus.Errorf("failed to sample kubelet cluster dns for dns %s: %v", dns.Name, err)
+			} else {
+				kubeletClusterDNS = sample
+				if old := conditions.FindOperatorCondition(dns.Status.Conditions, DNSKubeletClusterDNSMismatchConditionType); len(sample.mismatchedNodes) != 0 && (old == nil || old.Status != operatorv1.ConditionTrue) {
+					r.recorder.Eventf(dns, corev1.EventTypeWarning, "KubeletClusterDNSMismatch", "The kubelets on %d of %d nodes do not use cluster DNS address %s", len(sample.mismatchedNodes), sample.sampledNodes, clusterIP)
+				}
+			}
 		}
-		if haveSvc, svc, err := r.ensureDNSService(dns, clusterIP, daemonsetRef); err != nil {
-			// Set clusterIP to an empty string to cause ClusterOperator to report
-			// Available=False and Degraded=True.
-			clusterIP = ""
-			errs = append(errs, fmt.Errorf("failed to create service for dns %s: %v", dns.Name, err))
-		} else if !haveSvc {
-			errs = append(errs, fmt.Errorf("failed to get service for dns %s", dns.Name))
-		} else if err := r.ensureMetricsIntegration(dns, svc, daemonsetRef); err != nil {
-			errs = append(errs, fmt.Errorf("failed to integrate metrics with openshift-monitoring for dns %s: %v", dns.Name, err))
+
+		// Node exclusions can leave too few nodes to run dns pods.
+		nodeCoverage, err := r.sampleDNSNodeCoverage(dns)
+		if err != nil {
+			logrus.Errorf("failed to sample node coverage of dns %s: %v", dns.Name, err)
+		} else if old := conditions.FindOperatorCondition(dns.Status.Conditions, DNSInsufficientNodeCoverageConditionType); nodeCoverage.insufficient() && (old == nil || old.Status != operatorv1.ConditionTrue) {
+			r.recorder.Eventf(dns, corev1.EventTypeWarning, "InsufficientNodeCoverage", "The node exclusions leave %d of %d ready nodes to run CoreDNS", nodeCoverage.coveredNodes, nodeCoverage.readyNodes)
 		}
 
-		if err := r.syncDNSStatus(dns, clusterIP, clusterDomain, daemonset); err != nil {
+		// Pods that still run an older generation of a daemonset show
+		// a rollout that is stuck on some nodes.
+		podVersions, err := r.sampleDNSPodVersions(dns)
+		if err != nil {
+			logrus.Errorf("failed to sample pod versions of dns %s: %v", dns.Name, err)
+			podVersions = dns.Status.PodVersions
+		} else {
+			recordPodVersionsMetrics(dns.Name, podVersions)
+		}
+
+		statusSamples := dnsStatusSamples{
+			unhealthyNodePods:     unhealthyNodePods,
+			rolloutDeferral:       rolloutDeferral,
+			cacheStats:            cacheStats,
+			forwarderStats:        forwarderStats,
+			cpuThrottling:         cpuThrottling,
+			cacheHitRatio:         cacheHitRatio,
+			kubeletClusterDNS:     kubeletClusterDNS,
+			corefileCompatibility: corefileCompatibility,
+			nodeCoverage:          nodeCoverage,
+			corefileStatus:        corefileStatus,
+			podVersions:           podVersions,
+		}
+		if suppressedFor, err := r.syncDNSStatus(dns, clusterIP, clusterDomain, daemonset, statusSamples, disabledCapabilities, conflicts.messages(), paused.messages()); err != nil {
 			errs = append(errs, fmt.Errorf("failed to sync status of dns %s/%s: %v", daemonset.Namespace, daemonset.Name, err))
+		} else if suppressedFor != 0 && suppressedFor < requeueAfter {
+			// Check the pods again when the degraded suppression
+			// period ends.
+			requeueAfter = suppressedFor
 		}
 	}
 
-	return utilerrors.NewAggregate(errs)
+	return requeueAfter, utilerrors.NewAggregate(errs)
 }
 
 // getClusterIPFromNetworkConfig will return 10th IP from the service CIDR range
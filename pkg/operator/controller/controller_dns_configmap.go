@@ -1,11 +1,14 @@
 package controller
 
 import (
-	"bytes"
 	"context"
 	"fmt"
-	"text/template"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
 
+	"github.com/openshift/cluster-dns-operator/pkg/dnsstatus"
 	"github.com/openshift/cluster-dns-operator/pkg/manifests"
 
 	"github.com/sirupsen/logrus"
@@ -19,59 +22,994 @@ import (
 
 	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation"
 )
 
-var corefileTemplate = template.Must(template.New("Corefile").Parse(`{{range .Servers -}}
-# {{.Name}}
-{{range .Zones}}{{.}}:5353 {{end}}{
-    {{with .ForwardPlugin -}}
-    forward .{{range .Upstreams}} {{.}}{{end}}
-    {{- end}}
-}
-{{end -}}
-.:5353 {
-    errors
-    health
-    kubernetes {{.ClusterDomain}} in-addr.arpa ip6.arpa {
-        pods insecure
-        upstream
-        fallthrough in-addr.arpa ip6.arpa
-    }
-    prometheus :9153
-    forward . /etc/resolv.conf {
-        policy sequential
-    }
-    cache 30
-    reload
-}
-`))
-
-// ensureDNSConfigMap ensures that a configmap exists for a given DNS.
-func (r *reconciler) ensureDNSConfigMap(dns *operatorv1.DNS, clusterDomain string) (bool, *corev1.ConfigMap, error) {
+// corefileParams are the parameters from which the server blocks of a listener
+// of the Corefile of a dns are built.
+type corefileParams struct {
+	ClusterDomains []string
+	Servers        []corefileServer
+	ClusterPeers   []operatorv1.DNSClusterPeer
+	InternalZones  []corefileInternalZone
+	HealthPort     int32
+	ReadyPort      int32
+	LogClass       string
+	QueryLogRules  []corefileQueryLogRule
+	PerCPUSockets  bool
+	QueryTimeout   string
+	ServiceAliases []corefileServiceAlias
+	LocalhostZones bool
+	DebugZone      *corefileDebugZone
+	NSID           string
+	ExceptedZones  []string
+	Port           int32
+	Bind           string
+	BindAddresses  []string
+
+	ClientAttribution          bool
+	ClientAttributionLogFormat string
+
+	DefaultUpstreams     []string
+	DefaultForwardPolicy string
+	Pprof                *corefileImport
+
+	KubernetesFallthrough []string
+	KubernetesUpstream    bool
+
+	DefaultForceTCP bool
+
+	CachePositiveTTL int
+	CacheNegativeTTL int
+
+	ExtraServerBlocks  []corefileImport
+	ExtraDefaultServer []corefileImport
+}
+
+// key returns the server block key for the given zone on the port of the
+// listener.
+func (p *corefileParams) key(zone string) string {
+	return fmt.Sprintf("%s:%d", zone, p.Port)
+}
+
+// bindPlugins returns the bind plugin of the server blocks of the listener, if
+// the listener binds specific addresses.
+func (p *corefileParams) bindPlugins() []corefileDirective {
+	if len(p.BindAddresses) == 0 {
+		return nil
+	}
+	return []corefileDirective{newCorefileDirective("bind", p.BindAddresses...)}
+}
+
+// tuningPlugins returns the plugins that tune how every server block of the
+// listener serves and logs queries.
+func (p *corefileParams) tuningPlugins() []corefileDirective {
+	plugins := []corefileDirective{}
+	if p.PerCPUSockets {
+		plugins = append(plugins, newCorefileDirective("multisocket"))
+	}
+	if len(p.QueryTimeout) != 0 {
+		plugins = append(plugins, newCorefileDirective("cancel", p.QueryTimeout))
+	}
+	if len(p.NSID) != 0 {
+		plugins = append(plugins, newCorefileDirective("nsid", p.NSID))
+	}
+	return plugins
+}
+
+// logPlugin returns the log plugin with the given arguments after the name
+// that it logs.
+func (p *corefileParams) logPlugin(args ...string) corefileDirective {
+	return newCorefileDirective("log", append([]string{"."}, args...)...).
+		withOptions(newCorefileDirective("class", strings.Fields(p.LogClass)...))
+}
+
+// logPlugins returns the log plugins of a server block for the given zones,
+// with the given arguments after the names that they log.  The query logging
+// rules whose zones overlap the zones of the block precede the log plugin for
+// all names, because CoreDNS logs each query by the first rule that matches.
+func (p *corefileParams) logPlugins(zones []string, args ...string) []corefileDirective {
+	plugins := []corefileDirective{}
+	for _, rule := range p.QueryLogRules {
+		if !zoneOverlaps(rule.Zone, zones) {
+			continue
+		}
+		plugins = append(plugins, newCorefileDirective("log", append([]string{rule.Zone}, args...)...).
+			withOptions(newCorefileDirective("class", strings.Fields(rule.Class)...)))
+	}
+	return append(plugins, p.logPlugin(args...))
+}
+
+// importDirectives returns an import of each of the given imports.
+func importDirectives(imports []corefileImport) []corefileDirective {
+	directives := []corefileDirective{}
+	for _, i := range imports {
+		d := newCorefileDirective("import", i.Pattern)
+		d.Comment = i.Hash
+		directives = append(directives, d)
+	}
+	return directives
+}
+
+// serverBlock returns the server block of the given server.
+func (p *corefileParams) serverBlock(server corefileServer) corefileServerBlock {
+	keys := []string{}
+	for _, zone := range server.Zones {
+		keys = append(keys, p.key(zone))
+	}
+	plugins := p.bindPlugins()
+	if limit := server.RateLimit; limit != nil {
+		rrl := newCorefileDirective("rrl").withOptions(
+			newCorefileDirective("requests-per-second", fmt.Sprint(limit.RequestsPerSecond)),
+			newCorefileDirective("ipv4-prefix-length", fmt.Sprint(limit.IPv4PrefixLength)),
+			newCorefileDirective("ipv6-prefix-length", fmt.Sprint(limit.IPv6PrefixLength)),
+		)
+		if limit.ReportOnly {
+			rrl = rrl.withOptions(newCorefileDirective("report-only"))
+		}
+		plugins = append(plugins, rrl)
+	}
+	plugins = append(plugins, newCorefileDirective("forward", append([]string{"."}, server.Upstreams...)...).withOptions(server.ForwardOptions...))
+	if server.Minimal {
+		plugins = append(plugins, newCorefileDirective("minimal"))
+	}
+	plugins = append(plugins, p.tuningPlugins()...)
+	plugins = append(plugins, p.logPlugins(server.Zones)...)
+	return corefileServerBlock{Comments: []string{server.Name}, Keys: keys, Plugins: plugins}
+}
+
+// peerBlock returns the server block of the given cluster peer.
+func (p *corefileParams) peerBlock(peer operatorv1.DNSClusterPeer) corefileServerBlock {
+	plugins := p.bindPlugins()
+	plugins = append(plugins, newCorefileDirective("forward", append([]string{"."}, peer.Nameservers...)...))
+	plugins = append(plugins, p.tuningPlugins()...)
+	plugins = append(plugins, p.logPlugins([]string{peer.ClusterDomain})...)
+	return corefileServerBlock{Comments: []string{"peer " + peer.Name}, Keys: []string{p.key(peer.ClusterDomain)}, Plugins: plugins}
+}
+
+// debugBlock returns the server block of the given debug zone.
+func (p *corefileParams) debugBlock(zone *corefileDebugZone) corefileServerBlock {
+	plugins := p.bindPlugins()
+	plugins = append(plugins,
+		newCorefileDirective("template", "IN", "TXT", zone.Zone).withOptions(newCorefileDirective("answer", `"`+zone.PodAnswer+`"`)),
+		newCorefileDirective("whoami"),
+	)
+	plugins = append(plugins, p.logPlugins([]string{zone.Zone})...)
+	return corefileServerBlock{Comments: []string{"debug"}, Keys: []string{p.key(zone.Zone)}, Plugins: plugins}
+}
+
+// defaultBlock returns the server block for the root zone, which serves the
+// cluster domains and forwards all other names to the default upstreams.
+func (p *corefileParams) defaultBlock() corefileServerBlock {
+	keys := []string{p.key(".")}
+	for _, zone := range p.ExceptedZones {
+		keys = append(keys, p.key(zone))
+	}
+	plugins := p.bindPlugins()
+	plugins = append(plugins, newCorefileDirective("errors"))
+	if p.ClientAttribution {
+		plugins = append(plugins, newCorefileDirective("metadata"))
+	}
+	plugins = append(plugins, p.tuningPlugins()...)
+	if p.ClientAttribution {
+		plugins = append(plugins, p.logPlugins([]string{"."}, `"`+p.ClientAttributionLogFormat+`"`)...)
+	} else {
+		plugins = append(plugins, p.logPlugins([]string{"."})...)
+	}
+	if len(p.Bind) == 0 {
+		plugins = append(plugins,
+			newCorefileDirective("health", fmt.Sprintf(":%d", p.HealthPort)),
+			newCorefileDirective("ready", fmt.Sprintf(":%d", p.ReadyPort)),
+		)
+		if p.Pprof != nil {
+			plugins = append(plugins, importDirectives([]corefileImport{*p.Pprof})...)
+		}
+	}
+	for _, alias := range p.ServiceAliases {
+		plugins = append(plugins, newCorefileDirective("rewrite", "stop").withOptions(
+			newCorefileDirective("name", "regex", alias.NamePattern, alias.Target),
+			newCorefileDirective("answer", "name", alias.TargetPattern, alias.Name),
+		))
+	}
+	for _, internal := range p.InternalZones {
+		options := []corefileDirective{}
+		if len(internal.Match) != 0 {
+			options = append(options, newCorefileDirective("match", internal.Match))
+		}
+		options = append(options, newCorefileDirective("rcode", "NXDOMAIN"))
+		if len(internal.Match) != 0 {
+			options = append(options, newCorefileDirective("fallthrough"))
+		}
+		plugins = append(plugins, newCorefileDirective("template", "ANY", "ANY", internal.Zone).withOptions(options...))
+	}
+	if p.LocalhostZones {
+		plugins = append(plugins, newCorefileDirective("local"))
+	}
+	pods := "insecure"
+	if p.ClientAttribution {
+		pods = "verified"
+	}
+	kubernetes := newCorefileDirective("kubernetes", append(append([]string{}, p.ClusterDomains...), "in-addr.arpa", "ip6.arpa")...).withOptions(
+		newCorefileDirective("pods", pods),
+	)
+	if p.KubernetesUpstream {
+		kubernetes = kubernetes.withOptions(newCorefileDirective("upstream"))
+	}
+	if len(p.KubernetesFallthrough) != 0 {
+		kubernetes = kubernetes.withOptions(newCorefileDirective("fallthrough", p.KubernetesFallthrough...))
+	}
+	plugins = append(plugins,
+		kubernetes,
+		newCorefileDirective("prometheus", ":9153"),
+		newCorefileDirective("forward", append([]string{"."}, p.DefaultUpstreams...)...).withOptions(p.defaultForwardOptions()...),
+		p.cacheDirective(),
+	)
+	plugins = append(plugins, importDirectives(p.ExtraDefaultServer)...)
+	if len(p.Bind) == 0 {
+		plugins = append(plugins, newCorefileDirective("reload"))
+	}
+	return corefileServerBlock{Keys: keys, Plugins: plugins}
+}
+
+// defaultForwardOptions returns the options of the forward plugin of the
+// default server block.
+func (p *corefileParams) defaultForwardOptions() []corefileDirective {
+	options := []corefileDirective{newCorefileDirective("policy", p.DefaultForwardPolicy)}
+	if p.DefaultForceTCP {
+		options = append(options, newCorefileDirective("force_tcp"))
+	}
+	return options
+}
+
+// serverBlocks returns the server blocks of the listener.
+func (p *corefileParams) serverBlocks() []corefileServerBlock {
+	blocks := []corefileServerBlock{}
+	for _, server := range p.Servers {
+		blocks = append(blocks, p.serverBlock(server))
+	}
+	for _, peer := range p.ClusterPeers {
+		blocks = append(blocks, p.peerBlock(peer))
+	}
+	if p.DebugZone != nil {
+		blocks = append(blocks, p.debugBlock(p.DebugZone))
+	}
+	return append(blocks, p.defaultBlock())
+}
+
+// corefileLogClass returns the classes of responses that the CoreDNS log
+// plugin should log for the given log level.  Changing the log level only
+// changes the Corefile, which CoreDNS reloads without restarting.
+func corefileLogClass(level operatorv1.DNSLogLevel) string {
+	switch level {
+	case operatorv1.DNSLogLevelDebug:
+		return "denial error"
+	case operatorv1.DNSLogLevelTrace:
+		return "all"
+	default:
+		return "error"
+	}
+}
+
+// clientAttributionLogFormat is the format of the query log of the default
+// server when queries are attributed to pods.  It is the common log format of
+// the CoreDNS log plugin with the namespace and name of the pod of the client,
+// which the kubernetes plugin publishes through the metadata plugin, after the
+// address of the client.  CoreDNS logs "-" for a client that is not a pod.
+const clientAttributionLogFormat = `{remote}:{port} {/kubernetes/client-namespace}/{/kubernetes/client-pod-name} - {>id} \"{type} {class} {name} {proto} {size} {>do} {>bufsize}\" {rcode} {>rflags} {rsize} {duration}`
+
+// corefileClientAttribution returns a Boolean indicating whether the default
+// server should attribute queries to the pods of their clients in the query log
+// for the given dns.  The kubernetes plugin only looks up the pod of a client
+// if it watches pods, which it does in verified mode.
+func corefileClientAttribution(dns *operatorv1.DNS) bool {
+	return dns.Spec.LogClientAttribution == operatorv1.DNSLogClientAttributionPod
+}
+
+// corefileLocalhostZones returns a Boolean indicating whether CoreDNS should
+// answer queries for localhost and the loopback reverse zones with the local
+// plugin for the given dns, which it does unless the dns disables it.  The
+// operator disables it for versions of CoreDNS that do not have the plugin;
+// see withoutUnsupportedFeatures.
+func corefileLocalhostZones(dns *operatorv1.DNS) bool {
+	return dns.Spec.LocalhostZones != operatorv1.LocalhostZonesDisabled
+}
+
+const (
+	// forwardHealthCheckInterval and forwardMaxFails are the defaults of
+	// the health_check and max_fails options of the CoreDNS forward
+	// plugin, which the operator does not set.  An upstream is considered
+	// down after forwardMaxFails consecutive failed health checks.
+	forwardHealthCheckInterval = 500 * time.Millisecond
+	forwardMaxFails            = 2
+
+	// forwardTimeout is the time after which the forward plugin gives up
+	// on a query.
+	forwardTimeout = 5 * time.Second
+)
+
+// corefileServer is a server of a dns as it is rendered in the Corefile.
+type corefileServer struct {
+	operatorv1.Server
+	// Upstreams are the upstreams of the forward plugin of the server.
+	Upstreams []string
+	// ForwardOptions are the options of the forward plugin of the server.
+	ForwardOptions []corefileDirective
+	// Minimal is true if the server minimizes its responses.
+	Minimal bool
+	// Except are the subdomains of the zones of the server that the
+	// forward plugin does not forward.
+	Except []string
+	// RateLimit is the rate limit of queries for the zones of the
+	// server, or nil if they are not rate limited.
+	RateLimit *corefileRateLimit
+}
+
+// corefileRateLimit is the rate limit of a server as it is rendered in the
+// Corefile.
+type corefileRateLimit struct {
+	RequestsPerSecond int32
+	IPv4PrefixLength  int32
+	IPv6PrefixLength  int32
+	// ReportOnly is true if queries that exceed the limit are counted
+	// but answered.
+	ReportOnly bool
+}
+
+// corefileSpecServers returns the servers in the spec of the given dns with
+// their zones in lower case and without trailing dots.  A zone that is the
+// cluster domain, or that is listed earlier by the same server or by an earlier
+// server, is ignored so that the Corefile does not have duplicate server
+// blocks, which CoreDNS refuses to load; the first server that lists a zone
+// takes precedence.  A server without any remaining zones is ignored.  An
+// upstream that is a DNS-over-HTTPS URL is ignored because the forward plugin
+// of CoreDNS cannot forward to it, and a server whose upstreams are all ignored
+// is ignored as well.
+//
+// Servers may have nested zones with different upstreams.  CoreDNS answers a
+// query from the server block of the most specific zone that contains the
+// name, so a query for a name in a nested zone is forwarded to the upstreams
+// of the server of the nested zone.
+func corefileSpecServers(dns *operatorv1.DNS, clusterDomain string) []operatorv1.Server {
+	servers := []operatorv1.Server{}
+	zones := map[string]string{clusterDomain: ""}
+	for _, server := range dns.Spec.Servers {
+		if upstreams := corefileForwardableUpstreams(dns, server); len(upstreams) != len(server.ForwardPlugin.Upstreams) {
+			if len(upstreams) == 0 {
+				logrus.Warningf("ignoring server %s of dns %s: server has no upstreams that CoreDNS can forward to", server.Name, dns.Name)
+				continue
+			}
+			server.ForwardPlugin.Upstreams = upstreams
+		}
+		serverZones := []string{}
+		for _, zone := range server.Zones {
+			zone = strings.ToLower(strings.TrimSuffix(zone, "."))
+			if other, ok := zones[zone]; ok {
+				switch {
+				case zone == clusterDomain:
+					logrus.Warningf("ignoring zone %s of server %s of dns %s: the cluster domain cannot be forwarded", zone, server.Name, dns.Name)
+				case other != server.Name:
+					logrus.Warningf("ignoring zone %s of server %s of dns %s: zone is already served by server %s", zone, server.Name, dns.Name, other)
+				}
+				continue
+			}
+			zones[zone] = server.Name
+			serverZones = append(serverZones, zone)
+		}
+		if len(serverZones) == 0 {
+			logrus.Warningf("ignoring server %s of dns %s: server has no zones that are not already served", server.Name, dns.Name)
+			continue
+		}
+		server.Zones = serverZones
+		servers = append(servers, server)
+	}
+	return servers
+}
+
+// dohUpstreamPrefix is the prefix of an upstream that is a DNS-over-HTTPS
+// endpoint.
+const dohUpstreamPrefix = "https://"
+
+// corefileForwardableUpstreams returns the upstreams of the given server of the
+// given dns that the forward plugin can forward to.  The forward plugin only
+// forwards over plain DNS and DNS-over-TLS, so DNS-over-HTTPS endpoints are
+// ignored; a resolver that serves DNS-over-TLS on port 443 can be used with the
+// TLS transport instead.
+func corefileForwardableUpstreams(dns *operatorv1.DNS, server operatorv1.Server) []string {
+	upstreams := []string{}
+	for _, upstream := range server.ForwardPlugin.Upstreams {
+		if strings.HasPrefix(strings.ToLower(upstream), dohUpstreamPrefix) {
+			logrus.Warningf("ignoring upstream %s of server %s of dns %s: CoreDNS cannot forward to DNS-over-HTTPS upstreams", upstream, server.Name, dns.Name)
+			continue
+		}
+		upstreams = append(upstreams, upstream)
+	}
+	return upstreams
+}
+
+// corefileServers returns the given servers of the given dns as they are
+// rendered in the Corefile, given the hashes of the CA bundles that the dns
+// pods have, keyed by the name of their configmaps.
+func corefileServers(dns *operatorv1.DNS, servers []operatorv1.Server, caBundles map[string]string) []corefileServer {
+	result := []corefileServer{}
+	for _, server := range servers {
+		except := corefileForwardExcept(dns, server)
+		options := corefileForwardOptions(dns, server)
+		if len(except) != 0 {
+			options = append(options, newCorefileDirective("except", except...))
+		}
+		options = append(options, corefileForwardTLSOptions(dns, server, caBundles)...)
+		result = append(result, corefileServer{
+			Server:         server,
+			Upstreams:      forwardUpstreams(server),
+			ForwardOptions: options,
+			Minimal:        server.MinimalResponses == operatorv1.MinimalResponsesEnabled,
+			Except:         except,
+			RateLimit:      corefileRateLimitFor(dns, server),
+		})
+	}
+	return result
+}
+
+// corefileRateLimitFor returns the rate limit of the given server of the given
+// dns, or nil if it has none.  A rate limit whose rate is not positive is
+// ignored, and a prefix length that is out of range is replaced with the
+// default, which limits each client separately.
+func corefileRateLimitFor(dns *operatorv1.DNS, server operatorv1.Server) *corefileRateLimit {
+	limit := server.RateLimit
+	if limit == nil {
+		return nil
+	}
+	if limit.RequestsPerSecond < 1 {
+		logrus.Warningf("ignoring rate limit of server %s of dns %s: requests per second must be positive, got %d", server.Name, dns.Name, limit.RequestsPerSecond)
+		return nil
+	}
+	result := &corefileRateLimit{
+		RequestsPerSecond: limit.RequestsPerSecond,
+		IPv4PrefixLength:  limit.IPv4PrefixLength,
+		IPv6PrefixLength:  limit.IPv6PrefixLength,
+		ReportOnly:        limit.Mode == operatorv1.DNSRateLimitModeReportOnly,
+	}
+	if result.IPv4PrefixLength < 0 || result.IPv4PrefixLength > 32 {
+		logrus.Warningf("ignoring IPv4 prefix length %d of the rate limit of server %s of dns %s: must be between 1 and 32", result.IPv4PrefixLength, server.Name, dns.Name)
+		result.IPv4PrefixLength = 0
+	}
+	if result.IPv6PrefixLength < 0 || result.IPv6PrefixLength > 128 {
+		logrus.Warningf("ignoring IPv6 prefix length %d of the rate limit of server %s of dns %s: must be between 1 and 128", result.IPv6PrefixLength, server.Name, dns.Name)
+		result.IPv6PrefixLength = 0
+	}
+	if result.IPv4PrefixLength == 0 {
+		result.IPv4PrefixLength = 32
+	}
+	if result.IPv6PrefixLength == 0 {
+		result.IPv6PrefixLength = 128
+	}
+	return result
+}
+
+// corefileForwardExcept returns the subdomains of the zones of the given server
+// of the given dns that are not forwarded.  A subdomain that is invalid, that
+// is not a strict subdomain of a zone of the server, or that is listed earlier
+// is ignored.
+func corefileForwardExcept(dns *operatorv1.DNS, server operatorv1.Server) []string {
+	except := []string{}
+	seen := map[string]struct{}{}
+subdomains:
+	for _, subdomain := range server.ForwardPlugin.Except {
+		subdomain = strings.ToLower(strings.TrimSuffix(subdomain, "."))
+		if msgs := validation.IsDNS1123Subdomain(subdomain); len(msgs) != 0 {
+			logrus.Warningf("ignoring except %q of server %s of dns %s: %s", subdomain, server.Name, dns.Name, strings.Join(msgs, ", "))
+			continue
+		}
+		if _, ok := seen[subdomain]; ok {
+			continue
+		}
+		for _, zone := range server.Zones {
+			zone = strings.ToLower(strings.TrimSuffix(zone, "."))
+			if len(zone) == 0 || strings.HasSuffix(subdomain, "."+zone) {
+				seen[subdomain] = struct{}{}
+				except = append(except, subdomain)
+				continue subdomains
+			}
+		}
+		logrus.Warningf("ignoring except %s of server %s of dns %s: not a subdomain of a zone of the server", subdomain, server.Name, dns.Name)
+	}
+	return except
+}
+
+// corefileExceptedZones returns the subdomains that the given servers do not
+// forward and that are not already zones of the given servers or cluster
+// peers, or the given debug zone.  The default server block serves these
+// subdomains so that their names are resolved in the same way as names that
+// are outside of the zones of all servers.
+func corefileExceptedZones(servers []corefileServer, peers []operatorv1.DNSClusterPeer, debugZone *corefileDebugZone) []string {
+	zones := map[string]struct{}{}
+	for _, server := range servers {
+		for _, zone := range server.Zones {
+			zones[strings.ToLower(strings.TrimSuffix(zone, "."))] = struct{}{}
+		}
+	}
+	for _, peer := range peers {
+		zones[peer.ClusterDomain] = struct{}{}
+	}
+	if debugZone != nil {
+		zones[debugZone.Zone] = struct{}{}
+	}
+	excepted := []string{}
+	for _, server := range servers {
+		for _, subdomain := range server.Except {
+			if _, ok := zones[subdomain]; ok {
+				continue
+			}
+			zones[subdomain] = struct{}{}
+			excepted = append(excepted, subdomain)
+		}
+	}
+	return excepted
+}
+
+// corefileForwardOptions returns the options of the forward plugin of the
+// given server of the given dns.  Options that are unset or that have their
+// default values are left out so that the Corefile of a dns that does not set
+// them is unchanged.
+func corefileForwardOptions(dns *operatorv1.DNS, server operatorv1.Server) []corefileDirective {
+	options := []corefileDirective{}
+	if expire := server.ForwardPlugin.Expire; expire != nil {
+		switch {
+		case expire.Duration < 0:
+			logrus.Warningf("ignoring negative expire %s of server %s of dns %s", expire.Duration, server.Name, dns.Name)
+		case expire.Duration > 0:
+			options = append(options, newCorefileDirective("expire", expire.Duration.String()))
+		}
+	}
+	// The forward plugin refuses to load with both force_tcp and
+	// prefer_udp, so forcing TCP takes precedence.
+	switch strategy := server.ForwardPlugin.ProtocolStrategy; strategy {
+	case operatorv1.ProtocolStrategyTCP:
+		if server.ForwardPlugin.ProtocolPreference == operatorv1.DNSProtocolPreferencePreferUDP {
+			logrus.Warningf("ignoring protocol preference %s of server %s of dns %s: the protocol strategy forces TCP", server.ForwardPlugin.ProtocolPreference, server.Name, dns.Name)
+		}
+		options = append(options, newCorefileDirective("force_tcp"))
+	default:
+		if strategy != operatorv1.ProtocolStrategyDefault {
+			logrus.Warningf("ignoring unknown protocol strategy %q of server %s of dns %s", strategy, server.Name, dns.Name)
+		}
+		if server.ForwardPlugin.ProtocolPreference == operatorv1.DNSProtocolPreferencePreferUDP {
+			options = append(options, newCorefileDirective("prefer_udp"))
+		}
+	}
+	switch policy := server.ForwardPlugin.Policy; policy {
+	case "", operatorv1.RandomForwardingPolicy:
+	default:
+		if name := corefileForwardPolicy(policy); len(name) != 0 {
+			options = append(options, newCorefileDirective("policy", name))
+		} else {
+			logrus.Warningf("ignoring unknown policy %q of server %s of dns %s", policy, server.Name, dns.Name)
+		}
+	}
+	return options
+}
+
+// corefileForwardPolicy returns the name that the forward plugin uses for the
+// given forwarding policy, or an empty string if the policy is unknown.
+func corefileForwardPolicy(policy operatorv1.ForwardingPolicy) string {
+	switch policy {
+	case operatorv1.RandomForwardingPolicy:
+		return "random"
+	case operatorv1.RoundRobinForwardingPolicy:
+		return "round_robin"
+	case operatorv1.SequentialForwardingPolicy:
+		return "sequential"
+	default:
+		return ""
+	}
+}
+
+// corefileQueryTimeout returns the timeout of the cancel plugin for the given
+// dns, or an empty string if queries are not canceled.  A zero timeout means
+// the default, which lets the forward plugin give up on a query before it is
+// canceled.  A timeout shorter than the time that the forward plugin needs to
+// consider an upstream down is raised to that time so that queries are not
+// canceled before the forward plugin can fail over to another upstream.
+func corefileQueryTimeout(dns *operatorv1.DNS) string {
+	if dns.Spec.Performance.QueryTimeout == nil {
+		return ""
+	}
+	minimum := forwardHealthCheckInterval * forwardMaxFails
+	timeout := dns.Spec.Performance.QueryTimeout.Duration
+	switch {
+	case timeout == 0:
+		timeout = forwardTimeout + forwardHealthCheckInterval
+	case timeout < minimum:
+		logrus.Warningf("raising query timeout of dns %s from %s to %s", dns.Name, timeout, minimum)
+		timeout = minimum
+	}
+	return timeout.String()
+}
+
+// corefileClusterDomains returns the cluster domain followed by the additional
+// cluster domains of the given dns, which the kubernetes plugin serves in that
+// order.  An additional domain that is invalid, that is the cluster domain or
+// is listed earlier, or that is a zone of a server is ignored.
+func corefileClusterDomains(dns *operatorv1.DNS, clusterDomain string) []string {
+	domains := []string{clusterDomain}
+	zones := map[string]struct{}{clusterDomain: {}}
+	for _, server := range dns.Spec.Servers {
+		for _, zone := range server.Zones {
+			zones[strings.ToLower(strings.TrimSuffix(zone, "."))] = struct{}{}
+		}
+	}
+	for _, domain := range dns.Spec.AdditionalClusterDomains {
+		domain = strings.ToLower(strings.TrimSuffix(domain, "."))
+		if msgs := validation.IsDNS1123Subdomain(domain); len(msgs) != 0 {
+			logrus.Warningf("ignoring additional cluster domain %q of dns %s: %s", domain, dns.Name, strings.Join(msgs, ", "))
+			continue
+		}
+		if _, ok := zones[domain]; ok {
+			logrus.Warningf("ignoring additional cluster domain %s of dns %s: zone %s is already served", domain, dns.Name, domain)
+			continue
+		}
+		zones[domain] = struct{}{}
+		domains = append(domains, domain)
+	}
+	return domains
+}
+
+// corefileClusterPeers returns the cluster peers of the given dns for which
+// the Corefile forwards queries.  A peer whose cluster domain is the cluster
+// domain or an additional cluster domain of this cluster, or is already a zone
+// of a server or of another peer, is ignored so that the Corefile does not have
+// duplicate server blocks.
+func corefileClusterPeers(dns *operatorv1.DNS, clusterDomain string) []operatorv1.DNSClusterPeer {
+	peers := []operatorv1.DNSClusterPeer{}
+	zones := map[string]struct{}{}
+	for _, domain := range corefileClusterDomains(dns, clusterDomain) {
+		zones[domain] = struct{}{}
+	}
+	for _, server := range dns.Spec.Servers {
+		for _, zone := range server.Zones {
+			zones[strings.ToLower(strings.TrimSuffix(zone, "."))] = struct{}{}
+		}
+	}
+	for _, peer := range dns.Spec.ClusterPeers {
+		domain := strings.ToLower(strings.TrimSuffix(peer.ClusterDomain, "."))
+		if _, ok := zones[domain]; ok {
+			logrus.Warningf("ignoring cluster peer %s of dns %s: zone %s is already served", peer.Name, dns.Name, domain)
+			continue
+		}
+		zones[domain] = struct{}{}
+		peer.ClusterDomain = domain
+		peers = append(peers, peer)
+	}
+	return peers
+}
+
+// corefileInternalZone is a zone whose names the Corefile answers with
+// NXDOMAIN.
+type corefileInternalZone struct {
+	// Zone is the zone.
+	Zone string
+	// Match is a regular expression that matches the names in the zone
+	// that are answered with NXDOMAIN, or empty if all names in the zone
+	// are.
+	Match string
+}
+
+// corefileInternalZones returns the zones of the given dns whose names the
+// Corefile answers with NXDOMAIN.  A zone that is a cluster domain or one of
+// its subdomains, or that is already a zone of one of the given servers or
+// cluster peers, is ignored.  The server blocks of servers and cluster peers
+// in an internal zone take precedence over the default server block, but the
+// cluster domains are served by the default server block, in which the
+// template plugin runs before the kubernetes plugin.  So the names in a cluster
+// domain are excluded by a regular expression from an internal zone that
+// contains it, and a zone that contains more than one cluster domain, which a
+// single regular expression cannot exclude, is ignored.
+func corefileInternalZones(dns *operatorv1.DNS, clusterDomain string, servers []operatorv1.Server, peers []operatorv1.DNSClusterPeer) []corefileInternalZone {
+	result := []corefileInternalZone{}
+	if dns.Spec.InternalNames.SingleLabel == operatorv1.DNSInternalNamesNXDomain {
+		result = append(result, corefileInternalZone{Zone: ".", Match: `^[^.]+\.$`})
+	}
+	internal := dns.Spec.InternalNames.Zones
+	if dns.Spec.InternalNames.Local == operatorv1.DNSInternalNamesNXDomain {
+		internal = append([]string{"local"}, internal...)
+	}
+	clusterDomains := corefileClusterDomains(dns, clusterDomain)
+	zones := map[string]struct{}{}
+	for _, server := range servers {
+		for _, zone := range server.Zones {
+			zones[strings.ToLower(strings.TrimSuffix(zone, "."))] = struct{}{}
+		}
+	}
+	for _, peer := range peers {
+		zones[peer.ClusterDomain] = struct{}{}
+	}
+zones:
+	for _, zone := range internal {
+		zone = strings.ToLower(strings.TrimSuffix(zone, "."))
+		if msgs := validation.IsDNS1123Subdomain(zone); len(msgs) != 0 {
+			logrus.Warningf("ignoring internal zone %q of dns %s: %s", zone, dns.Name, strings.Join(msgs, ", "))
+			continue
+		}
+		if _, ok := zones[zone]; ok {
+			continue
+		}
+		internalZone := corefileInternalZone{Zone: zone}
+		for _, domain := range clusterDomains {
+			switch {
+			case zone == domain || strings.HasSuffix(zone, "."+domain):
+				logrus.Warningf("ignoring internal zone %s of dns %s: zone is in cluster domain %s", zone, dns.Name, domain)
+				continue zones
+			case !strings.HasSuffix(domain, "."+zone):
+			case len(internalZone.Match) != 0:
+				logrus.Warningf("ignoring internal zone %s of dns %s: zone contains more than one cluster domain", zone, dns.Name)
+				continue zones
+			default:
+				internalZone.Match = corefileExcludeSubdomainPattern(zone, strings.TrimSuffix(domain, "."+zone))
+			}
+		}
+		zones[zone] = struct{}{}
+		result = append(result, internalZone)
+	}
+	return result
+}
+
+// corefileExcludeSubdomainPattern returns a regular expression that matches the
+// fully qualified names in the given zone except for those in the subdomain of
+// the zone with the given relative name.  For example, for the zone "local"
+// and the relative name "cluster", it matches "local." and "foo.local." but
+// not "cluster.local." or "foo.cluster.local.".  Because regular expressions in
+// Go cannot look ahead, the pattern has an alternative for each label of the
+// relative name that matches the names that diverge from the subdomain at that
+// label.
+func corefileExcludeSubdomainPattern(zone, relative string) string {
+	labels := strings.Split(relative, ".")
+	alternatives := []string{}
+	suffix := regexp.QuoteMeta(zone + ".")
+	for i := len(labels) - 1; i >= 0; i-- {
+		// The names that are ancestors of the subdomain.
+		alternatives = append(alternatives, "^"+suffix+"$")
+		// The names whose label at this position differs.
+		alternatives = append(alternatives, `^(.*\.)?`+corefileOtherLabelPattern(labels[i])+`\.`+suffix+"$")
+		suffix = regexp.QuoteMeta(labels[i]+".") + suffix
+	}
+	return strings.Join(alternatives, "|")
+}
+
+// corefileOtherLabelPattern returns a regular expression that matches any DNS
+// label other than the given one.
+func corefileOtherLabelPattern(label string) string {
+	n := len(label)
+	alternatives := []string{fmt.Sprintf("[^.]{%d,}", n+1)}
+	if n > 1 {
+		alternatives = append(alternatives, fmt.Sprintf("[^.]{1,%d}", n-1))
+	}
+	for i := 0; i < n; i++ {
+		other := regexp.QuoteMeta(label[:i]) + "[^." + regexp.QuoteMeta(label[i:i+1]) + "]"
+		if rest := n - i - 1; rest > 0 {
+			other += fmt.Sprintf("[^.]{%d}", rest)
+		}
+		alternatives = append(alternatives, other)
+	}
+	return "(" + strings.Join(alternatives, "|") + ")"
+}
+
+// corefileDebugZone is the debug zone of a dns as it is rendered in the
+// Corefile.
+type corefileDebugZone struct {
+	// Zone is the name of the debug zone.
+	Zone string
+	// PodAnswer is the answer of the template plugin to TXT queries in
+	// the zone.  CoreDNS substitutes the HOSTNAME environment variable,
+	// which is the name of the dns pod, when it loads the Corefile.
+	PodAnswer string
+}
+
+// corefileDebugZoneFor returns the debug zone of the given dns in the given
+// cluster domain, or nil if the dns does not enable it.
+func corefileDebugZoneFor(dns *operatorv1.DNS, clusterDomain string) *corefileDebugZone {
+	if dns.Spec.DebugZone != operatorv1.DNSDebugZoneEnabled {
+		return nil
+	}
+	return &corefileDebugZone{
+		Zone:      "debug.dns." + clusterDomain,
+		PodAnswer: `{{ .Name }} 0 IN TXT \"{$HOSTNAME}\"`,
+	}
+}
+
+// nodeNameEnvVar is the environment variable of the dns container that holds
+// the name of the node of the dns pod.
+const nodeNameEnvVar = "NODE_NAME"
+
+// corefileNSID returns the name server identifier that CoreDNS adds to
+// responses for the given dns, or the empty string if the dns does not enable
+// it.  CoreDNS substitutes the environment variable with the name of the node
+// when it loads the Corefile.
+func corefileNSID(dns *operatorv1.DNS) string {
+	if dns.Spec.NSID != operatorv1.DNSNSIDEnabled {
+		return ""
+	}
+	return "{$" + nodeNameEnvVar + "}"
+}
+
+// reverseZones are the reverse zones that the kubernetes plugin serves.
+var reverseZones = []string{"in-addr.arpa", "ip6.arpa"}
+
+// corefileKubernetesFallthrough returns the zones in which the kubernetes
+// plugin passes queries that it cannot answer on to the next plugin for the
+// given dns, or nil if no queries fall through.  A zone that is invalid, that
+// is not a reverse zone, or that is listed earlier is ignored.  Queries for the
+// cluster domains never fall through, so that names of services that do not
+// exist are not forwarded.
+func corefileKubernetesFallthrough(dns *operatorv1.DNS) []string {
+	switch dns.Spec.KubernetesFallthrough.Policy {
+	case operatorv1.DNSKubernetesFallthroughNone:
+		return nil
+	case operatorv1.DNSKubernetesFallthroughZones:
+	default:
+		return reverseZones
+	}
+	zones := []string{}
+	seen := map[string]struct{}{}
+	for _, zone := range dns.Spec.KubernetesFallthrough.Zones {
+		zone = strings.ToLower(strings.TrimSuffix(zone, "."))
+		if msgs := validation.IsDNS1123Subdomain(zone); len(msgs) != 0 {
+			logrus.Warningf("ignoring fallthrough zone %q of dns %s: %s", zone, dns.Name, strings.Join(msgs, ", "))
+			continue
+		}
+		if !isReverseZone(zone) {
+			logrus.Warningf("ignoring fallthrough zone %s of dns %s: zone is not in %s", zone, dns.Name, strings.Join(reverseZones, " or "))
+			continue
+		}
+		if _, ok := seen[zone]; ok {
+			continue
+		}
+		seen[zone] = struct{}{}
+		zones = append(zones, zone)
+	}
+	if len(zones) == 0 {
+		logrus.Warningf("dns %s has no valid fallthrough zones; no queries fall through", dns.Name)
+		return nil
+	}
+	return zones
+}
+
+// isReverseZone returns a Boolean indicating whether the given zone is a
+// reverse zone or a subdomain of one.
+func isReverseZone(zone string) bool {
+	for _, reverse := range reverseZones {
+		if zone == reverse || strings.HasSuffix(zone, "."+reverse) {
+			return true
+		}
+	}
+	return false
+}
+
+// corefileServiceAlias is a service alias of a dns as it is rendered in the
+// Corefile.  Queries for the alias are rewritten to the name of the service,
+// and the answers are rewritten back to the alias.
+type corefileServiceAlias struct {
+	// Name is the fully qualified alias, with a trailing dot.
+	Name string
+	// NamePattern is a regular expression that matches only Name.
+	NamePattern string
+	// Target is the fully qualified name of the service, with a trailing
+	// dot.
+	Target string
+	// TargetPattern is a regular expression that matches only Target.
+	TargetPattern string
+}
+
+// corefileServiceAliases returns the service aliases of the given dns, followed
+// by aliases of the given ingress host names to the internal service of the
+// default ingress controller, sorted by name.  Aliases in the cluster domain
+// are ignored because they would shadow the names of services, and only the
+// first alias with a given name is used.
+func corefileServiceAliases(dns *operatorv1.DNS, clusterDomain string, ingressHosts []string) []corefileServiceAlias {
+	aliases := []corefileServiceAlias{}
+	seen := map[string]struct{}{}
+	serviceAliases := dns.Spec.ServiceAliases
+	for _, host := range ingressHosts {
+		serviceAliases = append(serviceAliases, operatorv1.DNSServiceAlias{
+			Name: host,
+			Service: operatorv1.DNSServiceReference{
+				Namespace: routerInternalServiceName.Namespace,
+				Name:      routerInternalServiceName.Name,
+			},
+		})
+	}
+	for _, alias := range serviceAliases {
+		name := strings.ToLower(strings.TrimSuffix(alias.Name, "."))
+		if name == clusterDomain || strings.HasSuffix(name, "."+clusterDomain) {
+			logrus.Warningf("ignoring service alias %s of dns %s: the alias is in the cluster domain", alias.Name, dns.Name)
+			continue
+		}
+		if _, ok := seen[name]; ok {
+			logrus.Warningf("ignoring duplicate service alias %s of dns %s", alias.Name, dns.Name)
+			continue
+		}
+		seen[name] = struct{}{}
+		target := fmt.Sprintf("%s.%s.svc.%s", alias.Service.Name, alias.Service.Namespace, clusterDomain)
+		aliases = append(aliases, corefileServiceAlias{
+			Name:          name + ".",
+			NamePattern:   "^" + regexp.QuoteMeta(name+".") + "$",
+			Target:        target + ".",
+			TargetPattern: "^" + regexp.QuoteMeta(target+".") + "$",
+		})
+	}
+	sort.Slice(aliases, func(i, j int) bool { return aliases[i].Name < aliases[j].Name })
+	return aliases
+}
+
+// ensureDNSConfigMap ensures that a configmap exists for a given DNS.  Optional
+// features that the managed version of CoreDNS does not have the plugins for
+// are left out of the Corefile.  The desired Corefile is then checked against
+// the plugin matrix for that version, and it is not written if the version
+// does not support it so that the dns pods do not crashloop.  The result of
+// the check is returned.
+func (r *reconciler) ensureDNSConfigMap(dns *operatorv1.DNS, clusterDomain string, ingressHosts []string, extensions []extensionServer, extraConfigs []extraConfig, listenAddresses []string, caBundles map[string]string) (bool, *corev1.ConfigMap, *corefileCompatibility, error) {
 	haveCM, current, err := r.currentDNSConfigMap(dns)
 	if err != nil {
-		return false, nil, fmt.Errorf("failed to get configmap: %v", err)
+		return false, nil, nil, fmt.Errorf("failed to get configmap: %v", err)
 	}
-	desired, err := desiredDNSConfigMap(dns, clusterDomain)
+	supported, ignored := withoutUnsupportedFeatures(dns, r.CoreDNSVersion, r.CoreDNSExternalPlugins)
+	for _, message := range ignored {
+		logrus.Warningf("corefile for dns %s: %s", dns.Name, message)
+	}
+	desired, err := desiredDNSConfigMap(supported, clusterDomain, ingressHosts, extensions, extraConfigs, listenAddresses, caBundles, r.CoreDNSVersion)
+	if err != nil {
+		return haveCM, current, nil, fmt.Errorf("failed to build configmap: %v", err)
+	}
+	compatibility := checkCorefileCompatibility(allCorefiles(desired), r.CoreDNSVersion, r.CoreDNSExternalPlugins)
+	if compatibility != nil {
+		compatibility.ignored = ignored
+		for _, message := range compatibility.deprecated {
+			logrus.Warningf("corefile for dns %s: %s", dns.Name, message)
+		}
+		if len(compatibility.incompatible) != 0 {
+			logrus.Errorf("not writing corefile for dns %s: coredns %s does not support it: %v", dns.Name, compatibility.version, compatibility.incompatible)
+			r.recorder.Eventf(dns, corev1.EventTypeWarning, "CorefileIncompatible", "Not writing Corefile: CoreDNS %s does not support %s", compatibility.version, strings.Join(compatibility.incompatible, "; "))
+			r.history.record(dns.Name, dnsHistoryCorefileRejected, fmt.Sprintf("CoreDNS %s does not support %s", compatibility.version, strings.Join(compatibility.incompatible, "; ")))
+			return haveCM, current, compatibility, nil
+		}
+	}
+
+	// Corefiles that do not fit in the configmap are split into part
+	// configmaps, which are written first so that the Corefiles that
+	// import them never refer to missing parts.
+	data, parts, err := splitCorefiles(desired.Data, corefileConfigMapSizeLimit)
 	if err != nil {
-		return haveCM, current, fmt.Errorf("failed to build configmap: %v", err)
+		return haveCM, current, compatibility, fmt.Errorf("failed to split Corefiles: %v", err)
+	}
+	desired.Data = data
+	if err := r.ensureCorefilePartConfigMaps(dns, parts); err != nil {
+		return haveCM, current, compatibility, err
 	}
 
+	changed := false
 	switch {
 	case !haveCM:
 		if err := r.client.Create(context.TODO(), desired); err != nil {
-			return false, nil, fmt.Errorf("failed to create configmap: %v", err)
+			return false, nil, compatibility, fmt.Errorf("failed to create configmap: %v", err)
 		}
 		logrus.Infof("created configmap: %s", desired.Name)
-		return r.currentDNSConfigMap(dns)
+		r.history.record(dns.Name, dnsHistoryCorefileUpdated, fmt.Sprintf("Created Corefile with hash %s", corefileHash(desired.Data["Corefile"])))
+		changed = true
 	case haveCM:
 		if updated, err := r.updateDNSConfigMap(current, desired); err != nil {
-			return true, current, err
+			return true, current, compatibility, err
 		} else if updated {
-			return r.currentDNSConfigMap(dns)
+			r.history.record(dns.Name, dnsHistoryCorefileUpdated, fmt.Sprintf("Updated Corefile to hash %s", corefileHash(desired.Data["Corefile"])))
+			changed = true
 		}
 	}
-	return true, current, nil
+	if err := r.deleteUnusedCorefilePartConfigMaps(dns, len(parts)); err != nil {
+		return true, current, compatibility, err
+	}
+	if changed {
+		haveCM, current, err := r.currentDNSConfigMap(dns)
+		return haveCM, current, compatibility, err
+	}
+	return true, current, compatibility, nil
 }
 
 func (r *reconciler) currentDNSConfigMap(dns *operatorv1.DNS) (bool, *corev1.ConfigMap, error) {
@@ -86,22 +1024,16 @@ func (r *reconciler) currentDNSConfigMap(dns *operatorv1.DNS) (bool, *corev1.Con
 	return true, current, nil
 }
 
-func desiredDNSConfigMap(dns *operatorv1.DNS, clusterDomain string) (*corev1.ConfigMap, error) {
+func desiredDNSConfigMap(dns *operatorv1.DNS, clusterDomain string, ingressHosts []string, extensions []extensionServer, extraConfigs []extraConfig, listenAddresses []string, caBundles map[string]string, coreDNSVersion string) (*corev1.ConfigMap, error) {
 	if len(clusterDomain) == 0 {
-		clusterDomain = "cluster.local"
+		clusterDomain = dnsstatus.DefaultClusterDomain
 	}
 
-	corefileParameters := struct {
-		ClusterDomain string
-		Servers       interface{}
-	}{
-		ClusterDomain: clusterDomain,
-		Servers:       dns.Spec.Servers,
-	}
-	corefile := new(bytes.Buffer)
-	if err := corefileTemplate.Execute(corefile, corefileParameters); err != nil {
+	corefile, err := renderCorefile(dns, clusterDomain, ingressHosts, extensions, extraConfigs, listenAddresses, caBundles, coreDNSVersion)
+	if err != nil {
 		return nil, err
 	}
+	logrus.Tracef("rendered Corefile of dns %s:\n%s", dns.Name, corefile)
 
 	name := DNSConfigMapName(dns)
 	cm := &corev1.ConfigMap{
@@ -113,21 +1045,102 @@ func desiredDNSConfigMap(dns *operatorv1.DNS, clusterDomain string) (*corev1.Con
 			},
 		},
 		Data: map[string]string{
-			"Corefile": corefile.String(),
+			"Corefile": corefile,
 		},
 	}
+	// The Corefile of each node override has the servers of the override
+	// in place of the servers of the dns.
+	for _, override := range dnsNodeOverrides(dns) {
+		variant := dns.DeepCopy()
+		variant.Spec.Servers = override.Servers
+		corefile, err := renderCorefile(variant, clusterDomain, ingressHosts, extensions, extraConfigs, listenAddresses, caBundles, coreDNSVersion)
+		if err != nil {
+			return nil, err
+		}
+		cm.Data[nodeOverrideCorefileKey(override)] = corefile
+	}
 	cm.SetOwnerReferences([]metav1.OwnerReference{dnsOwnerRef(dns)})
 
 	return cm, nil
 }
 
+// renderCorefile returns the Corefile for the given dns.  The servers of the
+// usual listener bind the given addresses, or all addresses if none are given.
+// Servers that forward over TLS use the CA bundles in caBundles, which maps the
+// name of the configmap of each CA bundle that the dns pods have to its hash.
+// Options that the given version of CoreDNS has removed are left out.
+func renderCorefile(dns *operatorv1.DNS, clusterDomain string, ingressHosts []string, extensions []extensionServer, extraConfigs []extraConfig, listenAddresses []string, caBundles map[string]string, coreDNSVersion string) (string, error) {
+	healthPort, readyPort := dnsProbePorts(dns)
+	peers := corefileClusterPeers(dns, clusterDomain)
+	servers := corefileSpecServers(dns, clusterDomain)
+	servers = append(servers, corefileExtensionServers(dns, clusterDomain, peers, extensions)...)
+	corefileParameters := &corefileParams{
+		ClusterDomains: corefileClusterDomains(dns, clusterDomain),
+		Servers:        corefileServers(dns, servers, caBundles),
+		ClusterPeers:   peers,
+		InternalZones:  corefileInternalZones(dns, clusterDomain, servers, peers),
+		HealthPort:     healthPort,
+		ReadyPort:      readyPort,
+		LogClass:       corefileLogClass(dns.Spec.LogLevel),
+		QueryLogRules:  corefileQueryLogRules(dns),
+		// Without an argument, the multisocket plugin listens on as
+		// many sockets as GOMAXPROCS.
+		PerCPUSockets:  dns.Spec.Performance.ListenSockets == operatorv1.DNSListenSocketsPerCPU,
+		QueryTimeout:   corefileQueryTimeout(dns),
+		ServiceAliases: corefileServiceAliases(dns, clusterDomain, ingressHosts),
+		LocalhostZones: corefileLocalhostZones(dns),
+		DebugZone:      corefileDebugZoneFor(dns, clusterDomain),
+		NSID:           corefileNSID(dns),
+		Port:           dnsPort,
+		BindAddresses:  listenAddresses,
+
+		ClientAttribution:          corefileClientAttribution(dns),
+		ClientAttributionLogFormat: clientAttributionLogFormat,
+
+		DefaultUpstreams:     corefileDefaultUpstreams(dns),
+		DefaultForwardPolicy: corefileDefaultForwardPolicy(dns),
+		Pprof:                corefilePprof(dns),
+
+		KubernetesFallthrough: corefileKubernetesFallthrough(dns),
+		KubernetesUpstream:    !corefileOptionRemoved("kubernetes", "upstream", coreDNSVersion),
+
+		DefaultForceTCP: corefileDefaultForceTCP(dns),
+
+		CachePositiveTTL: corefileCacheTTL(dns.Spec.Cache.PositiveTTL, "positive", dns.Name),
+		CacheNegativeTTL: corefileCacheTTL(dns.Spec.Cache.NegativeTTL, "negative", dns.Name),
+
+		ExtraServerBlocks:  corefileImports(extraConfigs, operatorv1.DNSExtensionPointServerBlocks),
+		ExtraDefaultServer: corefileImports(extraConfigs, operatorv1.DNSExtensionPointDefaultServer),
+	}
+	corefileParameters.ExceptedZones = corefileExceptedZones(corefileParameters.Servers, peers, corefileParameters.DebugZone)
+	corefile := &corefileConfig{
+		Imports:      importDirectives(corefileParameters.ExtraServerBlocks),
+		ServerBlocks: corefileParameters.serverBlocks(),
+	}
+	// CoreDNS serves the same zones on the interface of each additional
+	// network.  The health, readiness, and reload plugins apply to the
+	// whole process, so only the usual listener has them.
+	for _, iface := range additionalNetworkInterfaces(dns) {
+		corefileParameters.Port = additionalNetworkDNSPort
+		corefileParameters.Bind = iface
+		corefileParameters.BindAddresses = []string{iface}
+		corefile.ServerBlocks = append(corefile.ServerBlocks, corefileParameters.serverBlocks()...)
+	}
+	if err := corefile.validate(); err != nil {
+		return "", fmt.Errorf("invalid Corefile: %v", err)
+	}
+	return corefile.String(), nil
+}
+
 func (r *reconciler) updateDNSConfigMap(current, desired *corev1.ConfigMap) (bool, error) {
 	changed, updated := corefileChanged(current, desired)
 	if !changed {
 		return false, nil
 	}
 
-	if err := r.client.Update(context.TODO(), updated); err != nil {
+	err := r.client.Update(context.TODO(), updated)
+	r.trackUpdate("configmap", current, updated, updated.Data, err)
+	if err != nil {
 		return false, fmt.Errorf("failed to update configmap: %v", err)
 	}
 	logrus.Infof("updated configmap; old: %#v, new: %#v", current, updated)
@@ -1,7 +1,12 @@
 package controller
 
 import (
+	"regexp"
+	"strings"
 	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
 
 	operatorv1 "github.com/openshift/api/operator/v1"
 
@@ -36,14 +41,25 @@ func TestDesiredDNSConfigmap(t *testing.T) {
 	expectedCorefile := `# foo
 foo.com:5353 {
     forward . 1.1.1.1 2.2.2.2:5353
+    log . {
+        class error
+    }
 }
 # bar
 bar.com:5353 example.com:5353 {
     forward . 3.3.3.3
+    log . {
+        class error
+    }
 }
 .:5353 {
     errors
-    health
+    log . {
+        class error
+    }
+    health :8080
+    ready :8181
+    local
     kubernetes cluster.local in-addr.arpa ip6.arpa {
         pods insecure
         upstream
@@ -57,9 +73,718 @@ bar.com:5353 example.com:5353 {
     reload
 }
 `
-	if cm, err := desiredDNSConfigMap(dns, clusterDomain); err != nil {
+	if cm, err := desiredDNSConfigMap(dns, clusterDomain, nil, nil, nil, nil, nil, ""); err != nil {
 		t.Errorf("invalid dns configmap: %v", err)
 	} else if cm.Data["Corefile"] != expectedCorefile {
 		t.Errorf("unexpected Corefile; got:\n%s\nexpected:\n%s\n", cm.Data["Corefile"], expectedCorefile)
 	}
 }
+
+func TestDesiredDNSConfigmapLogLevel(t *testing.T) {
+	testCases := []struct {
+		level    operatorv1.DNSLogLevel
+		expected string
+	}{
+		{"", "class error"},
+		{operatorv1.DNSLogLevelNormal, "class error"},
+		{operatorv1.DNSLogLevelDebug, "class denial error"},
+		{operatorv1.DNSLogLevelTrace, "class all"},
+	}
+	for _, tc := range testCases {
+		dns := &operatorv1.DNS{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: DefaultDNSController,
+			},
+			Spec: operatorv1.DNSSpec{
+				LogLevel: tc.level,
+			},
+		}
+		cm, err := desiredDNSConfigMap(dns, "cluster.local", nil, nil, nil, nil, nil, "")
+		if err != nil {
+			t.Errorf("invalid dns configmap: %v", err)
+			continue
+		}
+		if !strings.Contains(cm.Data["Corefile"], "    log . {\n        "+tc.expected+"\n    }\n") {
+			t.Errorf("expected Corefile for log level %q to contain %q, got:\n%s", tc.level, tc.expected, cm.Data["Corefile"])
+		}
+		// The debug plugin disables the recovery from panics, so no
+		// log level enables it.
+		if strings.Contains(cm.Data["Corefile"], "    debug\n") {
+			t.Errorf("expected no debug plugin for log level %q, got:\n%s", tc.level, cm.Data["Corefile"])
+		}
+	}
+}
+
+func TestDesiredDNSConfigmapListenSockets(t *testing.T) {
+	for _, tc := range []struct {
+		sockets  operatorv1.DNSListenSockets
+		expected bool
+	}{
+		{"", false},
+		{operatorv1.DNSListenSocketsSingle, false},
+		{operatorv1.DNSListenSocketsPerCPU, true},
+	} {
+		dns := &operatorv1.DNS{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: DefaultDNSController,
+			},
+			Spec: operatorv1.DNSSpec{
+				Servers: []operatorv1.Server{{
+					Name:  "foo",
+					Zones: []string{"foo.com"},
+					ForwardPlugin: operatorv1.ForwardPlugin{
+						Upstreams: []string{"1.1.1.1"},
+					},
+				}},
+				Performance: operatorv1.DNSPerformance{
+					ListenSockets: tc.sockets,
+				},
+			},
+		}
+		cm, err := desiredDNSConfigMap(dns, "cluster.local", nil, nil, nil, nil, nil, "")
+		if err != nil {
+			t.Errorf("invalid dns configmap: %v", err)
+			continue
+		}
+		expected := 0
+		if tc.expected {
+			// Both the server block and the default server block
+			// should listen on a socket per CPU.
+			expected = 2
+		}
+		if actual := strings.Count(cm.Data["Corefile"], "\n    multisocket\n"); actual != expected {
+			t.Errorf("expected Corefile for listen sockets %q to contain multisocket %d times, got %d:\n%s", tc.sockets, expected, actual, cm.Data["Corefile"])
+		}
+	}
+}
+
+func TestDesiredDNSConfigMapServiceAliases(t *testing.T) {
+	dns := &operatorv1.DNS{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: DefaultDNSController,
+		},
+		Spec: operatorv1.DNSSpec{
+			ServiceAliases: []operatorv1.DNSServiceAlias{
+				{
+					Name:    "web.apps.example.com",
+					Service: operatorv1.DNSServiceReference{Namespace: "shop", Name: "web"},
+				},
+				{
+					Name:    "api.apps.example.com",
+					Service: operatorv1.DNSServiceReference{Namespace: "shop", Name: "api"},
+				},
+				// Duplicates and aliases in the cluster domain are
+				// ignored.
+				{
+					Name:    "api.apps.example.com",
+					Service: operatorv1.DNSServiceReference{Namespace: "shop", Name: "other"},
+				},
+				{
+					Name:    "foo.default.svc.cluster.local",
+					Service: operatorv1.DNSServiceReference{Namespace: "shop", Name: "web"},
+				},
+			},
+		},
+	}
+	cm, err := desiredDNSConfigMap(dns, "cluster.local", nil, nil, nil, nil, nil, "")
+	if err != nil {
+		t.Fatalf("invalid dns configmap: %v", err)
+	}
+	expected := `    rewrite stop {
+        name regex ^api\.apps\.example\.com\.$ api.shop.svc.cluster.local.
+        answer name ^api\.shop\.svc\.cluster\.local\.$ api.apps.example.com.
+    }
+    rewrite stop {
+        name regex ^web\.apps\.example\.com\.$ web.shop.svc.cluster.local.
+        answer name ^web\.shop\.svc\.cluster\.local\.$ web.apps.example.com.
+    }
+    local
+    kubernetes cluster.local`
+	if !strings.Contains(cm.Data["Corefile"], expected) {
+		t.Errorf("expected Corefile to contain:\n%s\ngot:\n%s", expected, cm.Data["Corefile"])
+	}
+	if actual := strings.Count(cm.Data["Corefile"], "rewrite stop"); actual != 2 {
+		t.Errorf("expected Corefile to contain 2 aliases, got %d:\n%s", actual, cm.Data["Corefile"])
+	}
+}
+
+func TestDesiredDNSConfigMapIngressHosts(t *testing.T) {
+	dns := &operatorv1.DNS{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: DefaultDNSController,
+		},
+		Spec: operatorv1.DNSSpec{
+			ServiceAliases: []operatorv1.DNSServiceAlias{{
+				Name:    "web.apps.example.com",
+				Service: operatorv1.DNSServiceReference{Namespace: "shop", Name: "web"},
+			}},
+		},
+	}
+	// A service alias takes precedence over an ingress host name.
+	cm, err := desiredDNSConfigMap(dns, "cluster.local", []string{"console.apps.example.com", "web.apps.example.com"}, nil, nil, nil, nil, "")
+	if err != nil {
+		t.Fatalf("invalid dns configmap: %v", err)
+	}
+	for _, expected := range []string{
+		`name regex ^console\.apps\.example\.com\.$ router-internal-default.openshift-ingress.svc.cluster.local.`,
+		`name regex ^web\.apps\.example\.com\.$ web.shop.svc.cluster.local.`,
+	} {
+		if !strings.Contains(cm.Data["Corefile"], expected) {
+			t.Errorf("expected Corefile to contain %q, got:\n%s", expected, cm.Data["Corefile"])
+		}
+	}
+	if actual := strings.Count(cm.Data["Corefile"], "rewrite stop"); actual != 2 {
+		t.Errorf("expected Corefile to contain 2 aliases, got %d:\n%s", actual, cm.Data["Corefile"])
+	}
+}
+
+func TestDesiredDNSConfigMapClusterPeers(t *testing.T) {
+	dns := &operatorv1.DNS{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: DefaultDNSController,
+		},
+		Spec: operatorv1.DNSSpec{
+			Servers: []operatorv1.Server{{
+				Name:  "foo",
+				Zones: []string{"foo.com"},
+				ForwardPlugin: operatorv1.ForwardPlugin{
+					Upstreams: []string{"1.1.1.1"},
+				},
+			}},
+			ClusterPeers: []operatorv1.DNSClusterPeer{
+				{Name: "east", ClusterDomain: "east.local", Nameservers: []string{"10.1.0.10", "10.1.0.11:5353"}},
+				// Peers for zones that are already served are
+				// ignored.
+				{Name: "self", ClusterDomain: "cluster.local", Nameservers: []string{"10.2.0.10"}},
+				{Name: "foo", ClusterDomain: "foo.com", Nameservers: []string{"10.3.0.10"}},
+				{Name: "east-again", ClusterDomain: "east.local", Nameservers: []string{"10.4.0.10"}},
+			},
+		},
+	}
+	cm, err := desiredDNSConfigMap(dns, "cluster.local", nil, nil, nil, nil, nil, "")
+	if err != nil {
+		t.Fatalf("invalid dns configmap: %v", err)
+	}
+	expected := `# peer east
+east.local:5353 {
+    forward . 10.1.0.10 10.1.0.11:5353
+    log . {
+        class error
+    }
+}
+.:5353 {`
+	if !strings.Contains(cm.Data["Corefile"], expected) {
+		t.Errorf("expected Corefile to contain:\n%s\ngot:\n%s", expected, cm.Data["Corefile"])
+	}
+	if actual := strings.Count(cm.Data["Corefile"], "# peer "); actual != 1 {
+		t.Errorf("expected Corefile to contain 1 peer, got %d:\n%s", actual, cm.Data["Corefile"])
+	}
+}
+
+func TestDesiredDNSConfigMapExtensionServers(t *testing.T) {
+	dns := &operatorv1.DNS{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: DefaultDNSController,
+		},
+	}
+	extensions := []extensionServer{{
+		source: "lighthouse",
+		server: operatorv1.Server{
+			Name:  "lighthouse",
+			Zones: []string{"clusterset.local"},
+			ForwardPlugin: operatorv1.ForwardPlugin{
+				Upstreams: []string{"172.30.10.10"},
+			},
+		},
+	}}
+	cm, err := desiredDNSConfigMap(dns, "cluster.local", nil, extensions, nil, nil, nil, "")
+	if err != nil {
+		t.Fatalf("invalid dns configmap: %v", err)
+	}
+	expected := `# lighthouse
+clusterset.local:5353 {
+    forward . 172.30.10.10
+`
+	if !strings.HasPrefix(cm.Data["Corefile"], expected) {
+		t.Errorf("expected Corefile to start with:\n%s\ngot:\n%s", expected, cm.Data["Corefile"])
+	}
+}
+
+func TestDesiredDNSConfigMapQueryTimeout(t *testing.T) {
+	for _, tc := range []struct {
+		timeout  *metav1.Duration
+		expected string
+	}{
+		{nil, ""},
+		{&metav1.Duration{}, "5.5s"},
+		{&metav1.Duration{Duration: 200 * time.Millisecond}, "1s"},
+		{&metav1.Duration{Duration: 3 * time.Second}, "3s"},
+	} {
+		dns := &operatorv1.DNS{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: DefaultDNSController,
+			},
+			Spec: operatorv1.DNSSpec{
+				Servers: []operatorv1.Server{{
+					Name:  "foo",
+					Zones: []string{"foo.com"},
+					ForwardPlugin: operatorv1.ForwardPlugin{
+						Upstreams: []string{"1.1.1.1"},
+					},
+				}},
+				Performance: operatorv1.DNSPerformance{
+					QueryTimeout: tc.timeout,
+				},
+			},
+		}
+		cm, err := desiredDNSConfigMap(dns, "cluster.local", nil, nil, nil, nil, nil, "")
+		if err != nil {
+			t.Errorf("invalid dns configmap: %v", err)
+			continue
+		}
+		corefile := cm.Data["Corefile"]
+		if len(tc.expected) == 0 {
+			if strings.Contains(corefile, "cancel") {
+				t.Errorf("expected Corefile without cancel for timeout %v, got:\n%s", tc.timeout, corefile)
+			}
+			continue
+		}
+		// Both the server block and the default server block should
+		// cancel queries.
+		if actual := strings.Count(corefile, "\n    cancel "+tc.expected+"\n"); actual != 2 {
+			t.Errorf("expected Corefile for timeout %v to contain cancel %s twice, got %d:\n%s", tc.timeout, tc.expected, actual, corefile)
+		}
+	}
+}
+
+func TestDesiredDNSConfigMapForwardOptions(t *testing.T) {
+	for _, tc := range []struct {
+		description string
+		plugin      operatorv1.ForwardPlugin
+		expected    string
+	}{
+		{
+			description: "no options",
+			plugin:      operatorv1.ForwardPlugin{Upstreams: []string{"1.1.1.1"}},
+			expected: `    forward . 1.1.1.1
+    log . {`,
+		},
+		{
+			description: "default options",
+			plugin: operatorv1.ForwardPlugin{
+				Upstreams:          []string{"1.1.1.1"},
+				Expire:             &metav1.Duration{},
+				ProtocolPreference: operatorv1.DNSProtocolPreferenceMatchClient,
+				Policy:             operatorv1.RandomForwardingPolicy,
+			},
+			expected: `    forward . 1.1.1.1
+    log . {`,
+		},
+		{
+			description: "negative expire",
+			plugin: operatorv1.ForwardPlugin{
+				Upstreams: []string{"1.1.1.1"},
+				Expire:    &metav1.Duration{Duration: -time.Second},
+			},
+			expected: `    forward . 1.1.1.1
+    log . {`,
+		},
+		{
+			description: "expire and prefer UDP",
+			plugin: operatorv1.ForwardPlugin{
+				Upstreams:          []string{"1.1.1.1", "2.2.2.2:5353"},
+				Expire:             &metav1.Duration{Duration: 90 * time.Second},
+				ProtocolPreference: operatorv1.DNSProtocolPreferencePreferUDP,
+			},
+			expected: `    forward . 1.1.1.1 2.2.2.2:5353 {
+        expire 1m30s
+        prefer_udp
+    }
+    log . {`,
+		},
+		{
+			description: "TCP protocol strategy",
+			plugin: operatorv1.ForwardPlugin{
+				Upstreams:        []string{"1.1.1.1"},
+				ProtocolStrategy: operatorv1.ProtocolStrategyTCP,
+			},
+			expected: `    forward . 1.1.1.1 {
+        force_tcp
+    }
+    log . {`,
+		},
+		{
+			description: "TCP protocol strategy overrides prefer UDP",
+			plugin: operatorv1.ForwardPlugin{
+				Upstreams:          []string{"1.1.1.1"},
+				ProtocolPreference: operatorv1.DNSProtocolPreferencePreferUDP,
+				ProtocolStrategy:   operatorv1.ProtocolStrategyTCP,
+			},
+			expected: `    forward . 1.1.1.1 {
+        force_tcp
+    }
+    log . {`,
+		},
+		{
+			description: "unknown protocol strategy",
+			plugin: operatorv1.ForwardPlugin{
+				Upstreams:        []string{"1.1.1.1"},
+				ProtocolStrategy: "QUIC",
+			},
+			expected: `    forward . 1.1.1.1
+    log . {`,
+		},
+		{
+			description: "sequential policy",
+			plugin: operatorv1.ForwardPlugin{
+				Upstreams: []string{"1.1.1.1", "2.2.2.2"},
+				Policy:    operatorv1.SequentialForwardingPolicy,
+			},
+			expected: `    forward . 1.1.1.1 2.2.2.2 {
+        policy sequential
+    }
+    log . {`,
+		},
+		{
+			description: "round robin policy",
+			plugin: operatorv1.ForwardPlugin{
+				Upstreams: []string{"1.1.1.1", "2.2.2.2"},
+				Policy:    operatorv1.RoundRobinForwardingPolicy,
+			},
+			expected: `    forward . 1.1.1.1 2.2.2.2 {
+        policy round_robin
+    }
+    log . {`,
+		},
+		{
+			description: "unknown policy",
+			plugin: operatorv1.ForwardPlugin{
+				Upstreams: []string{"1.1.1.1"},
+				Policy:    operatorv1.ForwardingPolicy("Fastest"),
+			},
+			expected: `    forward . 1.1.1.1
+    log . {`,
+		},
+	} {
+		dns := &operatorv1.DNS{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: DefaultDNSController,
+			},
+			Spec: operatorv1.DNSSpec{
+				Servers: []operatorv1.Server{{
+					Name:          "foo",
+					Zones:         []string{"foo.com"},
+					ForwardPlugin: tc.plugin,
+				}},
+			},
+		}
+		cm, err := desiredDNSConfigMap(dns, "cluster.local", nil, nil, nil, nil, nil, "")
+		if err != nil {
+			t.Errorf("%q: invalid dns configmap: %v", tc.description, err)
+			continue
+		}
+		if corefile := cm.Data["Corefile"]; !strings.Contains(corefile, "foo.com:5353 {\n"+tc.expected) {
+			t.Errorf("%q: expected Corefile to contain:\n%s\ngot:\n%s", tc.description, tc.expected, corefile)
+		}
+	}
+}
+
+func TestCorefileInternalZones(t *testing.T) {
+	dns := &operatorv1.DNS{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: DefaultDNSController,
+		},
+		Spec: operatorv1.DNSSpec{
+			Servers: []operatorv1.Server{{
+				Name:          "foo",
+				Zones:         []string{"foo.com"},
+				ForwardPlugin: operatorv1.ForwardPlugin{Upstreams: []string{"1.1.1.1"}},
+			}},
+			InternalNames: operatorv1.DNSInternalNames{
+				Local:       operatorv1.DNSInternalNamesNXDomain,
+				SingleLabel: operatorv1.DNSInternalNamesNXDomain,
+				Zones: []string{
+					"corp.example.com.",
+					// Zones that are invalid, duplicate, in
+					// the cluster domain, or served by a
+					// server are ignored.
+					"not_a_zone",
+					"Corp.Example.Com",
+					"svc.cluster.local",
+					"foo.com",
+				},
+			},
+		},
+	}
+	peers := corefileClusterPeers(dns, "cluster.local")
+	actual := corefileInternalZones(dns, "cluster.local", dns.Spec.Servers, peers)
+	expected := []corefileInternalZone{
+		{Zone: ".", Match: `^[^.]+\.$`},
+		{Zone: "local", Match: corefileExcludeSubdomainPattern("local", "cluster")},
+		{Zone: "corp.example.com"},
+	}
+	if len(actual) != len(expected) {
+		t.Fatalf("expected %v, got %v", expected, actual)
+	}
+	for i := range expected {
+		if actual[i] != expected[i] {
+			t.Errorf("expected %v, got %v", expected[i], actual[i])
+		}
+	}
+}
+
+func TestCorefileSpecServers(t *testing.T) {
+	dns := &operatorv1.DNS{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: DefaultDNSController,
+		},
+		Spec: operatorv1.DNSSpec{
+			Servers: []operatorv1.Server{
+				{
+					Name:          "corp",
+					Zones:         []string{"Example.com.", "example.org", "example.com"},
+					ForwardPlugin: operatorv1.ForwardPlugin{Upstreams: []string{"10.0.0.1"}},
+				},
+				{
+					// A nested zone is kept, a zone of an
+					// earlier server is ignored.
+					Name:          "lab",
+					Zones:         []string{"lab.example.com", "example.org"},
+					ForwardPlugin: operatorv1.ForwardPlugin{Upstreams: []string{"10.0.0.2"}},
+				},
+				{
+					// A server whose zones are all served
+					// already is ignored.
+					Name:          "shadowed",
+					Zones:         []string{"example.com.", "cluster.local"},
+					ForwardPlugin: operatorv1.ForwardPlugin{Upstreams: []string{"10.0.0.3"}},
+				},
+				{
+					// A DNS-over-HTTPS upstream is ignored.
+					Name:          "mixed",
+					Zones:         []string{"example.net"},
+					ForwardPlugin: operatorv1.ForwardPlugin{Upstreams: []string{"https://dns.example.net/dns-query", "10.0.0.4"}},
+				},
+				{
+					// A server with only DNS-over-HTTPS
+					// upstreams is ignored and does not claim
+					// its zones.
+					Name:          "doh",
+					Zones:         []string{"example.info"},
+					ForwardPlugin: operatorv1.ForwardPlugin{Upstreams: []string{"HTTPS://dns.example.info/dns-query"}},
+				},
+				{
+					Name:          "info",
+					Zones:         []string{"example.info"},
+					ForwardPlugin: operatorv1.ForwardPlugin{Upstreams: []string{"10.0.0.5"}},
+				},
+			},
+		},
+	}
+	actual := corefileSpecServers(dns, "cluster.local")
+	expected := []operatorv1.Server{
+		{
+			Name:          "corp",
+			Zones:         []string{"example.com", "example.org"},
+			ForwardPlugin: operatorv1.ForwardPlugin{Upstreams: []string{"10.0.0.1"}},
+		},
+		{
+			Name:          "lab",
+			Zones:         []string{"lab.example.com"},
+			ForwardPlugin: operatorv1.ForwardPlugin{Upstreams: []string{"10.0.0.2"}},
+		},
+		{
+			Name:          "mixed",
+			Zones:         []string{"example.net"},
+			ForwardPlugin: operatorv1.ForwardPlugin{Upstreams: []string{"10.0.0.4"}},
+		},
+		{
+			Name:          "info",
+			Zones:         []string{"example.info"},
+			ForwardPlugin: operatorv1.ForwardPlugin{Upstreams: []string{"10.0.0.5"}},
+		},
+	}
+	if diff := cmp.Diff(expected, actual); diff != "" {
+		t.Errorf("unexpected servers (-want +got):\n%s", diff)
+	}
+	if zones := dns.Spec.Servers[0].Zones; zones[0] != "Example.com." {
+		t.Errorf("expected the spec to be unchanged, got zones %v", zones)
+	}
+	if upstreams := dns.Spec.Servers[3].ForwardPlugin.Upstreams; len(upstreams) != 2 {
+		t.Errorf("expected the spec to be unchanged, got upstreams %v", upstreams)
+	}
+}
+
+func TestCorefileExcludeSubdomainPattern(t *testing.T) {
+	re := regexp.MustCompile(corefileExcludeSubdomainPattern("local", "a.cluster"))
+	for name, expected := range map[string]bool{
+		"local.":               true,
+		"foo.local.":           true,
+		"cluster.local.":       true,
+		"b.cluster.local.":     true,
+		"x.b.cluster.local.":   true,
+		"clusterx.local.":      true,
+		"clust.local.":         true,
+		"cluxter.local.":       true,
+		"a.clusters.local.":    true,
+		"aa.cluster.local.":    true,
+		"a.cluster.local.":     false,
+		"x.a.cluster.local.":   false,
+		"x.y.a.cluster.local.": false,
+		"foo.com.":             false,
+	} {
+		if actual := re.MatchString(name); actual != expected {
+			t.Errorf("expected pattern %q to match %q: %t, got %t", re, name, expected, actual)
+		}
+	}
+}
+
+func TestDesiredDNSConfigMapMinimalResponses(t *testing.T) {
+	dns := &operatorv1.DNS{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: DefaultDNSController,
+		},
+		Spec: operatorv1.DNSSpec{
+			Servers: []operatorv1.Server{{
+				Name:             "foo",
+				Zones:            []string{"foo.com"},
+				ForwardPlugin:    operatorv1.ForwardPlugin{Upstreams: []string{"1.1.1.1"}},
+				MinimalResponses: operatorv1.MinimalResponsesEnabled,
+			}, {
+				Name:             "bar",
+				Zones:            []string{"bar.com"},
+				ForwardPlugin:    operatorv1.ForwardPlugin{Upstreams: []string{"2.2.2.2"}},
+				MinimalResponses: operatorv1.MinimalResponsesDisabled,
+			}},
+		},
+	}
+	cm, err := desiredDNSConfigMap(dns, "cluster.local", nil, nil, nil, nil, nil, "")
+	if err != nil {
+		t.Fatalf("invalid dns configmap: %v", err)
+	}
+	corefile := cm.Data["Corefile"]
+	expected := `foo.com:5353 {
+    forward . 1.1.1.1
+    minimal
+    log . {`
+	if !strings.Contains(corefile, expected) {
+		t.Errorf("expected Corefile to contain:\n%s\ngot:\n%s", expected, corefile)
+	}
+	if actual := strings.Count(corefile, "minimal"); actual != 1 {
+		t.Errorf("expected Corefile to contain minimal once, got %d:\n%s", actual, corefile)
+	}
+}
+
+func TestDesiredDNSConfigMapAdditionalClusterDomains(t *testing.T) {
+	dns := &operatorv1.DNS{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: DefaultDNSController,
+		},
+		Spec: operatorv1.DNSSpec{
+			Servers: []operatorv1.Server{{
+				Name:          "foo",
+				Zones:         []string{"foo.com"},
+				ForwardPlugin: operatorv1.ForwardPlugin{Upstreams: []string{"1.1.1.1"}},
+			}},
+			AdditionalClusterDomains: []string{
+				"old.example.",
+				// Domains that are invalid, duplicate, the
+				// cluster domain, or served by a server are
+				// ignored.
+				"not_a_domain",
+				"Old.Example",
+				"cluster.local",
+				"foo.com",
+				"legacy.local",
+			},
+			ClusterPeers: []operatorv1.DNSClusterPeer{
+				// A peer for an additional cluster domain is
+				// ignored.
+				{Name: "old", ClusterDomain: "old.example", Nameservers: []string{"10.0.0.10"}},
+			},
+			InternalNames: operatorv1.DNSInternalNames{
+				// The "local" zone contains both cluster.local
+				// and legacy.local, so it is ignored.
+				Local: operatorv1.DNSInternalNamesNXDomain,
+			},
+		},
+	}
+	expected := []string{"cluster.local", "old.example", "legacy.local"}
+	if actual := corefileClusterDomains(dns, "cluster.local"); strings.Join(actual, ",") != strings.Join(expected, ",") {
+		t.Errorf("expected cluster domains %v, got %v", expected, actual)
+	}
+	cm, err := desiredDNSConfigMap(dns, "cluster.local", nil, nil, nil, nil, nil, "")
+	if err != nil {
+		t.Fatalf("invalid dns configmap: %v", err)
+	}
+	corefile := cm.Data["Corefile"]
+	if !strings.Contains(corefile, "\n    kubernetes cluster.local old.example legacy.local in-addr.arpa ip6.arpa {\n") {
+		t.Errorf("expected kubernetes plugin to serve all cluster domains, got:\n%s", corefile)
+	}
+	for _, unexpected := range []string{"# peer old", "template"} {
+		if strings.Contains(corefile, unexpected) {
+			t.Errorf("expected Corefile not to contain %q, got:\n%s", unexpected, corefile)
+		}
+	}
+}
+
+func TestDesiredDNSConfigMapKubernetesFallthrough(t *testing.T) {
+	testCases := []struct {
+		description string
+		config      operatorv1.DNSKubernetesFallthrough
+		expected    string
+	}{
+		{
+			description: "default",
+			expected:    "\n        fallthrough in-addr.arpa ip6.arpa\n",
+		},
+		{
+			description: "reverse zones",
+			config:      operatorv1.DNSKubernetesFallthrough{Policy: operatorv1.DNSKubernetesFallthroughReverseZones},
+			expected:    "\n        fallthrough in-addr.arpa ip6.arpa\n",
+		},
+		{
+			description: "listed zones",
+			config: operatorv1.DNSKubernetesFallthrough{
+				Policy: operatorv1.DNSKubernetesFallthroughZones,
+				// Zones that are invalid, duplicate, or not
+				// reverse zones are ignored.
+				Zones: []string{"10.in-addr.arpa.", "not_a_zone", "cluster.local", "10.IN-ADDR.ARPA", "8.b.d.0.1.0.0.2.ip6.arpa"},
+			},
+			expected: "\n        fallthrough 10.in-addr.arpa 8.b.d.0.1.0.0.2.ip6.arpa\n",
+		},
+		{
+			description: "no valid zones",
+			config: operatorv1.DNSKubernetesFallthrough{
+				Policy: operatorv1.DNSKubernetesFallthroughZones,
+				Zones:  []string{"example.com"},
+			},
+		},
+		{
+			description: "none",
+			config:      operatorv1.DNSKubernetesFallthrough{Policy: operatorv1.DNSKubernetesFallthroughNone},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.description, func(t *testing.T) {
+			dns := &operatorv1.DNS{
+				ObjectMeta: metav1.ObjectMeta{Name: DefaultDNSController},
+				Spec:       operatorv1.DNSSpec{KubernetesFallthrough: tc.config},
+			}
+			cm, err := desiredDNSConfigMap(dns, "cluster.local", nil, nil, nil, nil, nil, "")
+			if err != nil {
+				t.Fatalf("invalid dns configmap: %v", err)
+			}
+			corefile := cm.Data["Corefile"]
+			if len(tc.expected) == 0 {
+				if strings.Contains(corefile, "fallthrough") {
+					t.Errorf("expected no fallthrough, got:\n%s", corefile)
+				}
+				return
+			}
+			if !strings.Contains(corefile, tc.expected) {
+				t.Errorf("expected Corefile to contain %q, got:\n%s", tc.expected, corefile)
+			}
+		})
+	}
+}
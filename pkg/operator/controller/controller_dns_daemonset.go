@@ -3,6 +3,9 @@ package controller
 import (
 	"context"
 	"fmt"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/go-cmp/cmp/cmpopts"
@@ -16,33 +19,163 @@ import (
 	"github.com/sirupsen/logrus"
 
 	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/intstr"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
-// ensureDNSDaemonSet ensures the dns daemonset exists for a given dns.
-func (r *reconciler) ensureDNSDaemonSet(dns *operatorv1.DNS, clusterIP, clusterDomain string) (bool, *appsv1.DaemonSet, error) {
+// ensureDNSDaemonSet ensures the dns daemonset exists for a given dns.  An
+// update that rolls out new pods is deferred while too many dns pods are
+// unavailable, either of the daemonset or of all the daemonsets of the dns, or,
+// unless it changes an image, while machine config pools are updating too many
+// nodes, in which case a message that explains the deferral is returned.
+// Updates that do not replace pods, such as Corefile changes, are not
+// deferred.
+func (r *reconciler) ensureDNSDaemonSet(dns *operatorv1.DNS, clusterIP, clusterDomain string, haveTrustedCA bool, disabledCapabilities []string) (bool, *appsv1.DaemonSet, string, error) {
 	haveDS, current, err := r.currentDNSDaemonSet(dns)
 	if err != nil {
-		return false, nil, err
+		return false, nil, "", err
 	}
-	desired, err := desiredDNSDaemonSet(dns, clusterIP, clusterDomain, r.CoreDNSImage, r.OpenshiftCLIImage, r.KubeRBACProxyImage)
+	desired, err := desiredDNSDaemonSet(dns, clusterIP, clusterDomain, r.CoreDNSImage, r.OpenshiftCLIImage, r.KubeRBACProxyImage, haveTrustedCA, disabledCapabilities)
 	if err != nil {
-		return haveDS, current, fmt.Errorf("failed to build dns daemonset: %v", err)
+		return haveDS, current, "", fmt.Errorf("failed to build dns daemonset: %v", err)
 	}
 	switch {
 	case !haveDS:
 		if err := r.createDNSDaemonSet(desired); err != nil {
-			return false, nil, err
+			return false, nil, "", err
 		}
-		return r.currentDNSDaemonSet(dns)
+		r.history.record(dns.Name, dnsHistoryDaemonSetUpdated, "Created DaemonSet")
+		haveDS, current, err := r.currentDNSDaemonSet(dns)
+		return haveDS, current, "", err
 	case haveDS:
+		if changed, updated := daemonsetConfigChanged(current, desired); changed && daemonsetRolloutRequired(current, updated) {
+			deferral := r.dnsRolloutDeferral(dns, current)
+			if len(deferral) == 0 && !daemonsetRolloutUrgent(current, updated) {
+				if deferral, err = r.machineConfigRolloutDeferral(); err != nil {
+					logrus.Warningf("failed to check machine config pools for dns daemonset %s/%s: %v", current.Namespace, current.Name, err)
+				}
+			}
+			if len(deferral) != 0 {
+				logrus.Warningf("not updating dns daemonset %s/%s: %s", current.Namespace, current.Name, deferral)
+				r.history.record(dns.Name, dnsHistoryDaemonSetRolloutDeferred, deferral)
+				return true, current, deferral, nil
+			}
+		}
 		if updated, err := r.updateDNSDaemonSet(current, desired); err != nil {
-			return true, current, err
+			return true, current, "", err
 		} else if updated {
-			return r.currentDNSDaemonSet(dns)
+			haveDS, current, err := r.currentDNSDaemonSet(dns)
+			if err == nil && haveDS {
+				r.history.record(dns.Name, dnsHistoryDaemonSetUpdated, fmt.Sprintf("Updated DaemonSet to generation %d", current.Generation))
+			}
+			return haveDS, current, "", err
 		}
 	}
-	return true, current, nil
+	return true, current, "", nil
+}
+
+// ensureDNSDaemonSetUnlessPaused ensures the dns daemonset exists for a given
+// dns, or only gets it if its reconciliation is paused.
+func (r *reconciler) ensureDNSDaemonSetUnlessPaused(dns *operatorv1.DNS, paused managedResourceSet, clusterIP, clusterDomain string, haveTrustedCA bool, disabledCapabilities []string) (bool, *appsv1.DaemonSet, string, error) {
+	if paused.has(DNSDaemonSetName(dns)) {
+		haveDS, current, err := r.currentDNSDaemonSet(dns)
+		return haveDS, current, "", err
+	}
+	return r.ensureDNSDaemonSet(dns, clusterIP, clusterDomain, haveTrustedCA, disabledCapabilities)
+}
+
+// daemonsetRolloutRequired returns a Boolean indicating whether updating the
+// current daemonset to the updated one changes the pod template and thus
+// replaces the dns pods.
+func daemonsetRolloutRequired(current, updated *appsv1.DaemonSet) bool {
+	return !cmp.Equal(current.Spec.Template, updated.Spec.Template, cmpopts.EquateEmpty())
+}
+
+// dnsRolloutDeferral returns a message that explains why a rollout of the
+// given daemonset should be deferred, or an empty string if it is safe to roll
+// out new pods.  A rollout is deferred if more pods are unavailable than the
+// rolling update allows, because the rollout would make more pods unavailable
+// on top of those.  A rollout that has not finished is not considered: the
+// pods that it made unavailable may need the update to become available.
+func dnsRolloutDeferral(ds *appsv1.DaemonSet) string {
+	desired := ds.Status.DesiredNumberScheduled
+	if desired == 0 {
+		return ""
+	}
+	if ds.Status.ObservedGeneration < ds.Generation || ds.Status.UpdatedNumberScheduled < desired {
+		return ""
+	}
+	maxUnavailable := 1
+	if ru := ds.Spec.UpdateStrategy.RollingUpdate; ru != nil && ru.MaxUnavailable != nil {
+		if v, err := intstr.GetValueFromIntOrPercent(ru.MaxUnavailable, int(desired), true); err == nil {
+			maxUnavailable = v
+		}
+	}
+	unavailable := int(desired - ds.Status.NumberAvailable)
+	if unavailable <= maxUnavailable {
+		return ""
+	}
+	return fmt.Sprintf("Deferring DaemonSet rollout: %d of %d DNS pods are unavailable, more than the %d that a rollout may make unavailable", unavailable, desired, maxUnavailable)
+}
+
+const (
+	// dnsQuorumMinPods is the number of dns pods below which a rollout is
+	// not deferred for lack of a quorum: a majority of one or two pods is
+	// all of them, and the rollout may be what makes them available.
+	dnsQuorumMinPods = 3
+)
+
+// dnsQuorumDeferral returns a message that explains why a rollout of the given
+// daemonset should be deferred because no more than half of the pods of the
+// given daemonsets of its dns, which the dns service load-balances across, are
+// available, or an empty string if it is safe to roll out new pods.  During
+// an incident that takes out most dns pods, replacing pods that still serve
+// would leave even fewer to answer queries.  As with dnsRolloutDeferral, a
+// rollout of the daemonset that has not finished is not held back.
+func dnsQuorumDeferral(ds *appsv1.DaemonSet, daemonsets []appsv1.DaemonSet) string {
+	if ds.Status.ObservedGeneration < ds.Generation || ds.Status.UpdatedNumberScheduled < ds.Status.DesiredNumberScheduled {
+		return ""
+	}
+	var desired, available int32
+	for i := range daemonsets {
+		desired += daemonsets[i].Status.DesiredNumberScheduled
+		available += daemonsets[i].Status.NumberAvailable
+	}
+	if desired < dnsQuorumMinPods || available*2 > desired {
+		return ""
+	}
+	return fmt.Sprintf("Deferring DaemonSet rollout: only %d of %d DNS pods are available, not a majority", available, desired)
+}
+
+// dnsRolloutDeferral returns a message that explains why a rollout of the
+// given daemonset of the given dns should be deferred because too many dns
+// pods are unavailable, or an empty string if it is safe to roll out new pods.
+// If the daemonsets of the dns cannot be listed, only the given daemonset is
+// considered.
+func (r *reconciler) dnsRolloutDeferral(dns *operatorv1.DNS, ds *appsv1.DaemonSet) string {
+	if deferral := dnsRolloutDeferral(ds); len(deferral) != 0 {
+		return deferral
+	}
+	daemonsets, err := r.listDNSDaemonSets(dns)
+	if err != nil {
+		logrus.Warningf("failed to check for a quorum of dns %s: %v", dns.Name, err)
+		return ""
+	}
+	return dnsQuorumDeferral(ds, daemonsets)
+}
+
+// listDNSDaemonSets returns the daemonsets of the given dns, including those
+// of its node overrides.
+func (r *reconciler) listDNSDaemonSets(dns *operatorv1.DNS) ([]appsv1.DaemonSet, error) {
+	daemonsets := &appsv1.DaemonSetList{}
+	if err := r.client.List(context.TODO(), daemonsets, client.InNamespace(DNSDaemonSetName(dns).Namespace), client.MatchingLabels{manifests.OwningDNSLabel: DNSDaemonSetLabel(dns)}); err != nil {
+		return nil, fmt.Errorf("failed to list daemonsets of dns %s: %v", dns.Name, err)
+	}
+	return daemonsets.Items, nil
 }
 
 // ensureDNSDaemonSetDeleted ensures deletion of daemonset and related resources
@@ -62,8 +195,93 @@ func (r *reconciler) ensureDNSDaemonSetDeleted(dns *operatorv1.DNS) error {
 	return nil
 }
 
-// desiredDNSDaemonSet returns the desired dns daemonset.
-func desiredDNSDaemonSet(dns *operatorv1.DNS, clusterIP, clusterDomain, coreDNSImage, openshiftCLIImage, kubeRBACProxyImage string) (*appsv1.DaemonSet, error) {
+const (
+	// defaultNodeResolverPollInterval is the interval at which the
+	// node-resolver refreshes /etc/hosts if the dns does not specify one.
+	defaultNodeResolverPollInterval = 60 * time.Second
+	// minNodeResolverPollInterval is the shortest interval at which the
+	// node-resolver may refresh /etc/hosts.
+	minNodeResolverPollInterval = 5 * time.Second
+
+	// apiServerReadinessPeriod is the interval at which the node-resolver
+	// checks whether it can reach the API server if the dns enables API
+	// server readiness.
+	apiServerReadinessPeriod = 10 * time.Second
+	// defaultAPIServerReadinessGracePeriod is how long a dns pod may be
+	// unable to reach the API server before it reports that it is not
+	// ready if the dns does not specify a grace period.
+	defaultAPIServerReadinessGracePeriod = 30 * time.Second
+
+	// defaultHealthPort is the port on which CoreDNS serves its health
+	// endpoint if the dns does not specify one.
+	defaultHealthPort = int32(8080)
+	// defaultReadyPort is the port on which CoreDNS serves its readiness
+	// endpoint if the dns does not specify one.
+	defaultReadyPort = int32(8181)
+
+	// dnsPort, metricsPort, and secureMetricsPort are the ports on which the
+	// dns pods serve DNS, plain-text metrics, and TLS-secured metrics.
+	dnsPort           = int32(5353)
+	metricsPort       = int32(9153)
+	secureMetricsPort = int32(9154)
+)
+
+// dnsGOMAXPROCSEnv returns the GOMAXPROCS environment variable for the dns
+// container, or nil if CoreDNS should use all of the node's CPUs.  If the dns
+// listens on a socket per CPU without specifying gomaxprocs, the number of
+// allocatable CPUs on each node is used, which the downward API reports as the
+// CPU limit of a container without one, rounded up to a whole CPU.
+func dnsGOMAXPROCSEnv(dns *operatorv1.DNS) *corev1.EnvVar {
+	switch {
+	case dns.Spec.Performance.GOMAXPROCS > 0:
+		return &corev1.EnvVar{
+			Name:  "GOMAXPROCS",
+			Value: strconv.Itoa(int(dns.Spec.Performance.GOMAXPROCS)),
+		}
+	case dns.Spec.Performance.ListenSockets == operatorv1.DNSListenSocketsPerCPU:
+		return &corev1.EnvVar{
+			Name: "GOMAXPROCS",
+			ValueFrom: &corev1.EnvVarSource{
+				ResourceFieldRef: &corev1.ResourceFieldSelector{
+					ContainerName: "dns",
+					Resource:      "limits.cpu",
+					Divisor:       resource.MustParse("1"),
+				},
+			},
+		}
+	}
+	return nil
+}
+
+// dnsNodeNameEnv returns the environment variable with the name of the node of
+// the dns pod, which the Corefile refers to for the name server identifier and
+// for the pprof snippet of the node.  The dns container always has it so that
+// enabling either does not change the pod template.
+func dnsNodeNameEnv() corev1.EnvVar {
+	return fieldRefEnv(nodeNameEnvVar, "spec.nodeName")
+}
+
+// fieldRefEnv returns an environment variable with the value of the given
+// field of the pod.  The API version of the field reference is set to the
+// default so that the daemonset does not differ from the desired one once the
+// API server sets it.
+func fieldRefEnv(name, fieldPath string) corev1.EnvVar {
+	return corev1.EnvVar{
+		Name: name,
+		ValueFrom: &corev1.EnvVarSource{
+			FieldRef: &corev1.ObjectFieldSelector{
+				APIVersion: "v1",
+				FieldPath:  fieldPath,
+			},
+		},
+	}
+}
+
+// desiredDNSDaemonSet returns the desired dns daemonset.  If haveTrustedCA is
+// true, the trusted CA bundle is mounted into the dns container.  Containers
+// for optional components whose capabilities are in disabledCapabilities are
+// omitted.
+func desiredDNSDaemonSet(dns *operatorv1.DNS, clusterIP, clusterDomain, coreDNSImage, openshiftCLIImage, kubeRBACProxyImage string, haveTrustedCA bool, disabledCapabilities []string) (*appsv1.DaemonSet, error) {
 	daemonset := manifests.DNSDaemonSet()
 	name := DNSDaemonSetName(dns)
 	daemonset.Name = name.Name
@@ -96,14 +314,72 @@ func desiredDNSDaemonSet(dns *operatorv1.DNS, clusterIP, clusterDomain, coreDNSI
 	if !coreFileVolumeFound {
 		return nil, fmt.Errorf("volume 'config-volume' is not found")
 	}
+	if haveTrustedCA {
+		daemonset.Spec.Template.Spec.Volumes = append(daemonset.Spec.Template.Spec.Volumes, corev1.Volume{
+			Name: trustedCABundleVolumeName,
+			VolumeSource: corev1.VolumeSource{
+				ConfigMap: &corev1.ConfigMapVolumeSource{
+					LocalObjectReference: corev1.LocalObjectReference{
+						Name: DNSTrustedCAConfigMapName(dns).Name,
+					},
+					Items: []corev1.KeyToPath{{
+						Key:  trustedCABundleKey,
+						Path: trustedCABundlePath,
+					}},
+				},
+			},
+		})
+	}
 
 	for i, c := range daemonset.Spec.Template.Spec.Containers {
 		switch c.Name {
 		case "dns":
 			daemonset.Spec.Template.Spec.Containers[i].Image = coreDNSImage
+			healthPort, readyPort := dnsProbePorts(dns)
+			daemonset.Spec.Template.Spec.Containers[i].Ports = append(daemonset.Spec.Template.Spec.Containers[i].Ports,
+				corev1.ContainerPort{
+					Name:          "health",
+					ContainerPort: healthPort,
+					Protocol:      corev1.ProtocolTCP,
+				},
+				corev1.ContainerPort{
+					Name:          "ready",
+					ContainerPort: readyPort,
+					Protocol:      corev1.ProtocolTCP,
+				},
+			)
+			if probe := daemonset.Spec.Template.Spec.Containers[i].LivenessProbe; probe != nil && probe.HTTPGet != nil {
+				probe.HTTPGet.Port = intstr.FromInt(int(healthPort))
+			}
+			if probe := daemonset.Spec.Template.Spec.Containers[i].ReadinessProbe; probe != nil && probe.HTTPGet != nil {
+				probe.HTTPGet.Port = intstr.FromInt(int(readyPort))
+			}
+			if env := dnsGOMAXPROCSEnv(dns); env != nil {
+				daemonset.Spec.Template.Spec.Containers[i].Env = append(daemonset.Spec.Template.Spec.Containers[i].Env, *env)
+			}
+			daemonset.Spec.Template.Spec.Containers[i].Env = append(daemonset.Spec.Template.Spec.Containers[i].Env, dnsNodeNameEnv())
+			if dns.Spec.ListenAddresses == operatorv1.DNSListenAddressesPodIP {
+				daemonset.Spec.Template.Spec.Containers[i].Env = append(daemonset.Spec.Template.Spec.Containers[i].Env, dnsPodIPEnv())
+			}
+			if haveTrustedCA {
+				daemonset.Spec.Template.Spec.Containers[i].VolumeMounts = append(daemonset.Spec.Template.Spec.Containers[i].VolumeMounts, corev1.VolumeMount{
+					Name:      trustedCABundleVolumeName,
+					MountPath: trustedCABundleMountPath,
+					ReadOnly:  true,
+				})
+			}
 		case "dns-node-resolver":
 			daemonset.Spec.Template.Spec.Containers[i].Image = openshiftCLIImage
-			envs := []corev1.EnvVar{}
+			for j, e := range c.Env {
+				if e.Name == "SERVICES" && len(dns.Spec.NodeResolver.AdditionalNames) > 0 {
+					names := append([]string{e.Value}, dns.Spec.NodeResolver.AdditionalNames...)
+					daemonset.Spec.Template.Spec.Containers[i].Env[j].Value = strings.Join(names, ",")
+				}
+			}
+			envs := []corev1.EnvVar{{
+				Name:  "POLL_INTERVAL",
+				Value: strconv.Itoa(nodeResolverPollIntervalSeconds(dns)),
+			}}
 			if len(clusterIP) > 0 {
 				envs = append(envs, corev1.EnvVar{
 					Name:  "NAMESERVER",
@@ -116,18 +392,142 @@ func desiredDNSDaemonSet(dns *operatorv1.DNS, clusterIP, clusterDomain, coreDNSI
 					Value: clusterDomain,
 				})
 			}
+			if len(dns.Spec.NodeResolver.HostAliases) > 0 {
+				services := nodeResolverServices(daemonset.Spec.Template.Spec.Containers[i])
+				if err := validateNodeResolverHostAliases(dns, services, clusterDomain); err != nil {
+					return nil, fmt.Errorf("invalid node-resolver host aliases: %v", err)
+				}
+				envs = append(envs, corev1.EnvVar{
+					Name:  "HOST_ALIASES",
+					Value: nodeResolverHostAliases(dns),
+				})
+			}
 
 			if daemonset.Spec.Template.Spec.Containers[i].Env == nil {
 				daemonset.Spec.Template.Spec.Containers[i].Env = []corev1.EnvVar{}
 			}
 			daemonset.Spec.Template.Spec.Containers[i].Env = append(daemonset.Spec.Template.Spec.Containers[i].Env, envs...)
+			daemonset.Spec.Template.Spec.Containers[i].ReadinessProbe = apiServerReadinessProbe(dns)
 		case "kube-rbac-proxy":
 			daemonset.Spec.Template.Spec.Containers[i].Image = kubeRBACProxyImage
 		}
 	}
+
+	setDNSNodePlacement(daemonset, dns)
+	// The daemonsets of the node overrides run on the nodes of the
+	// overrides, and no daemonset runs on excluded nodes.
+	daemonset.Spec.Template.Spec.Affinity = nodeOverridesAffinity(dnsNodeOverrides(dns), dnsNodeExclusions(dns))
+
+	setCorefilePartsVolume(daemonset, dns)
+	setPprofVolume(daemonset, dns)
+	setExtraConfigVolumes(daemonset, dns)
+	setForwardCABundleVolumes(daemonset, dns)
+	setUpstreamResolvConfReload(daemonset, dns, openshiftCLIImage)
+	setDNSTermination(daemonset, dns)
+
+	if err := setDNSAdditionalNetworks(daemonset, dns); err != nil {
+		return nil, err
+	}
+
+	if capabilityDisabled(disabledCapabilities, NodeResolverCapability) {
+		removeContainerAndVolume(&daemonset.Spec.Template.Spec, "dns-node-resolver", "hosts-file")
+	}
+	if capabilityDisabled(disabledCapabilities, MetricsCapability) {
+		removeContainerAndVolume(&daemonset.Spec.Template.Spec, "kube-rbac-proxy", "metrics-tls")
+	}
 	return daemonset, nil
 }
 
+// removeContainerAndVolume removes the named container and volume from the
+// given pod spec.
+func removeContainerAndVolume(spec *corev1.PodSpec, containerName, volumeName string) {
+	containers := []corev1.Container{}
+	for _, c := range spec.Containers {
+		if c.Name != containerName {
+			containers = append(containers, c)
+		}
+	}
+	spec.Containers = containers
+	volumes := []corev1.Volume{}
+	for _, v := range spec.Volumes {
+		if v.Name != volumeName {
+			volumes = append(volumes, v)
+		}
+	}
+	spec.Volumes = volumes
+}
+
+// dnsProbePorts returns the ports on which CoreDNS should serve its health and
+// readiness endpoints for the given dns.
+func dnsProbePorts(dns *operatorv1.DNS) (int32, int32) {
+	healthPort, readyPort := defaultHealthPort, defaultReadyPort
+	if dns.Spec.ProbePorts.Health != 0 {
+		healthPort = dns.Spec.ProbePorts.Health
+	}
+	if dns.Spec.ProbePorts.Ready != 0 {
+		readyPort = dns.Spec.ProbePorts.Ready
+	}
+	return healthPort, readyPort
+}
+
+// validateDNSProbePorts returns an error if the probe ports of the given dns
+// conflict with each other or with ports that are reserved for dns and
+// metrics.
+func validateDNSProbePorts(dns *operatorv1.DNS) error {
+	healthPort, readyPort := dnsProbePorts(dns)
+	if healthPort == readyPort {
+		return fmt.Errorf("health port and ready port must differ, both are %d", healthPort)
+	}
+	for _, port := range []int32{healthPort, readyPort} {
+		switch port {
+		case dnsPort, metricsPort, secureMetricsPort:
+			return fmt.Errorf("probe port %d conflicts with a reserved port", port)
+		}
+	}
+	return nil
+}
+
+// nodeResolverPollIntervalSeconds returns the interval, in seconds, at which
+// the node-resolver should refresh /etc/hosts for the given dns.
+func nodeResolverPollIntervalSeconds(dns *operatorv1.DNS) int {
+	interval := defaultNodeResolverPollInterval
+	if d := dns.Spec.NodeResolver.PollInterval; d != nil {
+		interval = d.Duration
+	}
+	if interval < minNodeResolverPollInterval {
+		interval = minNodeResolverPollInterval
+	}
+	return int(interval.Round(time.Second) / time.Second)
+}
+
+// apiServerReadinessProbe returns the readiness probe of the node-resolver
+// container that makes a dns pod report that it is not ready once it has been
+// unable to reach the API server for the grace period of the given dns, or nil
+// if the dns does not enable API server readiness.  The probe uses the
+// in-cluster configuration of the oc client, so it reaches the API server the
+// same way as the kubernetes plugin of CoreDNS.
+func apiServerReadinessProbe(dns *operatorv1.DNS) *corev1.Probe {
+	if dns.Spec.APIServerReadiness.Policy != operatorv1.DNSAPIServerReadinessEnabled {
+		return nil
+	}
+	gracePeriod := defaultAPIServerReadinessGracePeriod
+	if d := dns.Spec.APIServerReadiness.GracePeriod; d != nil && d.Duration > 0 {
+		gracePeriod = d.Duration
+	}
+	failureThreshold := int32((gracePeriod + apiServerReadinessPeriod - 1) / apiServerReadinessPeriod)
+	return &corev1.Probe{
+		Handler: corev1.Handler{
+			Exec: &corev1.ExecAction{
+				Command: []string{"oc", "get", "--raw", "/version", "--request-timeout=5s"},
+			},
+		},
+		PeriodSeconds:    int32(apiServerReadinessPeriod / time.Second),
+		TimeoutSeconds:   8,
+		SuccessThreshold: 1,
+		FailureThreshold: failureThreshold,
+	}
+}
+
 // currentDNSDaemonSet returns the current dns daemonset.
 func (r *reconciler) currentDNSDaemonSet(dns *operatorv1.DNS) (bool, *appsv1.DaemonSet, error) {
 	daemonset := &appsv1.DaemonSet{}
@@ -153,10 +553,22 @@ func (r *reconciler) createDNSDaemonSet(daemonset *appsv1.DaemonSet) error {
 func (r *reconciler) updateDNSDaemonSet(current, desired *appsv1.DaemonSet) (bool, error) {
 	changed, updated := daemonsetConfigChanged(current, desired)
 	if !changed {
+		r.updateRejections.record(current.Labels[manifests.OwningDNSLabel], types.NamespacedName{Namespace: current.Namespace, Name: current.Name}, "")
 		return false, nil
 	}
 
-	if err := r.client.Update(context.TODO(), updated); err != nil {
+	// Catch updates that admission rejects, such as a pod template that
+	// a security context constraint does not allow, before attempting
+	// them, so that the rejection is reported in the status of the dns.
+	if err := r.dryRunDaemonSetUpdate(updated); err != nil {
+		return false, err
+	}
+	if logrus.IsLevelEnabled(logrus.DebugLevel) {
+		logrus.Debugf("dns daemonset %s/%s differs from the desired daemonset (-current +updated):\n%s", current.Namespace, current.Name, cmp.Diff(current.Spec, updated.Spec))
+	}
+	err := r.client.Update(context.TODO(), updated)
+	r.trackUpdate("daemonset", current, updated, updated.Spec, err)
+	if err != nil {
 		return false, fmt.Errorf("failed to update dns daemonset %s/%s: %v", updated.Namespace, updated.Name, err)
 	}
 	logrus.Infof("updated dns daemonset: %s/%s", updated.Namespace, updated.Name)
@@ -173,16 +585,23 @@ func daemonsetConfigChanged(current, expected *appsv1.DaemonSet) (bool, *appsv1.
 		var curIndex int
 		var curImage, expImage string
 
-		for i, c := range current.Spec.Template.Spec.Containers {
+		for i, c := range expected.Spec.Template.Spec.Containers {
 			if name == c.Name {
-				curIndex = i
-				curImage = current.Spec.Template.Spec.Containers[i].Image
+				expImage = expected.Spec.Template.Spec.Containers[i].Image
 				break
 			}
 		}
-		for i, c := range expected.Spec.Template.Spec.Containers {
+		if len(expImage) == 0 {
+			// The container is not expected, for example because the
+			// capability for its component is disabled.  If the current
+			// daemonset has it, the container count check below detects
+			// the change.
+			continue
+		}
+		for i, c := range current.Spec.Template.Spec.Containers {
 			if name == c.Name {
-				expImage = expected.Spec.Template.Spec.Containers[i].Image
+				curIndex = i
+				curImage = current.Spec.Template.Spec.Containers[i].Image
 				break
 			}
 		}
@@ -197,29 +616,80 @@ func daemonsetConfigChanged(current, expected *appsv1.DaemonSet) (bool, *appsv1.
 			changed = true
 		}
 	}
-	// TODO: Also check Env?
 
 	if !cmp.Equal(current.Spec.Template.Spec.NodeSelector, expected.Spec.Template.Spec.NodeSelector, cmpopts.EquateEmpty()) {
 		updated.Spec.Template.Spec.NodeSelector = expected.Spec.Template.Spec.NodeSelector
 		changed = true
 	}
+	if !cmp.Equal(current.Spec.Template.Spec.Affinity, expected.Spec.Template.Spec.Affinity, cmpopts.EquateEmpty()) {
+		updated.Spec.Template.Spec.Affinity = expected.Spec.Template.Spec.Affinity
+		changed = true
+	}
+	if current.Spec.Template.Annotations[multusNetworksAnnotation] != expected.Spec.Template.Annotations[multusNetworksAnnotation] {
+		if value, ok := expected.Spec.Template.Annotations[multusNetworksAnnotation]; ok {
+			if updated.Spec.Template.Annotations == nil {
+				updated.Spec.Template.Annotations = map[string]string{}
+			}
+			updated.Spec.Template.Annotations[multusNetworksAnnotation] = value
+		} else {
+			delete(updated.Spec.Template.Annotations, multusNetworksAnnotation)
+		}
+		changed = true
+	}
 	if !cmp.Equal(current.Spec.Template.Spec.Tolerations, expected.Spec.Template.Spec.Tolerations, cmpopts.EquateEmpty(), cmpopts.SortSlices(cmpTolerations)) {
 		updated.Spec.Template.Spec.Tolerations = expected.Spec.Template.Spec.Tolerations
 		changed = true
 	}
-	if !cmp.Equal(current.Spec.Template.Spec.Volumes, expected.Spec.Template.Spec.Volumes, cmpopts.EquateEmpty(), cmp.Comparer(cmpConfigMapVolumeSource), cmp.Comparer(cmpSecretVolumeSource)) {
+	if !cmp.Equal(current.Spec.Template.Spec.TerminationGracePeriodSeconds, expected.Spec.Template.Spec.TerminationGracePeriodSeconds) {
+		updated.Spec.Template.Spec.TerminationGracePeriodSeconds = expected.Spec.Template.Spec.TerminationGracePeriodSeconds
+		changed = true
+	}
+	if !cmp.Equal(current.Spec.Template.Spec.ShareProcessNamespace, expected.Spec.Template.Spec.ShareProcessNamespace, cmpopts.EquateEmpty()) {
+		updated.Spec.Template.Spec.ShareProcessNamespace = expected.Spec.Template.Spec.ShareProcessNamespace
+		changed = true
+	}
+	if initContainersChanged(current.Spec.Template.Spec.InitContainers, expected.Spec.Template.Spec.InitContainers) {
+		updated.Spec.Template.Spec.InitContainers = expected.Spec.Template.Spec.InitContainers
+		changed = true
+	}
+	if !cmp.Equal(current.Spec.Template.Spec.Volumes, expected.Spec.Template.Spec.Volumes, cmpopts.EquateEmpty(), cmp.Comparer(cmpConfigMapVolumeSource), cmp.Comparer(cmpSecretVolumeSource), cmp.Comparer(cmpProjectedVolumeSource)) {
 		updated.Spec.Template.Spec.Volumes = expected.Spec.Template.Spec.Volumes
 		changed = true
 	}
 
-	// Detect changes to container commands
+	// Detect changes to container commands and environment variables
 	if len(current.Spec.Template.Spec.Containers) != len(expected.Spec.Template.Spec.Containers) {
 		updated.Spec.Template.Spec.Containers = expected.Spec.Template.Spec.Containers
 		changed = true
 	} else {
 		for i, a := range current.Spec.Template.Spec.Containers {
 			b := expected.Spec.Template.Spec.Containers[i]
-			if !cmp.Equal(a.Command, b.Command, cmpopts.EquateEmpty()) {
+			if !cmp.Equal(a.Command, b.Command, cmpopts.EquateEmpty()) || !cmp.Equal(a.Env, b.Env, cmpopts.EquateEmpty()) {
+				updated.Spec.Template.Spec.Containers = expected.Spec.Template.Spec.Containers
+				changed = true
+				break
+			}
+			if !cmp.Equal(a.VolumeMounts, b.VolumeMounts, cmpopts.EquateEmpty()) {
+				updated.Spec.Template.Spec.Containers = expected.Spec.Template.Spec.Containers
+				changed = true
+				break
+			}
+			if !cmp.Equal(containerCapabilities(a), containerCapabilities(b), cmpopts.EquateEmpty()) {
+				updated.Spec.Template.Spec.Containers = expected.Spec.Template.Spec.Containers
+				changed = true
+				break
+			}
+			if !cmp.Equal(a.Ports, b.Ports, cmpopts.EquateEmpty(), cmp.Comparer(cmpContainerPort)) {
+				updated.Spec.Template.Spec.Containers = expected.Spec.Template.Spec.Containers
+				changed = true
+				break
+			}
+			if !cmp.Equal(a.LivenessProbe, b.LivenessProbe, cmpopts.EquateEmpty()) || !cmp.Equal(a.ReadinessProbe, b.ReadinessProbe, cmpopts.EquateEmpty()) {
+				updated.Spec.Template.Spec.Containers = expected.Spec.Template.Spec.Containers
+				changed = true
+				break
+			}
+			if !cmp.Equal(a.Lifecycle, b.Lifecycle, cmpopts.EquateEmpty()) {
 				updated.Spec.Template.Spec.Containers = expected.Spec.Template.Spec.Containers
 				changed = true
 				break
@@ -233,6 +703,25 @@ func daemonsetConfigChanged(current, expected *appsv1.DaemonSet) (bool, *appsv1.
 	return true, updated
 }
 
+// initContainersChanged returns a Boolean indicating whether the current init
+// containers differ from the expected ones in the fields that the operator
+// sets and the API server does not default.
+func initContainersChanged(current, expected []corev1.Container) bool {
+	if len(current) != len(expected) {
+		return true
+	}
+	for i := range current {
+		a, b := current[i], expected[i]
+		if a.Name != b.Name || a.Image != b.Image {
+			return true
+		}
+		if !cmp.Equal(a.Command, b.Command, cmpopts.EquateEmpty()) || !cmp.Equal(a.VolumeMounts, b.VolumeMounts, cmpopts.EquateEmpty()) {
+			return true
+		}
+	}
+	return false
+}
+
 // volumeDefaultMode is the default mode value that the API uses for configmap
 // and secret volume sources.  Decimal 420 is octal 0644, which is u=rw,g=r,o=r.
 const volumeDefaultMode = int32(420)
@@ -263,6 +752,23 @@ func cmpConfigMapVolumeSource(a, b corev1.ConfigMapVolumeSource) bool {
 	return true
 }
 
+// cmpProjectedVolumeSource compares two projected volume source values and
+// returns a Boolean indicating whether they are equal.
+func cmpProjectedVolumeSource(a, b corev1.ProjectedVolumeSource) bool {
+	if !cmp.Equal(a.Sources, b.Sources, cmpopts.EquateEmpty()) {
+		return false
+	}
+	aDefaultMode := volumeDefaultMode
+	if a.DefaultMode != nil {
+		aDefaultMode = *a.DefaultMode
+	}
+	bDefaultMode := volumeDefaultMode
+	if b.DefaultMode != nil {
+		bDefaultMode = *b.DefaultMode
+	}
+	return aDefaultMode == bDefaultMode
+}
+
 // cmpSecretVolumeSource compares two secret volume source values and returns a
 // Boolean indicating whether they are equal.
 func cmpSecretVolumeSource(a, b corev1.SecretVolumeSource) bool {
@@ -289,6 +795,24 @@ func cmpSecretVolumeSource(a, b corev1.SecretVolumeSource) bool {
 	return true
 }
 
+// cmpContainerPort compares two container port values and returns a Boolean
+// indicating whether they are equal.  An empty protocol is treated as TCP,
+// which is the value that the API uses by default.
+func cmpContainerPort(a, b corev1.ContainerPort) bool {
+	if a.Name != b.Name || a.ContainerPort != b.ContainerPort || a.HostPort != b.HostPort || a.HostIP != b.HostIP {
+		return false
+	}
+	aProtocol := a.Protocol
+	if len(aProtocol) == 0 {
+		aProtocol = corev1.ProtocolTCP
+	}
+	bProtocol := b.Protocol
+	if len(bProtocol) == 0 {
+		bProtocol = corev1.ProtocolTCP
+	}
+	return aProtocol == bProtocol
+}
+
 // cmpTolerations compares two Tolerations values and returns a Boolean
 // indicating whether they are equal.
 func cmpTolerations(a, b corev1.Toleration) bool {
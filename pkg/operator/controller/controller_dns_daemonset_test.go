@@ -1,14 +1,18 @@
 package controller
 
 import (
+	"reflect"
 	"testing"
+	"time"
 
 	operatorv1 "github.com/openshift/api/operator/v1"
 
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
 
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
 )
 
 func TestDesiredDNSDaemonset(t *testing.T) {
@@ -24,7 +28,7 @@ func TestDesiredDNSDaemonset(t *testing.T) {
 		},
 	}
 
-	if ds, err := desiredDNSDaemonSet(dns, clusterIP, clusterDomain, coreDNSImage, openshiftCLIImage, kubeRBACProxyImage); err != nil {
+	if ds, err := desiredDNSDaemonSet(dns, clusterIP, clusterDomain, coreDNSImage, openshiftCLIImage, kubeRBACProxyImage, false, nil); err != nil {
 		t.Errorf("invalid dns daemonset: %v", err)
 	} else {
 		// Validate the daemonset
@@ -69,6 +73,432 @@ func TestDesiredDNSDaemonset(t *testing.T) {
 	}
 }
 
+func TestDesiredDNSDaemonsetNodeResolver(t *testing.T) {
+	testCases := []struct {
+		description          string
+		nodeResolver         operatorv1.NodeResolverConfig
+		expectedServices     string
+		expectedPollInterval string
+		expectedHostAliases  string
+	}{
+		{
+			description:          "default node-resolver config",
+			expectedServices:     "image-registry.openshift-image-registry.svc",
+			expectedPollInterval: "60",
+		},
+		{
+			description: "additional names and poll interval",
+			nodeResolver: operatorv1.NodeResolverConfig{
+				PollInterval:    &metav1.Duration{Duration: 2 * time.Minute},
+				AdditionalNames: []string{"foo.bar.svc", "mirror.example.com."},
+			},
+			expectedServices:     "image-registry.openshift-image-registry.svc,foo.bar.svc,mirror.example.com.",
+			expectedPollInterval: "120",
+		},
+		{
+			description: "poll interval below the minimum",
+			nodeResolver: operatorv1.NodeResolverConfig{
+				PollInterval: &metav1.Duration{Duration: time.Second},
+			},
+			expectedServices:     "image-registry.openshift-image-registry.svc",
+			expectedPollInterval: "5",
+		},
+		{
+			description: "host aliases",
+			nodeResolver: operatorv1.NodeResolverConfig{
+				HostAliases: []operatorv1.NodeResolverHostAlias{
+					{IP: "10.0.0.1", Hostnames: []string{"registry.example.com", "mirror.example.com"}},
+					{IP: "fd00::1", Hostnames: []string{"api.example.com"}},
+				},
+			},
+			expectedServices:     "image-registry.openshift-image-registry.svc",
+			expectedPollInterval: "60",
+			expectedHostAliases:  "10.0.0.1 registry.example.com mirror.example.com\nfd00::1 api.example.com",
+		},
+	}
+	for _, tc := range testCases {
+		dns := &operatorv1.DNS{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: DefaultDNSController,
+			},
+			Spec: operatorv1.DNSSpec{
+				NodeResolver: tc.nodeResolver,
+			},
+		}
+		ds, err := desiredDNSDaemonSet(dns, "172.30.77.10", "cluster.local", "coredns", "cli", "kube-rbac-proxy", false, nil)
+		if err != nil {
+			t.Errorf("%s: invalid dns daemonset: %v", tc.description, err)
+			continue
+		}
+		for _, c := range ds.Spec.Template.Spec.Containers {
+			if c.Name != "dns-node-resolver" {
+				continue
+			}
+			envs := map[string]string{}
+			for _, e := range c.Env {
+				envs[e.Name] = e.Value
+			}
+			if e, a := tc.expectedServices, envs["SERVICES"]; e != a {
+				t.Errorf("%s: expected SERVICES env %q, got %q", tc.description, e, a)
+			}
+			if e, a := tc.expectedPollInterval, envs["POLL_INTERVAL"]; e != a {
+				t.Errorf("%s: expected POLL_INTERVAL env %q, got %q", tc.description, e, a)
+			}
+			if e, a := tc.expectedHostAliases, envs["HOST_ALIASES"]; e != a {
+				t.Errorf("%s: expected HOST_ALIASES env %q, got %q", tc.description, e, a)
+			}
+		}
+	}
+}
+
+func TestDesiredDNSDaemonsetProbePorts(t *testing.T) {
+	dns := &operatorv1.DNS{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: DefaultDNSController,
+		},
+		Spec: operatorv1.DNSSpec{
+			ProbePorts: operatorv1.ProbePorts{
+				Health: 18080,
+				Ready:  18181,
+			},
+		},
+	}
+	ds, err := desiredDNSDaemonSet(dns, "172.30.77.10", "cluster.local", "coredns", "cli", "kube-rbac-proxy", false, nil)
+	if err != nil {
+		t.Fatalf("invalid dns daemonset: %v", err)
+	}
+	for _, c := range ds.Spec.Template.Spec.Containers {
+		if c.Name != "dns" {
+			continue
+		}
+		ports := map[string]int32{}
+		for _, p := range c.Ports {
+			ports[p.Name] = p.ContainerPort
+		}
+		if e, a := int32(18080), ports["health"]; e != a {
+			t.Errorf("expected health container port %d, got %d", e, a)
+		}
+		if e, a := int32(18181), ports["ready"]; e != a {
+			t.Errorf("expected ready container port %d, got %d", e, a)
+		}
+		if e, a := 18080, c.LivenessProbe.HTTPGet.Port.IntValue(); e != a {
+			t.Errorf("expected liveness probe port %d, got %d", e, a)
+		}
+		if e, a := 18181, c.ReadinessProbe.HTTPGet.Port.IntValue(); e != a {
+			t.Errorf("expected readiness probe port %d, got %d", e, a)
+		}
+	}
+}
+
+func TestDesiredDNSDaemonsetAPIServerReadiness(t *testing.T) {
+	testCases := []struct {
+		description      string
+		readiness        operatorv1.DNSAPIServerReadiness
+		expectProbe      bool
+		failureThreshold int32
+	}{
+		{
+			description: "unset",
+		},
+		{
+			description: "disabled",
+			readiness:   operatorv1.DNSAPIServerReadiness{Policy: operatorv1.DNSAPIServerReadinessDisabled},
+		},
+		{
+			description:      "enabled with the default grace period",
+			readiness:        operatorv1.DNSAPIServerReadiness{Policy: operatorv1.DNSAPIServerReadinessEnabled},
+			expectProbe:      true,
+			failureThreshold: 3,
+		},
+		{
+			description: "enabled with a grace period that is rounded up",
+			readiness: operatorv1.DNSAPIServerReadiness{
+				Policy:      operatorv1.DNSAPIServerReadinessEnabled,
+				GracePeriod: &metav1.Duration{Duration: 45 * time.Second},
+			},
+			expectProbe:      true,
+			failureThreshold: 5,
+		},
+		{
+			description: "enabled with a short grace period",
+			readiness: operatorv1.DNSAPIServerReadiness{
+				Policy:      operatorv1.DNSAPIServerReadinessEnabled,
+				GracePeriod: &metav1.Duration{Duration: time.Second},
+			},
+			expectProbe:      true,
+			failureThreshold: 1,
+		},
+	}
+	for _, tc := range testCases {
+		dns := &operatorv1.DNS{
+			ObjectMeta: metav1.ObjectMeta{Name: DefaultDNSController},
+			Spec:       operatorv1.DNSSpec{APIServerReadiness: tc.readiness},
+		}
+		ds, err := desiredDNSDaemonSet(dns, "172.30.77.10", "cluster.local", "coredns", "cli", "kube-rbac-proxy", false, nil)
+		if err != nil {
+			t.Fatalf("%q: invalid dns daemonset: %v", tc.description, err)
+		}
+		for _, c := range ds.Spec.Template.Spec.Containers {
+			if c.Name != "dns-node-resolver" {
+				continue
+			}
+			switch {
+			case !tc.expectProbe && c.ReadinessProbe != nil:
+				t.Errorf("%q: expected no readiness probe, got %#v", tc.description, c.ReadinessProbe)
+			case tc.expectProbe && (c.ReadinessProbe == nil || c.ReadinessProbe.Exec == nil):
+				t.Errorf("%q: expected an exec readiness probe, got %#v", tc.description, c.ReadinessProbe)
+			case tc.expectProbe && c.ReadinessProbe.FailureThreshold != tc.failureThreshold:
+				t.Errorf("%q: expected failure threshold %d, got %d", tc.description, tc.failureThreshold, c.ReadinessProbe.FailureThreshold)
+			}
+		}
+	}
+}
+
+func TestDesiredDNSDaemonsetGOMAXPROCS(t *testing.T) {
+	testCases := []struct {
+		description string
+		performance operatorv1.DNSPerformance
+		expected    *corev1.EnvVar
+	}{
+		{
+			description: "default",
+		},
+		{
+			description: "single socket with gomaxprocs",
+			performance: operatorv1.DNSPerformance{GOMAXPROCS: 4},
+			expected:    &corev1.EnvVar{Name: "GOMAXPROCS", Value: "4"},
+		},
+		{
+			description: "socket per cpu with gomaxprocs",
+			performance: operatorv1.DNSPerformance{
+				ListenSockets: operatorv1.DNSListenSocketsPerCPU,
+				GOMAXPROCS:    2,
+			},
+			expected: &corev1.EnvVar{Name: "GOMAXPROCS", Value: "2"},
+		},
+		{
+			description: "socket per cpu without gomaxprocs",
+			performance: operatorv1.DNSPerformance{
+				ListenSockets: operatorv1.DNSListenSocketsPerCPU,
+			},
+			expected: &corev1.EnvVar{
+				Name: "GOMAXPROCS",
+				ValueFrom: &corev1.EnvVarSource{
+					ResourceFieldRef: &corev1.ResourceFieldSelector{
+						ContainerName: "dns",
+						Resource:      "limits.cpu",
+						Divisor:       resource.MustParse("1"),
+					},
+				},
+			},
+		},
+	}
+	for _, tc := range testCases {
+		dns := &operatorv1.DNS{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: DefaultDNSController,
+			},
+			Spec: operatorv1.DNSSpec{
+				Performance: tc.performance,
+			},
+		}
+		ds, err := desiredDNSDaemonSet(dns, "172.30.77.10", "cluster.local", "coredns", "cli", "kube-rbac-proxy", false, nil)
+		if err != nil {
+			t.Fatalf("%s: invalid dns daemonset: %v", tc.description, err)
+		}
+		var actual *corev1.EnvVar
+		for _, c := range ds.Spec.Template.Spec.Containers {
+			for i, e := range c.Env {
+				if e.Name == "GOMAXPROCS" {
+					if c.Name != "dns" {
+						t.Errorf("%s: unexpected GOMAXPROCS in container %q", tc.description, c.Name)
+					}
+					actual = &c.Env[i]
+				}
+			}
+		}
+		if !reflect.DeepEqual(actual, tc.expected) {
+			t.Errorf("%s: expected %+v, got %+v", tc.description, tc.expected, actual)
+		}
+	}
+}
+
+func TestDesiredDNSDaemonsetNSID(t *testing.T) {
+	for _, state := range []operatorv1.DNSNSIDState{"", operatorv1.DNSNSIDDisabled, operatorv1.DNSNSIDEnabled} {
+		dns := &operatorv1.DNS{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: DefaultDNSController,
+			},
+			Spec: operatorv1.DNSSpec{
+				NSID: state,
+			},
+		}
+		ds, err := desiredDNSDaemonSet(dns, "172.30.77.10", "cluster.local", "coredns", "cli", "kube-rbac-proxy", false, nil)
+		if err != nil {
+			t.Fatalf("%q: invalid dns daemonset: %v", state, err)
+		}
+		var actual *corev1.EnvVar
+		for _, c := range ds.Spec.Template.Spec.Containers {
+			for i, e := range c.Env {
+				if e.Name == nodeNameEnvVar {
+					if c.Name != "dns" {
+						t.Errorf("%q: unexpected %s in container %q", state, nodeNameEnvVar, c.Name)
+					}
+					actual = &c.Env[i]
+				}
+			}
+		}
+		// The pprof snippet refers to the node name as well, so the
+		// dns container has it whether or not the dns enables NSID.
+		env := dnsNodeNameEnv()
+		expected := &env
+		if !reflect.DeepEqual(actual, expected) {
+			t.Errorf("%q: expected %+v, got %+v", state, expected, actual)
+		}
+	}
+}
+
+func TestDesiredDNSDaemonsetTrustedCA(t *testing.T) {
+	dns := &operatorv1.DNS{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: DefaultDNSController,
+		},
+	}
+	for _, haveTrustedCA := range []bool{false, true} {
+		ds, err := desiredDNSDaemonSet(dns, "172.30.77.10", "cluster.local", "coredns", "cli", "kube-rbac-proxy", haveTrustedCA, nil)
+		if err != nil {
+			t.Fatalf("invalid dns daemonset: %v", err)
+		}
+		foundVolume := false
+		for _, v := range ds.Spec.Template.Spec.Volumes {
+			if v.Name == trustedCABundleVolumeName {
+				foundVolume = true
+				if e, a := "dns-default-trusted-ca", v.ConfigMap.Name; e != a {
+					t.Errorf("expected trusted ca volume to refer to configmap %q, got %q", e, a)
+				}
+			}
+		}
+		foundMount := false
+		for _, c := range ds.Spec.Template.Spec.Containers {
+			for _, m := range c.VolumeMounts {
+				if m.Name == trustedCABundleVolumeName {
+					if c.Name != "dns" {
+						t.Errorf("unexpected trusted ca volume mount in container %q", c.Name)
+					}
+					foundMount = true
+				}
+			}
+		}
+		if foundVolume != haveTrustedCA || foundMount != haveTrustedCA {
+			t.Errorf("expected trusted ca volume and mount to be present %t, got volume %t and mount %t", haveTrustedCA, foundVolume, foundMount)
+		}
+	}
+}
+
+func TestDesiredDNSDaemonsetDisabledCapabilities(t *testing.T) {
+	dns := &operatorv1.DNS{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: DefaultDNSController,
+		},
+	}
+	testCases := []struct {
+		description        string
+		disabled           []string
+		expectedContainers []string
+		expectedVolumes    []string
+	}{
+		{
+			description:        "no disabled capabilities",
+			expectedContainers: []string{"dns", "kube-rbac-proxy", "dns-node-resolver"},
+			expectedVolumes:    []string{"config-volume", "hosts-file", "metrics-tls", "config-parts", "pprof"},
+		},
+		{
+			description:        "node-resolver disabled",
+			disabled:           []string{string(NodeResolverCapability)},
+			expectedContainers: []string{"dns", "kube-rbac-proxy"},
+			expectedVolumes:    []string{"config-volume", "metrics-tls", "config-parts", "pprof"},
+		},
+		{
+			description:        "node-resolver and metrics disabled",
+			disabled:           []string{string(NodeResolverCapability), string(MetricsCapability)},
+			expectedContainers: []string{"dns"},
+			expectedVolumes:    []string{"config-volume", "config-parts", "pprof"},
+		},
+	}
+	for _, tc := range testCases {
+		ds, err := desiredDNSDaemonSet(dns, "172.30.77.10", "cluster.local", "coredns", "cli", "kube-rbac-proxy", false, tc.disabled)
+		if err != nil {
+			t.Errorf("%s: invalid dns daemonset: %v", tc.description, err)
+			continue
+		}
+		containers := []string{}
+		for _, c := range ds.Spec.Template.Spec.Containers {
+			containers = append(containers, c.Name)
+		}
+		volumes := []string{}
+		for _, v := range ds.Spec.Template.Spec.Volumes {
+			volumes = append(volumes, v.Name)
+		}
+		if !reflect.DeepEqual(containers, tc.expectedContainers) {
+			t.Errorf("%s: expected containers %v, got %v", tc.description, tc.expectedContainers, containers)
+		}
+		if !reflect.DeepEqual(volumes, tc.expectedVolumes) {
+			t.Errorf("%s: expected volumes %v, got %v", tc.description, tc.expectedVolumes, volumes)
+		}
+
+		// Disabling a capability must converge.
+		current, err := desiredDNSDaemonSet(dns, "172.30.77.10", "cluster.local", "coredns", "cli", "kube-rbac-proxy", false, nil)
+		if err != nil {
+			t.Fatalf("invalid dns daemonset: %v", err)
+		}
+		changed, updated := daemonsetConfigChanged(current, ds)
+		if e, a := len(tc.disabled) != 0, changed; e != a {
+			t.Errorf("%s: expected daemonsetConfigChanged to be %t, got %t", tc.description, e, a)
+		} else if changed {
+			if changedAgain, _ := daemonsetConfigChanged(updated, ds); changedAgain {
+				t.Errorf("%s: daemonsetConfigChanged does not behave as a fixed point function", tc.description)
+			}
+		}
+	}
+}
+
+func TestValidateDNSProbePorts(t *testing.T) {
+	testCases := []struct {
+		description string
+		ports       operatorv1.ProbePorts
+		expectError bool
+	}{
+		{
+			description: "default ports",
+		},
+		{
+			description: "custom ports",
+			ports:       operatorv1.ProbePorts{Health: 18080, Ready: 18181},
+		},
+		{
+			description: "health port equal to the default ready port",
+			ports:       operatorv1.ProbePorts{Health: 8181},
+			expectError: true,
+		},
+		{
+			description: "ready port conflicts with the dns port",
+			ports:       operatorv1.ProbePorts{Ready: 5353},
+			expectError: true,
+		},
+		{
+			description: "health port conflicts with the metrics port",
+			ports:       operatorv1.ProbePorts{Health: 9154},
+			expectError: true,
+		},
+	}
+	for _, tc := range testCases {
+		dns := &operatorv1.DNS{Spec: operatorv1.DNSSpec{ProbePorts: tc.ports}}
+		if err := validateDNSProbePorts(dns); (err != nil) != tc.expectError {
+			t.Errorf("%s: expected error %t, got %v", tc.description, tc.expectError, err)
+		}
+	}
+}
+
 var toleration = corev1.Toleration{
 	Key:      "foo",
 	Value:    "bar",
@@ -155,6 +585,48 @@ func TestDaemonsetConfigChanged(t *testing.T) {
 			},
 			expect: true,
 		},
+		{
+			description: "if a container env changed",
+			mutate: func(daemonset *appsv1.DaemonSet) {
+				daemonset.Spec.Template.Spec.Containers[1].Env = []corev1.EnvVar{{
+					Name:  "POLL_INTERVAL",
+					Value: "30",
+				}}
+			},
+			expect: true,
+		},
+		{
+			description: "if a container volume mount is added",
+			mutate: func(daemonset *appsv1.DaemonSet) {
+				daemonset.Spec.Template.Spec.Containers[0].VolumeMounts = []corev1.VolumeMount{{
+					Name:      trustedCABundleVolumeName,
+					MountPath: trustedCABundleMountPath,
+					ReadOnly:  true,
+				}}
+			},
+			expect: true,
+		},
+		{
+			description: "if a container port protocol is defaulted",
+			mutate: func(daemonset *appsv1.DaemonSet) {
+				daemonset.Spec.Template.Spec.Containers[2].Ports[0].Protocol = corev1.ProtocolTCP
+			},
+			expect: false,
+		},
+		{
+			description: "if a container port changed",
+			mutate: func(daemonset *appsv1.DaemonSet) {
+				daemonset.Spec.Template.Spec.Containers[0].Ports[0].ContainerPort = 18080
+			},
+			expect: true,
+		},
+		{
+			description: "if the readiness probe port changed",
+			mutate: func(daemonset *appsv1.DaemonSet) {
+				daemonset.Spec.Template.Spec.Containers[0].ReadinessProbe.HTTPGet.Port = intstr.FromInt(18181)
+			},
+			expect: true,
+		},
 		{
 			description: "if an unexpected additional container is added",
 			mutate: func(daemonset *appsv1.DaemonSet) {
@@ -198,6 +670,44 @@ func TestDaemonsetConfigChanged(t *testing.T) {
 			},
 			expect: true,
 		},
+		{
+			description: "if the process namespace becomes shared",
+			mutate: func(daemonset *appsv1.DaemonSet) {
+				share := true
+				daemonset.Spec.Template.Spec.ShareProcessNamespace = &share
+			},
+			expect: true,
+		},
+		{
+			description: "if an init container is added",
+			mutate: func(daemonset *appsv1.DaemonSet) {
+				daemonset.Spec.Template.Spec.InitContainers = []corev1.Container{{
+					Name:    upstreamResolvConfInitContainerName,
+					Image:   "openshift/origin-cli:v4.0",
+					Command: []string{"cp"},
+				}}
+			},
+			expect: true,
+		},
+		{
+			description: "if the termination grace period changes",
+			mutate: func(daemonset *appsv1.DaemonSet) {
+				gracePeriod := int64(60)
+				daemonset.Spec.Template.Spec.TerminationGracePeriodSeconds = &gracePeriod
+			},
+			expect: true,
+		},
+		{
+			description: "if a pre-stop hook is added",
+			mutate: func(daemonset *appsv1.DaemonSet) {
+				daemonset.Spec.Template.Spec.Containers[0].Lifecycle = &corev1.Lifecycle{
+					PreStop: &corev1.Handler{
+						Exec: &corev1.ExecAction{Command: []string{"sleep", "5"}},
+					},
+				}
+			},
+			expect: true,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -219,6 +729,21 @@ func TestDaemonsetConfigChanged(t *testing.T) {
 									"a",
 									"b",
 								},
+								Ports: []corev1.ContainerPort{
+									{
+										Name:          "health",
+										ContainerPort: 8080,
+										Protocol:      corev1.ProtocolTCP,
+									},
+								},
+								ReadinessProbe: &corev1.Probe{
+									Handler: corev1.Handler{
+										HTTPGet: &corev1.HTTPGetAction{
+											Path: "/ready",
+											Port: intstr.FromInt(8181),
+										},
+									},
+								},
 							},
 							{
 								Name:  "dns-node-resolver",
@@ -235,6 +760,12 @@ func TestDaemonsetConfigChanged(t *testing.T) {
 									"e",
 									"f",
 								},
+								Ports: []corev1.ContainerPort{
+									{
+										Name:          "metrics",
+										ContainerPort: 9154,
+									},
+								},
 							},
 						},
 						NodeSelector: map[string]string{
@@ -284,3 +815,147 @@ func TestDaemonsetConfigChanged(t *testing.T) {
 		}
 	}
 }
+
+func TestDNSRolloutDeferral(t *testing.T) {
+	percent := intstr.FromString("25%")
+	testCases := []struct {
+		description    string
+		generation     int64
+		observed       int64
+		desired        int32
+		updated        int32
+		available      int32
+		maxUnavailable *intstr.IntOrString
+		expectDeferred bool
+	}{
+		{
+			description: "all pods available",
+			generation:  1, observed: 1, desired: 6, updated: 6, available: 6,
+			expectDeferred: false,
+		},
+		{
+			description: "one pod unavailable",
+			generation:  1, observed: 1, desired: 6, updated: 6, available: 5,
+			expectDeferred: false,
+		},
+		{
+			description: "two pods unavailable",
+			generation:  1, observed: 1, desired: 6, updated: 6, available: 4,
+			expectDeferred: true,
+		},
+		{
+			description: "two pods unavailable with a percentage max unavailable",
+			generation:  1, observed: 1, desired: 8, updated: 8, available: 6,
+			maxUnavailable: &percent,
+			expectDeferred: false,
+		},
+		{
+			description: "pods unavailable during an unfinished rollout",
+			generation:  2, observed: 2, desired: 6, updated: 3, available: 2,
+			expectDeferred: false,
+		},
+		{
+			description: "pods unavailable before the rollout is observed",
+			generation:  2, observed: 1, desired: 6, updated: 6, available: 2,
+			expectDeferred: false,
+		},
+		{
+			description: "no pods desired",
+			generation:  1, observed: 1,
+			expectDeferred: false,
+		},
+	}
+	for _, tc := range testCases {
+		ds := &appsv1.DaemonSet{
+			ObjectMeta: metav1.ObjectMeta{Generation: tc.generation},
+			Status: appsv1.DaemonSetStatus{
+				ObservedGeneration:     tc.observed,
+				DesiredNumberScheduled: tc.desired,
+				UpdatedNumberScheduled: tc.updated,
+				NumberAvailable:        tc.available,
+			},
+		}
+		if tc.maxUnavailable != nil {
+			ds.Spec.UpdateStrategy.RollingUpdate = &appsv1.RollingUpdateDaemonSet{MaxUnavailable: tc.maxUnavailable}
+		}
+		if deferral := dnsRolloutDeferral(ds); (len(deferral) != 0) != tc.expectDeferred {
+			t.Errorf("%s: expected deferred to be %t, got %q", tc.description, tc.expectDeferred, deferral)
+		}
+	}
+}
+
+func TestDNSQuorumDeferral(t *testing.T) {
+	daemonset := func(generation, observed int64, desired, updated, available int32) appsv1.DaemonSet {
+		return appsv1.DaemonSet{
+			ObjectMeta: metav1.ObjectMeta{Generation: generation},
+			Status: appsv1.DaemonSetStatus{
+				ObservedGeneration:     observed,
+				DesiredNumberScheduled: desired,
+				UpdatedNumberScheduled: updated,
+				NumberAvailable:        available,
+			},
+		}
+	}
+	testCases := []struct {
+		description    string
+		daemonsets     []appsv1.DaemonSet
+		expectDeferred bool
+	}{
+		{
+			description:    "majority available",
+			daemonsets:     []appsv1.DaemonSet{daemonset(1, 1, 6, 6, 6), daemonset(1, 1, 4, 4, 0)},
+			expectDeferred: false,
+		},
+		{
+			description:    "half available across daemonsets",
+			daemonsets:     []appsv1.DaemonSet{daemonset(1, 1, 6, 6, 5), daemonset(1, 1, 4, 4, 0)},
+			expectDeferred: true,
+		},
+		{
+			description:    "majority unavailable",
+			daemonsets:     []appsv1.DaemonSet{daemonset(1, 1, 3, 3, 1)},
+			expectDeferred: true,
+		},
+		{
+			description:    "too few pods for a quorum",
+			daemonsets:     []appsv1.DaemonSet{daemonset(1, 1, 2, 2, 0)},
+			expectDeferred: false,
+		},
+		{
+			description:    "majority unavailable during an unfinished rollout",
+			daemonsets:     []appsv1.DaemonSet{daemonset(2, 2, 6, 3, 2)},
+			expectDeferred: false,
+		},
+		{
+			description:    "majority unavailable before the rollout is observed",
+			daemonsets:     []appsv1.DaemonSet{daemonset(2, 1, 6, 6, 2)},
+			expectDeferred: false,
+		},
+	}
+	for _, tc := range testCases {
+		if deferral := dnsQuorumDeferral(&tc.daemonsets[0], tc.daemonsets); (len(deferral) != 0) != tc.expectDeferred {
+			t.Errorf("%s: expected deferred to be %t, got %q", tc.description, tc.expectDeferred, deferral)
+		}
+	}
+}
+
+func TestDaemonsetRolloutRequired(t *testing.T) {
+	current := &appsv1.DaemonSet{
+		Spec: appsv1.DaemonSetSpec{
+			Template: corev1.PodTemplateSpec{
+				Spec: corev1.PodSpec{
+					Containers: []corev1.Container{{Name: "dns", Image: "coredns:1"}},
+				},
+			},
+		},
+	}
+	updated := current.DeepCopy()
+	updated.Labels = map[string]string{"foo": "bar"}
+	if daemonsetRolloutRequired(current, updated) {
+		t.Errorf("expected no rollout for a label change")
+	}
+	updated.Spec.Template.Spec.Containers[0].Image = "coredns:2"
+	if !daemonsetRolloutRequired(current, updated) {
+		t.Errorf("expected a rollout for an image change")
+	}
+}
@@ -0,0 +1,123 @@
+package controller
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	operatorv1 "github.com/openshift/api/operator/v1"
+	"github.com/openshift/cluster-dns-operator/pkg/manifests"
+
+	appsv1 "k8s.io/api/apps/v1"
+	policyv1beta1 "k8s.io/api/policy/v1beta1"
+
+	"github.com/sirupsen/logrus"
+
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+)
+
+const (
+	// dnsPDBMaxUnavailablePercent is the percentage of the dns pods that
+	// evictions may make unavailable at once.
+	dnsPDBMaxUnavailablePercent = 10
+)
+
+// dnsPDBMinAvailable returns the number of dns pods that must stay available
+// during evictions, given the number of dns pods that the daemonsets of the
+// dns schedule.  At least one pod may always be evicted, so that a budget
+// never blocks the drain of a node in a small cluster.
+func dnsPDBMinAvailable(desired int32) int32 {
+	maxUnavailable := desired * dnsPDBMaxUnavailablePercent / 100
+	if maxUnavailable < 1 {
+		maxUnavailable = 1
+	}
+	if desired <= maxUnavailable {
+		return 0
+	}
+	return desired - maxUnavailable
+}
+
+// ensureDNSPodDisruptionBudget ensures that a pod disruption budget exists
+// for the pods of the given dns, with the number of pods that must stay
+// available computed from the number of pods that the given daemonsets of the
+// dns schedule.  The budget sets minAvailable rather than maxUnavailable
+// because the disruption controller can only compute a maxUnavailable or a
+// percentage for pods of controllers with a scale subresource, which
+// daemonsets lack; the operator recomputes it as nodes come and go instead.
+func (r *reconciler) ensureDNSPodDisruptionBudget(dns *operatorv1.DNS, daemonsets []appsv1.DaemonSet) error {
+	var desiredPods int32
+	for i := range daemonsets {
+		desiredPods += daemonsets[i].Status.DesiredNumberScheduled
+	}
+	haveBudget, current, err := r.currentDNSPodDisruptionBudget(dns)
+	if err != nil {
+		return err
+	}
+	desired := desiredDNSPodDisruptionBudget(dns, desiredPods)
+	if !haveBudget {
+		if err := r.client.Create(context.TODO(), desired); err != nil {
+			return fmt.Errorf("failed to create dns pod disruption budget: %v", err)
+		}
+		logrus.Infof("created dns pod disruption budget: %s/%s", desired.Namespace, desired.Name)
+		return nil
+	}
+	changed, updated := podDisruptionBudgetChanged(current, desired)
+	if !changed {
+		return nil
+	}
+	if err := r.client.Update(context.TODO(), updated); err != nil {
+		return fmt.Errorf("failed to update dns pod disruption budget %s/%s: %v", updated.Namespace, updated.Name, err)
+	}
+	logrus.Infof("updated dns pod disruption budget %s/%s: minAvailable %s", updated.Namespace, updated.Name, updated.Spec.MinAvailable.String())
+	return nil
+}
+
+func (r *reconciler) currentDNSPodDisruptionBudget(dns *operatorv1.DNS) (bool, *policyv1beta1.PodDisruptionBudget, error) {
+	current := &policyv1beta1.PodDisruptionBudget{}
+	if err := r.client.Get(context.TODO(), DNSPodDisruptionBudgetName(dns), current); err != nil {
+		if errors.IsNotFound(err) {
+			return false, nil, nil
+		}
+		return false, nil, err
+	}
+	return true, current, nil
+}
+
+// desiredDNSPodDisruptionBudget returns the desired pod disruption budget for
+// the pods of the given dns, which the daemonsets of the dns schedule the given
+// number of.  The budget selects the pods of node overrides as well, because
+// they serve the same dns service.  It is owned by the dns so that it is
+// deleted with the dns.
+func desiredDNSPodDisruptionBudget(dns *operatorv1.DNS, desiredPods int32) *policyv1beta1.PodDisruptionBudget {
+	name := DNSPodDisruptionBudgetName(dns)
+	minAvailable := intstr.FromInt(int(dnsPDBMinAvailable(desiredPods)))
+	return &policyv1beta1.PodDisruptionBudget{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name.Name,
+			Namespace: name.Namespace,
+			Labels: map[string]string{
+				manifests.OwningDNSLabel: DNSDaemonSetLabel(dns),
+			},
+			OwnerReferences: []metav1.OwnerReference{dnsOwnerRef(dns)},
+		},
+		Spec: policyv1beta1.PodDisruptionBudgetSpec{
+			MinAvailable: &minAvailable,
+			Selector:     DNSDaemonSetPodSelector(dns),
+		},
+	}
+}
+
+// podDisruptionBudgetChanged returns a Boolean indicating whether the current
+// pod disruption budget matches the expected one, and the updated budget if
+// it does not.
+func podDisruptionBudgetChanged(current, expected *policyv1beta1.PodDisruptionBudget) (bool, *policyv1beta1.PodDisruptionBudget) {
+	if cmp.Equal(current.Spec, expected.Spec, cmpopts.EquateEmpty()) {
+		return false, nil
+	}
+	updated := current.DeepCopy()
+	updated.Spec = expected.Spec
+	return true, updated
+}
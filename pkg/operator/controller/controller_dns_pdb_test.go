@@ -0,0 +1,61 @@
+package controller
+
+import (
+	"testing"
+
+	operatorv1 "github.com/openshift/api/operator/v1"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+)
+
+func TestDNSPDBMinAvailable(t *testing.T) {
+	testCases := []struct {
+		desired  int32
+		expected int32
+	}{
+		{desired: 0, expected: 0},
+		{desired: 1, expected: 0},
+		{desired: 2, expected: 1},
+		{desired: 9, expected: 8},
+		{desired: 20, expected: 18},
+		{desired: 105, expected: 95},
+	}
+	for _, tc := range testCases {
+		if actual := dnsPDBMinAvailable(tc.desired); actual != tc.expected {
+			t.Errorf("expected minAvailable %d for %d pods, got %d", tc.expected, tc.desired, actual)
+		}
+	}
+}
+
+func TestDesiredDNSPodDisruptionBudget(t *testing.T) {
+	dns := &operatorv1.DNS{ObjectMeta: metav1.ObjectMeta{Name: DefaultDNSController, UID: "1"}}
+	pdb := desiredDNSPodDisruptionBudget(dns, 20)
+	if expected := intstr.FromInt(18); pdb.Spec.MinAvailable == nil || *pdb.Spec.MinAvailable != expected {
+		t.Errorf("expected minAvailable %v, got %v", expected, pdb.Spec.MinAvailable)
+	}
+	if expected := DNSDaemonSetPodSelector(dns).MatchLabels; pdb.Spec.Selector == nil || len(pdb.Spec.Selector.MatchLabels) != len(expected) {
+		t.Errorf("expected selector %v, got %v", expected, pdb.Spec.Selector)
+	}
+	if ref := metav1.GetControllerOf(pdb); ref == nil || ref.UID != dns.UID {
+		t.Errorf("expected the pod disruption budget to be controlled by the dns, got %v", pdb.OwnerReferences)
+	}
+
+	// The budget is updated as the number of pods changes, and not
+	// otherwise.
+	current := desiredDNSPodDisruptionBudget(dns, 20)
+	current.ResourceVersion = "1"
+	if changed, _ := podDisruptionBudgetChanged(current, pdb); changed {
+		t.Errorf("expected no change for the same number of pods")
+	}
+	changed, updated := podDisruptionBudgetChanged(current, desiredDNSPodDisruptionBudget(dns, 30))
+	if !changed {
+		t.Fatalf("expected a change for a different number of pods")
+	}
+	if expected := intstr.FromInt(27); *updated.Spec.MinAvailable != expected {
+		t.Errorf("expected updated minAvailable %v, got %v", expected, updated.Spec.MinAvailable)
+	}
+	if updated.ResourceVersion != current.ResourceVersion {
+		t.Errorf("expected the update to keep the resource version, got %q", updated.ResourceVersion)
+	}
+}
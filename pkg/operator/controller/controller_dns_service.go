@@ -72,12 +72,40 @@ func desiredDNSService(dns *operatorv1.DNS, clusterIP string, daemonsetRef metav
 
 	s.Spec.Selector = DNSDaemonSetPodSelector(dns).MatchLabels
 
+	setServiceSessionAffinity(s, dns)
+
 	if len(clusterIP) > 0 {
 		s.Spec.ClusterIP = clusterIP
 	}
 	return s
 }
 
+// defaultSessionAffinityTimeoutSeconds is the timeout of ClientIP session
+// affinity that the API server defaults to.
+const defaultSessionAffinityTimeoutSeconds = int32(10800)
+
+// setServiceSessionAffinity sets the session affinity of the given dns service
+// from the given dns.  The timeout of ClientIP session affinity is always set
+// because the API server would otherwise default it, and the service would
+// differ from the desired one.  An invalid timeout is ignored.
+func setServiceSessionAffinity(s *corev1.Service, dns *operatorv1.DNS) {
+	affinity := dns.Spec.ServiceSessionAffinity
+	if affinity.Policy != operatorv1.DNSServiceSessionAffinityClientIP {
+		return
+	}
+	timeout := affinity.TimeoutSeconds
+	if timeout < 1 || timeout > 86400 {
+		if timeout != 0 {
+			logrus.Warningf("ignoring session affinity timeout %d of dns %s: must be between 1 and 86400", timeout, dns.Name)
+		}
+		timeout = defaultSessionAffinityTimeoutSeconds
+	}
+	s.Spec.SessionAffinity = corev1.ServiceAffinityClientIP
+	s.Spec.SessionAffinityConfig = &corev1.SessionAffinityConfig{
+		ClientIP: &corev1.ClientIPConfig{TimeoutSeconds: &timeout},
+	}
+}
+
 func (r *reconciler) updateDNSService(current, desired *corev1.Service) (bool, error) {
 	changed, updated := serviceChanged(current, desired)
 	if !changed {
@@ -3,6 +3,8 @@ package controller
 import (
 	"testing"
 
+	operatorv1 "github.com/openshift/api/operator/v1"
+
 	corev1 "k8s.io/api/core/v1"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -123,3 +125,111 @@ func TestDNSServiceChanged(t *testing.T) {
 		}
 	}
 }
+
+// TestDesiredDNSServicePorts verifies that the dns service exposes port 53 over
+// both UDP and TCP, so that clients can retry truncated responses over TCP,
+// and that each port targets a container port of the dns pods with the same
+// protocol whatever listeners the dns configures.
+func TestDesiredDNSServicePorts(t *testing.T) {
+	dnses := []*operatorv1.DNS{
+		{ObjectMeta: metav1.ObjectMeta{Name: DefaultDNSController}},
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: DefaultDNSController},
+			Spec: operatorv1.DNSSpec{
+				AdditionalNetworks: []operatorv1.DNSAdditionalNetwork{{Name: "storage"}},
+				DebugZone:          operatorv1.DNSDebugZoneEnabled,
+				NodeOverrides:      []operatorv1.DNSNodeOverride{{Name: "edge", NodeSelector: map[string]string{"pool": "edge"}}},
+			},
+		},
+	}
+	for _, dns := range dnses {
+		ds, err := desiredDNSDaemonSet(dns, "172.30.0.10", "cluster.local", "coredns", "cli", "proxy", false, nil)
+		if err != nil {
+			t.Fatalf("invalid dns daemonset: %v", err)
+		}
+		ports := map[string]corev1.ContainerPort{}
+		for _, c := range ds.Spec.Template.Spec.Containers {
+			for _, port := range c.Ports {
+				ports[port.Name] = port
+			}
+		}
+		protocols := map[corev1.Protocol]bool{}
+		service := desiredDNSService(dns, "172.30.0.10", metav1.OwnerReference{})
+		for _, servicePort := range service.Spec.Ports {
+			containerPort, ok := ports[servicePort.TargetPort.StrVal]
+			if !ok {
+				t.Errorf("service port %s targets unknown container port %q", servicePort.Name, servicePort.TargetPort.StrVal)
+				continue
+			}
+			// The API defaults the protocol of a container port to TCP.
+			if len(containerPort.Protocol) == 0 {
+				containerPort.Protocol = corev1.ProtocolTCP
+			}
+			if containerPort.Protocol != servicePort.Protocol {
+				t.Errorf("service port %s has protocol %s, but container port %s has protocol %s", servicePort.Name, servicePort.Protocol, containerPort.Name, containerPort.Protocol)
+			}
+			if servicePort.Port == 53 {
+				protocols[servicePort.Protocol] = true
+				if containerPort.ContainerPort != dnsPort {
+					t.Errorf("service port %s targets port %d, expected %d", servicePort.Name, containerPort.ContainerPort, dnsPort)
+				}
+			}
+		}
+		if !protocols[corev1.ProtocolUDP] || !protocols[corev1.ProtocolTCP] {
+			t.Errorf("expected the service to expose port 53 over UDP and TCP, got %v", protocols)
+		}
+	}
+}
+
+func TestDesiredDNSServiceSessionAffinity(t *testing.T) {
+	testCases := []struct {
+		description     string
+		affinity        operatorv1.DNSServiceSessionAffinity
+		expectAffinity  corev1.ServiceAffinity
+		expectedTimeout int32
+	}{
+		{
+			description: "default",
+		},
+		{
+			description: "none",
+			affinity:    operatorv1.DNSServiceSessionAffinity{Policy: operatorv1.DNSServiceSessionAffinityNone, TimeoutSeconds: 60},
+		},
+		{
+			description:     "client IP with the default timeout",
+			affinity:        operatorv1.DNSServiceSessionAffinity{Policy: operatorv1.DNSServiceSessionAffinityClientIP},
+			expectAffinity:  corev1.ServiceAffinityClientIP,
+			expectedTimeout: 10800,
+		},
+		{
+			description:     "client IP with a timeout",
+			affinity:        operatorv1.DNSServiceSessionAffinity{Policy: operatorv1.DNSServiceSessionAffinityClientIP, TimeoutSeconds: 60},
+			expectAffinity:  corev1.ServiceAffinityClientIP,
+			expectedTimeout: 60,
+		},
+		{
+			description:     "client IP with an invalid timeout",
+			affinity:        operatorv1.DNSServiceSessionAffinity{Policy: operatorv1.DNSServiceSessionAffinityClientIP, TimeoutSeconds: 100000},
+			expectAffinity:  corev1.ServiceAffinityClientIP,
+			expectedTimeout: 10800,
+		},
+	}
+	for _, tc := range testCases {
+		dns := &operatorv1.DNS{
+			ObjectMeta: metav1.ObjectMeta{Name: DefaultDNSController},
+			Spec:       operatorv1.DNSSpec{ServiceSessionAffinity: tc.affinity},
+		}
+		for _, svc := range []*corev1.Service{desiredDNSService(dns, "172.30.0.10", metav1.OwnerReference{}), desiredKubeDNSService(dns)} {
+			if svc.Spec.SessionAffinity != tc.expectAffinity {
+				t.Errorf("%s: expected service %s to have session affinity %q, got %q", tc.description, svc.Name, tc.expectAffinity, svc.Spec.SessionAffinity)
+			}
+			timeout := int32(0)
+			if c := svc.Spec.SessionAffinityConfig; c != nil && c.ClientIP != nil && c.ClientIP.TimeoutSeconds != nil {
+				timeout = *c.ClientIP.TimeoutSeconds
+			}
+			if timeout != tc.expectedTimeout {
+				t.Errorf("%s: expected service %s to have timeout %d, got %d", tc.description, svc.Name, tc.expectedTimeout, timeout)
+			}
+		}
+	}
+}
@@ -0,0 +1,139 @@
+package controller
+
+import (
+	"context"
+	"fmt"
+
+	operatorv1 "github.com/openshift/api/operator/v1"
+	"github.com/openshift/cluster-dns-operator/pkg/manifests"
+
+	corev1 "k8s.io/api/core/v1"
+
+	"github.com/sirupsen/logrus"
+
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	// kubeDNSAppLabel is the label by which upstream tooling finds the
+	// cluster DNS service, and kubeDNSAppLabelValue is its value.
+	kubeDNSAppLabel      = "k8s-app"
+	kubeDNSAppLabelValue = "kube-dns"
+)
+
+// kubeDNSAliasEnabled returns a Boolean indicating whether the kube-dns alias
+// service is enabled for the given dns.
+func kubeDNSAliasEnabled(dns *operatorv1.DNS) bool {
+	return dns.Spec.KubeDNSAlias == operatorv1.KubeDNSAliasEnabled
+}
+
+// ensureKubeDNSService ensures that the kube-dns alias service exists for the
+// given dns if the alias is enabled and that it does not exist otherwise.
+func (r *reconciler) ensureKubeDNSService(dns *operatorv1.DNS) (bool, *corev1.Service, error) {
+	haveService, current, err := r.currentKubeDNSService(dns)
+	if err != nil {
+		return false, nil, err
+	}
+	if !kubeDNSAliasEnabled(dns) {
+		if haveService {
+			if err := r.deleteKubeDNSService(dns, current); err != nil {
+				return true, current, err
+			}
+		}
+		return false, nil, nil
+	}
+	desired := desiredKubeDNSService(dns)
+
+	switch {
+	case !haveService:
+		if err := r.client.Create(context.TODO(), desired); err != nil {
+			return false, nil, fmt.Errorf("failed to create kube-dns service: %v", err)
+		}
+		logrus.Infof("created kube-dns service: %s/%s", desired.Namespace, desired.Name)
+		return r.currentKubeDNSService(dns)
+	case haveService:
+		if changed, updated := kubeDNSServiceChanged(current, desired); changed {
+			if err := r.client.Update(context.TODO(), updated); err != nil {
+				return true, current, fmt.Errorf("failed to update kube-dns service %s/%s: %v", updated.Namespace, updated.Name, err)
+			}
+			logrus.Infof("updated kube-dns service: %s/%s", updated.Namespace, updated.Name)
+			return r.currentKubeDNSService(dns)
+		}
+	}
+	return true, current, nil
+}
+
+// deleteKubeDNSService deletes the given kube-dns service if it is owned by
+// the given dns.  A kube-dns service that was created by something else is
+// left alone.
+func (r *reconciler) deleteKubeDNSService(dns *operatorv1.DNS, current *corev1.Service) error {
+	if current.Labels[manifests.OwningDNSLabel] != DNSDaemonSetLabel(dns) {
+		return nil
+	}
+	if err := r.client.Delete(context.TODO(), current); err != nil {
+		if errors.IsNotFound(err) {
+			return nil
+		}
+		return fmt.Errorf("failed to delete kube-dns service %s/%s: %v", current.Namespace, current.Name, err)
+	}
+	logrus.Infof("deleted kube-dns service: %s/%s", current.Namespace, current.Name)
+	return nil
+}
+
+func (r *reconciler) currentKubeDNSService(dns *operatorv1.DNS) (bool, *corev1.Service, error) {
+	current := &corev1.Service{}
+	if err := r.client.Get(context.TODO(), KubeDNSServiceName(dns), current); err != nil {
+		if errors.IsNotFound(err) {
+			return false, nil, nil
+		}
+		return false, nil, err
+	}
+	return true, current, nil
+}
+
+// desiredKubeDNSService returns the desired kube-dns alias service.  It selects
+// the same pods as the dns service and exposes the same DNS ports, but not the
+// metrics port, which is served with a certificate for the dns service only.
+func desiredKubeDNSService(dns *operatorv1.DNS) *corev1.Service {
+	s := manifests.DNSService()
+
+	name := KubeDNSServiceName(dns)
+	s.Namespace = name.Namespace
+	s.Name = name.Name
+	s.SetOwnerReferences([]metav1.OwnerReference{dnsOwnerRef(dns)})
+
+	s.Labels = map[string]string{
+		manifests.OwningDNSLabel: DNSDaemonSetLabel(dns),
+		kubeDNSAppLabel:          kubeDNSAppLabelValue,
+	}
+
+	ports := []corev1.ServicePort{}
+	for _, p := range s.Spec.Ports {
+		if p.Name != "metrics" {
+			ports = append(ports, p)
+		}
+	}
+	s.Spec.Ports = ports
+
+	s.Spec.Selector = DNSDaemonSetPodSelector(dns).MatchLabels
+	setServiceSessionAffinity(s, dns)
+	return s
+}
+
+// kubeDNSServiceChanged checks whether the current kube-dns service matches
+// the expected service and if not returns an updated one.
+func kubeDNSServiceChanged(current, expected *corev1.Service) (bool, *corev1.Service) {
+	changed, updated := serviceChanged(current, expected)
+	if current.Labels[kubeDNSAppLabel] != kubeDNSAppLabelValue {
+		if !changed {
+			updated = current.DeepCopy()
+		}
+		if updated.Labels == nil {
+			updated.Labels = map[string]string{}
+		}
+		updated.Labels[kubeDNSAppLabel] = kubeDNSAppLabelValue
+		changed = true
+	}
+	return changed, updated
+}
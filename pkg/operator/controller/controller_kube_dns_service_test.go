@@ -0,0 +1,106 @@
+package controller
+
+import (
+	"testing"
+
+	operatorv1 "github.com/openshift/api/operator/v1"
+	"github.com/openshift/cluster-dns-operator/pkg/manifests"
+
+	corev1 "k8s.io/api/core/v1"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestDesiredKubeDNSService(t *testing.T) {
+	dns := &operatorv1.DNS{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: DefaultDNSController,
+		},
+	}
+	svc := desiredKubeDNSService(dns)
+	if e, a := "kube-dns", svc.Name; e != a {
+		t.Errorf("expected name %q, got %q", e, a)
+	}
+	if e, a := "kube-dns", svc.Labels["k8s-app"]; e != a {
+		t.Errorf("expected k8s-app label %q, got %q", e, a)
+	}
+	if e, a := "default", svc.Labels[manifests.OwningDNSLabel]; e != a {
+		t.Errorf("expected owning dns label %q, got %q", e, a)
+	}
+	if e, a := "default", svc.Spec.Selector[controllerDaemonSetLabel]; e != a {
+		t.Errorf("expected selector %s=%q, got %q", controllerDaemonSetLabel, e, a)
+	}
+	if len(svc.Spec.ClusterIP) != 0 {
+		t.Errorf("expected no cluster IP, got %q", svc.Spec.ClusterIP)
+	}
+	if len(svc.Annotations) != 0 {
+		t.Errorf("expected no annotations, got %v", svc.Annotations)
+	}
+	ports := map[string]bool{}
+	for _, p := range svc.Spec.Ports {
+		ports[p.Name] = true
+	}
+	if !ports["dns"] || !ports["dns-tcp"] || ports["metrics"] {
+		t.Errorf("expected ports dns and dns-tcp only, got %v", svc.Spec.Ports)
+	}
+}
+
+func TestKubeDNSServiceChanged(t *testing.T) {
+	testCases := []struct {
+		description string
+		mutate      func(*corev1.Service)
+		expect      bool
+	}{
+		{
+			description: "if nothing changes",
+			mutate:      func(_ *corev1.Service) {},
+			expect:      false,
+		},
+		{
+			description: "if .spec.clusterIP changes",
+			mutate: func(service *corev1.Service) {
+				service.Spec.ClusterIP = "1.2.3.4"
+			},
+			expect: false,
+		},
+		{
+			description: "if .spec.selector changes",
+			mutate: func(service *corev1.Service) {
+				service.Spec.Selector = map[string]string{"foo": "bar"}
+			},
+			expect: true,
+		},
+		{
+			description: "if the k8s-app label is removed",
+			mutate: func(service *corev1.Service) {
+				delete(service.Labels, "k8s-app")
+			},
+			expect: true,
+		},
+		{
+			description: "if another label is added",
+			mutate: func(service *corev1.Service) {
+				service.Labels["foo"] = "bar"
+			},
+			expect: false,
+		},
+	}
+
+	dns := &operatorv1.DNS{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: DefaultDNSController,
+		},
+	}
+	for _, tc := range testCases {
+		expected := desiredKubeDNSService(dns)
+		current := expected.DeepCopy()
+		tc.mutate(current)
+		if changed, updated := kubeDNSServiceChanged(current, expected); changed != tc.expect {
+			t.Errorf("%s, expect kubeDNSServiceChanged to be %t, got %t", tc.description, tc.expect, changed)
+		} else if changed {
+			if changedAgain, _ := kubeDNSServiceChanged(updated, expected); changedAgain {
+				t.Errorf("%s, kubeDNSServiceChanged does not behave as a fixed point function", tc.description)
+			}
+		}
+	}
+}
@@ -0,0 +1,183 @@
+package controller
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+
+	operatorv1 "github.com/openshift/api/operator/v1"
+	"github.com/openshift/cluster-dns-operator/pkg/manifests"
+
+	"github.com/sirupsen/logrus"
+
+	corev1 "k8s.io/api/core/v1"
+
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	// serviceMeshNameserverKey is the key of the service mesh configmap
+	// that holds the cluster IP of the dns service.
+	serviceMeshNameserverKey = "nameserver"
+
+	// serviceMeshClusterDomainKey is the key of the service mesh configmap
+	// that holds the cluster domain.
+	serviceMeshClusterDomainKey = "clusterDomain"
+
+	// serviceMeshDomainsKey is the key of the service mesh configmap that
+	// holds the domains that the dns serves, one per line.  A mesh DNS
+	// proxy should forward queries for these domains to the nameserver
+	// rather than answer them itself.
+	serviceMeshDomainsKey = "domains"
+)
+
+// serviceMeshCoexistenceEnabled returns a Boolean indicating whether the given
+// dns is configured to coexist with a service mesh that intercepts DNS queries.
+func serviceMeshCoexistenceEnabled(dns *operatorv1.DNS) bool {
+	return dns.Spec.ServiceMeshCoexistence == operatorv1.ServiceMeshCoexistenceEnabled
+}
+
+// corefileServedZones returns the sorted zones other than the root zone for
+// which the Corefile of the given dns has server blocks, which the kubernetes
+// plugin serves, or whose names the Corefile answers with NXDOMAIN.
+func corefileServedZones(dns *operatorv1.DNS, clusterDomain string, extensions []extensionServer) []string {
+	set := map[string]struct{}{
+		"in-addr.arpa": {},
+		"ip6.arpa":     {},
+	}
+	for _, domain := range corefileClusterDomains(dns, clusterDomain) {
+		set[domain] = struct{}{}
+	}
+	peers := corefileClusterPeers(dns, clusterDomain)
+	servers := append(corefileSpecServers(dns, clusterDomain), corefileExtensionServers(dns, clusterDomain, peers, extensions)...)
+	for _, server := range servers {
+		for _, zone := range server.Zones {
+			set[strings.ToLower(strings.TrimSuffix(zone, "."))] = struct{}{}
+		}
+	}
+	for _, peer := range peers {
+		set[peer.ClusterDomain] = struct{}{}
+	}
+	for _, internal := range corefileInternalZones(dns, clusterDomain, servers, peers) {
+		if internal.Zone != "." {
+			set[internal.Zone] = struct{}{}
+		}
+	}
+	zones := []string{}
+	for zone := range set {
+		zones = append(zones, zone)
+	}
+	sort.Strings(zones)
+	return zones
+}
+
+// ensureServiceMeshConfigMap ensures that the service mesh configmap exists
+// for the given dns if service mesh coexistence is enabled and that it does not
+// exist otherwise.
+func (r *reconciler) ensureServiceMeshConfigMap(dns *operatorv1.DNS, clusterIP, clusterDomain string, zones []string) (bool, *corev1.ConfigMap, error) {
+	haveCM, current, err := r.currentServiceMeshConfigMap(dns)
+	if err != nil {
+		return false, nil, fmt.Errorf("failed to get service mesh configmap: %v", err)
+	}
+	if !serviceMeshCoexistenceEnabled(dns) {
+		if haveCM {
+			if err := r.deleteServiceMeshConfigMap(dns, current); err != nil {
+				return true, current, err
+			}
+		}
+		return false, nil, nil
+	}
+	desired := desiredServiceMeshConfigMap(dns, clusterIP, clusterDomain, zones)
+
+	switch {
+	case !haveCM:
+		if err := r.client.Create(context.TODO(), desired); err != nil {
+			return false, nil, fmt.Errorf("failed to create service mesh configmap: %v", err)
+		}
+		logrus.Infof("created service mesh configmap: %s/%s", desired.Namespace, desired.Name)
+		return r.currentServiceMeshConfigMap(dns)
+	case haveCM:
+		if changed, updated := serviceMeshConfigMapChanged(current, desired); changed {
+			if err := r.client.Update(context.TODO(), updated); err != nil {
+				return true, current, fmt.Errorf("failed to update service mesh configmap: %v", err)
+			}
+			logrus.Infof("updated service mesh configmap: %s/%s", updated.Namespace, updated.Name)
+			return r.currentServiceMeshConfigMap(dns)
+		}
+	}
+	return true, current, nil
+}
+
+// deleteServiceMeshConfigMap deletes the given service mesh configmap if it is
+// owned by the given dns.
+func (r *reconciler) deleteServiceMeshConfigMap(dns *operatorv1.DNS, current *corev1.ConfigMap) error {
+	if current.Labels[manifests.OwningDNSLabel] != DNSDaemonSetLabel(dns) {
+		return nil
+	}
+	if err := r.client.Delete(context.TODO(), current); err != nil {
+		if errors.IsNotFound(err) {
+			return nil
+		}
+		return fmt.Errorf("failed to delete service mesh configmap %s/%s: %v", current.Namespace, current.Name, err)
+	}
+	logrus.Infof("deleted service mesh configmap: %s/%s", current.Namespace, current.Name)
+	return nil
+}
+
+func (r *reconciler) currentServiceMeshConfigMap(dns *operatorv1.DNS) (bool, *corev1.ConfigMap, error) {
+	current := &corev1.ConfigMap{}
+	if err := r.client.Get(context.TODO(), DNSServiceMeshConfigMapName(dns), current); err != nil {
+		if errors.IsNotFound(err) {
+			return false, nil, nil
+		}
+		return false, nil, err
+	}
+	return true, current, nil
+}
+
+// desiredServiceMeshConfigMap returns the desired service mesh configmap,
+// which publishes the address of the dns and the domains that it serves.
+func desiredServiceMeshConfigMap(dns *operatorv1.DNS, clusterIP, clusterDomain string, zones []string) *corev1.ConfigMap {
+	name := DNSServiceMeshConfigMapName(dns)
+	cm := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name.Name,
+			Namespace: name.Namespace,
+			Labels: map[string]string{
+				manifests.OwningDNSLabel: DNSDaemonSetLabel(dns),
+			},
+		},
+		Data: map[string]string{
+			serviceMeshNameserverKey:    clusterIP,
+			serviceMeshClusterDomainKey: clusterDomain,
+			serviceMeshDomainsKey:       strings.Join(zones, "\n"),
+		},
+	}
+	cm.SetOwnerReferences([]metav1.OwnerReference{dnsOwnerRef(dns)})
+	return cm
+}
+
+// serviceMeshConfigMapChanged checks whether the current service mesh
+// configmap has the expected data and labels and if not returns an updated
+// configmap.
+func serviceMeshConfigMapChanged(current, expected *corev1.ConfigMap) (bool, *corev1.ConfigMap) {
+	changed := false
+	updated := current.DeepCopy()
+	if !reflect.DeepEqual(current.Data, expected.Data) {
+		updated.Data = expected.Data
+		changed = true
+	}
+	for k, v := range expected.Labels {
+		if current.Labels[k] != v {
+			if updated.Labels == nil {
+				updated.Labels = map[string]string{}
+			}
+			updated.Labels[k] = v
+			changed = true
+		}
+	}
+	return changed, updated
+}
@@ -0,0 +1,117 @@
+package controller
+
+import (
+	"reflect"
+	"testing"
+
+	operatorv1 "github.com/openshift/api/operator/v1"
+	"github.com/openshift/cluster-dns-operator/pkg/manifests"
+
+	corev1 "k8s.io/api/core/v1"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestCorefileServedZones(t *testing.T) {
+	dns := &operatorv1.DNS{
+		Spec: operatorv1.DNSSpec{
+			Servers: []operatorv1.Server{
+				{Name: "foo", Zones: []string{"Foo.com.", "bar.com"}},
+			},
+			ClusterPeers: []operatorv1.DNSClusterPeer{
+				{Name: "east", ClusterDomain: "east.local", Nameservers: []string{"10.0.0.10"}},
+				{Name: "self", ClusterDomain: "cluster.local", Nameservers: []string{"10.0.0.11"}},
+			},
+		},
+	}
+	extensions := []extensionServer{
+		{source: "lighthouse", server: operatorv1.Server{Name: "lighthouse", Zones: []string{"clusterset.local"}}},
+		{source: "conflict", server: operatorv1.Server{Name: "conflict", Zones: []string{"foo.com", "other.com"}}},
+	}
+	expected := []string{"bar.com", "cluster.local", "clusterset.local", "east.local", "foo.com", "in-addr.arpa", "ip6.arpa"}
+	if actual := corefileServedZones(dns, "cluster.local", extensions); !reflect.DeepEqual(actual, expected) {
+		t.Errorf("expected zones %v, got %v", expected, actual)
+	}
+}
+
+func TestDesiredServiceMeshConfigMap(t *testing.T) {
+	dns := &operatorv1.DNS{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: DefaultDNSController,
+		},
+	}
+	cm := desiredServiceMeshConfigMap(dns, "172.30.0.10", "cluster.local", []string{"cluster.local", "in-addr.arpa", "ip6.arpa"})
+	if e, a := "dns-default-service-mesh", cm.Name; e != a {
+		t.Errorf("expected name %q, got %q", e, a)
+	}
+	if e, a := "default", cm.Labels[manifests.OwningDNSLabel]; e != a {
+		t.Errorf("expected owning dns label %q, got %q", e, a)
+	}
+	expected := map[string]string{
+		"nameserver":    "172.30.0.10",
+		"clusterDomain": "cluster.local",
+		"domains":       "cluster.local\nin-addr.arpa\nip6.arpa",
+	}
+	if !reflect.DeepEqual(cm.Data, expected) {
+		t.Errorf("expected data %v, got %v", expected, cm.Data)
+	}
+}
+
+func TestServiceMeshConfigMapChanged(t *testing.T) {
+	testCases := []struct {
+		description string
+		mutate      func(*corev1.ConfigMap)
+		expect      bool
+	}{
+		{
+			description: "if nothing changes",
+			mutate:      func(_ *corev1.ConfigMap) {},
+			expect:      false,
+		},
+		{
+			description: "if the nameserver changes",
+			mutate: func(cm *corev1.ConfigMap) {
+				cm.Data["nameserver"] = "172.30.0.11"
+			},
+			expect: true,
+		},
+		{
+			description: "if the domains change",
+			mutate: func(cm *corev1.ConfigMap) {
+				cm.Data["domains"] = "cluster.local"
+			},
+			expect: true,
+		},
+		{
+			description: "if the owning dns label is removed",
+			mutate: func(cm *corev1.ConfigMap) {
+				delete(cm.Labels, manifests.OwningDNSLabel)
+			},
+			expect: true,
+		},
+		{
+			description: "if an unrelated label is added",
+			mutate: func(cm *corev1.ConfigMap) {
+				cm.Labels["foo"] = "bar"
+			},
+			expect: false,
+		},
+	}
+	dns := &operatorv1.DNS{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: DefaultDNSController,
+		},
+	}
+	for _, tc := range testCases {
+		original := desiredServiceMeshConfigMap(dns, "172.30.0.10", "cluster.local", []string{"cluster.local", "in-addr.arpa", "ip6.arpa"})
+		mutated := original.DeepCopy()
+		tc.mutate(mutated)
+		if changed, updated := serviceMeshConfigMapChanged(mutated, original); changed != tc.expect {
+			t.Errorf("%s, expect serviceMeshConfigMapChanged to be %t, got %t", tc.description, tc.expect, changed)
+		} else if changed {
+			if changedAgain, _ := serviceMeshConfigMapChanged(updated, original); changedAgain {
+				t.Errorf("%s, serviceMeshConfigMapChanged does not behave as a fixed point function", tc.description)
+			}
+		}
+	}
+}
@@ -0,0 +1,123 @@
+package controller
+
+import (
+	"context"
+	"fmt"
+
+	operatorv1 "github.com/openshift/api/operator/v1"
+
+	"github.com/openshift/cluster-dns-operator/pkg/manifests"
+
+	"github.com/sirupsen/logrus"
+
+	corev1 "k8s.io/api/core/v1"
+
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	// injectTrustedCABundleLabel is the label that causes the cluster
+	// network operator to inject the cluster's trusted CA bundle into a
+	// configmap.
+	injectTrustedCABundleLabel = "config.openshift.io/inject-trusted-cabundle"
+
+	// trustedCABundleKey is the configmap key into which the trusted CA
+	// bundle is injected.
+	trustedCABundleKey = "ca-bundle.crt"
+
+	// trustedCABundleVolumeName is the name of the dns pod volume for the
+	// trusted CA bundle.
+	trustedCABundleVolumeName = "trusted-ca-bundle"
+
+	// trustedCABundleMountPath is the directory in which the trusted CA
+	// bundle is mounted in the dns container.  CoreDNS loads the system
+	// roots from this directory when validating the certificates of
+	// upstream resolvers.
+	trustedCABundleMountPath = "/etc/pki/ca-trust/extracted/pem"
+
+	// trustedCABundlePath is the file name of the trusted CA bundle within
+	// trustedCABundleMountPath.
+	trustedCABundlePath = "tls-ca-bundle.pem"
+)
+
+// ensureTrustedCAConfigMap ensures that the configmap into which the trusted
+// CA bundle is injected exists for the given dns.
+func (r *reconciler) ensureTrustedCAConfigMap(dns *operatorv1.DNS) (bool, *corev1.ConfigMap, error) {
+	haveCM, current, err := r.currentTrustedCAConfigMap(dns)
+	if err != nil {
+		return false, nil, fmt.Errorf("failed to get trusted ca configmap: %v", err)
+	}
+	desired := desiredTrustedCAConfigMap(dns)
+
+	switch {
+	case !haveCM:
+		if err := r.client.Create(context.TODO(), desired); err != nil {
+			return false, nil, fmt.Errorf("failed to create trusted ca configmap: %v", err)
+		}
+		logrus.Infof("created trusted ca configmap: %s/%s", desired.Namespace, desired.Name)
+		return r.currentTrustedCAConfigMap(dns)
+	case haveCM:
+		if changed, updated := trustedCAConfigMapChanged(current, desired); changed {
+			if err := r.client.Update(context.TODO(), updated); err != nil {
+				return true, current, fmt.Errorf("failed to update trusted ca configmap: %v", err)
+			}
+			logrus.Infof("updated trusted ca configmap: %s/%s", updated.Namespace, updated.Name)
+			return r.currentTrustedCAConfigMap(dns)
+		}
+	}
+	return true, current, nil
+}
+
+func (r *reconciler) currentTrustedCAConfigMap(dns *operatorv1.DNS) (bool, *corev1.ConfigMap, error) {
+	current := &corev1.ConfigMap{}
+	if err := r.client.Get(context.TODO(), DNSTrustedCAConfigMapName(dns), current); err != nil {
+		if errors.IsNotFound(err) {
+			return false, nil, nil
+		}
+		return false, nil, err
+	}
+	return true, current, nil
+}
+
+// desiredTrustedCAConfigMap returns the desired trusted CA configmap.  The
+// data are left empty for the cluster network operator to inject.
+func desiredTrustedCAConfigMap(dns *operatorv1.DNS) *corev1.ConfigMap {
+	name := DNSTrustedCAConfigMapName(dns)
+	cm := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name.Name,
+			Namespace: name.Namespace,
+			Labels: map[string]string{
+				manifests.OwningDNSLabel:   DNSDaemonSetLabel(dns),
+				injectTrustedCABundleLabel: "true",
+			},
+		},
+	}
+	cm.SetOwnerReferences([]metav1.OwnerReference{dnsOwnerRef(dns)})
+	return cm
+}
+
+// trustedCAConfigMapChanged checks whether the current trusted CA configmap
+// has the expected labels and if not returns an updated configmap.  The data
+// are never updated as they are managed by the cluster network operator.
+func trustedCAConfigMapChanged(current, expected *corev1.ConfigMap) (bool, *corev1.ConfigMap) {
+	changed := false
+	updated := current.DeepCopy()
+	for k, v := range expected.Labels {
+		if current.Labels[k] != v {
+			if updated.Labels == nil {
+				updated.Labels = map[string]string{}
+			}
+			updated.Labels[k] = v
+			changed = true
+		}
+	}
+	return changed, updated
+}
+
+// trustedCABundleInjected returns a Boolean indicating whether the trusted CA
+// bundle has been injected into the given configmap.
+func trustedCABundleInjected(cm *corev1.ConfigMap) bool {
+	return cm != nil && len(cm.Data[trustedCABundleKey]) != 0
+}
@@ -0,0 +1,96 @@
+package controller
+
+import (
+	"testing"
+
+	operatorv1 "github.com/openshift/api/operator/v1"
+
+	corev1 "k8s.io/api/core/v1"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestDesiredTrustedCAConfigMap(t *testing.T) {
+	dns := &operatorv1.DNS{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: DefaultDNSController,
+		},
+	}
+	cm := desiredTrustedCAConfigMap(dns)
+	if e, a := "dns-default-trusted-ca", cm.Name; e != a {
+		t.Errorf("expected configmap name %q, got %q", e, a)
+	}
+	if e, a := "true", cm.Labels[injectTrustedCABundleLabel]; e != a {
+		t.Errorf("expected label %s=%q, got %q", injectTrustedCABundleLabel, e, a)
+	}
+	if len(cm.Data) != 0 {
+		t.Errorf("expected configmap to have no data, got %v", cm.Data)
+	}
+}
+
+func TestTrustedCAConfigMapChanged(t *testing.T) {
+	dns := &operatorv1.DNS{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: DefaultDNSController,
+		},
+	}
+	testCases := []struct {
+		description string
+		mutate      func(*corev1.ConfigMap)
+		expect      bool
+	}{
+		{
+			description: "if nothing changes",
+			mutate:      func(_ *corev1.ConfigMap) {},
+			expect:      false,
+		},
+		{
+			description: "if the ca bundle is injected",
+			mutate: func(cm *corev1.ConfigMap) {
+				cm.Data = map[string]string{trustedCABundleKey: "bundle"}
+			},
+			expect: false,
+		},
+		{
+			description: "if an unrelated label is added",
+			mutate: func(cm *corev1.ConfigMap) {
+				cm.Labels["foo"] = "bar"
+			},
+			expect: false,
+		},
+		{
+			description: "if the inject label is removed",
+			mutate: func(cm *corev1.ConfigMap) {
+				delete(cm.Labels, injectTrustedCABundleLabel)
+			},
+			expect: true,
+		},
+	}
+	for _, tc := range testCases {
+		expected := desiredTrustedCAConfigMap(dns)
+		current := expected.DeepCopy()
+		tc.mutate(current)
+		changed, updated := trustedCAConfigMapChanged(current, expected)
+		if changed != tc.expect {
+			t.Errorf("%s: expected trustedCAConfigMapChanged to be %t, got %t", tc.description, tc.expect, changed)
+		} else if changed {
+			if changedAgain, _ := trustedCAConfigMapChanged(updated, expected); changedAgain {
+				t.Errorf("%s: trustedCAConfigMapChanged does not behave as a fixed point function", tc.description)
+			}
+		}
+	}
+}
+
+func TestTrustedCABundleInjected(t *testing.T) {
+	if trustedCABundleInjected(nil) {
+		t.Errorf("expected a missing configmap not to be injected")
+	}
+	cm := &corev1.ConfigMap{}
+	if trustedCABundleInjected(cm) {
+		t.Errorf("expected an empty configmap not to be injected")
+	}
+	cm.Data = map[string]string{trustedCABundleKey: "bundle"}
+	if !trustedCABundleInjected(cm) {
+		t.Errorf("expected a configmap with a ca bundle to be injected")
+	}
+}
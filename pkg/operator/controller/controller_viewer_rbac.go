@@ -0,0 +1,118 @@
+package controller
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/openshift/cluster-dns-operator/pkg/manifests"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+
+	"github.com/sirupsen/logrus"
+
+	rbacv1 "k8s.io/api/rbac/v1"
+
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+// ensureViewerRBAC ensures that the read-only dns viewer roles and the role
+// binding that grants cluster readers access to the dns configmaps exist and
+// are up to date.
+func (r *reconciler) ensureViewerRBAC() error {
+	return runConcurrently(
+		r.ensureViewerClusterRole,
+		r.ensureViewerRole,
+		r.ensureViewerRoleBinding,
+	)
+}
+
+// ensureViewerClusterRole ensures that the dns viewer cluster role exists and
+// that its rules and aggregation labels are as expected.
+func (r *reconciler) ensureViewerClusterRole() error {
+	desired := manifests.ViewerClusterRole()
+	current := &rbacv1.ClusterRole{}
+	if err := r.client.Get(context.TODO(), types.NamespacedName{Name: desired.Name}, current); err != nil {
+		if !errors.IsNotFound(err) {
+			return fmt.Errorf("failed to get dns viewer cluster role %s: %v", desired.Name, err)
+		}
+		if err := r.client.Create(context.TODO(), desired); err != nil {
+			return fmt.Errorf("failed to create dns viewer cluster role %s: %v", desired.Name, err)
+		}
+		logrus.Infof("created dns viewer cluster role: %s", desired.Name)
+		return nil
+	}
+	changed, updated := viewerClusterRoleChanged(current, desired)
+	if !changed {
+		return nil
+	}
+	if err := r.client.Update(context.TODO(), updated); err != nil {
+		return fmt.Errorf("failed to update dns viewer cluster role %s: %v", updated.Name, err)
+	}
+	logrus.Infof("updated dns viewer cluster role: %s", updated.Name)
+	return nil
+}
+
+// viewerClusterRoleChanged returns a Boolean indicating whether the current
+// dns viewer cluster role differs from the expected one in its rules or in the
+// labels that aggregate it to other roles, and the updated cluster role if so.
+func viewerClusterRoleChanged(current, expected *rbacv1.ClusterRole) (bool, *rbacv1.ClusterRole) {
+	changed, updated := clusterRoleChanged(current, expected)
+	if !changed {
+		updated = current.DeepCopy()
+	}
+	for key, value := range expected.Labels {
+		if current.Labels[key] == value {
+			continue
+		}
+		if updated.Labels == nil {
+			updated.Labels = map[string]string{}
+		}
+		updated.Labels[key] = value
+		changed = true
+	}
+	return changed, updated
+}
+
+// ensureViewerRole ensures that the dns viewer role exists and that its rules
+// are as expected.
+func (r *reconciler) ensureViewerRole() error {
+	desired := manifests.ViewerRole()
+	current := &rbacv1.Role{}
+	if err := r.client.Get(context.TODO(), types.NamespacedName{Namespace: desired.Namespace, Name: desired.Name}, current); err != nil {
+		if !errors.IsNotFound(err) {
+			return fmt.Errorf("failed to get dns viewer role %s/%s: %v", desired.Namespace, desired.Name, err)
+		}
+		if err := r.client.Create(context.TODO(), desired); err != nil {
+			return fmt.Errorf("failed to create dns viewer role %s/%s: %v", desired.Namespace, desired.Name, err)
+		}
+		logrus.Infof("created dns viewer role: %s/%s", desired.Namespace, desired.Name)
+		return nil
+	}
+	if cmp.Equal(current.Rules, desired.Rules, cmpopts.EquateEmpty()) {
+		return nil
+	}
+	updated := current.DeepCopy()
+	updated.Rules = desired.Rules
+	if err := r.client.Update(context.TODO(), updated); err != nil {
+		return fmt.Errorf("failed to update dns viewer role %s/%s: %v", updated.Namespace, updated.Name, err)
+	}
+	logrus.Infof("updated dns viewer role: %s/%s", updated.Namespace, updated.Name)
+	return nil
+}
+
+// ensureViewerRoleBinding ensures that the dns viewer role binding exists.
+func (r *reconciler) ensureViewerRoleBinding() error {
+	rb := manifests.ViewerRoleBinding()
+	if err := r.client.Get(context.TODO(), types.NamespacedName{Namespace: rb.Namespace, Name: rb.Name}, rb); err != nil {
+		if !errors.IsNotFound(err) {
+			return fmt.Errorf("failed to get dns viewer role binding %s/%s: %v", rb.Namespace, rb.Name, err)
+		}
+		if err := r.client.Create(context.TODO(), rb); err != nil {
+			return fmt.Errorf("failed to create dns viewer role binding %s/%s: %v", rb.Namespace, rb.Name, err)
+		}
+		logrus.Infof("created dns viewer role binding: %s/%s", rb.Namespace, rb.Name)
+	}
+	return nil
+}
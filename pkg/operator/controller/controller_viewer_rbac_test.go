@@ -0,0 +1,57 @@
+package controller
+
+import (
+	"testing"
+
+	"github.com/openshift/cluster-dns-operator/pkg/manifests"
+	rbacv1 "k8s.io/api/rbac/v1"
+)
+
+func TestViewerClusterRoleChanged(t *testing.T) {
+	testCases := []struct {
+		description string
+		mutate      func(*rbacv1.ClusterRole)
+		expect      bool
+	}{
+		{
+			description: "if nothing changes",
+			mutate:      func(_ *rbacv1.ClusterRole) {},
+			expect:      false,
+		},
+		{
+			description: "if a rule is removed",
+			mutate: func(cr *rbacv1.ClusterRole) {
+				cr.Rules = cr.Rules[1:]
+			},
+			expect: true,
+		},
+		{
+			description: "if the aggregation label is removed",
+			mutate: func(cr *rbacv1.ClusterRole) {
+				cr.Labels = nil
+			},
+			expect: true,
+		},
+		{
+			description: "if another label is added",
+			mutate: func(cr *rbacv1.ClusterRole) {
+				cr.Labels["test"] = "test"
+			},
+			expect: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		expected := manifests.ViewerClusterRole()
+		current := expected.DeepCopy()
+		tc.mutate(current)
+		changed, updated := viewerClusterRoleChanged(current, expected)
+		if changed != tc.expect {
+			t.Errorf("%s, expect viewerClusterRoleChanged to be %t, got %t", tc.description, tc.expect, changed)
+		} else if changed {
+			if changedAgain, _ := viewerClusterRoleChanged(updated, expected); changedAgain {
+				t.Errorf("%s, viewerClusterRoleChanged does not behave as a fixed point function", tc.description)
+			}
+		}
+	}
+}
@@ -0,0 +1,51 @@
+package controller
+
+import (
+	"math"
+	"strconv"
+	"time"
+
+	"github.com/sirupsen/logrus"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	// defaultCacheTTL is the maximum time for which CoreDNS caches
+	// positive and negative responses of the default server if the dns
+	// does not specify one.
+	defaultCacheTTL = 30 * time.Second
+
+	// cacheDenialCapacity is the number of negative responses that the
+	// cache plugin holds, which is the default of the plugin.  The denial
+	// option needs it in order to set the TTL of negative responses.
+	cacheDenialCapacity = 9984
+)
+
+// corefileCacheTTL returns the given positive or negative cache TTL of the
+// named dns rounded up to whole seconds, which is the granularity of the cache
+// plugin.  An unset or zero TTL means the default, because the cache plugin
+// rejects a TTL of zero, and a negative TTL is ignored.
+func corefileCacheTTL(ttl *metav1.Duration, kind, name string) int {
+	d := defaultCacheTTL
+	if ttl != nil {
+		switch {
+		case ttl.Duration < 0:
+			logrus.Warningf("ignoring negative %s cache TTL %s of dns %s", kind, ttl.Duration, name)
+		case ttl.Duration > 0:
+			d = ttl.Duration
+		}
+	}
+	return int(math.Ceil(d.Seconds()))
+}
+
+// cacheDirective returns the cache plugin of the default server block.  The
+// argument of the plugin caps the TTL of all responses, so the denial option
+// is only needed if negative responses have a different cap.
+func (p *corefileParams) cacheDirective() corefileDirective {
+	cache := newCorefileDirective("cache", strconv.Itoa(p.CachePositiveTTL))
+	if p.CacheNegativeTTL != p.CachePositiveTTL {
+		cache = cache.withOptions(newCorefileDirective("denial", strconv.Itoa(cacheDenialCapacity), strconv.Itoa(p.CacheNegativeTTL)))
+	}
+	return cache
+}
@@ -0,0 +1,51 @@
+package controller
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestCorefileCacheTTL(t *testing.T) {
+	testCases := []struct {
+		description string
+		ttl         *metav1.Duration
+		expected    int
+	}{
+		{"unset", nil, 30},
+		{"zero", &metav1.Duration{}, 30},
+		{"negative", &metav1.Duration{Duration: -time.Second}, 30},
+		{"whole seconds", &metav1.Duration{Duration: 5 * time.Minute}, 300},
+		{"fractional seconds", &metav1.Duration{Duration: 1500 * time.Millisecond}, 2},
+		{"below a second", &metav1.Duration{Duration: time.Millisecond}, 1},
+	}
+	for _, tc := range testCases {
+		if actual := corefileCacheTTL(tc.ttl, "positive", "default"); actual != tc.expected {
+			t.Errorf("%s: expected %d, got %d", tc.description, tc.expected, actual)
+		}
+	}
+}
+
+func TestCacheDirective(t *testing.T) {
+	testCases := []struct {
+		description string
+		positive    int
+		negative    int
+		expected    string
+	}{
+		{"defaults", 30, 30, "cache 30\n"},
+		{"same TTLs", 600, 600, "cache 600\n"},
+		{"shorter negative TTL", 3600, 5, "cache 3600 {\n    denial 9984 5\n}\n"},
+		{"longer negative TTL", 30, 300, "cache 30 {\n    denial 9984 300\n}\n"},
+	}
+	for _, tc := range testCases {
+		p := &corefileParams{CachePositiveTTL: tc.positive, CacheNegativeTTL: tc.negative}
+		b := &strings.Builder{}
+		writeCorefileDirective(b, p.cacheDirective(), 0)
+		if actual := b.String(); actual != tc.expected {
+			t.Errorf("%s: expected %q, got %q", tc.description, tc.expected, actual)
+		}
+	}
+}
@@ -0,0 +1,338 @@
+package controller
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	operatorv1 "github.com/openshift/api/operator/v1"
+
+	"github.com/openshift/cluster-dns-operator/pkg/util/conditions"
+)
+
+// DNSCorefileCompatibleConditionType is the type of the dns status condition
+// that reports whether the Corefile of the dns is compatible with the version
+// of CoreDNS that the operator manages.
+const DNSCorefileCompatibleConditionType = "CorefileCompatible"
+
+// MinimumCoreDNSVersion is the oldest version of CoreDNS that the operator
+// supports.  The Corefile is rendered for it and checked against it if the
+// version of CoreDNS is not set or cannot be parsed.
+const MinimumCoreDNSVersion = "1.6.6"
+
+// corefilePluginChange records the CoreDNS versions in which a plugin, or an
+// option of a plugin, was introduced, deprecated, or removed.  An empty version
+// means that the change has not happened.
+type corefilePluginChange struct {
+	// plugin is the name of the plugin.
+	plugin string
+	// option is the name of the option of the plugin, or empty if the
+	// change is to the plugin itself.
+	option string
+
+	introduced string
+	deprecated string
+	removed    string
+
+	// external is true if the plugin is not compiled into CoreDNS by
+	// default, so that a CoreDNS image has the plugin only if the
+	// operator is configured with the plugin among the external plugins
+	// of the image.
+	external bool
+}
+
+// corefilePluginMatrix lists the changes to the plugins and plugin options that
+// the operator may render in a Corefile, and the external plugins that it may
+// render but that CoreDNS does not have by default.  When the operator is updated to manage
+// a new version of CoreDNS, any plugin or option that the new version changes
+// must be added here.
+var corefilePluginMatrix = []corefilePluginChange{
+	{plugin: "ready", introduced: "1.5.0"},
+	{plugin: "multisocket", introduced: "1.12.0"},
+	{plugin: "cancel", introduced: "1.6.4"},
+	{plugin: "minimal", introduced: "1.8.1"},
+	{plugin: "local", introduced: "1.8.1"},
+	{plugin: "kubernetes", option: "upstream", deprecated: "1.5.0", removed: "1.7.0"},
+	{plugin: "kubernetes", option: "resyncperiod", deprecated: "1.5.0", removed: "1.7.0"},
+	{plugin: "health", option: "lameduck", introduced: "1.2.0"},
+	{plugin: "federation", deprecated: "1.6.0", removed: "1.7.0"},
+	{plugin: "rrl", external: true},
+}
+
+// corefileCompatibility is the result of checking a Corefile against the
+// plugin matrix for a version of CoreDNS.
+type corefileCompatibility struct {
+	// version is the CoreDNS version that the Corefile was checked
+	// against.
+	version string
+	// incompatible describes the plugins and options in the Corefile that
+	// the version does not support.
+	incompatible []string
+	// deprecated describes the plugins and options in the Corefile that
+	// the version supports but has deprecated.
+	deprecated []string
+	// ignored describes the fields of the dns that were ignored because
+	// the version does not have the plugins for them.
+	ignored []string
+}
+
+// coreDNSVersion is a parsed CoreDNS version.
+type coreDNSVersion [3]int
+
+// parseCoreDNSVersion parses a version of the form "1.6.6", optionally with a
+// "v" prefix and a "-" suffix.
+func parseCoreDNSVersion(s string) (coreDNSVersion, error) {
+	var v coreDNSVersion
+	trimmed := strings.TrimPrefix(s, "v")
+	if i := strings.IndexAny(trimmed, "-+"); i != -1 {
+		trimmed = trimmed[:i]
+	}
+	parts := strings.Split(trimmed, ".")
+	if len(parts) == 0 || len(parts) > 3 {
+		return v, fmt.Errorf("invalid coredns version %q", s)
+	}
+	for i, p := range parts {
+		n, err := strconv.Atoi(p)
+		if err != nil || n < 0 {
+			return v, fmt.Errorf("invalid coredns version %q", s)
+		}
+		v[i] = n
+	}
+	return v, nil
+}
+
+// effectiveCoreDNSVersion returns the given version of CoreDNS, parsed, or
+// MinimumCoreDNSVersion if the given version is empty or cannot be parsed.
+func effectiveCoreDNSVersion(version string) (string, coreDNSVersion) {
+	if v, err := parseCoreDNSVersion(version); err == nil {
+		return version, v
+	}
+	v, _ := parseCoreDNSVersion(MinimumCoreDNSVersion)
+	return MinimumCoreDNSVersion, v
+}
+
+// externalPluginCompiled returns a Boolean indicating whether the given plugin
+// is among the given comma-separated external plugins of a CoreDNS image.
+func externalPluginCompiled(plugin, externalPlugins string) bool {
+	for _, p := range strings.Split(externalPlugins, ",") {
+		if strings.TrimSpace(p) == plugin {
+			return true
+		}
+	}
+	return false
+}
+
+// atLeast returns a Boolean indicating whether v is the given version or
+// later.  An empty version is never reached.
+func (v coreDNSVersion) atLeast(s string) bool {
+	if len(s) == 0 {
+		return false
+	}
+	other, err := parseCoreDNSVersion(s)
+	if err != nil {
+		return false
+	}
+	for i := range v {
+		if v[i] != other[i] {
+			return v[i] > other[i]
+		}
+	}
+	return true
+}
+
+// corefilePluginUnsupported returns the reason why the given version of
+// CoreDNS, with the given comma-separated external plugins, does not have the
+// given plugin according to the plugin matrix, or the empty string if it does.
+// An unknown version is taken to be MinimumCoreDNSVersion.
+func corefilePluginUnsupported(plugin, version, externalPlugins string) string {
+	_, v := effectiveCoreDNSVersion(version)
+	for _, change := range corefilePluginMatrix {
+		if change.plugin != plugin || len(change.option) != 0 {
+			continue
+		}
+		switch {
+		case change.external && !externalPluginCompiled(plugin, externalPlugins):
+			return fmt.Sprintf("plugin %s is not compiled into CoreDNS", plugin)
+		case len(change.introduced) != 0 && !v.atLeast(change.introduced):
+			return fmt.Sprintf("plugin %s was introduced in %s", plugin, change.introduced)
+		case v.atLeast(change.removed):
+			return fmt.Sprintf("plugin %s was removed in %s", plugin, change.removed)
+		}
+	}
+	return ""
+}
+
+// corefileOptionRemoved returns a Boolean indicating whether the given version
+// of CoreDNS has removed the given option of the given plugin according to the
+// plugin matrix.  An unknown version is taken to be MinimumCoreDNSVersion.
+func corefileOptionRemoved(plugin, option, version string) bool {
+	_, v := effectiveCoreDNSVersion(version)
+	for _, change := range corefilePluginMatrix {
+		if change.plugin == plugin && change.option == option && v.atLeast(change.removed) {
+			return true
+		}
+	}
+	return false
+}
+
+// withoutUnsupportedFeatures returns the given dns with the optional features
+// that the given version of CoreDNS, with the given comma-separated external
+// plugins, does not have the plugins for turned off, and a description of each
+// of those features that the dns requests explicitly.  The Corefile would
+// otherwise be refused, and one optional field would hold back every other
+// change to it.  A feature that is on by default is turned off silently.  The
+// dns itself is returned if no feature is turned off.
+func withoutUnsupportedFeatures(dns *operatorv1.DNS, version, externalPlugins string) (*operatorv1.DNS, []string) {
+	var supported *operatorv1.DNS
+	copyDNS := func() *operatorv1.DNS {
+		if supported == nil {
+			supported = dns.DeepCopy()
+		}
+		return supported
+	}
+	ignored := []string{}
+	if dns.Spec.LocalhostZones != operatorv1.LocalhostZonesDisabled {
+		if reason := corefilePluginUnsupported("local", version, externalPlugins); len(reason) != 0 {
+			copyDNS().Spec.LocalhostZones = operatorv1.LocalhostZonesDisabled
+			if dns.Spec.LocalhostZones == operatorv1.LocalhostZonesEnabled {
+				ignored = append(ignored, fmt.Sprintf("localhostZones %s is ignored: %s", dns.Spec.LocalhostZones, reason))
+			}
+		}
+	}
+	if dns.Spec.Performance.ListenSockets == operatorv1.DNSListenSocketsPerCPU {
+		if reason := corefilePluginUnsupported("multisocket", version, externalPlugins); len(reason) != 0 {
+			copyDNS().Spec.Performance.ListenSockets = operatorv1.DNSListenSocketsSingle
+			ignored = append(ignored, fmt.Sprintf("performance.listenSockets %s is ignored: %s", dns.Spec.Performance.ListenSockets, reason))
+		}
+	}
+	if reason := corefilePluginUnsupported("minimal", version, externalPlugins); len(reason) != 0 {
+		for i, server := range dns.Spec.Servers {
+			if server.MinimalResponses == operatorv1.MinimalResponsesEnabled {
+				copyDNS().Spec.Servers[i].MinimalResponses = operatorv1.MinimalResponsesDisabled
+				ignored = append(ignored, fmt.Sprintf("minimalResponses of server %s is ignored: %s", server.Name, reason))
+			}
+		}
+		for i, override := range dns.Spec.NodeOverrides {
+			for j, server := range override.Servers {
+				if server.MinimalResponses == operatorv1.MinimalResponsesEnabled {
+					copyDNS().Spec.NodeOverrides[i].Servers[j].MinimalResponses = operatorv1.MinimalResponsesDisabled
+					ignored = append(ignored, fmt.Sprintf("minimalResponses of server %s of node override %s is ignored: %s", server.Name, override.Name, reason))
+				}
+			}
+		}
+	}
+	if reason := corefilePluginUnsupported("rrl", version, externalPlugins); len(reason) != 0 {
+		for i, server := range dns.Spec.Servers {
+			if server.RateLimit != nil {
+				copyDNS().Spec.Servers[i].RateLimit = nil
+				ignored = append(ignored, fmt.Sprintf("rateLimit of server %s is ignored: %s", server.Name, reason))
+			}
+		}
+		for i, override := range dns.Spec.NodeOverrides {
+			for j, server := range override.Servers {
+				if server.RateLimit != nil {
+					copyDNS().Spec.NodeOverrides[i].Servers[j].RateLimit = nil
+					ignored = append(ignored, fmt.Sprintf("rateLimit of server %s of node override %s is ignored: %s", server.Name, override.Name, reason))
+				}
+			}
+		}
+	}
+	if supported == nil {
+		return dns, nil
+	}
+	return supported, ignored
+}
+
+// corefileDirectives returns the plugins that the given Corefile uses, mapped
+// to the options that it sets for each plugin.  A Corefile that cannot be
+// parsed uses no plugins.
+func corefileDirectives(corefile string) map[string]map[string]struct{} {
+	directives := map[string]map[string]struct{}{}
+	c, err := parseCorefile(corefile)
+	if err != nil {
+		return directives
+	}
+	for _, block := range c.ServerBlocks {
+		for _, plugin := range block.Plugins {
+			options, ok := directives[plugin.Name]
+			if !ok {
+				options = map[string]struct{}{}
+				directives[plugin.Name] = options
+			}
+			for _, option := range plugin.Options {
+				options[option.Name] = struct{}{}
+			}
+		}
+	}
+	return directives
+}
+
+// checkCorefileCompatibility checks the given Corefile against the plugin
+// matrix for the given CoreDNS version with the given comma-separated external
+// plugins.  An unknown version is taken to be MinimumCoreDNSVersion.
+func checkCorefileCompatibility(corefile, version, externalPlugins string) *corefileCompatibility {
+	version, v := effectiveCoreDNSVersion(version)
+	result := &corefileCompatibility{version: version}
+	directives := corefileDirectives(corefile)
+	for _, change := range corefilePluginMatrix {
+		options, ok := directives[change.plugin]
+		if !ok {
+			continue
+		}
+		name := fmt.Sprintf("plugin %s", change.plugin)
+		if len(change.option) != 0 {
+			if _, ok := options[change.option]; !ok {
+				continue
+			}
+			name = fmt.Sprintf("option %s of plugin %s", change.option, change.plugin)
+		}
+		switch {
+		case change.external && !externalPluginCompiled(change.plugin, externalPlugins):
+			result.incompatible = append(result.incompatible, fmt.Sprintf("%s is not compiled into CoreDNS", name))
+		case len(change.introduced) != 0 && !v.atLeast(change.introduced):
+			result.incompatible = append(result.incompatible, fmt.Sprintf("%s was introduced in %s", name, change.introduced))
+		case v.atLeast(change.removed):
+			result.incompatible = append(result.incompatible, fmt.Sprintf("%s was removed in %s", name, change.removed))
+		case v.atLeast(change.deprecated):
+			result.deprecated = append(result.deprecated, fmt.Sprintf("%s was deprecated in %s", name, change.deprecated))
+		}
+	}
+	sort.Strings(result.incompatible)
+	sort.Strings(result.deprecated)
+	return result
+}
+
+// computeDNSCorefileCompatibleCondition computes the dns CorefileCompatible
+// status condition from the given compatibility.  If compatibility is nil, the
+// old condition is kept.
+func computeDNSCorefileCompatibleCondition(oldConditions []operatorv1.OperatorCondition, compatibility *corefileCompatibility) *operatorv1.OperatorCondition {
+	oldCondition := conditions.FindOperatorCondition(oldConditions, DNSCorefileCompatibleConditionType)
+	if compatibility == nil {
+		return oldCondition
+	}
+
+	condition := &operatorv1.OperatorCondition{
+		Type: DNSCorefileCompatibleConditionType,
+	}
+	switch {
+	case len(compatibility.incompatible) != 0:
+		condition.Status = operatorv1.ConditionFalse
+		condition.Reason = "UnsupportedPlugins"
+		condition.Message = fmt.Sprintf("The Corefile was not updated because CoreDNS %s does not support it: %s", compatibility.version, strings.Join(compatibility.incompatible, "; "))
+	case len(compatibility.ignored) != 0:
+		condition.Status = operatorv1.ConditionTrue
+		condition.Reason = "UnsupportedFieldsIgnored"
+		condition.Message = fmt.Sprintf("The Corefile leaves out features that CoreDNS %s does not support: %s", compatibility.version, strings.Join(compatibility.ignored, "; "))
+	case len(compatibility.deprecated) != 0:
+		condition.Status = operatorv1.ConditionTrue
+		condition.Reason = "DeprecatedPlugins"
+		condition.Message = fmt.Sprintf("The Corefile uses features that CoreDNS %s has deprecated: %s", compatibility.version, strings.Join(compatibility.deprecated, "; "))
+	default:
+		condition.Status = operatorv1.ConditionTrue
+		condition.Reason = "AsExpected"
+		condition.Message = fmt.Sprintf("The Corefile is compatible with CoreDNS %s", compatibility.version)
+	}
+	c := conditions.SetOperatorConditionTransitionTime(condition, oldCondition)
+	return &c
+}
@@ -0,0 +1,356 @@
+package controller
+
+import (
+	"io/ioutil"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+
+	operatorv1 "github.com/openshift/api/operator/v1"
+
+	appsv1 "k8s.io/api/apps/v1"
+
+	"sigs.k8s.io/yaml"
+)
+
+func TestParseCoreDNSVersion(t *testing.T) {
+	testCases := []struct {
+		version string
+		expect  coreDNSVersion
+		err     bool
+	}{
+		{version: "1.6.6", expect: coreDNSVersion{1, 6, 6}},
+		{version: "v1.7.0", expect: coreDNSVersion{1, 7, 0}},
+		{version: "1.8", expect: coreDNSVersion{1, 8, 0}},
+		{version: "1.6.6-rc1", expect: coreDNSVersion{1, 6, 6}},
+		{version: "", err: true},
+		{version: "one.two", err: true},
+		{version: "1.2.3.4", err: true},
+	}
+	for _, tc := range testCases {
+		v, err := parseCoreDNSVersion(tc.version)
+		switch {
+		case tc.err && err == nil:
+			t.Errorf("%q: expected error", tc.version)
+		case !tc.err && err != nil:
+			t.Errorf("%q: unexpected error: %v", tc.version, err)
+		case !tc.err && v != tc.expect:
+			t.Errorf("%q: expected %v, got %v", tc.version, tc.expect, v)
+		}
+	}
+}
+
+func TestCorefileDirectives(t *testing.T) {
+	dns := &operatorv1.DNS{}
+	cm, err := desiredDNSConfigMap(dns, "cluster.local", nil, nil, nil, nil, nil, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	directives := corefileDirectives(cm.Data["Corefile"])
+	for _, plugin := range []string{"errors", "log", "health", "ready", "kubernetes", "prometheus", "forward", "cache", "reload"} {
+		if _, ok := directives[plugin]; !ok {
+			t.Errorf("expected plugin %s in %v", plugin, directives)
+		}
+	}
+	if _, ok := directives["kubernetes"]["upstream"]; !ok {
+		t.Errorf("expected option upstream of plugin kubernetes in %v", directives)
+	}
+	if _, ok := directives["forward"]["policy"]; !ok {
+		t.Errorf("expected option policy of plugin forward in %v", directives)
+	}
+}
+
+func TestCheckCorefileCompatibility(t *testing.T) {
+	corefile := `foo.com:5353 {
+    rrl
+    forward . 1.1.1.1
+}
+.:5353 {
+    errors
+    multisocket
+    ready :8181
+    kubernetes cluster.local in-addr.arpa ip6.arpa {
+        pods insecure
+        upstream
+    }
+    cache 30
+}
+`
+	testCases := []struct {
+		description string
+		version     string
+		expect      *corefileCompatibility
+	}{
+		{
+			description: "unknown version",
+			version:     "",
+			expect: &corefileCompatibility{
+				version:      MinimumCoreDNSVersion,
+				incompatible: []string{"plugin multisocket was introduced in 1.12.0", "plugin rrl is not compiled into CoreDNS"},
+				deprecated:   []string{"option upstream of plugin kubernetes was deprecated in 1.5.0"},
+			},
+		},
+		{
+			description: "version before the plugins were introduced",
+			version:     "1.4.0",
+			expect: &corefileCompatibility{
+				version: "1.4.0",
+				incompatible: []string{
+					"plugin multisocket was introduced in 1.12.0",
+					"plugin ready was introduced in 1.5.0",
+					"plugin rrl is not compiled into CoreDNS",
+				},
+			},
+		},
+		{
+			description: "version that deprecated an option",
+			version:     "1.6.6",
+			expect: &corefileCompatibility{
+				version:      "1.6.6",
+				incompatible: []string{"plugin multisocket was introduced in 1.12.0", "plugin rrl is not compiled into CoreDNS"},
+				deprecated:   []string{"option upstream of plugin kubernetes was deprecated in 1.5.0"},
+			},
+		},
+		{
+			description: "version that removed an option",
+			version:     "1.12.0",
+			expect: &corefileCompatibility{
+				version:      "1.12.0",
+				incompatible: []string{"option upstream of plugin kubernetes was removed in 1.7.0", "plugin rrl is not compiled into CoreDNS"},
+			},
+		},
+	}
+	for _, tc := range testCases {
+		actual := checkCorefileCompatibility(corefile, tc.version, "")
+		if !cmp.Equal(actual, tc.expect, cmp.AllowUnexported(corefileCompatibility{}), cmpopts.EquateEmpty()) {
+			t.Errorf("%s: expected %+v, got %+v", tc.description, tc.expect, actual)
+		}
+	}
+}
+
+func TestComputeDNSCorefileCompatibleCondition(t *testing.T) {
+	if c := computeDNSCorefileCompatibleCondition(nil, nil); c != nil {
+		t.Errorf("expected no condition without a compatibility check, got %+v", c)
+	}
+
+	compatibility := &corefileCompatibility{version: "1.7.0", incompatible: []string{"option upstream of plugin kubernetes was removed in 1.7.0"}}
+	c := computeDNSCorefileCompatibleCondition(nil, compatibility)
+	if c == nil || c.Status != operatorv1.ConditionFalse {
+		t.Fatalf("expected status %s for an incompatible Corefile, got %+v", operatorv1.ConditionFalse, c)
+	}
+
+	// Without a new check, the old condition is kept.
+	if old := computeDNSCorefileCompatibleCondition([]operatorv1.OperatorCondition{*c}, nil); old == nil || *old != *c {
+		t.Errorf("expected old condition %+v, got %+v", c, old)
+	}
+
+	c = computeDNSCorefileCompatibleCondition([]operatorv1.OperatorCondition{*c}, &corefileCompatibility{version: "1.7.0"})
+	if c.Status != operatorv1.ConditionTrue || c.Reason != "AsExpected" {
+		t.Errorf("expected status %s with reason AsExpected for a compatible Corefile, got %+v", operatorv1.ConditionTrue, c)
+	}
+
+	c = computeDNSCorefileCompatibleCondition([]operatorv1.OperatorCondition{*c}, &corefileCompatibility{version: "1.6.6", ignored: []string{"localhostZones Enabled is ignored: plugin local was introduced in 1.8.1"}})
+	if c.Status != operatorv1.ConditionTrue || c.Reason != "UnsupportedFieldsIgnored" {
+		t.Errorf("expected status %s with reason UnsupportedFieldsIgnored for ignored fields, got %+v", operatorv1.ConditionTrue, c)
+	}
+}
+
+// manifestCoreDNSVersion returns the version of CoreDNS that the operator
+// deployment manifest sets in COREDNS_VERSION.
+func manifestCoreDNSVersion(t *testing.T) string {
+	data, err := ioutil.ReadFile("../../../manifests/0000_70_dns-operator_02-deployment.yaml")
+	if err != nil {
+		t.Fatalf("failed to read operator deployment manifest: %v", err)
+	}
+	deployment := &appsv1.Deployment{}
+	if err := yaml.Unmarshal(data, deployment); err != nil {
+		t.Fatalf("failed to parse operator deployment manifest: %v", err)
+	}
+	for _, container := range deployment.Spec.Template.Spec.Containers {
+		for _, env := range container.Env {
+			if env.Name == "COREDNS_VERSION" {
+				return env.Value
+			}
+		}
+	}
+	t.Fatalf("operator deployment manifest does not set COREDNS_VERSION")
+	return ""
+}
+
+// TestDefaultDNSCorefileCompatible verifies that the Corefile of a dns with an
+// empty spec is compatible with the version of CoreDNS that the operator
+// manages, and with later versions that removed options that it renders for
+// older ones, so that the operator writes it.
+func TestDefaultDNSCorefileCompatible(t *testing.T) {
+	for _, version := range []string{manifestCoreDNSVersion(t), "1.8.1", "1.12.0"} {
+		dns, ignored := withoutUnsupportedFeatures(&operatorv1.DNS{}, version, "")
+		if len(ignored) != 0 {
+			t.Errorf("%s: expected no ignored fields for an empty spec, got %v", version, ignored)
+		}
+		cm, err := desiredDNSConfigMap(dns, "cluster.local", nil, nil, nil, nil, nil, version)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if compatibility := checkCorefileCompatibility(allCorefiles(cm), version, ""); compatibility == nil || len(compatibility.incompatible) != 0 {
+			t.Errorf("expected the default Corefile to be compatible with CoreDNS %s, got %+v", version, compatibility)
+		}
+	}
+}
+
+func TestCorefileKubernetesUpstream(t *testing.T) {
+	testCases := []struct {
+		version string
+		expect  bool
+	}{
+		{version: "", expect: true},
+		{version: "1.6.6", expect: true},
+		{version: "1.7.0", expect: false},
+		{version: "1.12.0", expect: false},
+	}
+	for _, tc := range testCases {
+		cm, err := desiredDNSConfigMap(&operatorv1.DNS{}, "cluster.local", nil, nil, nil, nil, nil, tc.version)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, actual := corefileDirectives(cm.Data["Corefile"])["kubernetes"]["upstream"]; actual != tc.expect {
+			t.Errorf("version %q: expected option upstream of plugin kubernetes %v, got %v", tc.version, tc.expect, actual)
+		}
+	}
+}
+
+func TestWithoutUnsupportedFeatures(t *testing.T) {
+	testCases := []struct {
+		description    string
+		localhostZones operatorv1.LocalhostZonesState
+		version        string
+		expectLocal    bool
+		expectIgnored  int
+	}{
+		{
+			description: "default with an unknown version",
+		},
+		{
+			description: "default with a version that has the plugin",
+			version:     "1.8.1",
+			expectLocal: true,
+		},
+		{
+			description: "default with a version that lacks the plugin",
+			version:     "1.6.6",
+		},
+		{
+			description:    "enabled with a version that lacks the plugin",
+			localhostZones: operatorv1.LocalhostZonesEnabled,
+			version:        "1.6.6",
+			expectIgnored:  1,
+		},
+		{
+			description:    "disabled with a version that lacks the plugin",
+			localhostZones: operatorv1.LocalhostZonesDisabled,
+			version:        "1.6.6",
+		},
+	}
+	for _, tc := range testCases {
+		dns := &operatorv1.DNS{Spec: operatorv1.DNSSpec{LocalhostZones: tc.localhostZones}}
+		supported, ignored := withoutUnsupportedFeatures(dns, tc.version, "")
+		if actual := corefileLocalhostZones(supported); actual != tc.expectLocal {
+			t.Errorf("%s: expected localhost zones %v, got %v", tc.description, tc.expectLocal, actual)
+		}
+		if len(ignored) != tc.expectIgnored {
+			t.Errorf("%s: expected %d ignored fields, got %v", tc.description, tc.expectIgnored, ignored)
+		}
+		if dns.Spec.LocalhostZones != tc.localhostZones {
+			t.Errorf("%s: expected the dns not to change, got %q", tc.description, dns.Spec.LocalhostZones)
+		}
+	}
+}
+
+func TestWithoutUnsupportedListenSockets(t *testing.T) {
+	dns := &operatorv1.DNS{Spec: operatorv1.DNSSpec{
+		LocalhostZones: operatorv1.LocalhostZonesDisabled,
+		Performance:    operatorv1.DNSPerformance{ListenSockets: operatorv1.DNSListenSocketsPerCPU},
+	}}
+	supported, ignored := withoutUnsupportedFeatures(dns, "1.6.6", "")
+	if supported.Spec.Performance.ListenSockets == operatorv1.DNSListenSocketsPerCPU || len(ignored) != 1 {
+		t.Errorf("expected listenSockets to be ignored with CoreDNS 1.6.6, got %q and %v", supported.Spec.Performance.ListenSockets, ignored)
+	}
+	if supported, ignored := withoutUnsupportedFeatures(dns, "1.12.0", ""); supported != dns || len(ignored) != 0 {
+		t.Errorf("expected listenSockets to be kept with CoreDNS 1.12.0, got %q and %v", supported.Spec.Performance.ListenSockets, ignored)
+	}
+}
+
+// TestPerCPUListenSocketsCompatible verifies that a dns with per-CPU listen
+// sockets gets a Corefile with the multisocket plugin that the operator writes
+// for a version of CoreDNS that has the plugin.
+func TestPerCPUListenSocketsCompatible(t *testing.T) {
+	dns := &operatorv1.DNS{Spec: operatorv1.DNSSpec{
+		Performance: operatorv1.DNSPerformance{ListenSockets: operatorv1.DNSListenSocketsPerCPU},
+	}}
+	supported, ignored := withoutUnsupportedFeatures(dns, "1.12.0", "")
+	if len(ignored) != 0 {
+		t.Errorf("expected no ignored fields, got %v", ignored)
+	}
+	cm, err := desiredDNSConfigMap(supported, "cluster.local", nil, nil, nil, nil, nil, "1.12.0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(cm.Data["Corefile"], "\n    multisocket\n") {
+		t.Errorf("expected the Corefile to contain multisocket:\n%s", cm.Data["Corefile"])
+	}
+	if compatibility := checkCorefileCompatibility(allCorefiles(cm), "1.12.0", ""); len(compatibility.incompatible) != 0 {
+		t.Errorf("expected the Corefile to be compatible with CoreDNS 1.12.0, got %v", compatibility.incompatible)
+	}
+}
+
+func TestWithoutUnsupportedMinimalResponses(t *testing.T) {
+	minimal := operatorv1.Server{Name: "foo", Zones: []string{"foo.com"}, MinimalResponses: operatorv1.MinimalResponsesEnabled}
+	dns := &operatorv1.DNS{Spec: operatorv1.DNSSpec{
+		LocalhostZones: operatorv1.LocalhostZonesDisabled,
+		Servers:        []operatorv1.Server{minimal, {Name: "bar", Zones: []string{"bar.com"}}},
+		NodeOverrides:  []operatorv1.DNSNodeOverride{{Name: "edge", Servers: []operatorv1.Server{minimal}}},
+	}}
+	supported, ignored := withoutUnsupportedFeatures(dns, "1.6.6", "")
+	if len(ignored) != 2 {
+		t.Errorf("expected minimalResponses of 2 servers to be ignored with CoreDNS 1.6.6, got %v", ignored)
+	}
+	if supported.Spec.Servers[0].MinimalResponses == operatorv1.MinimalResponsesEnabled || supported.Spec.NodeOverrides[0].Servers[0].MinimalResponses == operatorv1.MinimalResponsesEnabled {
+		t.Errorf("expected minimalResponses to be turned off, got %+v", supported.Spec)
+	}
+	if dns.Spec.Servers[0].MinimalResponses != operatorv1.MinimalResponsesEnabled {
+		t.Errorf("expected the dns not to change, got %+v", dns.Spec.Servers[0])
+	}
+	if supported, ignored := withoutUnsupportedFeatures(dns, "1.8.1", ""); supported != dns || len(ignored) != 0 {
+		t.Errorf("expected minimalResponses to be kept with CoreDNS 1.8.1, got %v", ignored)
+	}
+}
+
+func TestWithoutUnsupportedRateLimit(t *testing.T) {
+	limited := operatorv1.Server{Name: "foo", Zones: []string{"foo.com"}, RateLimit: &operatorv1.DNSRateLimit{RequestsPerSecond: 100}}
+	dns := &operatorv1.DNS{Spec: operatorv1.DNSSpec{
+		LocalhostZones: operatorv1.LocalhostZonesDisabled,
+		Servers:        []operatorv1.Server{limited},
+		NodeOverrides:  []operatorv1.DNSNodeOverride{{Name: "edge", Servers: []operatorv1.Server{limited}}},
+	}}
+	supported, ignored := withoutUnsupportedFeatures(dns, "1.12.0", "")
+	if len(ignored) != 2 || supported.Spec.Servers[0].RateLimit != nil || supported.Spec.NodeOverrides[0].Servers[0].RateLimit != nil {
+		t.Errorf("expected rateLimit of 2 servers to be ignored, got %v", ignored)
+	}
+	if dns.Spec.Servers[0].RateLimit == nil {
+		t.Errorf("expected the dns not to change")
+	}
+	if _, ignored := withoutUnsupportedFeatures(dns, "", ""); len(ignored) != 2 {
+		t.Errorf("expected rateLimit of 2 servers to be ignored with an unknown version, got %v", ignored)
+	}
+	if supported, ignored := withoutUnsupportedFeatures(dns, "1.12.0", "dnstap, rrl"); supported != dns || len(ignored) != 0 {
+		t.Errorf("expected rateLimit to be kept with the rrl plugin compiled in, got %v", ignored)
+	}
+	cm, err := desiredDNSConfigMap(dns, "cluster.local", nil, nil, nil, nil, nil, "1.12.0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if compatibility := checkCorefileCompatibility(allCorefiles(cm), "1.12.0", "rrl"); len(compatibility.incompatible) != 0 {
+		t.Errorf("expected the rrl plugin to be compatible when it is compiled in, got %v", compatibility.incompatible)
+	}
+}
@@ -0,0 +1,719 @@
+package controller
+
+import (
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"math/rand"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+
+	configv1 "github.com/openshift/api/config/v1"
+	operatorv1 "github.com/openshift/api/operator/v1"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// updateGolden causes TestCorefileGolden to rewrite the golden Corefiles in
+// testdata/corefiles from the current Corefile model.
+var updateGolden = flag.Bool("update", false, "update golden Corefiles")
+
+// parsedServerBlock is a server block of a parsed Corefile.
+type parsedServerBlock struct {
+	// keys are the zone and port keys of the block.
+	keys []string
+	// lines are the directive lines in the block, with whitespace
+	// normalized, including those of nested blocks.
+	lines []string
+}
+
+// parseCorefileServerBlocks parses the server blocks of the given Corefile.  It
+// returns an error if the braces are unbalanced or if a server block has no
+// keys.  Top-level imports are skipped.
+func parseCorefileServerBlocks(corefile string) ([]parsedServerBlock, error) {
+	blocks := []parsedServerBlock{}
+	depth := 0
+	for n, line := range strings.Split(corefile, "\n") {
+		if i := strings.Index(line, "#"); i != -1 {
+			line = line[:i]
+		}
+		fields := strings.Fields(line)
+		if len(fields) == 0 {
+			continue
+		}
+		switch {
+		case depth == 0 && fields[0] == "import":
+			continue
+		case depth == 0:
+			if fields[len(fields)-1] != "{" {
+				return nil, fmt.Errorf("line %d: expected server block keys followed by {, got %q", n+1, line)
+			}
+			if len(fields) == 1 {
+				return nil, fmt.Errorf("line %d: server block without keys", n+1)
+			}
+			blocks = append(blocks, parsedServerBlock{keys: fields[:len(fields)-1]})
+			depth++
+		case len(fields) == 1 && fields[0] == "}":
+			depth--
+		default:
+			block := &blocks[len(blocks)-1]
+			block.lines = append(block.lines, strings.Join(fields, " "))
+			for _, f := range fields {
+				switch f {
+				case "{":
+					depth++
+				case "}":
+					return nil, fmt.Errorf("line %d: unexpected } in %q", n+1, line)
+				}
+			}
+		}
+	}
+	if depth != 0 {
+		return nil, fmt.Errorf("unbalanced braces: depth %d at end of Corefile", depth)
+	}
+	return blocks, nil
+}
+
+func TestCorefileGolden(t *testing.T) {
+	gomaxprocs := int32(4)
+	testCases := []struct {
+		name          string
+		dns           *operatorv1.DNS
+		clusterDomain string
+		ingressHosts  []string
+		extraConfigs  []extraConfig
+		// listenAddresses are the addresses that the usual listener
+		// binds.
+		listenAddresses []string
+		// caBundles maps the name of each CA bundle that the dns pods
+		// have to its hash.
+		caBundles map[string]string
+	}{
+		{
+			name:          "default",
+			dns:           &operatorv1.DNS{},
+			clusterDomain: "cluster.local",
+		},
+		{
+			name: "servers-and-peers",
+			dns: &operatorv1.DNS{
+				Spec: operatorv1.DNSSpec{
+					Servers: []operatorv1.Server{{
+						Name:          "foo",
+						Zones:         []string{"foo.com", "example.org"},
+						ForwardPlugin: operatorv1.ForwardPlugin{Upstreams: []string{"1.1.1.1", "2.2.2.2:5353"}},
+					}},
+					ClusterPeers: []operatorv1.DNSClusterPeer{{
+						Name:          "east",
+						ClusterDomain: "east.local",
+						Nameservers:   []string{"10.0.0.10"},
+					}, {
+						Name:          "duplicate",
+						ClusterDomain: "foo.com",
+						Nameservers:   []string{"10.0.0.11"},
+					}},
+				},
+			},
+			clusterDomain: "cluster.local",
+		},
+		{
+			name: "service-aliases",
+			dns: &operatorv1.DNS{
+				Spec: operatorv1.DNSSpec{
+					ServiceAliases: []operatorv1.DNSServiceAlias{{
+						Name:    "db.example.com",
+						Service: operatorv1.DNSServiceReference{Namespace: "data", Name: "postgres"},
+					}},
+				},
+			},
+			clusterDomain: "cluster.local",
+			ingressHosts:  []string{"app.apps.example.com"},
+		},
+		{
+			name: "internal-names",
+			dns: &operatorv1.DNS{
+				Spec: operatorv1.DNSSpec{
+					InternalNames: operatorv1.DNSInternalNames{
+						Local:       operatorv1.DNSInternalNamesNXDomain,
+						SingleLabel: operatorv1.DNSInternalNamesNXDomain,
+						Zones:       []string{"corp.example.com"},
+					},
+				},
+			},
+			clusterDomain: "cluster.local",
+		},
+		{
+			name: "performance",
+			dns: &operatorv1.DNS{
+				Spec: operatorv1.DNSSpec{
+					LogLevel: operatorv1.DNSLogLevelDebug,
+					Performance: operatorv1.DNSPerformance{
+						ListenSockets: operatorv1.DNSListenSocketsPerCPU,
+						GOMAXPROCS:    gomaxprocs,
+						QueryTimeout:  &metav1.Duration{Duration: 3 * time.Second},
+					},
+					Servers: []operatorv1.Server{{
+						Name:  "foo",
+						Zones: []string{"foo.com"},
+						ForwardPlugin: operatorv1.ForwardPlugin{
+							Upstreams:          []string{"1.1.1.1"},
+							Expire:             &metav1.Duration{Duration: time.Minute},
+							ProtocolPreference: operatorv1.DNSProtocolPreferencePreferUDP,
+						},
+					}},
+				},
+			},
+			clusterDomain: "cluster.local",
+		},
+		{
+			name: "localhost-zones-disabled",
+			dns: &operatorv1.DNS{
+				Spec: operatorv1.DNSSpec{
+					LocalhostZones: operatorv1.LocalhostZonesDisabled,
+				},
+			},
+			clusterDomain: "cluster.local",
+		},
+		{
+			name: "forward-except",
+			dns: &operatorv1.DNS{
+				Spec: operatorv1.DNSSpec{
+					Servers: []operatorv1.Server{
+						{
+							Name:  "corp",
+							Zones: []string{"example.com"},
+							ForwardPlugin: operatorv1.ForwardPlugin{
+								Upstreams: []string{"10.0.0.1"},
+								Except:    []string{"apps.example.com", "Lab.Example.com.", "other.org", "apps.example.com"},
+							},
+						},
+						{
+							Name:          "lab",
+							Zones:         []string{"lab.example.com"},
+							ForwardPlugin: operatorv1.ForwardPlugin{Upstreams: []string{"10.0.0.2"}},
+						},
+					},
+				},
+			},
+			clusterDomain: "cluster.local",
+		},
+		{
+			name: "client-attribution",
+			dns: &operatorv1.DNS{
+				Spec: operatorv1.DNSSpec{
+					LogLevel:             operatorv1.DNSLogLevelTrace,
+					LogClientAttribution: operatorv1.DNSLogClientAttributionPod,
+				},
+			},
+			clusterDomain: "cluster.local",
+		},
+		{
+			name: "rate-limit",
+			dns: &operatorv1.DNS{
+				Spec: operatorv1.DNSSpec{
+					Servers: []operatorv1.Server{
+						{
+							Name:          "corp",
+							Zones:         []string{"corp.example.com"},
+							ForwardPlugin: operatorv1.ForwardPlugin{Upstreams: []string{"10.0.0.1"}},
+							RateLimit:     &operatorv1.DNSRateLimit{RequestsPerSecond: 100, IPv4PrefixLength: 24},
+						},
+						{
+							Name:          "lab",
+							Zones:         []string{"lab.example.com"},
+							ForwardPlugin: operatorv1.ForwardPlugin{Upstreams: []string{"10.0.0.2"}},
+							RateLimit:     &operatorv1.DNSRateLimit{RequestsPerSecond: 10, IPv6PrefixLength: 200, Mode: operatorv1.DNSRateLimitModeReportOnly},
+						},
+						{
+							Name:          "test",
+							Zones:         []string{"test.example.com"},
+							ForwardPlugin: operatorv1.ForwardPlugin{Upstreams: []string{"10.0.0.3"}},
+							RateLimit:     &operatorv1.DNSRateLimit{},
+						},
+					},
+				},
+			},
+			clusterDomain: "cluster.local",
+		},
+		{
+			name: "debug-zone",
+			dns: &operatorv1.DNS{
+				Spec: operatorv1.DNSSpec{
+					DebugZone: operatorv1.DNSDebugZoneEnabled,
+				},
+			},
+			clusterDomain: "cluster.local",
+		},
+		{
+			name: "nsid",
+			dns: &operatorv1.DNS{
+				Spec: operatorv1.DNSSpec{
+					NSID: operatorv1.DNSNSIDEnabled,
+					Servers: []operatorv1.Server{{
+						Name:          "foo",
+						Zones:         []string{"foo.com"},
+						ForwardPlugin: operatorv1.ForwardPlugin{Upstreams: []string{"1.1.1.1"}},
+					}},
+					ClusterPeers: []operatorv1.DNSClusterPeer{{
+						Name:          "east",
+						ClusterDomain: "east.local",
+						Nameservers:   []string{"10.0.0.10"},
+					}},
+				},
+			},
+			clusterDomain: "cluster.local",
+		},
+		{
+			name: "overlapping-servers",
+			dns: &operatorv1.DNS{
+				Spec: operatorv1.DNSSpec{
+					Servers: []operatorv1.Server{
+						{
+							Name:          "corp",
+							Zones:         []string{"example.com", "example.org"},
+							ForwardPlugin: operatorv1.ForwardPlugin{Upstreams: []string{"10.0.0.1"}},
+						},
+						{
+							Name:          "lab",
+							Zones:         []string{"lab.example.com", "Example.org."},
+							ForwardPlugin: operatorv1.ForwardPlugin{Upstreams: []string{"10.0.0.2"}},
+						},
+						{
+							Name:          "shadowed",
+							Zones:         []string{"example.com", "cluster.local"},
+							ForwardPlugin: operatorv1.ForwardPlugin{Upstreams: []string{"10.0.0.3"}},
+						},
+					},
+				},
+			},
+			clusterDomain: "cluster.local",
+		},
+		{
+			name: "extra-config",
+			dns: &operatorv1.DNS{
+				Spec: operatorv1.DNSSpec{
+					AdditionalNetworks: []operatorv1.DNSAdditionalNetwork{{Name: "storage"}},
+				},
+			},
+			clusterDomain: "cluster.local",
+			extraConfigs: []extraConfig{
+				{ref: operatorv1.DNSExtraConfigReference{Name: "hosts", ExtensionPoint: operatorv1.DNSExtensionPointDefaultServer}, hash: "0123456789abcdef"},
+				{ref: operatorv1.DNSExtraConfigReference{Name: "zones", ExtensionPoint: operatorv1.DNSExtensionPointServerBlocks}, hash: "fedcba9876543210"},
+			},
+		},
+		{
+			name: "resolv-conf-reload",
+			dns: &operatorv1.DNS{
+				Spec: operatorv1.DNSSpec{
+					NodeResolver: operatorv1.NodeResolverConfig{
+						ResolvConfReload: operatorv1.NodeResolverResolvConfReloadEnabled,
+					},
+				},
+			},
+			clusterDomain: "cluster.local",
+		},
+		{
+			name: "listen-pod-ip",
+			dns: &operatorv1.DNS{
+				Spec: operatorv1.DNSSpec{
+					ListenAddresses: operatorv1.DNSListenAddressesPodIP,
+					Servers: []operatorv1.Server{{
+						Name:          "foo",
+						Zones:         []string{"foo.com"},
+						ForwardPlugin: operatorv1.ForwardPlugin{Upstreams: []string{"1.1.1.1"}},
+					}},
+					AdditionalNetworks: []operatorv1.DNSAdditionalNetwork{{Name: "storage"}},
+				},
+			},
+			clusterDomain:   "cluster.local",
+			listenAddresses: []string{"{$POD_IP}"},
+		},
+		{
+			name: "forward-tls",
+			dns: &operatorv1.DNS{
+				Spec: operatorv1.DNSSpec{
+					Servers: []operatorv1.Server{{
+						Name:  "corp",
+						Zones: []string{"corp.example.com"},
+						ForwardPlugin: operatorv1.ForwardPlugin{
+							Upstreams: []string{"10.0.0.53", "10.0.1.53:8853"},
+							TransportConfig: operatorv1.DNSTransportConfig{
+								Transport: operatorv1.TLSTransport,
+								TLS: &operatorv1.DNSOverTLSConfig{
+									ServerName: "dns.corp.example.com",
+									CABundle:   configv1.ConfigMapNameReference{Name: "corp-ca"},
+								},
+							},
+						},
+					}, {
+						Name:  "public",
+						Zones: []string{"example.org"},
+						ForwardPlugin: operatorv1.ForwardPlugin{
+							Upstreams: []string{"1.1.1.1"},
+							TransportConfig: operatorv1.DNSTransportConfig{
+								Transport: operatorv1.TLSTransport,
+								TLS:       &operatorv1.DNSOverTLSConfig{ServerName: "cloudflare-dns.com"},
+							},
+						},
+					}},
+				},
+			},
+			clusterDomain: "cluster.local",
+			caBundles:     map[string]string{"corp-ca": "0123456789abcdef"},
+		},
+		{
+			name: "upstream-resolvers",
+			dns: &operatorv1.DNS{
+				Spec: operatorv1.DNSSpec{
+					UpstreamResolvers: operatorv1.UpstreamResolvers{
+						Upstreams: []operatorv1.Upstream{
+							{Type: operatorv1.NetworkResolverType, Address: "10.0.0.53"},
+							{Type: operatorv1.NetworkResolverType, Address: "2001:db8::53", Port: 5353},
+							{Type: operatorv1.SystemResolveConfType},
+						},
+						Policy: operatorv1.RoundRobinForwardingPolicy,
+					},
+				},
+			},
+			clusterDomain: "cluster.local",
+		},
+		{
+			name: "query-logging",
+			dns: &operatorv1.DNS{
+				Spec: operatorv1.DNSSpec{
+					Servers: []operatorv1.Server{{
+						Name:  "corp",
+						Zones: []string{"corp.example.com"},
+						ForwardPlugin: operatorv1.ForwardPlugin{
+							Upstreams: []string{"10.0.0.53"},
+						},
+					}},
+					QueryLogging: []operatorv1.DNSQueryLogRule{
+						{
+							Zones:   []string{"cluster.local", "example.com"},
+							Classes: []operatorv1.DNSQueryLogClass{operatorv1.DNSQueryLogClassDenial},
+						},
+						{
+							Zones:   []string{"storm.corp.example.com"},
+							Classes: []operatorv1.DNSQueryLogClass{operatorv1.DNSQueryLogClassAll},
+						},
+					},
+				},
+			},
+			clusterDomain: "cluster.local",
+		},
+		{
+			name: "cache-ttls",
+			dns: &operatorv1.DNS{
+				Spec: operatorv1.DNSSpec{
+					Cache: operatorv1.DNSCache{
+						PositiveTTL: &metav1.Duration{Duration: time.Hour},
+						NegativeTTL: &metav1.Duration{Duration: 5 * time.Second},
+					},
+				},
+			},
+			clusterDomain: "cluster.local",
+		},
+		{
+			name: "upstream-resolvers-tcp",
+			dns: &operatorv1.DNS{
+				Spec: operatorv1.DNSSpec{
+					UpstreamResolvers: operatorv1.UpstreamResolvers{
+						Upstreams: []operatorv1.Upstream{
+							{Type: operatorv1.NetworkResolverType, Address: "10.0.0.53"},
+						},
+						ProtocolStrategy: operatorv1.ProtocolStrategyTCP,
+					},
+				},
+			},
+			clusterDomain: "cluster.local",
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			cm, err := desiredDNSConfigMap(tc.dns, tc.clusterDomain, tc.ingressHosts, nil, tc.extraConfigs, tc.listenAddresses, tc.caBundles, "")
+			if err != nil {
+				t.Fatalf("failed to render Corefile: %v", err)
+			}
+			actual := cm.Data["Corefile"]
+			path := filepath.Join("testdata", "corefiles", tc.name+".Corefile")
+			if *updateGolden {
+				if err := ioutil.WriteFile(path, []byte(actual), 0644); err != nil {
+					t.Fatal(err)
+				}
+			}
+			expected, err := ioutil.ReadFile(path)
+			if err != nil {
+				t.Fatalf("failed to read golden Corefile (run with -update to create it): %v", err)
+			}
+			if actual != string(expected) {
+				t.Errorf("Corefile differs from %s (run with -update if the change is intended):\n%s", path, cmp.Diff(string(expected), actual))
+			}
+			if _, err := parseCorefileServerBlocks(actual); err != nil {
+				t.Errorf("failed to parse Corefile: %v", err)
+			}
+		})
+	}
+}
+
+// randomLabel returns a random DNS label.
+func randomLabel(rng *rand.Rand) string {
+	const letters = "abcdefghijklmnopqrstuvwxyz0123456789"
+	n := 1 + rng.Intn(8)
+	b := make([]byte, n)
+	for i := range b {
+		b[i] = letters[rng.Intn(len(letters))]
+	}
+	if n > 2 && rng.Intn(4) == 0 {
+		b[n/2] = '-'
+	}
+	return string(b)
+}
+
+// randomDomain returns a random subdomain with the given number of labels.
+func randomDomain(rng *rand.Rand, labels int) string {
+	parts := make([]string, labels)
+	for i := range parts {
+		parts[i] = randomLabel(rng)
+	}
+	return strings.Join(parts, ".")
+}
+
+// randomUpstream returns a random IP address or IP:port.
+func randomUpstream(rng *rand.Rand) string {
+	ip := fmt.Sprintf("%d.%d.%d.%d", 1+rng.Intn(254), rng.Intn(256), rng.Intn(256), 1+rng.Intn(254))
+	if rng.Intn(3) == 0 {
+		return fmt.Sprintf("%s:%d", ip, 1024+rng.Intn(60000))
+	}
+	return ip
+}
+
+// randomDNS returns a random dns with a valid spec.  Zones of servers may be
+// nested in or duplicate the zones of other servers or the cluster domain, and
+// cluster peers and service aliases may overlap with them or with the cluster
+// domain to exercise the deduplication.
+func randomDNS(rng *rand.Rand, clusterDomain string) *operatorv1.DNS {
+	dns := &operatorv1.DNS{}
+	zones := []string{}
+	for i := rng.Intn(5); i > 0; i-- {
+		server := operatorv1.Server{Name: fmt.Sprintf("server-%d", i)}
+		for j := 1 + rng.Intn(3); j > 0; j-- {
+			zone := randomDomain(rng, 1+rng.Intn(3))
+			switch rng.Intn(8) {
+			case 0:
+				zone = clusterDomain
+			case 1, 2:
+				if len(zones) != 0 {
+					zone = zones[rng.Intn(len(zones))]
+				}
+			case 3:
+				if len(zones) != 0 {
+					zone = randomLabel(rng) + "." + zones[rng.Intn(len(zones))]
+				}
+			}
+			zones = append(zones, zone)
+			if rng.Intn(4) == 0 {
+				zone += "."
+			}
+			server.Zones = append(server.Zones, zone)
+		}
+		for j := 1 + rng.Intn(3); j > 0; j-- {
+			server.ForwardPlugin.Upstreams = append(server.ForwardPlugin.Upstreams, randomUpstream(rng))
+		}
+		if rng.Intn(3) == 0 {
+			server.ForwardPlugin.Expire = &metav1.Duration{Duration: time.Duration(rng.Intn(120)) * time.Second}
+		}
+		if rng.Intn(3) == 0 {
+			server.ForwardPlugin.ProtocolPreference = operatorv1.DNSProtocolPreferencePreferUDP
+		}
+		if rng.Intn(3) == 0 {
+			policies := []operatorv1.ForwardingPolicy{operatorv1.RandomForwardingPolicy, operatorv1.RoundRobinForwardingPolicy, operatorv1.SequentialForwardingPolicy}
+			server.ForwardPlugin.Policy = policies[rng.Intn(len(policies))]
+		}
+		if rng.Intn(4) == 0 {
+			server.ForwardPlugin.ProtocolStrategy = operatorv1.ProtocolStrategyTCP
+		}
+		dns.Spec.Servers = append(dns.Spec.Servers, server)
+	}
+	for i := rng.Intn(4); i > 0; i-- {
+		domain := randomDomain(rng, 1+rng.Intn(2))
+		switch rng.Intn(5) {
+		case 0:
+			domain = clusterDomain
+		case 1:
+			if len(zones) != 0 {
+				domain = zones[rng.Intn(len(zones))]
+			}
+		}
+		dns.Spec.ClusterPeers = append(dns.Spec.ClusterPeers, operatorv1.DNSClusterPeer{
+			Name:          fmt.Sprintf("peer-%d", i),
+			ClusterDomain: domain,
+			Nameservers:   []string{randomUpstream(rng)},
+		})
+	}
+	for i := rng.Intn(4); i > 0; i-- {
+		name := randomDomain(rng, 2+rng.Intn(2))
+		if rng.Intn(5) == 0 {
+			name = randomLabel(rng) + "." + clusterDomain
+		}
+		dns.Spec.ServiceAliases = append(dns.Spec.ServiceAliases, operatorv1.DNSServiceAlias{
+			Name:    name,
+			Service: operatorv1.DNSServiceReference{Namespace: randomLabel(rng), Name: randomLabel(rng)},
+		})
+	}
+	if rng.Intn(3) == 0 {
+		dns.Spec.InternalNames.Local = operatorv1.DNSInternalNamesNXDomain
+	}
+	if rng.Intn(3) == 0 {
+		dns.Spec.InternalNames.SingleLabel = operatorv1.DNSInternalNamesNXDomain
+	}
+	for i := rng.Intn(3); i > 0; i-- {
+		zone := randomDomain(rng, 1+rng.Intn(2))
+		if rng.Intn(4) == 0 && len(zones) != 0 {
+			zone = zones[rng.Intn(len(zones))]
+		}
+		dns.Spec.InternalNames.Zones = append(dns.Spec.InternalNames.Zones, zone)
+	}
+	if rng.Intn(2) == 0 {
+		dns.Spec.Performance.ListenSockets = operatorv1.DNSListenSocketsPerCPU
+	}
+	if rng.Intn(2) == 0 {
+		dns.Spec.Performance.QueryTimeout = &metav1.Duration{Duration: time.Duration(rng.Intn(10000)) * time.Millisecond}
+	}
+	dns.Spec.LogLevel = []operatorv1.DNSLogLevel{"", operatorv1.DNSLogLevelNormal, operatorv1.DNSLogLevelDebug, operatorv1.DNSLogLevelTrace}[rng.Intn(4)]
+	return dns
+}
+
+// expectedServerKeys returns the server block keys that the Corefile of the
+// given dns should have for each of its servers, or nil for a server that
+// should not have a server block because its zones are all served already.
+func expectedServerKeys(dns *operatorv1.DNS, clusterDomain string) [][]string {
+	served := map[string]bool{clusterDomain: true}
+	result := [][]string{}
+	for _, server := range dns.Spec.Servers {
+		var keys []string
+		for _, zone := range server.Zones {
+			zone = strings.TrimSuffix(zone, ".")
+			if served[zone] {
+				continue
+			}
+			served[zone] = true
+			keys = append(keys, zone+":5353")
+		}
+		result = append(result, keys)
+	}
+	return result
+}
+
+// expectedCorefileKeys returns the sorted server block keys that the Corefile
+// of the given dns should have.
+func expectedCorefileKeys(dns *operatorv1.DNS, clusterDomain string) []string {
+	keys := []string{".:5353"}
+	served := map[string]bool{clusterDomain: true}
+	for _, serverKeys := range expectedServerKeys(dns, clusterDomain) {
+		for _, key := range serverKeys {
+			keys = append(keys, key)
+			served[strings.TrimSuffix(key, ":5353")] = true
+		}
+	}
+	for _, peer := range dns.Spec.ClusterPeers {
+		if served[peer.ClusterDomain] {
+			continue
+		}
+		served[peer.ClusterDomain] = true
+		keys = append(keys, peer.ClusterDomain+":5353")
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// TestCorefileRandomSpecs renders the Corefile for random valid dns specs and
+// checks that it parses, that it has exactly the expected server blocks, that
+// each server forwards to its upstreams, that the rewrite patterns of the
+// service aliases match the aliases, and that the internal zones do not match
+// names in the cluster domain.
+func TestCorefileRandomSpecs(t *testing.T) {
+	const clusterDomain = "cluster.local"
+	seed := time.Now().UnixNano()
+	rng := rand.New(rand.NewSource(seed))
+	for i := 0; i < 500; i++ {
+		dns := randomDNS(rng, clusterDomain)
+		fail := func(format string, args ...interface{}) {
+			t.Fatalf("seed %d, iteration %d: %s\nspec: %#v", seed, i, fmt.Sprintf(format, args...), dns.Spec)
+		}
+		cm, err := desiredDNSConfigMap(dns, clusterDomain, nil, nil, nil, nil, nil, "")
+		if err != nil {
+			fail("failed to render Corefile: %v", err)
+		}
+		corefile := cm.Data["Corefile"]
+		blocks, err := parseCorefileServerBlocks(corefile)
+		if err != nil {
+			fail("failed to parse Corefile: %v\n%s", err, corefile)
+		}
+
+		keys := []string{}
+		for _, block := range blocks {
+			keys = append(keys, block.keys...)
+		}
+		sort.Strings(keys)
+		if expected := expectedCorefileKeys(dns, clusterDomain); !cmp.Equal(keys, expected) {
+			fail("unexpected server block keys:\n%s\n%s", cmp.Diff(expected, keys), corefile)
+		}
+
+		b := 0
+		for s, serverKeys := range expectedServerKeys(dns, clusterDomain) {
+			server := dns.Spec.Servers[s]
+			if serverKeys == nil {
+				continue
+			}
+			block := blocks[b]
+			b++
+			if !cmp.Equal(block.keys, serverKeys) {
+				fail("server %s rendered with keys %v, expected %v\n%s", server.Name, block.keys, serverKeys, corefile)
+			}
+			forward := "forward . " + strings.Join(server.ForwardPlugin.Upstreams, " ")
+			if len(corefileForwardOptions(dns, server)) != 0 {
+				forward += " {"
+			}
+			if len(block.lines) == 0 || block.lines[0] != forward {
+				fail("server %s does not start with %q\n%s", server.Name, forward, corefile)
+			}
+		}
+
+		for _, alias := range corefileServiceAliases(dns, clusterDomain, nil) {
+			for _, p := range []struct{ pattern, name string }{{alias.NamePattern, alias.Name}, {alias.TargetPattern, alias.Target}} {
+				re, err := regexp.Compile(p.pattern)
+				if err != nil {
+					fail("invalid rewrite pattern %q: %v", p.pattern, err)
+				}
+				if !re.MatchString(p.name) || re.MatchString("x"+p.name) || re.MatchString(strings.Replace(p.name, ".", "x", 1)) {
+					fail("rewrite pattern %q does not match exactly %q", p.pattern, p.name)
+				}
+			}
+			if !strings.Contains(corefile, "name regex "+alias.NamePattern+" "+alias.Target) {
+				fail("Corefile does not rewrite alias %s\n%s", alias.Name, corefile)
+			}
+		}
+
+		for _, line := range blocks[len(blocks)-1].lines {
+			if !strings.HasPrefix(line, "match ") {
+				continue
+			}
+			re, err := regexp.Compile(strings.TrimPrefix(line, "match "))
+			if err != nil {
+				fail("invalid template pattern in %q: %v", line, err)
+			}
+			for _, name := range []string{clusterDomain + ".", "foo.bar." + clusterDomain + "."} {
+				if re.MatchString(name) {
+					fail("template pattern in %q matches %s", line, name)
+				}
+			}
+		}
+	}
+}
@@ -0,0 +1,314 @@
+package controller
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+)
+
+// corefileIndent is the indentation of each level of nesting in a rendered
+// Corefile.
+const corefileIndent = "    "
+
+// corefileConfig is a parsed Corefile.  The operator builds the Corefile of a
+// dns as a corefileConfig and renders it, so that features compose on the
+// structure of the Corefile rather than on its text.
+type corefileConfig struct {
+	// Imports are the imports of server blocks from other files, which
+	// precede the server blocks.
+	Imports []corefileDirective
+	// ServerBlocks are the server blocks in the order in which they
+	// appear.
+	ServerBlocks []corefileServerBlock
+}
+
+// corefileServerBlock is a server block of a Corefile.
+type corefileServerBlock struct {
+	// Comments are the lines of the comment that precedes the block,
+	// without the leading "#".
+	Comments []string
+	// Keys are the zones and ports that the block serves, such as
+	// "example.com:5353".
+	Keys []string
+	// Plugins are the plugins of the block in the order in which they
+	// appear.
+	Plugins []corefileDirective
+}
+
+// corefileDirective is a plugin of a server block, an option of a plugin, or an
+// import.
+type corefileDirective struct {
+	// Comments are the lines of the comment that precedes the directive,
+	// without the leading "#".
+	Comments []string
+	// Name is the name of the plugin or option.
+	Name string
+	// Args are the arguments of the directive.  Quoted arguments keep
+	// their quotes.
+	Args []string
+	// Comment is the comment that follows the directive on the same line,
+	// without the leading "#".
+	Comment string
+	// Options are the directives in the block of the directive, or nil
+	// if it has no block.
+	Options []corefileDirective
+}
+
+// newCorefileDirective returns a directive with the given name and arguments.
+func newCorefileDirective(name string, args ...string) corefileDirective {
+	return corefileDirective{Name: name, Args: args}
+}
+
+// withOptions returns a copy of the directive with the given options appended
+// to its block.
+func (d corefileDirective) withOptions(options ...corefileDirective) corefileDirective {
+	d.Options = append(append([]corefileDirective{}, d.Options...), options...)
+	return d
+}
+
+// String renders the Corefile.
+func (c *corefileConfig) String() string {
+	b := &strings.Builder{}
+	for _, d := range c.Imports {
+		writeCorefileDirective(b, d, 0)
+	}
+	for _, block := range c.ServerBlocks {
+		block.writeTo(b)
+	}
+	return b.String()
+}
+
+// String renders the server block.
+func (s corefileServerBlock) String() string {
+	b := &strings.Builder{}
+	s.writeTo(b)
+	return b.String()
+}
+
+func (s corefileServerBlock) writeTo(b *strings.Builder) {
+	writeCorefileComments(b, s.Comments, 0)
+	b.WriteString(strings.Join(s.Keys, " "))
+	b.WriteString(" {\n")
+	for _, d := range s.Plugins {
+		writeCorefileDirective(b, d, 1)
+	}
+	b.WriteString("}\n")
+}
+
+// writeCorefileDirective renders the given directive at the given level of
+// nesting.  The block of a directive is only rendered if it has options.
+func writeCorefileDirective(b *strings.Builder, d corefileDirective, depth int) {
+	indent := strings.Repeat(corefileIndent, depth)
+	writeCorefileComments(b, d.Comments, depth)
+	b.WriteString(indent)
+	b.WriteString(d.Name)
+	for _, arg := range d.Args {
+		b.WriteString(" ")
+		b.WriteString(arg)
+	}
+	if len(d.Options) != 0 {
+		b.WriteString(" {")
+	}
+	if len(d.Comment) != 0 {
+		b.WriteString(" # ")
+		b.WriteString(d.Comment)
+	}
+	b.WriteString("\n")
+	if len(d.Options) == 0 {
+		return
+	}
+	for _, option := range d.Options {
+		writeCorefileDirective(b, option, depth+1)
+	}
+	b.WriteString(indent)
+	b.WriteString("}\n")
+}
+
+func writeCorefileComments(b *strings.Builder, comments []string, depth int) {
+	for _, comment := range comments {
+		b.WriteString(strings.Repeat(corefileIndent, depth))
+		b.WriteString(strings.TrimSpace("# " + comment))
+		b.WriteString("\n")
+	}
+}
+
+// tokenizeCorefileLine splits a line of a Corefile into its tokens and the
+// comment that ends it, if any.  A quoted token keeps its quotes and escapes,
+// and a "#" only starts a comment at the start of a token.  The tokens are
+// substrings of the line, so that parsing a large Corefile does not copy it
+// rune by rune.
+func tokenizeCorefileLine(line string) ([]string, string, error) {
+	// Most lines have a directive and a few arguments, so that one
+	// allocation holds their tokens.
+	tokens := make([]string, 0, 4)
+	// start is the index of the first byte of the current token, or -1
+	// between tokens.
+	start := -1
+	quoted, escaped := false, false
+	for i, c := range line {
+		switch {
+		case escaped:
+			escaped = false
+		case quoted && c == '\\':
+			escaped = true
+		case c == '"':
+			quoted = !quoted
+		case quoted:
+		case unicode.IsSpace(c):
+			if start != -1 {
+				tokens = append(tokens, line[start:i])
+				start = -1
+			}
+			continue
+		case c == '#' && start == -1:
+			return tokens, strings.TrimSpace(line[i+1:]), nil
+		}
+		if start == -1 {
+			start = i
+		}
+	}
+	if quoted {
+		return nil, "", fmt.Errorf("unterminated quote in %q", line)
+	}
+	if start != -1 {
+		tokens = append(tokens, line[start:])
+	}
+	return tokens, "", nil
+}
+
+// parseCorefile parses the given Corefile.  It returns an error if the braces
+// are unbalanced, if a server block has no keys, or if a directive other than
+// an import is outside of a server block or follows the server blocks.
+func parseCorefile(corefile string) (*corefileConfig, error) {
+	c := &corefileConfig{}
+	// blocks holds the directives of each open block, innermost last.
+	blocks := []*[]corefileDirective{}
+	var comments []string
+	for n, line := range strings.Split(corefile, "\n") {
+		tokens, comment, err := tokenizeCorefileLine(line)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %v", n+1, err)
+		}
+		if len(tokens) == 0 {
+			if strings.HasPrefix(strings.TrimSpace(line), "#") {
+				comments = append(comments, comment)
+			}
+			continue
+		}
+		if tokens[0] == "}" {
+			if len(tokens) != 1 || len(blocks) == 0 {
+				return nil, fmt.Errorf("line %d: unexpected } in %q", n+1, line)
+			}
+			blocks = blocks[:len(blocks)-1]
+			continue
+		}
+		opens := tokens[len(tokens)-1] == "{"
+		if opens {
+			tokens = tokens[:len(tokens)-1]
+		}
+		for _, token := range tokens {
+			if token == "{" || token == "}" {
+				return nil, fmt.Errorf("line %d: unexpected %s in %q", n+1, token, line)
+			}
+		}
+		if len(blocks) == 0 && opens {
+			if len(tokens) == 0 {
+				return nil, fmt.Errorf("line %d: server block without keys", n+1)
+			}
+			c.ServerBlocks = append(c.ServerBlocks, corefileServerBlock{Comments: comments, Keys: tokens})
+			blocks = append(blocks, &c.ServerBlocks[len(c.ServerBlocks)-1].Plugins)
+			comments = nil
+			continue
+		}
+		if len(tokens) == 0 {
+			return nil, fmt.Errorf("line %d: block without a directive", n+1)
+		}
+		d := corefileDirective{Comments: comments, Name: tokens[0], Comment: comment}
+		if len(tokens) > 1 {
+			d.Args = tokens[1:]
+		}
+		comments = nil
+		if len(blocks) == 0 {
+			switch {
+			case d.Name != "import":
+				return nil, fmt.Errorf("line %d: directive %s outside of a server block", n+1, d.Name)
+			case len(c.ServerBlocks) != 0:
+				return nil, fmt.Errorf("line %d: import follows a server block", n+1)
+			}
+			c.Imports = append(c.Imports, d)
+			continue
+		}
+		parent := blocks[len(blocks)-1]
+		*parent = append(*parent, d)
+		if opens {
+			blocks = append(blocks, &(*parent)[len(*parent)-1].Options)
+		}
+	}
+	if len(blocks) != 0 {
+		return nil, fmt.Errorf("unbalanced braces: %d blocks are not closed at the end of the Corefile", len(blocks))
+	}
+	return c, nil
+}
+
+// validate returns an error if the Corefile could not be rendered in a way
+// that parses back to the same structure.
+func (c *corefileConfig) validate() error {
+	for _, d := range c.Imports {
+		if d.Name != "import" || len(d.Options) != 0 {
+			return fmt.Errorf("invalid import %s outside of a server block", d.Name)
+		}
+		if err := d.validate(); err != nil {
+			return err
+		}
+	}
+	for _, block := range c.ServerBlocks {
+		if len(block.Keys) == 0 {
+			return fmt.Errorf("server block without keys")
+		}
+		for _, key := range block.Keys {
+			if !validCorefileToken(key) {
+				return fmt.Errorf("invalid server block key %q", key)
+			}
+		}
+		for _, d := range block.Plugins {
+			if err := d.validate(); err != nil {
+				return fmt.Errorf("server block %s: %v", strings.Join(block.Keys, " "), err)
+			}
+		}
+	}
+	return nil
+}
+
+func (d corefileDirective) validate() error {
+	if !validCorefileToken(d.Name) || strings.HasPrefix(d.Name, `"`) {
+		return fmt.Errorf("invalid directive name %q", d.Name)
+	}
+	for _, arg := range d.Args {
+		if !validCorefileToken(arg) {
+			return fmt.Errorf("invalid argument %q of directive %s", arg, d.Name)
+		}
+	}
+	if strings.ContainsAny(d.Comment, "\n") {
+		return fmt.Errorf("invalid comment of directive %s", d.Name)
+	}
+	for _, option := range d.Options {
+		if err := option.validate(); err != nil {
+			return fmt.Errorf("%s: %v", d.Name, err)
+		}
+	}
+	return nil
+}
+
+// validCorefileToken returns a Boolean indicating whether the given token is
+// rendered as a single token: it is not empty or a brace, and it is either
+// quoted or has no whitespace.
+func validCorefileToken(token string) bool {
+	if len(token) == 0 || token == "{" || token == "}" || strings.Contains(token, "\n") {
+		return false
+	}
+	if token[0] != '#' && !strings.ContainsAny(token, "\" \t\r") {
+		return true
+	}
+	tokens, comment, err := tokenizeCorefileLine(token)
+	return err == nil && len(comment) == 0 && len(tokens) == 1 && tokens[0] == token
+}
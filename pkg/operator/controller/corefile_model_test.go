@@ -0,0 +1,135 @@
+package controller
+
+import (
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestParseCorefile(t *testing.T) {
+	corefile := `import /etc/coredns-extra/zones/[^.]* # abc
+# corp
+corp.example.com:5353 {
+    forward . tls://10.0.0.53 {
+        tls /etc/ca/ca-bundle.crt # def
+    }
+}
+.:5353 {
+    log . "{remote} - \"{type} {name}\" {rcode}" {
+        class denial error
+    }
+    cache 30
+}
+`
+	expected := &corefileConfig{
+		Imports: []corefileDirective{
+			{Name: "import", Args: []string{"/etc/coredns-extra/zones/[^.]*"}, Comment: "abc"},
+		},
+		ServerBlocks: []corefileServerBlock{
+			{
+				Comments: []string{"corp"},
+				Keys:     []string{"corp.example.com:5353"},
+				Plugins: []corefileDirective{
+					{Name: "forward", Args: []string{".", "tls://10.0.0.53"}, Options: []corefileDirective{
+						{Name: "tls", Args: []string{"/etc/ca/ca-bundle.crt"}, Comment: "def"},
+					}},
+				},
+			},
+			{
+				Keys: []string{".:5353"},
+				Plugins: []corefileDirective{
+					{Name: "log", Args: []string{".", `"{remote} - \"{type} {name}\" {rcode}"`}, Options: []corefileDirective{
+						{Name: "class", Args: []string{"denial", "error"}},
+					}},
+					{Name: "cache", Args: []string{"30"}},
+				},
+			},
+		},
+	}
+	actual, err := parseCorefile(corefile)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !cmp.Equal(actual, expected) {
+		t.Errorf("unexpected parsed Corefile:\n%s", cmp.Diff(expected, actual))
+	}
+	if rendered := actual.String(); rendered != corefile {
+		t.Errorf("expected the parsed Corefile to render as:\n%s\ngot:\n%s", corefile, rendered)
+	}
+}
+
+// TestParseCorefileGolden verifies that every golden Corefile parses and renders
+// back to itself.
+func TestParseCorefileGolden(t *testing.T) {
+	paths, err := filepath.Glob(filepath.Join("testdata", "corefiles", "*.Corefile"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(paths) == 0 {
+		t.Fatal("no golden Corefiles")
+	}
+	for _, path := range paths {
+		data, err := ioutil.ReadFile(path)
+		if err != nil {
+			t.Fatal(err)
+		}
+		c, err := parseCorefile(string(data))
+		if err != nil {
+			t.Errorf("%s: %v", path, err)
+			continue
+		}
+		if err := c.validate(); err != nil {
+			t.Errorf("%s: %v", path, err)
+		}
+		if rendered := c.String(); rendered != string(data) {
+			t.Errorf("%s: expected the Corefile to render back to itself, got:\n%s", path, rendered)
+		}
+	}
+}
+
+func TestParseCorefileErrors(t *testing.T) {
+	for _, tc := range []struct {
+		description string
+		corefile    string
+	}{
+		{"unclosed block", ".:5353 {\n    errors\n"},
+		{"unmatched brace", ".:5353 {\n}\n}\n"},
+		{"block without keys", "{\n}\n"},
+		{"plugin outside of a server block", "errors\n"},
+		{"import after a server block", ".:5353 {\n}\nimport foo\n"},
+		{"unterminated quote", ".:5353 {\n    log . \"{remote}\n}\n"},
+		{"brace in the middle of a line", ".:5353 {\n    forward { .\n}\n"},
+	} {
+		if _, err := parseCorefile(tc.corefile); err == nil {
+			t.Errorf("%s: expected an error", tc.description)
+		}
+	}
+}
+
+func TestCorefileConfigValidate(t *testing.T) {
+	valid := corefileServerBlock{Keys: []string{".:5353"}, Plugins: []corefileDirective{newCorefileDirective("log", ".", `"{remote} {name}"`)}}
+	for _, tc := range []struct {
+		description string
+		corefile    *corefileConfig
+		valid       bool
+	}{
+		{"valid", &corefileConfig{ServerBlocks: []corefileServerBlock{valid}}, true},
+		{"no keys", &corefileConfig{ServerBlocks: []corefileServerBlock{{Plugins: valid.Plugins}}}, false},
+		{"argument with a space", &corefileConfig{ServerBlocks: []corefileServerBlock{{Keys: valid.Keys, Plugins: []corefileDirective{newCorefileDirective("forward", ". 1.1.1.1")}}}}, false},
+		{"empty argument", &corefileConfig{ServerBlocks: []corefileServerBlock{{Keys: valid.Keys, Plugins: []corefileDirective{newCorefileDirective("nsid", "")}}}}, false},
+		{"brace argument", &corefileConfig{ServerBlocks: []corefileServerBlock{{Keys: valid.Keys, Plugins: []corefileDirective{newCorefileDirective("forward", "{")}}}}, false},
+		{"comment argument", &corefileConfig{ServerBlocks: []corefileServerBlock{{Keys: valid.Keys, Plugins: []corefileDirective{newCorefileDirective("forward", "#foo")}}}}, false},
+		{"invalid option", &corefileConfig{ServerBlocks: []corefileServerBlock{{Keys: valid.Keys, Plugins: []corefileDirective{newCorefileDirective("forward", ".").withOptions(newCorefileDirective(""))}}}}, false},
+		{"plugin import", &corefileConfig{Imports: []corefileDirective{newCorefileDirective("errors")}}, false},
+	} {
+		err := tc.corefile.validate()
+		if tc.valid && err != nil {
+			t.Errorf("%s: unexpected error: %v", tc.description, err)
+		}
+		if !tc.valid && err == nil {
+			t.Errorf("%s: expected an error", tc.description)
+		}
+	}
+}
@@ -0,0 +1,249 @@
+package controller
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"sort"
+	"strings"
+
+	operatorv1 "github.com/openshift/api/operator/v1"
+
+	"github.com/sirupsen/logrus"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+const (
+	// corefileConfigMapSizeLimit is the number of bytes of data that the
+	// operator puts in a single configmap.  The API rejects configmaps
+	// with more than 1 MiB of data; the margin leaves room for the keys
+	// and metadata.
+	corefileConfigMapSizeLimit = 900 * 1024
+
+	// maxCorefileParts is the number of configmaps into which the
+	// Corefiles of a dns may be split in addition to the dns configmap.
+	// The dns pods mount all of them whether or not they exist, so that
+	// splitting the Corefiles does not change the pod template.
+	maxCorefileParts = 4
+
+	// corefilePartsVolumeName is the name of the volume of the dns pods
+	// that holds the parts of split Corefiles.
+	corefilePartsVolumeName = "config-parts"
+
+	// corefilePartsMountPath is the directory in which the dns container
+	// mounts the parts of split Corefiles.  It is not below the directory
+	// of the dns configmap because that volume is read-only.
+	corefilePartsMountPath = "/etc/coredns-parts"
+)
+
+// configMapDataSize returns the number of bytes of the given configmap data.
+func configMapDataSize(data map[string]string) int {
+	size := 0
+	for key, value := range data {
+		size += len(key) + len(value)
+	}
+	return size
+}
+
+// splitCorefiles splits the Corefiles in the given configmap data if they
+// exceed the given limit.  If they do not, the data is returned unchanged and
+// there are no parts.  Otherwise, each Corefile is split between server blocks
+// into chunks that are written to the returned parts, which are the data of
+// the part configmaps, and is replaced with a Corefile that imports its
+// chunks.
+//
+// Each chunk is named after the hash of its contents, so that the Corefile
+// that imports it changes whenever the chunk does.  CoreDNS's reload plugin
+// only watches the Corefile itself, so this makes it reload on any change to
+// the parts.
+func splitCorefiles(data map[string]string, limit int) (map[string]string, []map[string]string, error) {
+	if configMapDataSize(data) <= limit {
+		return data, nil, nil
+	}
+	keys := []string{}
+	for key := range data {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	main := map[string]string{}
+	parts := []map[string]string{}
+	sizes := []int{}
+	for _, key := range keys {
+		imports := []string{fmt.Sprintf("# %s is split into parts because it exceeds the size of a configmap.", key)}
+		blocks, err := corefileBlocks(data[key])
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to parse %s: %v", key, err)
+		}
+		for _, chunk := range corefileChunks(blocks, limit) {
+			sum := sha256.Sum256([]byte(chunk))
+			chunkKey := key + "-" + hex.EncodeToString(sum[:8])
+			size := len(chunkKey) + len(chunk)
+			if size > limit {
+				return nil, nil, fmt.Errorf("a server block of %s is larger than the limit of %d bytes", key, limit)
+			}
+			// Put the chunk in the first part that has room.
+			i := 0
+			for ; i < len(parts) && sizes[i]+size > limit; i++ {
+			}
+			if i == len(parts) {
+				if len(parts) == maxCorefileParts {
+					return nil, nil, fmt.Errorf("the Corefiles do not fit in %d configmaps of %d bytes", maxCorefileParts+1, limit)
+				}
+				parts = append(parts, map[string]string{})
+				sizes = append(sizes, 0)
+			}
+			parts[i][chunkKey] = chunk
+			sizes[i] += size
+			imports = append(imports, "import "+corefilePartsMountPath+"/"+chunkKey)
+		}
+		main[key] = strings.Join(imports, "\n") + "\n"
+	}
+	return main, parts, nil
+}
+
+// corefileChunks joins the given server blocks into chunks of at most the
+// given number of bytes, except that a block that is larger than that is a
+// chunk by itself.
+func corefileChunks(blocks []string, limit int) []string {
+	chunks := []string{}
+	current := ""
+	for _, block := range blocks {
+		if len(current) != 0 && len(current)+len(block) > limit {
+			chunks = append(chunks, current)
+			current = ""
+		}
+		current += block
+	}
+	if len(current) != 0 {
+		chunks = append(chunks, current)
+	}
+	return chunks
+}
+
+// corefileBlocks splits the given Corefile into its top-level server blocks.
+// Each block includes the comments that precede it, and the first block also
+// includes the imports that precede the server blocks.
+func corefileBlocks(corefile string) ([]string, error) {
+	c, err := parseCorefile(corefile)
+	if err != nil {
+		return nil, err
+	}
+	blocks := []string{}
+	for _, block := range c.ServerBlocks {
+		blocks = append(blocks, block.String())
+	}
+	if len(c.Imports) != 0 {
+		imports := (&corefileConfig{Imports: c.Imports}).String()
+		if len(blocks) == 0 {
+			blocks = append(blocks, "")
+		}
+		blocks[0] = imports + blocks[0]
+	}
+	return blocks, nil
+}
+
+// desiredCorefilePartConfigMaps returns the part configmaps of the given dns
+// with the given data.
+func desiredCorefilePartConfigMaps(dns *operatorv1.DNS, parts []map[string]string) []*corev1.ConfigMap {
+	configmaps := []*corev1.ConfigMap{}
+	for i, data := range parts {
+		name := DNSCorefilePartConfigMapName(dns, i)
+		cm := &corev1.ConfigMap{}
+		cm.Name = name.Name
+		cm.Namespace = name.Namespace
+		cm.Data = data
+		cm.SetOwnerReferences([]metav1.OwnerReference{dnsOwnerRef(dns)})
+		configmaps = append(configmaps, cm)
+	}
+	return configmaps
+}
+
+// ensureCorefilePartConfigMaps ensures that the part configmaps of the given
+// dns have the given data.  The parts must be written before the dns configmap
+// that imports them so that CoreDNS never loads a Corefile whose parts are
+// missing.
+func (r *reconciler) ensureCorefilePartConfigMaps(dns *operatorv1.DNS, parts []map[string]string) error {
+	for _, desired := range desiredCorefilePartConfigMaps(dns, parts) {
+		current := &corev1.ConfigMap{}
+		if err := r.client.Get(context.TODO(), types.NamespacedName{Namespace: desired.Namespace, Name: desired.Name}, current); err != nil {
+			if !errors.IsNotFound(err) {
+				return fmt.Errorf("failed to get configmap %s/%s: %v", desired.Namespace, desired.Name, err)
+			}
+			if err := r.client.Create(context.TODO(), desired); err != nil {
+				return fmt.Errorf("failed to create configmap %s/%s: %v", desired.Namespace, desired.Name, err)
+			}
+			logrus.Infof("created Corefile part configmap: %s/%s", desired.Namespace, desired.Name)
+			continue
+		}
+		if changed, updated := corefileChanged(current, desired); changed {
+			if err := r.client.Update(context.TODO(), updated); err != nil {
+				return fmt.Errorf("failed to update configmap %s/%s: %v", updated.Namespace, updated.Name, err)
+			}
+			logrus.Infof("updated Corefile part configmap: %s/%s", updated.Namespace, updated.Name)
+		}
+	}
+	return nil
+}
+
+// deleteUnusedCorefilePartConfigMaps deletes the part configmaps of the given
+// dns beyond the given number of parts.  They must be deleted only after the
+// dns configmap no longer imports them.
+func (r *reconciler) deleteUnusedCorefilePartConfigMaps(dns *operatorv1.DNS, used int) error {
+	for i := used; i < maxCorefileParts; i++ {
+		cm := &corev1.ConfigMap{}
+		name := DNSCorefilePartConfigMapName(dns, i)
+		cm.Name = name.Name
+		cm.Namespace = name.Namespace
+		if err := r.client.Delete(context.TODO(), cm); err != nil {
+			if errors.IsNotFound(err) {
+				continue
+			}
+			return fmt.Errorf("failed to delete configmap %s/%s: %v", cm.Namespace, cm.Name, err)
+		}
+		logrus.Infof("deleted Corefile part configmap: %s/%s", cm.Namespace, cm.Name)
+	}
+	return nil
+}
+
+// setCorefilePartsVolume adds the volume with the part configmaps of the given
+// dns to the given dns daemonset and mounts it in the dns container.  Each
+// part is optional so that the pods start whether or not the Corefiles are
+// split.
+func setCorefilePartsVolume(daemonset *appsv1.DaemonSet, dns *operatorv1.DNS) {
+	optional := true
+	sources := []corev1.VolumeProjection{}
+	for i := 0; i < maxCorefileParts; i++ {
+		sources = append(sources, corev1.VolumeProjection{
+			ConfigMap: &corev1.ConfigMapProjection{
+				LocalObjectReference: corev1.LocalObjectReference{
+					Name: DNSCorefilePartConfigMapName(dns, i).Name,
+				},
+				Optional: &optional,
+			},
+		})
+	}
+	daemonset.Spec.Template.Spec.Volumes = append(daemonset.Spec.Template.Spec.Volumes, corev1.Volume{
+		Name: corefilePartsVolumeName,
+		VolumeSource: corev1.VolumeSource{
+			Projected: &corev1.ProjectedVolumeSource{Sources: sources},
+		},
+	})
+	for i, c := range daemonset.Spec.Template.Spec.Containers {
+		if c.Name != "dns" {
+			continue
+		}
+		daemonset.Spec.Template.Spec.Containers[i].VolumeMounts = append(daemonset.Spec.Template.Spec.Containers[i].VolumeMounts, corev1.VolumeMount{
+			Name:      corefilePartsVolumeName,
+			MountPath: corefilePartsMountPath,
+			ReadOnly:  true,
+		})
+	}
+}
@@ -0,0 +1,132 @@
+package controller
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	operatorv1 "github.com/openshift/api/operator/v1"
+)
+
+func TestCorefileBlocks(t *testing.T) {
+	corefile := `# corp
+corp.example.com:5353 {
+    forward . 10.0.0.1
+}
+.:5353 {
+    log . "{remote} - \"{type} {name}\" {rcode}" {
+        class all
+    }
+    kubernetes cluster.local {
+        pods insecure
+    }
+}
+`
+	expected := []string{
+		"# corp\ncorp.example.com:5353 {\n    forward . 10.0.0.1\n}\n",
+		".:5353 {\n    log . \"{remote} - \\\"{type} {name}\\\" {rcode}\" {\n        class all\n    }\n    kubernetes cluster.local {\n        pods insecure\n    }\n}\n",
+	}
+	actual, err := corefileBlocks(corefile)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !cmp.Equal(actual, expected) {
+		t.Errorf("expected blocks:\n%q\ngot:\n%q", expected, actual)
+	}
+}
+
+// serversCorefile returns the Corefile of a dns with the given number of
+// servers.
+func serversCorefile(t *testing.T, servers int, upstream string) string {
+	t.Helper()
+	dns := &operatorv1.DNS{}
+	for i := 0; i < servers; i++ {
+		name := fmt.Sprintf("server-%d", i)
+		dns.Spec.Servers = append(dns.Spec.Servers, operatorv1.Server{
+			Name:          name,
+			Zones:         []string{name + ".example.com"},
+			ForwardPlugin: operatorv1.ForwardPlugin{Upstreams: []string{upstream, "10.0.0.2"}},
+		})
+	}
+	corefile, err := renderCorefile(dns, "cluster.local", nil, nil, nil, nil, nil, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	return corefile
+}
+
+func TestSplitCorefiles(t *testing.T) {
+	corefile := serversCorefile(t, 6, "10.0.0.1")
+	data := map[string]string{"Corefile": corefile}
+
+	// Corefiles within the limit are not split.
+	main, parts, err := splitCorefiles(data, configMapDataSize(data))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !cmp.Equal(main, data) || len(parts) != 0 {
+		t.Errorf("expected Corefile not to be split, got %v and %d parts", main, len(parts))
+	}
+
+	// Corefiles beyond the limit are split between server blocks, and
+	// importing the chunks in order yields the Corefile.
+	limit := 0
+	blocks, err := corefileBlocks(corefile)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, block := range blocks {
+		if len(block) > limit {
+			limit = len(block)
+		}
+	}
+	limit += 64
+	main, parts, err = splitCorefiles(data, limit)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(parts) < 2 {
+		t.Fatalf("expected the Corefile to be split into several parts, got %d", len(parts))
+	}
+	chunks := map[string]string{}
+	for _, part := range parts {
+		if size := configMapDataSize(part); size > limit {
+			t.Errorf("expected part to be at most %d bytes, got %d", limit, size)
+		}
+		for key, chunk := range part {
+			chunks[key] = chunk
+		}
+	}
+	joined := ""
+	for _, line := range strings.Split(strings.TrimSpace(main["Corefile"]), "\n") {
+		if strings.HasPrefix(line, "#") {
+			continue
+		}
+		key := strings.TrimPrefix(line, "import "+corefilePartsMountPath+"/")
+		chunk, ok := chunks[key]
+		if !ok {
+			t.Fatalf("Corefile imports missing chunk %q", key)
+		}
+		joined += chunk
+	}
+	if joined != corefile {
+		t.Errorf("expected the chunks to make up the Corefile:\n%s\ngot:\n%s", corefile, joined)
+	}
+
+	// Changing a server changes the Corefile that imports it, so that
+	// CoreDNS reloads it.
+	changed, _, err := splitCorefiles(map[string]string{"Corefile": serversCorefile(t, 6, "10.0.0.3")}, limit)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if changed["Corefile"] == main["Corefile"] {
+		t.Errorf("expected the split Corefile to change with its parts")
+	}
+
+	// Corefiles that need too many parts are rejected.
+	if _, _, err := splitCorefiles(map[string]string{"Corefile": serversCorefile(t, 40, "10.0.0.1")}, limit); err == nil {
+		t.Errorf("expected an error for a Corefile that does not fit in %d parts", maxCorefileParts)
+	}
+}
@@ -0,0 +1,146 @@
+package controller
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	operatorv1 "github.com/openshift/api/operator/v1"
+	"github.com/openshift/cluster-dns-operator/pkg/manifests"
+
+	"github.com/openshift/cluster-dns-operator/pkg/util/conditions"
+
+	"github.com/sirupsen/logrus"
+
+	appsv1 "k8s.io/api/apps/v1"
+
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// DNSDaemonSetUpdateRejectedConditionType is the type of the dns status
+// condition that reports that the API server rejects updates of the daemonsets
+// of the dns, for example because an admission webhook or a security context
+// constraint does not allow the updated pod template.
+const DNSDaemonSetUpdateRejectedConditionType = "DaemonSetUpdateRejected"
+
+// daemonsetRejectionKey identifies a daemonset of a dns.
+type daemonsetRejectionKey struct {
+	dns  string
+	name types.NamespacedName
+}
+
+// daemonsetRejectionTracker remembers the error with which the API server
+// rejected the last dry run of an update of each daemonset.
+type daemonsetRejectionTracker struct {
+	lock       sync.Mutex
+	rejections map[daemonsetRejectionKey]string
+}
+
+// record records the result of a dry run of an update of the named daemonset of
+// the named dns.  An empty message means that the update was accepted.
+func (t *daemonsetRejectionTracker) record(dns string, name types.NamespacedName, message string) {
+	if t == nil {
+		return
+	}
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	key := daemonsetRejectionKey{dns: dns, name: name}
+	if len(message) == 0 {
+		delete(t.rejections, key)
+		return
+	}
+	if t.rejections == nil {
+		t.rejections = map[daemonsetRejectionKey]string{}
+	}
+	t.rejections[key] = message
+}
+
+// rejected returns a description of each daemonset of the named dns whose last
+// update was rejected, sorted by the name of the daemonset.
+func (t *daemonsetRejectionTracker) rejected(dns string) []string {
+	if t == nil {
+		return nil
+	}
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	keys := []daemonsetRejectionKey{}
+	for key := range t.rejections {
+		if key.dns == dns {
+			keys = append(keys, key)
+		}
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		return keys[i].name.String() < keys[j].name.String()
+	})
+	descriptions := []string{}
+	for _, key := range keys {
+		descriptions = append(descriptions, fmt.Sprintf("%s: %s", key.name, t.rejections[key]))
+	}
+	return descriptions
+}
+
+// forget removes the rejections of the named dns.
+func (t *daemonsetRejectionTracker) forget(dns string) {
+	if t == nil {
+		return
+	}
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	for key := range t.rejections {
+		if key.dns == dns {
+			delete(t.rejections, key)
+		}
+	}
+}
+
+// dryRunRejected returns a Boolean indicating whether the given error of a dry
+// run of an update means that validation or admission rejects the update, in
+// which case the update itself would fail the same way.  Other errors, such as
+// a conflict or an admission webhook that does not support dry runs, do not.
+func dryRunRejected(err error) bool {
+	return errors.IsInvalid(err) || errors.IsForbidden(err)
+}
+
+// dryRunDaemonSetUpdate performs a server-side dry run of updating the given
+// daemonset and records whether the API server rejected it.  It returns an
+// error if the update was rejected, so that the daemonset is not updated
+// until whatever rejects it is resolved.
+func (r *reconciler) dryRunDaemonSetUpdate(updated *appsv1.DaemonSet) error {
+	dns := updated.Labels[manifests.OwningDNSLabel]
+	name := types.NamespacedName{Namespace: updated.Namespace, Name: updated.Name}
+	err := r.client.Update(context.TODO(), updated.DeepCopy(), client.DryRunAll)
+	switch {
+	case err == nil:
+		r.updateRejections.record(dns, name, "")
+	case dryRunRejected(err):
+		r.updateRejections.record(dns, name, err.Error())
+		return fmt.Errorf("dry run of update of dns daemonset %s was rejected: %v", name, err)
+	default:
+		logrus.Warningf("failed to dry run update of dns daemonset %s; updating it anyway: %v", name, err)
+	}
+	return nil
+}
+
+// computeDNSDaemonSetUpdateRejectedCondition computes the dns
+// DaemonSetUpdateRejected status condition from the given descriptions of the
+// daemonsets whose updates were rejected.
+func computeDNSDaemonSetUpdateRejectedCondition(oldConditions []operatorv1.OperatorCondition, rejected []string) operatorv1.OperatorCondition {
+	condition := &operatorv1.OperatorCondition{
+		Type: DNSDaemonSetUpdateRejectedConditionType,
+	}
+	if len(rejected) == 0 {
+		condition.Status = operatorv1.ConditionFalse
+		condition.Reason = "AsExpected"
+		condition.Message = "The API server accepts updates of the DNS DaemonSets"
+	} else {
+		condition.Status = operatorv1.ConditionTrue
+		condition.Reason = "DryRunRejected"
+		condition.Message = fmt.Sprintf("The API server rejected a dry run of updates of DaemonSets, which were therefore not updated: %s", strings.Join(rejected, "; "))
+	}
+	return conditions.SetOperatorConditionTransitionTime(condition, conditions.FindOperatorCondition(oldConditions, DNSDaemonSetUpdateRejectedConditionType))
+}
@@ -0,0 +1,72 @@
+package controller
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	operatorv1 "github.com/openshift/api/operator/v1"
+
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+func TestDryRunRejected(t *testing.T) {
+	resource := schema.GroupResource{Group: "apps", Resource: "daemonsets"}
+	kind := schema.GroupKind{Group: "apps", Kind: "DaemonSet"}
+	for _, tc := range []struct {
+		description string
+		err         error
+		expected    bool
+	}{
+		{"invalid", errors.NewInvalid(kind, "dns-default", field.ErrorList{field.Forbidden(field.NewPath("spec"), "not allowed")}), true},
+		{"forbidden", errors.NewForbidden(resource, "dns-default", fmt.Errorf("unable to validate against any security context constraint")), true},
+		{"conflict", errors.NewConflict(resource, "dns-default", fmt.Errorf("object was modified")), false},
+		{"webhook without dry run support", errors.NewBadRequest("admission webhook does not support dry run"), false},
+	} {
+		if actual := dryRunRejected(tc.err); actual != tc.expected {
+			t.Errorf("%s: expected %t, got %t", tc.description, tc.expected, actual)
+		}
+	}
+}
+
+func TestDaemonSetRejectionTracker(t *testing.T) {
+	tracker := &daemonsetRejectionTracker{}
+	foo := types.NamespacedName{Namespace: "openshift-dns", Name: "dns-default-foo"}
+	base := types.NamespacedName{Namespace: "openshift-dns", Name: "dns-default"}
+	tracker.record("default", foo, "forbidden")
+	tracker.record("default", base, "invalid")
+	tracker.record("other", base, "invalid")
+	expected := []string{"openshift-dns/dns-default: invalid", "openshift-dns/dns-default-foo: forbidden"}
+	if actual := tracker.rejected("default"); !cmp.Equal(actual, expected) {
+		t.Errorf("expected %v, got %v", expected, actual)
+	}
+
+	tracker.record("default", base, "")
+	expected = []string{"openshift-dns/dns-default-foo: forbidden"}
+	if actual := tracker.rejected("default"); !cmp.Equal(actual, expected) {
+		t.Errorf("expected %v after an accepted update, got %v", expected, actual)
+	}
+
+	tracker.forget("default")
+	if actual := tracker.rejected("default"); len(actual) != 0 {
+		t.Errorf("expected no rejections after forgetting the dns, got %v", actual)
+	}
+	if actual := tracker.rejected("other"); len(actual) != 1 {
+		t.Errorf("expected the rejection of the other dns to be kept, got %v", actual)
+	}
+}
+
+func TestComputeDNSDaemonSetUpdateRejectedCondition(t *testing.T) {
+	condition := computeDNSDaemonSetUpdateRejectedCondition(nil, nil)
+	if condition.Status != operatorv1.ConditionFalse || condition.Reason != "AsExpected" {
+		t.Errorf("expected AsExpected condition, got %+v", condition)
+	}
+	condition = computeDNSDaemonSetUpdateRejectedCondition(nil, []string{"openshift-dns/dns-default: forbidden"})
+	if condition.Status != operatorv1.ConditionTrue || condition.Reason != "DryRunRejected" {
+		t.Errorf("expected DryRunRejected condition, got %+v", condition)
+	}
+}
@@ -0,0 +1,174 @@
+package controller
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	operatorv1 "github.com/openshift/api/operator/v1"
+
+	"github.com/openshift/cluster-dns-operator/pkg/util/conditions"
+
+	dto "github.com/prometheus/client_model/go"
+)
+
+const (
+	// DNSCacheHitRatioLowConditionType is the type of the dns status
+	// condition that reports whether the cache hit ratio of CoreDNS across
+	// the cluster has collapsed.
+	DNSCacheHitRatioLowConditionType = "CacheHitRatioLow"
+
+	// cacheHitRatioWarningPercent is the cluster-wide cache hit ratio
+	// below which the ratio is considered to have collapsed, which often
+	// means that a client is flooding CoreDNS with queries for names that
+	// do not exist, for example because of the search path expansion of
+	// names with fewer dots than ndots.
+	cacheHitRatioWarningPercent = 30
+
+	// minCacheLookupsForHitRatio is the number of cache lookups in the
+	// sample interval below which the hit ratio is not judged, so that a
+	// nearly idle cluster does not report a collapse.
+	minCacheLookupsForHitRatio = 1000
+
+	// maxCacheHitRatioNodes is the number of nodes with the lowest hit
+	// ratio that are listed in the status condition.
+	maxCacheHitRatioNodes = 5
+)
+
+// cacheHitRatioSample is the cache hit ratio of the pods of a dns over the
+// sample interval.
+type cacheHitRatioSample struct {
+	// lookups is the number of cache lookups across all pods.
+	lookups int64
+	// percent is the cluster-wide cache hit ratio.
+	percent int64
+	// nodePercents maps the name of each node with lookups to the cache
+	// hit ratio of the dns pod on that node.
+	nodePercents map[string]int64
+}
+
+// collapsed returns a Boolean indicating whether the sample shows that the
+// cluster-wide cache hit ratio collapsed.
+func (s *cacheHitRatioSample) collapsed() bool {
+	return s.lookups >= minCacheLookupsForHitRatio && s.percent < cacheHitRatioWarningPercent
+}
+
+// lowestNodes returns up to maxCacheHitRatioNodes nodes with the lowest cache
+// hit ratio, sorted by name.
+func (s *cacheHitRatioSample) lowestNodes() []string {
+	nodes := []string{}
+	for node := range s.nodePercents {
+		nodes = append(nodes, node)
+	}
+	sort.Slice(nodes, func(i, j int) bool {
+		if s.nodePercents[nodes[i]] != s.nodePercents[nodes[j]] {
+			return s.nodePercents[nodes[i]] < s.nodePercents[nodes[j]]
+		}
+		return nodes[i] < nodes[j]
+	})
+	if len(nodes) > maxCacheHitRatioNodes {
+		nodes = nodes[:maxCacheHitRatioNodes]
+	}
+	sort.Strings(nodes)
+	return nodes
+}
+
+// cacheHitRatioTracker remembers the cache counters from the previous sample of
+// each dns pod so that the hit ratio is measured over the sample interval
+// rather than over the lifetime of the pods.
+type cacheHitRatioTracker struct {
+	lock sync.Mutex
+	last map[string]map[string]podCacheStats
+}
+
+// update records the given cache counters of the pods of the named dns, keyed
+// by pod name, and returns the cache hit ratio since the previous sample, with
+// the ratio of each pod attributed to the node in the given map of pod names to
+// node names.  Pods that were not sampled before, or whose counters were reset
+// because the container restarted, are measured over the lifetime of the
+// container.
+func (t *cacheHitRatioTracker) update(dns string, current map[string]podCacheStats, podNodes map[string]string) *cacheHitRatioSample {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	if t.last == nil {
+		t.last = map[string]map[string]podCacheStats{}
+	}
+	sample := &cacheHitRatioSample{nodePercents: map[string]int64{}}
+	var hits int64
+	for pod, c := range current {
+		delta := c
+		if last, ok := t.last[dns][pod]; ok && c.hits >= last.hits && c.misses >= last.misses {
+			delta = podCacheStats{hits: c.hits - last.hits, misses: c.misses - last.misses}
+		}
+		lookups := delta.hits + delta.misses
+		if lookups == 0 {
+			continue
+		}
+		hits += delta.hits
+		sample.lookups += lookups
+		if node, ok := podNodes[pod]; ok {
+			sample.nodePercents[node] = delta.hits * 100 / lookups
+		}
+	}
+	if sample.lookups > 0 {
+		sample.percent = hits * 100 / sample.lookups
+	}
+	t.last[dns] = current
+	return sample
+}
+
+// forget removes the counters of the named dns.
+func (t *cacheHitRatioTracker) forget(dns string) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	delete(t.last, dns)
+}
+
+// sampleCacheHitRatio returns the cache hit ratio of the given dns from the
+// given metrics of its pods, keyed by pod name.
+func (r *reconciler) sampleCacheHitRatio(dns *operatorv1.DNS, podMetrics map[string]map[string]*dto.MetricFamily) (*cacheHitRatioSample, error) {
+	pods, err := r.listDNSPods(dns)
+	if err != nil {
+		return nil, err
+	}
+	podNodes := map[string]string{}
+	for _, pod := range pods.Items {
+		if len(pod.Spec.NodeName) != 0 {
+			podNodes[pod.Name] = pod.Spec.NodeName
+		}
+	}
+	current := map[string]podCacheStats{}
+	for pod, families := range podMetrics {
+		current[pod] = parseCacheStats(families)
+	}
+	return r.cacheHitRatio.update(dns.Name, current, podNodes), nil
+}
+
+// computeDNSCacheHitRatioLowCondition computes the dns CacheHitRatioLow status
+// condition from the given sample.  If sample is nil, the old condition is
+// kept.
+func computeDNSCacheHitRatioLowCondition(oldConditions []operatorv1.OperatorCondition, sample *cacheHitRatioSample) *operatorv1.OperatorCondition {
+	oldCondition := conditions.FindOperatorCondition(oldConditions, DNSCacheHitRatioLowConditionType)
+	if sample == nil {
+		return oldCondition
+	}
+
+	condition := &operatorv1.OperatorCondition{
+		Type: DNSCacheHitRatioLowConditionType,
+	}
+	if !sample.collapsed() {
+		condition.Status = operatorv1.ConditionFalse
+		condition.Reason = "AsExpected"
+		condition.Message = fmt.Sprintf("The CoreDNS cache hit ratio is at least %d%% or there are too few queries to judge it", cacheHitRatioWarningPercent)
+	} else {
+		// Leave the ratios out of the message so that the transition
+		// time only changes when the set of nodes does.
+		condition.Status = operatorv1.ConditionTrue
+		condition.Reason = "HitRatioCollapsed"
+		condition.Message = fmt.Sprintf("The CoreDNS cache hit ratio across the cluster is below %d%%, which often means that a client sends many queries for names that do not exist; the nodes with the lowest hit ratio are: %s", cacheHitRatioWarningPercent, strings.Join(sample.lowestNodes(), ", "))
+	}
+	c := conditions.SetOperatorConditionTransitionTime(condition, oldCondition)
+	return &c
+}
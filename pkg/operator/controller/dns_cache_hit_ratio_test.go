@@ -0,0 +1,100 @@
+package controller
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	operatorv1 "github.com/openshift/api/operator/v1"
+)
+
+func TestCacheHitRatioTracker(t *testing.T) {
+	tracker := &cacheHitRatioTracker{}
+	podNodes := map[string]string{"a": "node-a", "b": "node-b", "c": "node-c"}
+	sample := tracker.update("default", map[string]podCacheStats{
+		"a": {hits: 900, misses: 100},
+		"b": {hits: 500, misses: 500},
+	}, podNodes)
+	if sample.lookups != 2000 || sample.percent != 70 {
+		t.Errorf("expected 2000 lookups at 70%%, got %d at %d%%", sample.lookups, sample.percent)
+	}
+	// Pod b restarted, so it is measured over its lifetime, and pod c has
+	// no lookups, so it has no ratio.
+	sample = tracker.update("default", map[string]podCacheStats{
+		"a": {hits: 1000, misses: 1000},
+		"b": {hits: 10, misses: 90},
+		"c": {},
+	}, podNodes)
+	expected := map[string]int64{"node-a": 10, "node-b": 10}
+	if sample.lookups != 1100 || sample.percent != 10 || !reflect.DeepEqual(sample.nodePercents, expected) {
+		t.Errorf("expected 1100 lookups at 10%% with nodes %v, got %d at %d%% with nodes %v", expected, sample.lookups, sample.percent, sample.nodePercents)
+	}
+	if !sample.collapsed() {
+		t.Errorf("expected the hit ratio to be reported as collapsed")
+	}
+	tracker.forget("default")
+	sample = tracker.update("default", map[string]podCacheStats{"a": {hits: 1000, misses: 1000}}, podNodes)
+	if sample.lookups != 2000 {
+		t.Errorf("expected the lifetime lookups after the dns is forgotten, got %d", sample.lookups)
+	}
+}
+
+func TestCacheHitRatioSampleCollapsed(t *testing.T) {
+	testCases := []struct {
+		description string
+		sample      cacheHitRatioSample
+		expected    bool
+	}{
+		{"high ratio", cacheHitRatioSample{lookups: 5000, percent: 80}, false},
+		{"low ratio", cacheHitRatioSample{lookups: 5000, percent: 20}, true},
+		{"low ratio with few lookups", cacheHitRatioSample{lookups: 10, percent: 0}, false},
+	}
+	for _, tc := range testCases {
+		if actual := tc.sample.collapsed(); actual != tc.expected {
+			t.Errorf("%s: expected %t, got %t", tc.description, tc.expected, actual)
+		}
+	}
+}
+
+func TestCacheHitRatioSampleLowestNodes(t *testing.T) {
+	sample := cacheHitRatioSample{nodePercents: map[string]int64{
+		"node-a": 50,
+		"node-b": 5,
+		"node-c": 20,
+		"node-d": 5,
+		"node-e": 90,
+		"node-f": 10,
+		"node-g": 30,
+	}}
+	expected := []string{"node-b", "node-c", "node-d", "node-f", "node-g"}
+	if actual := sample.lowestNodes(); !reflect.DeepEqual(actual, expected) {
+		t.Errorf("expected %v, got %v", expected, actual)
+	}
+}
+
+func TestComputeDNSCacheHitRatioLowCondition(t *testing.T) {
+	if c := computeDNSCacheHitRatioLowCondition(nil, nil); c != nil {
+		t.Errorf("expected no condition without a sample, got %#v", c)
+	}
+	old := []operatorv1.OperatorCondition{{Type: DNSCacheHitRatioLowConditionType, Status: operatorv1.ConditionFalse}}
+	if c := computeDNSCacheHitRatioLowCondition(old, nil); c == nil || c.Status != operatorv1.ConditionFalse {
+		t.Errorf("expected the old condition to be kept without a sample, got %#v", c)
+	}
+
+	sample := &cacheHitRatioSample{lookups: 5000, percent: 12, nodePercents: map[string]int64{"node-a": 8, "node-b": 15}}
+	c := computeDNSCacheHitRatioLowCondition(old, sample)
+	if c.Status != operatorv1.ConditionTrue || c.Reason != "HitRatioCollapsed" {
+		t.Fatalf("expected the condition to be true, got %#v", c)
+	}
+	if !strings.Contains(c.Message, "node-a, node-b") {
+		t.Errorf("expected the message to list the nodes, got %q", c.Message)
+	}
+	if strings.Contains(c.Message, "12%") {
+		t.Errorf("expected the message to leave out the ratio, got %q", c.Message)
+	}
+
+	sample = &cacheHitRatioSample{lookups: 5000, percent: 85}
+	if c := computeDNSCacheHitRatioLowCondition(old, sample); c.Status != operatorv1.ConditionFalse || c.Reason != "AsExpected" {
+		t.Errorf("expected the condition to be false, got %#v", c)
+	}
+}
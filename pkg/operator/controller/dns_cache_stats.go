@@ -0,0 +1,126 @@
+package controller
+
+import (
+	"sort"
+	"time"
+
+	operatorv1 "github.com/openshift/api/operator/v1"
+
+	"github.com/prometheus/client_golang/prometheus"
+	dto "github.com/prometheus/client_model/go"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+)
+
+// cacheStatsSampleInterval is the interval at which cache statistics are
+// sampled from the dns pods.
+const cacheStatsSampleInterval = 5 * time.Minute
+
+var (
+	// cacheEntriesGauge reports the summarized number of cache entries
+	// across the pods of each dns.
+	cacheEntriesGauge = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "dns_operator_cache_entries",
+		Help: "Summary (min, median, max) of the number of CoreDNS cache entries across DNS pods.",
+	}, []string{"dns", "statistic"})
+
+	// cacheHitRatioGauge reports the summarized cache hit ratio across
+	// the pods of each dns.
+	cacheHitRatioGauge = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "dns_operator_cache_hit_ratio_percent",
+		Help: "Summary (min, median, max) of the CoreDNS cache hit ratio percentage across DNS pods.",
+	}, []string{"dns", "statistic"})
+)
+
+func init() {
+	metrics.Registry.MustRegister(cacheEntriesGauge, cacheHitRatioGauge)
+}
+
+// podCacheStats holds the cache statistics scraped from a single dns pod.
+type podCacheStats struct {
+	entries int64
+	hits    int64
+	misses  int64
+}
+
+// cacheStatsDue returns a Boolean indicating whether the cache statistics
+// in the status of the given dns are missing or older than the sample
+// interval.
+func cacheStatsDue(dns *operatorv1.DNS, now time.Time) bool {
+	stats := dns.Status.CacheStats
+	return stats == nil || now.Sub(stats.SampleTime.Time) >= cacheStatsSampleInterval
+}
+
+// parseCacheStats returns the cache statistics from the given metrics of a
+// dns pod, summed over all servers and cache types.
+func parseCacheStats(families map[string]*dto.MetricFamily) podCacheStats {
+	sum := func(name string) int64 {
+		family, ok := families[name]
+		if !ok {
+			return 0
+		}
+		total := float64(0)
+		for _, m := range family.Metric {
+			total += metricValue(m)
+		}
+		return int64(total)
+	}
+	return podCacheStats{
+		// CoreDNS 1.7.0 renamed coredns_cache_size to coredns_cache_entries.
+		entries: sum("coredns_cache_entries") + sum("coredns_cache_size"),
+		hits:    sum("coredns_cache_hits_total"),
+		misses:  sum("coredns_cache_misses_total"),
+	}
+}
+
+// summarizeCacheStats computes the min, median, and max of the cache
+// statistics of the given pod metrics.
+func summarizeCacheStats(samples []map[string]*dto.MetricFamily, now metav1.Time) *operatorv1.DNSCacheStats {
+	entries := make([]int64, 0, len(samples))
+	ratios := make([]int64, 0, len(samples))
+	for _, families := range samples {
+		s := parseCacheStats(families)
+		entries = append(entries, s.entries)
+		ratio := int64(0)
+		if lookups := s.hits + s.misses; lookups > 0 {
+			ratio = s.hits * 100 / lookups
+		}
+		ratios = append(ratios, ratio)
+	}
+	return &operatorv1.DNSCacheStats{
+		SampleTime:      now,
+		PodCount:        int32(len(samples)),
+		Entries:         summarize(entries),
+		HitRatioPercent: summarize(ratios),
+	}
+}
+
+// summarize returns the min, median, and max of the given non-empty values.
+func summarize(values []int64) operatorv1.CacheStatSummary {
+	sort.Slice(values, func(i, j int) bool { return values[i] < values[j] })
+	n := len(values)
+	median := values[n/2]
+	if n%2 == 0 {
+		median = (values[n/2-1] + values[n/2]) / 2
+	}
+	return operatorv1.CacheStatSummary{
+		Min:    values[0],
+		Median: median,
+		Max:    values[n-1],
+	}
+}
+
+// recordCacheStatsMetrics publishes the given cache statistics summary as
+// operator metrics.
+func recordCacheStatsMetrics(name string, stats *operatorv1.DNSCacheStats) {
+	for gauge, summary := range map[*prometheus.GaugeVec]operatorv1.CacheStatSummary{
+		cacheEntriesGauge:  stats.Entries,
+		cacheHitRatioGauge: stats.HitRatioPercent,
+	} {
+		gauge.WithLabelValues(name, "min").Set(float64(summary.Min))
+		gauge.WithLabelValues(name, "median").Set(float64(summary.Median))
+		gauge.WithLabelValues(name, "max").Set(float64(summary.Max))
+	}
+}
@@ -0,0 +1,125 @@
+package controller
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+
+	operatorv1 "github.com/openshift/api/operator/v1"
+
+	dto "github.com/prometheus/client_model/go"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// cacheMetrics returns the parsed cache metrics of a dns pod with the given
+// number of cache entries, hits, and misses.
+func cacheMetrics(t *testing.T, entries, hits, misses int) map[string]*dto.MetricFamily {
+	text := fmt.Sprintf(`# TYPE coredns_cache_entries gauge
+coredns_cache_entries{server="dns://:5353",type="success"} %d
+# TYPE coredns_cache_hits_total counter
+coredns_cache_hits_total{server="dns://:5353",type="success"} %d
+# TYPE coredns_cache_misses_total counter
+coredns_cache_misses_total{server="dns://:5353"} %d
+`, entries, hits, misses)
+	families, err := parsePodMetrics(strings.NewReader(text))
+	if err != nil {
+		t.Fatalf("failed to parse metrics: %v", err)
+	}
+	return families
+}
+
+func TestParseCacheStats(t *testing.T) {
+	metrics := `# HELP coredns_cache_entries The number of elements in the cache.
+# TYPE coredns_cache_entries gauge
+coredns_cache_entries{server="dns://:5353",type="denial"} 12
+coredns_cache_entries{server="dns://:5353",type="success"} 30
+# HELP coredns_cache_hits_total The count of cache hits.
+# TYPE coredns_cache_hits_total counter
+coredns_cache_hits_total{server="dns://:5353",type="denial"} 100
+coredns_cache_hits_total{server="dns://:5353",type="success"} 200
+# HELP coredns_cache_misses_total The count of cache misses.
+# TYPE coredns_cache_misses_total counter
+coredns_cache_misses_total{server="dns://:5353"} 100
+`
+	families, err := parsePodMetrics(strings.NewReader(metrics))
+	if err != nil {
+		t.Fatalf("failed to parse metrics: %v", err)
+	}
+	expected := podCacheStats{entries: 42, hits: 300, misses: 100}
+	if stats := parseCacheStats(families); stats != expected {
+		t.Errorf("expected %+v, got %+v", expected, stats)
+	}
+}
+
+func TestSummarizeCacheStats(t *testing.T) {
+	now := metav1.Now()
+	testCases := []struct {
+		description string
+		samples     []map[string]*dto.MetricFamily
+		expected    *operatorv1.DNSCacheStats
+	}{
+		{
+			description: "single pod",
+			samples:     []map[string]*dto.MetricFamily{cacheMetrics(t, 10, 3, 1)},
+			expected: &operatorv1.DNSCacheStats{
+				SampleTime:      now,
+				PodCount:        1,
+				Entries:         operatorv1.CacheStatSummary{Min: 10, Median: 10, Max: 10},
+				HitRatioPercent: operatorv1.CacheStatSummary{Min: 75, Median: 75, Max: 75},
+			},
+		},
+		{
+			description: "odd number of pods",
+			samples: []map[string]*dto.MetricFamily{
+				cacheMetrics(t, 30, 0, 0),
+				cacheMetrics(t, 10, 1, 1),
+				cacheMetrics(t, 20, 9, 1),
+			},
+			expected: &operatorv1.DNSCacheStats{
+				SampleTime:      now,
+				PodCount:        3,
+				Entries:         operatorv1.CacheStatSummary{Min: 10, Median: 20, Max: 30},
+				HitRatioPercent: operatorv1.CacheStatSummary{Min: 0, Median: 50, Max: 90},
+			},
+		},
+		{
+			description: "even number of pods",
+			samples: []map[string]*dto.MetricFamily{
+				cacheMetrics(t, 40, 1, 0),
+				cacheMetrics(t, 10, 1, 1),
+			},
+			expected: &operatorv1.DNSCacheStats{
+				SampleTime:      now,
+				PodCount:        2,
+				Entries:         operatorv1.CacheStatSummary{Min: 10, Median: 25, Max: 40},
+				HitRatioPercent: operatorv1.CacheStatSummary{Min: 50, Median: 75, Max: 100},
+			},
+		},
+	}
+	for _, tc := range testCases {
+		actual := summarizeCacheStats(tc.samples, now)
+		if !cmp.Equal(actual, tc.expected) {
+			t.Errorf("%s: expected %+v, got %+v", tc.description, tc.expected, actual)
+		}
+	}
+}
+
+func TestCacheStatsDue(t *testing.T) {
+	now := time.Now()
+	dns := &operatorv1.DNS{}
+	if !cacheStatsDue(dns, now) {
+		t.Errorf("expected cache stats to be due when none have been recorded")
+	}
+	dns.Status.CacheStats = &operatorv1.DNSCacheStats{SampleTime: metav1.NewTime(now.Add(-time.Minute))}
+	if cacheStatsDue(dns, now) {
+		t.Errorf("expected cache stats not to be due after one minute")
+	}
+	dns.Status.CacheStats.SampleTime = metav1.NewTime(now.Add(-cacheStatsSampleInterval))
+	if !cacheStatsDue(dns, now) {
+		t.Errorf("expected cache stats to be due after the sample interval")
+	}
+}
@@ -0,0 +1,195 @@
+package controller
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"reflect"
+	"strconv"
+	"time"
+
+	operatorv1 "github.com/openshift/api/operator/v1"
+	"github.com/openshift/cluster-dns-operator/pkg/manifests"
+
+	"github.com/sirupsen/logrus"
+
+	corev1 "k8s.io/api/core/v1"
+
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"sigs.k8s.io/yaml"
+)
+
+const (
+	// clientSettingsDNSConfigKey is the key of the client settings
+	// configmap that holds a pod dnsConfig with the recommended resolver
+	// options, which an admission webhook or a helm chart can copy into
+	// the spec of a pod.
+	clientSettingsDNSConfigKey = "dnsConfig"
+
+	// clientSettingsNameserverKey is the key of the client settings
+	// configmap that holds the cluster IP of the dns service.
+	clientSettingsNameserverKey = "nameserver"
+
+	// clientSettingsClusterDomainKey is the key of the client settings
+	// configmap that holds the cluster domain.
+	clientSettingsClusterDomainKey = "clusterDomain"
+
+	// clientSettingsNdotsKey, clientSettingsTimeoutKey, and
+	// clientSettingsAttemptsKey are the keys of the client settings
+	// configmap that hold the recommended resolver options.
+	clientSettingsNdotsKey    = "ndots"
+	clientSettingsTimeoutKey  = "timeout"
+	clientSettingsAttemptsKey = "attempts"
+
+	// clientNdots is the recommended ndots option.  The kubelet sets ndots
+	// to 5, which makes the resolver try every search domain before the
+	// name itself for names with fewer than five dots, so that a lookup of
+	// an external name such as www.example.com sends several queries for
+	// names that do not exist.  With ndots 2, names of services in other
+	// namespaces ("svc.ns") still use the search path.
+	clientNdots = 2
+
+	// clientAttempts is the recommended attempts option.
+	clientAttempts = 2
+
+	// defaultClientTimeout is the recommended timeout option if the dns
+	// does not set a query timeout, which is the default of the resolver.
+	defaultClientTimeout = 5 * time.Second
+
+	// maxClientTimeout is the largest timeout option that the resolver
+	// accepts.
+	maxClientTimeout = 30 * time.Second
+)
+
+// clientTimeoutSeconds returns the recommended timeout option of the resolver
+// for clients of the given dns in seconds.  If the dns sets a query timeout,
+// the timeout is rounded up to whole seconds so that the resolver does not
+// retry a query that CoreDNS is still trying to answer.
+func clientTimeoutSeconds(dns *operatorv1.DNS) int {
+	timeout := defaultClientTimeout
+	if s := corefileQueryTimeout(dns); len(s) != 0 {
+		if d, err := time.ParseDuration(s); err == nil {
+			timeout = d
+		}
+	}
+	if timeout > maxClientTimeout {
+		timeout = maxClientTimeout
+	}
+	seconds := int(math.Ceil(timeout.Seconds()))
+	if seconds < 1 {
+		seconds = 1
+	}
+	return seconds
+}
+
+// clientDNSConfig returns the pod dnsConfig with the recommended resolver
+// options for clients of the given dns.
+func clientDNSConfig(dns *operatorv1.DNS) *corev1.PodDNSConfig {
+	ndots := strconv.Itoa(clientNdots)
+	timeout := strconv.Itoa(clientTimeoutSeconds(dns))
+	attempts := strconv.Itoa(clientAttempts)
+	return &corev1.PodDNSConfig{
+		Options: []corev1.PodDNSConfigOption{
+			{Name: clientSettingsNdotsKey, Value: &ndots},
+			{Name: clientSettingsTimeoutKey, Value: &timeout},
+			{Name: clientSettingsAttemptsKey, Value: &attempts},
+		},
+	}
+}
+
+// ensureClientSettingsConfigMap ensures that the client settings configmap
+// exists for the given dns and is up to date.
+func (r *reconciler) ensureClientSettingsConfigMap(dns *operatorv1.DNS, clusterIP, clusterDomain string) (bool, *corev1.ConfigMap, error) {
+	desired, err := desiredClientSettingsConfigMap(dns, clusterIP, clusterDomain)
+	if err != nil {
+		return false, nil, fmt.Errorf("failed to build client settings configmap: %v", err)
+	}
+	haveCM, current, err := r.currentClientSettingsConfigMap(dns)
+	if err != nil {
+		return false, nil, fmt.Errorf("failed to get client settings configmap: %v", err)
+	}
+
+	switch {
+	case !haveCM:
+		if err := r.client.Create(context.TODO(), desired); err != nil {
+			return false, nil, fmt.Errorf("failed to create client settings configmap: %v", err)
+		}
+		logrus.Infof("created client settings configmap: %s/%s", desired.Namespace, desired.Name)
+		return r.currentClientSettingsConfigMap(dns)
+	case haveCM:
+		if changed, updated := clientSettingsConfigMapChanged(current, desired); changed {
+			if err := r.client.Update(context.TODO(), updated); err != nil {
+				return true, current, fmt.Errorf("failed to update client settings configmap: %v", err)
+			}
+			logrus.Infof("updated client settings configmap: %s/%s", updated.Namespace, updated.Name)
+			return r.currentClientSettingsConfigMap(dns)
+		}
+	}
+	return true, current, nil
+}
+
+func (r *reconciler) currentClientSettingsConfigMap(dns *operatorv1.DNS) (bool, *corev1.ConfigMap, error) {
+	current := &corev1.ConfigMap{}
+	if err := r.client.Get(context.TODO(), DNSClientSettingsConfigMapName(dns), current); err != nil {
+		if errors.IsNotFound(err) {
+			return false, nil, nil
+		}
+		return false, nil, err
+	}
+	return true, current, nil
+}
+
+// desiredClientSettingsConfigMap returns the desired client settings
+// configmap, which publishes the address of the dns and the resolver options
+// that are recommended for its clients.
+func desiredClientSettingsConfigMap(dns *operatorv1.DNS, clusterIP, clusterDomain string) (*corev1.ConfigMap, error) {
+	dnsConfig := clientDNSConfig(dns)
+	snippet, err := yaml.Marshal(dnsConfig)
+	if err != nil {
+		return nil, err
+	}
+	name := DNSClientSettingsConfigMapName(dns)
+	cm := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name.Name,
+			Namespace: name.Namespace,
+			Labels: map[string]string{
+				manifests.OwningDNSLabel: DNSDaemonSetLabel(dns),
+			},
+		},
+		Data: map[string]string{
+			clientSettingsDNSConfigKey:     string(snippet),
+			clientSettingsNameserverKey:    clusterIP,
+			clientSettingsClusterDomainKey: clusterDomain,
+		},
+	}
+	for _, option := range dnsConfig.Options {
+		cm.Data[option.Name] = *option.Value
+	}
+	cm.SetOwnerReferences([]metav1.OwnerReference{dnsOwnerRef(dns)})
+	return cm, nil
+}
+
+// clientSettingsConfigMapChanged checks whether the current client settings
+// configmap has the expected data and labels and if not returns an updated
+// configmap.
+func clientSettingsConfigMapChanged(current, expected *corev1.ConfigMap) (bool, *corev1.ConfigMap) {
+	changed := false
+	updated := current.DeepCopy()
+	if !reflect.DeepEqual(current.Data, expected.Data) {
+		updated.Data = expected.Data
+		changed = true
+	}
+	for k, v := range expected.Labels {
+		if current.Labels[k] != v {
+			if updated.Labels == nil {
+				updated.Labels = map[string]string{}
+			}
+			updated.Labels[k] = v
+			changed = true
+		}
+	}
+	return changed, updated
+}
@@ -0,0 +1,98 @@
+package controller
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	operatorv1 "github.com/openshift/api/operator/v1"
+	"github.com/openshift/cluster-dns-operator/pkg/manifests"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestClientTimeoutSeconds(t *testing.T) {
+	testCases := []struct {
+		description  string
+		queryTimeout *metav1.Duration
+		expected     int
+	}{
+		{"no query timeout", nil, 5},
+		{"default query timeout", &metav1.Duration{}, 6},
+		{"fractional query timeout", &metav1.Duration{Duration: 7500 * time.Millisecond}, 8},
+		{"query timeout above the maximum", &metav1.Duration{Duration: time.Minute}, 30},
+	}
+	for _, tc := range testCases {
+		dns := &operatorv1.DNS{
+			Spec: operatorv1.DNSSpec{
+				Performance: operatorv1.DNSPerformance{QueryTimeout: tc.queryTimeout},
+			},
+		}
+		if actual := clientTimeoutSeconds(dns); actual != tc.expected {
+			t.Errorf("%s: expected %d, got %d", tc.description, tc.expected, actual)
+		}
+	}
+}
+
+func TestDesiredClientSettingsConfigMap(t *testing.T) {
+	dns := &operatorv1.DNS{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: DefaultDNSController,
+		},
+	}
+	cm, err := desiredClientSettingsConfigMap(dns, "172.30.0.10", "cluster.local")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if e, a := "dns-default-client-settings", cm.Name; e != a {
+		t.Errorf("expected name %q, got %q", e, a)
+	}
+	if e, a := "default", cm.Labels[manifests.OwningDNSLabel]; e != a {
+		t.Errorf("expected owning dns label %q, got %q", e, a)
+	}
+	expected := map[string]string{
+		"dnsConfig": `options:
+- name: ndots
+  value: "2"
+- name: timeout
+  value: "5"
+- name: attempts
+  value: "2"
+`,
+		"nameserver":    "172.30.0.10",
+		"clusterDomain": "cluster.local",
+		"ndots":         "2",
+		"timeout":       "5",
+		"attempts":      "2",
+	}
+	if !reflect.DeepEqual(cm.Data, expected) {
+		t.Errorf("expected data %v, got %v", expected, cm.Data)
+	}
+}
+
+func TestClientSettingsConfigMapChanged(t *testing.T) {
+	dns := &operatorv1.DNS{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: DefaultDNSController,
+		},
+	}
+	current, err := desiredClientSettingsConfigMap(dns, "172.30.0.10", "cluster.local")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if changed, _ := clientSettingsConfigMapChanged(current, current); changed {
+		t.Error("expected no change for identical configmaps")
+	}
+	dns.Spec.Performance.QueryTimeout = &metav1.Duration{Duration: 10 * time.Second}
+	expected, err := desiredClientSettingsConfigMap(dns, "172.30.0.10", "cluster.local")
+	if err != nil {
+		t.Fatal(err)
+	}
+	changed, updated := clientSettingsConfigMapChanged(current, expected)
+	if !changed {
+		t.Fatal("expected a change for a new query timeout")
+	}
+	if e, a := "10", updated.Data["timeout"]; e != a {
+		t.Errorf("expected timeout %q, got %q", e, a)
+	}
+}
@@ -0,0 +1,100 @@
+package controller
+
+import (
+	"crypto/sha512"
+	"encoding/hex"
+	"fmt"
+	"time"
+
+	operatorv1 "github.com/openshift/api/operator/v1"
+
+	dto "github.com/prometheus/client_model/go"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	// corefileRolloutCheckInterval is the interval at which the dns pods
+	// are sampled while some of them have not yet loaded the current
+	// Corefile.
+	corefileRolloutCheckInterval = 30 * time.Second
+
+	// corefileHashMinVersion is the first version of CoreDNS whose reload
+	// plugin reports the hash of the loaded Corefile in the
+	// coredns_reload_version_info metric.
+	corefileHashMinVersion = "1.7.1"
+)
+
+// corefileHash returns the hash of the given Corefile as CoreDNS's reload
+// plugin reports it in the coredns_reload_version_info metric.
+func corefileHash(corefile string) string {
+	sum := sha512.Sum512([]byte(corefile))
+	return hex.EncodeToString(sum[:])
+}
+
+// corefileStatusStale returns a Boolean indicating whether the Corefile
+// status of the given dns is missing, refers to a different Corefile than the
+// one with the given hash, or reports pods that have not loaded the current
+// Corefile.
+func corefileStatusStale(status *operatorv1.DNSCorefileStatus, hash string) bool {
+	if status == nil || status.Hash != hash {
+		return true
+	}
+	return status.UpdatedPods < status.SampledPods
+}
+
+// corefileHashReported returns a Boolean indicating whether the given version
+// of CoreDNS reports the hash of the Corefile that it has loaded.  An unknown
+// version is taken to be MinimumCoreDNSVersion.
+func corefileHashReported(version string) bool {
+	_, v := effectiveCoreDNSVersion(version)
+	return v.atLeast(corefileHashMinVersion)
+}
+
+// unverifiedCorefileStatus returns the Corefile status for the Corefile with
+// the given hash when the given version of CoreDNS does not report the hash of
+// the Corefile that it has loaded, so that the dns pods cannot be checked for
+// it.  The given old status is kept if it already says so for the same hash,
+// so that the status is not rewritten on every reconcile.
+func unverifiedCorefileStatus(old *operatorv1.DNSCorefileStatus, hash, version string, now metav1.Time) *operatorv1.DNSCorefileStatus {
+	version, _ = effectiveCoreDNSVersion(version)
+	reason := fmt.Sprintf("CoreDNS %s does not report the hash of the Corefile that it has loaded; %s or later is required to verify that the DNS pods have loaded it", version, corefileHashMinVersion)
+	if old != nil && old.Hash == hash && old.UnverifiedReason == reason {
+		return old
+	}
+	return &operatorv1.DNSCorefileStatus{
+		Hash:             hash,
+		SampleTime:       now,
+		UnverifiedReason: reason,
+	}
+}
+
+// computeCorefileStatus computes the Corefile status from the given pod
+// metrics.  A pod has loaded the current Corefile if it reports the given hash
+// or, for pods of node overrides, one of the given hashes of the Corefiles of
+// the overrides.  Pods that do not report the hash of their loaded Corefile
+// are not counted.
+func computeCorefileStatus(samples []map[string]*dto.MetricFamily, hash string, overrideHashes []string, now metav1.Time) *operatorv1.DNSCorefileStatus {
+	status := &operatorv1.DNSCorefileStatus{
+		Hash:       hash,
+		SampleTime: now,
+	}
+	current := map[string]struct{}{hash: {}}
+	for _, h := range overrideHashes {
+		current[h] = struct{}{}
+	}
+	for _, families := range samples {
+		family, ok := families["coredns_reload_version_info"]
+		if !ok {
+			continue
+		}
+		status.SampledPods++
+		for _, m := range family.Metric {
+			if _, ok := current[metricLabel(m, "value")]; ok && metricLabel(m, "hash") == "sha512" {
+				status.UpdatedPods++
+				break
+			}
+		}
+	}
+	return status
+}
@@ -0,0 +1,138 @@
+package controller
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+
+	operatorv1 "github.com/openshift/api/operator/v1"
+
+	dto "github.com/prometheus/client_model/go"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// reloadMetrics returns the parsed reload metrics of a dns pod that has
+// loaded the Corefile with the given hash.
+func reloadMetrics(t *testing.T, hash string) map[string]*dto.MetricFamily {
+	text := fmt.Sprintf(`# TYPE coredns_reload_version_info gauge
+coredns_reload_version_info{hash="sha512",value=%q} 1
+`, hash)
+	families, err := parsePodMetrics(strings.NewReader(text))
+	if err != nil {
+		t.Fatalf("failed to parse metrics: %v", err)
+	}
+	return families
+}
+
+func TestComputeCorefileStatus(t *testing.T) {
+	now := metav1.Now()
+	current := corefileHash(".:5353 {\n}\n")
+	old := corefileHash("")
+	samples := []map[string]*dto.MetricFamily{
+		reloadMetrics(t, current),
+		reloadMetrics(t, old),
+		reloadMetrics(t, current),
+		// A pod that does not report its Corefile hash is not counted.
+		{},
+	}
+	expected := &operatorv1.DNSCorefileStatus{
+		Hash:        current,
+		SampleTime:  now,
+		SampledPods: 3,
+		UpdatedPods: 2,
+	}
+	actual := computeCorefileStatus(samples, current, nil, now)
+	if !cmp.Equal(actual, expected) {
+		t.Errorf("expected %+v, got %+v", expected, actual)
+	}
+
+	// Pods of node overrides that have loaded the Corefile of their
+	// override are counted as updated.
+	override := corefileHash(".:5353 {\n    errors\n}\n")
+	samples = append(samples, reloadMetrics(t, override))
+	expected.SampledPods, expected.UpdatedPods = 4, 3
+	actual = computeCorefileStatus(samples, current, []string{override}, now)
+	if !cmp.Equal(actual, expected) {
+		t.Errorf("expected %+v, got %+v", expected, actual)
+	}
+}
+
+func TestCorefileStatusStale(t *testing.T) {
+	hash := corefileHash("foo")
+	testCases := []struct {
+		description string
+		status      *operatorv1.DNSCorefileStatus
+		expect      bool
+	}{
+		{
+			description: "no status",
+			expect:      true,
+		},
+		{
+			description: "status for a different Corefile",
+			status:      &operatorv1.DNSCorefileStatus{Hash: corefileHash("bar")},
+			expect:      true,
+		},
+		{
+			description: "some pods have not loaded the current Corefile",
+			status:      &operatorv1.DNSCorefileStatus{Hash: hash, SampledPods: 3, UpdatedPods: 2},
+			expect:      true,
+		},
+		{
+			description: "all pods have loaded the current Corefile",
+			status:      &operatorv1.DNSCorefileStatus{Hash: hash, SampledPods: 3, UpdatedPods: 3},
+			expect:      false,
+		},
+	}
+	for _, tc := range testCases {
+		if actual := corefileStatusStale(tc.status, hash); actual != tc.expect {
+			t.Errorf("%s: expected %t, got %t", tc.description, tc.expect, actual)
+		}
+	}
+}
+
+func TestCorefileHashReported(t *testing.T) {
+	testCases := []struct {
+		version  string
+		expected bool
+	}{
+		{version: "", expected: false},
+		{version: "latest", expected: false},
+		{version: "1.6.6", expected: false},
+		{version: "1.7.0", expected: false},
+		{version: "1.7.1", expected: true},
+		{version: "v1.11.1", expected: true},
+	}
+	for _, tc := range testCases {
+		if actual := corefileHashReported(tc.version); actual != tc.expected {
+			t.Errorf("version %q: expected %v, got %v", tc.version, tc.expected, actual)
+		}
+	}
+}
+
+func TestUnverifiedCorefileStatus(t *testing.T) {
+	hash := corefileHash("foo")
+	now := metav1.Now()
+	status := unverifiedCorefileStatus(nil, hash, "1.6.6", now)
+	if status.Hash != hash || len(status.UnverifiedReason) == 0 || status.SampledPods != 0 {
+		t.Fatalf("expected an unverified status for hash %s, got %+v", hash, status)
+	}
+	if corefileStatusStale(status, hash) {
+		t.Errorf("expected an unverified status not to be stale")
+	}
+
+	// The status is kept while the Corefile does not change, and replaced
+	// when it does.
+	later := metav1.NewTime(now.Add(time.Minute))
+	if actual := unverifiedCorefileStatus(status, hash, "1.6.6", later); actual != status {
+		t.Errorf("expected the status to be kept, got %+v", actual)
+	}
+	other := corefileHash("bar")
+	if actual := unverifiedCorefileStatus(status, other, "1.6.6", later); actual.Hash != other || !actual.SampleTime.Equal(&later) {
+		t.Errorf("expected a new status for hash %s, got %+v", other, actual)
+	}
+}
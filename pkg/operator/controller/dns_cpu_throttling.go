@@ -0,0 +1,208 @@
+package controller
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	operatorv1 "github.com/openshift/api/operator/v1"
+
+	"github.com/openshift/cluster-dns-operator/pkg/util/conditions"
+
+	"github.com/prometheus/client_golang/prometheus"
+	dto "github.com/prometheus/client_model/go"
+
+	"github.com/sirupsen/logrus"
+
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+)
+
+const (
+	// DNSCPUThrottledConditionType is the type of the dns status condition
+	// that reports whether CoreDNS is being heavily CPU throttled.
+	DNSCPUThrottledConditionType = "CPUThrottled"
+
+	// cpuThrottlingWarningPercent is the percentage of CFS periods in which
+	// the dns container of a pod may be throttled before CoreDNS is
+	// considered to be heavily throttled.
+	cpuThrottlingWarningPercent = 25
+
+	// nodeMetricsScrapeTimeout is the timeout for scraping the cAdvisor
+	// metrics of a single node.
+	nodeMetricsScrapeTimeout = 10 * time.Second
+)
+
+// cpuThrottledGauge reports the highest percentage of CFS periods in which the
+// dns container of any pod of each dns was throttled.
+var cpuThrottledGauge = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+	Name: "dns_operator_cpu_throttled_percent",
+	Help: "Highest percentage of CFS periods in which a CoreDNS container was CPU throttled since the previous sample.",
+}, []string{"dns"})
+
+func init() {
+	metrics.Registry.MustRegister(cpuThrottledGauge)
+}
+
+// cfsPeriods holds the CFS period counters of the dns container of a pod.
+type cfsPeriods struct {
+	periods   float64
+	throttled float64
+}
+
+// cpuThrottlingSample is the CPU throttling of the pods of a dns.
+type cpuThrottlingSample struct {
+	// sampledPods is the number of pods for which CFS period counters were
+	// reported.
+	sampledPods int
+	// throttledPods maps the name of each heavily throttled pod to the
+	// percentage of CFS periods in which it was throttled.
+	throttledPods map[string]int64
+	// maxPercent is the highest percentage of CFS periods in which any pod
+	// was throttled.
+	maxPercent int64
+}
+
+// cpuThrottlingTracker remembers the CFS period counters from the previous
+// sample of each dns pod so that throttling is measured over the sample
+// interval rather than over the lifetime of the container.
+type cpuThrottlingTracker struct {
+	lock sync.Mutex
+	last map[string]cfsPeriods
+}
+
+// sampleDNSCPUThrottling scrapes the cAdvisor metrics of the nodes that run
+// pods of the given dns and returns the CPU throttling of the pods.
+func (r *reconciler) sampleDNSCPUThrottling(dns *operatorv1.DNS) (*cpuThrottlingSample, error) {
+	pods, err := r.listDNSPods(dns)
+	if err != nil {
+		return nil, err
+	}
+	nodes := map[string]struct{}{}
+	for _, pod := range pods.Items {
+		if len(pod.Spec.NodeName) != 0 {
+			nodes[pod.Spec.NodeName] = struct{}{}
+		}
+	}
+
+	namespace := DNSDaemonSetName(dns).Namespace
+	current := map[string]cfsPeriods{}
+	for node := range nodes {
+		families, err := r.scrapeNodeCadvisorMetrics(node)
+		if err != nil {
+			logrus.Infof("failed to scrape cadvisor metrics from node %s: %v", node, err)
+			continue
+		}
+		for pod, periods := range parseCFSPeriods(families, namespace) {
+			current[pod] = periods
+		}
+	}
+	sample := r.cpuThrottling.update(current)
+	cpuThrottledGauge.WithLabelValues(dns.Name).Set(float64(sample.maxPercent))
+	return sample, nil
+}
+
+// scrapeNodeCadvisorMetrics scrapes the cAdvisor metrics of the kubelet on the
+// given node through the API server's node proxy.
+func (r *reconciler) scrapeNodeCadvisorMetrics(node string) (map[string]*dto.MetricFamily, error) {
+	ctx, cancel := context.WithTimeout(context.TODO(), nodeMetricsScrapeTimeout)
+	defer cancel()
+	stream, err := r.kubeClient.CoreV1().RESTClient().Get().
+		Resource("nodes").Name(node).SubResource("proxy").Suffix("metrics", "cadvisor").
+		Stream(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer stream.Close()
+	return parsePodMetrics(stream)
+}
+
+// parseCFSPeriods returns the CFS period counters of the dns containers in the
+// given namespace from the given cAdvisor metrics, keyed by pod name.
+func parseCFSPeriods(families map[string]*dto.MetricFamily, namespace string) map[string]cfsPeriods {
+	result := map[string]cfsPeriods{}
+	collect := func(name string, set func(*cfsPeriods, float64)) {
+		family, ok := families[name]
+		if !ok {
+			return
+		}
+		for _, m := range family.Metric {
+			if metricLabel(m, "namespace") != namespace || metricLabel(m, "container") != "dns" {
+				continue
+			}
+			pod := metricLabel(m, "pod")
+			periods := result[pod]
+			set(&periods, metricValue(m))
+			result[pod] = periods
+		}
+	}
+	collect("container_cpu_cfs_periods_total", func(p *cfsPeriods, v float64) { p.periods = v })
+	collect("container_cpu_cfs_throttled_periods_total", func(p *cfsPeriods, v float64) { p.throttled = v })
+	return result
+}
+
+// update records the given CFS period counters and returns the CPU throttling
+// of each pod since its previous sample.  Pods that were not sampled before,
+// or whose counters were reset because the container restarted, are measured
+// over the lifetime of the container.
+func (t *cpuThrottlingTracker) update(current map[string]cfsPeriods) *cpuThrottlingSample {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	sample := &cpuThrottlingSample{
+		sampledPods:   len(current),
+		throttledPods: map[string]int64{},
+	}
+	for pod, c := range current {
+		delta := c
+		if last, ok := t.last[pod]; ok && c.periods >= last.periods && c.throttled >= last.throttled {
+			delta = cfsPeriods{periods: c.periods - last.periods, throttled: c.throttled - last.throttled}
+		}
+		if delta.periods == 0 {
+			continue
+		}
+		percent := int64(delta.throttled * 100 / delta.periods)
+		if percent > sample.maxPercent {
+			sample.maxPercent = percent
+		}
+		if percent >= cpuThrottlingWarningPercent {
+			sample.throttledPods[pod] = percent
+		}
+	}
+	t.last = current
+	return sample
+}
+
+// computeDNSCPUThrottledCondition computes the dns CPUThrottled status
+// condition from the given sample.  If sample is nil, the old condition is
+// kept.
+func computeDNSCPUThrottledCondition(oldConditions []operatorv1.OperatorCondition, sample *cpuThrottlingSample) *operatorv1.OperatorCondition {
+	oldCondition := conditions.FindOperatorCondition(oldConditions, DNSCPUThrottledConditionType)
+	if sample == nil {
+		return oldCondition
+	}
+
+	condition := &operatorv1.OperatorCondition{
+		Type: DNSCPUThrottledConditionType,
+	}
+	if len(sample.throttledPods) == 0 {
+		condition.Status = operatorv1.ConditionFalse
+		condition.Reason = "AsExpected"
+		condition.Message = "CoreDNS is not being heavily CPU throttled"
+	} else {
+		// Leave the percentages out of the message so that the
+		// transition time only changes when the set of pods does.
+		pods := []string{}
+		for pod := range sample.throttledPods {
+			pods = append(pods, pod)
+		}
+		sort.Strings(pods)
+		condition.Status = operatorv1.ConditionTrue
+		condition.Reason = "HeavilyThrottled"
+		condition.Message = fmt.Sprintf("CoreDNS is CPU throttled in at least %d%% of CFS periods in %d of %d pods: %s", cpuThrottlingWarningPercent, len(pods), sample.sampledPods, strings.Join(pods, ", "))
+	}
+	c := conditions.SetOperatorConditionTransitionTime(condition, oldCondition)
+	return &c
+}
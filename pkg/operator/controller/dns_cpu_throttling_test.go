@@ -0,0 +1,116 @@
+package controller
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	operatorv1 "github.com/openshift/api/operator/v1"
+)
+
+func TestParseCFSPeriods(t *testing.T) {
+	metrics := `# TYPE container_cpu_cfs_periods_total counter
+container_cpu_cfs_periods_total{container="dns",namespace="openshift-dns",pod="dns-default-a"} 1000
+container_cpu_cfs_periods_total{container="kube-rbac-proxy",namespace="openshift-dns",pod="dns-default-a"} 1000
+container_cpu_cfs_periods_total{container="dns",namespace="other",pod="dns-default-b"} 1000
+# TYPE container_cpu_cfs_throttled_periods_total counter
+container_cpu_cfs_throttled_periods_total{container="dns",namespace="openshift-dns",pod="dns-default-a"} 300
+container_cpu_cfs_throttled_periods_total{container="kube-rbac-proxy",namespace="openshift-dns",pod="dns-default-a"} 900
+`
+	families, err := parsePodMetrics(strings.NewReader(metrics))
+	if err != nil {
+		t.Fatalf("failed to parse metrics: %v", err)
+	}
+	expected := map[string]cfsPeriods{
+		"dns-default-a": {periods: 1000, throttled: 300},
+	}
+	actual := parseCFSPeriods(families, "openshift-dns")
+	if !cmp.Equal(actual, expected, cmp.AllowUnexported(cfsPeriods{})) {
+		t.Errorf("expected %v, got %v", expected, actual)
+	}
+}
+
+func TestCPUThrottlingTrackerUpdate(t *testing.T) {
+	tracker := &cpuThrottlingTracker{}
+
+	// The first sample is measured over the lifetime of the containers.
+	sample := tracker.update(map[string]cfsPeriods{
+		"a": {periods: 100, throttled: 50},
+		"b": {periods: 100, throttled: 10},
+		"c": {periods: 0, throttled: 0},
+	})
+	expected := &cpuThrottlingSample{
+		sampledPods:   3,
+		throttledPods: map[string]int64{"a": 50},
+		maxPercent:    50,
+	}
+	if !cmp.Equal(sample, expected, cmp.AllowUnexported(cpuThrottlingSample{})) {
+		t.Errorf("expected %+v, got %+v", expected, sample)
+	}
+
+	// Later samples are measured since the previous sample, except for
+	// containers whose counters were reset.
+	sample = tracker.update(map[string]cfsPeriods{
+		"a": {periods: 200, throttled: 55},
+		"b": {periods: 200, throttled: 60},
+		"c": {periods: 10, throttled: 3},
+	})
+	expected = &cpuThrottlingSample{
+		sampledPods:   3,
+		throttledPods: map[string]int64{"b": 50, "c": 30},
+		maxPercent:    50,
+	}
+	if !cmp.Equal(sample, expected, cmp.AllowUnexported(cpuThrottlingSample{})) {
+		t.Errorf("expected %+v, got %+v", expected, sample)
+	}
+
+	sample = tracker.update(map[string]cfsPeriods{
+		"a": {periods: 10, throttled: 1},
+	})
+	expected = &cpuThrottlingSample{
+		sampledPods:   1,
+		throttledPods: map[string]int64{},
+		maxPercent:    10,
+	}
+	if !cmp.Equal(sample, expected, cmp.AllowUnexported(cpuThrottlingSample{})) {
+		t.Errorf("expected %+v, got %+v", expected, sample)
+	}
+}
+
+func TestComputeDNSCPUThrottledCondition(t *testing.T) {
+	if c := computeDNSCPUThrottledCondition(nil, nil); c != nil {
+		t.Errorf("expected no condition without a sample, got %+v", c)
+	}
+
+	throttled := computeDNSCPUThrottledCondition(nil, &cpuThrottlingSample{
+		sampledPods:   2,
+		throttledPods: map[string]int64{"b": 40, "a": 30},
+	})
+	if throttled.Status != operatorv1.ConditionTrue {
+		t.Errorf("expected CPUThrottled=True, got %s", throttled.Status)
+	}
+	if expected := "in 2 of 2 pods: a, b"; !strings.HasSuffix(throttled.Message, expected) {
+		t.Errorf("expected message to end with %q, got %q", expected, throttled.Message)
+	}
+
+	// Without a new sample, the old condition is kept.
+	old := []operatorv1.OperatorCondition{*throttled}
+	if c := computeDNSCPUThrottledCondition(old, nil); !cmp.Equal(c, throttled) {
+		t.Errorf("expected %+v, got %+v", throttled, c)
+	}
+
+	// The transition time is kept while the same pods are throttled.
+	again := computeDNSCPUThrottledCondition(old, &cpuThrottlingSample{
+		sampledPods:   2,
+		throttledPods: map[string]int64{"a": 90, "b": 90},
+	})
+	if !cmp.Equal(again, throttled) {
+		t.Errorf("expected %+v, got %+v", throttled, again)
+	}
+
+	recovered := computeDNSCPUThrottledCondition(old, &cpuThrottlingSample{sampledPods: 2})
+	if recovered.Status != operatorv1.ConditionFalse {
+		t.Errorf("expected CPUThrottled=False, got %s", recovered.Status)
+	}
+}
@@ -0,0 +1,95 @@
+package controller
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	operatorv1 "github.com/openshift/api/operator/v1"
+
+	"github.com/openshift/cluster-dns-operator/pkg/util/conditions"
+)
+
+// podsUnavailableDegradedReasons are the reasons of the Degraded condition that
+// report too many unavailable dns pods.
+var podsUnavailableDegradedReasons = map[string]struct{}{
+	"MaxUnavailableExceeded": {},
+	"NoPodsAvailable":        {},
+}
+
+// unavailabilityTracker remembers since when too many dns pods have been
+// unavailable, or since when enough have been available.
+type unavailabilityTracker struct {
+	lock        sync.Mutex
+	unavailable bool
+	since       time.Time
+}
+
+// observe records whether too many dns pods are unavailable at the given time
+// and returns the time since which this has been the case.
+func (t *unavailabilityTracker) observe(unavailable bool, now time.Time) time.Time {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	if t.since.IsZero() || t.unavailable != unavailable {
+		t.unavailable = unavailable
+		t.since = now
+	}
+	return t.since
+}
+
+// suppressDNSDegradedCondition applies the degraded suppression periods of the
+// given dns to the given Degraded condition, where since is the time since
+// which the pods have been unavailable or available as the condition reports.
+// Too many unavailable pods are not reported until the unavailable grace
+// period has passed, unless no pods are available, and a report of
+// unavailable pods is kept until the recovery period has passed.  It returns
+// the condition to report and the time until it may change without the pods
+// changing, or zero if it may not.
+func suppressDNSDegradedCondition(dns *operatorv1.DNS, condition, oldCondition *operatorv1.OperatorCondition, since, now time.Time) (operatorv1.OperatorCondition, time.Duration) {
+	suppression := dns.Spec.DegradedSuppression
+	elapsed := now.Sub(since)
+	oldDegraded := oldCondition != nil && oldCondition.Status == operatorv1.ConditionTrue
+	switch {
+	case condition.Reason == "MaxUnavailableExceeded" && !oldDegraded && suppression.UnavailableGracePeriod != nil:
+		grace := suppression.UnavailableGracePeriod.Duration
+		if elapsed >= grace {
+			break
+		}
+		suppressed := &operatorv1.OperatorCondition{
+			Type:    operatorv1.OperatorStatusTypeDegraded,
+			Status:  operatorv1.ConditionFalse,
+			Reason:  "UnavailablePodsWithinGracePeriod",
+			Message: fmt.Sprintf("Too many CoreDNS pods have been unavailable for less than the %s grace period", grace),
+		}
+		return conditions.SetOperatorConditionTransitionTime(suppressed, oldCondition), grace - elapsed
+	case condition.Status == operatorv1.ConditionFalse && oldDegraded && suppression.RecoveryPeriod != nil:
+		if _, ok := podsUnavailableDegradedReasons[oldCondition.Reason]; !ok {
+			break
+		}
+		recovery := suppression.RecoveryPeriod.Duration
+		if elapsed >= recovery {
+			break
+		}
+		return *oldCondition, recovery - elapsed
+	}
+	return *condition, 0
+}
+
+// suppressDNSDegraded applies the degraded suppression periods of the given dns
+// to the Degraded condition in the given newly computed conditions.  It
+// returns the time until the Degraded condition may change without the pods
+// changing, or zero if it may not.
+func (r *reconciler) suppressDNSDegraded(dns *operatorv1.DNS, computed []operatorv1.OperatorCondition, now time.Time) time.Duration {
+	oldCondition := conditions.FindOperatorCondition(dns.Status.Conditions, operatorv1.OperatorStatusTypeDegraded)
+	for i := range computed {
+		if computed[i].Type != operatorv1.OperatorStatusTypeDegraded {
+			continue
+		}
+		_, unavailable := podsUnavailableDegradedReasons[computed[i].Reason]
+		since := r.unavailability.observe(unavailable, now)
+		var after time.Duration
+		computed[i], after = suppressDNSDegradedCondition(dns, &computed[i], oldCondition, since, now)
+		return after
+	}
+	return 0
+}
@@ -0,0 +1,123 @@
+package controller
+
+import (
+	"testing"
+	"time"
+
+	operatorv1 "github.com/openshift/api/operator/v1"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestUnavailabilityTrackerObserve(t *testing.T) {
+	tracker := &unavailabilityTracker{}
+	start := time.Now()
+	if since := tracker.observe(false, start); !since.Equal(start) {
+		t.Errorf("expected first observation to start at %v, got %v", start, since)
+	}
+	if since := tracker.observe(false, start.Add(time.Minute)); !since.Equal(start) {
+		t.Errorf("expected unchanged state to keep %v, got %v", start, since)
+	}
+	changed := start.Add(2 * time.Minute)
+	if since := tracker.observe(true, changed); !since.Equal(changed) {
+		t.Errorf("expected changed state to start at %v, got %v", changed, since)
+	}
+}
+
+func TestSuppressDNSDegradedCondition(t *testing.T) {
+	now := time.Now()
+	degraded := func(status operatorv1.ConditionStatus, reason string) *operatorv1.OperatorCondition {
+		return &operatorv1.OperatorCondition{
+			Type:   operatorv1.OperatorStatusTypeDegraded,
+			Status: status,
+			Reason: reason,
+		}
+	}
+	suppression := operatorv1.DNSDegradedSuppression{
+		UnavailableGracePeriod: &metav1.Duration{Duration: 10 * time.Minute},
+		RecoveryPeriod:         &metav1.Duration{Duration: 5 * time.Minute},
+	}
+	testCases := []struct {
+		description    string
+		suppression    operatorv1.DNSDegradedSuppression
+		condition      *operatorv1.OperatorCondition
+		oldCondition   *operatorv1.OperatorCondition
+		elapsed        time.Duration
+		expectedStatus operatorv1.ConditionStatus
+		expectedReason string
+		expectedAfter  time.Duration
+	}{
+		{
+			description:    "no suppression",
+			condition:      degraded(operatorv1.ConditionTrue, "MaxUnavailableExceeded"),
+			expectedStatus: operatorv1.ConditionTrue,
+			expectedReason: "MaxUnavailableExceeded",
+		},
+		{
+			description:    "unavailable within grace period",
+			suppression:    suppression,
+			condition:      degraded(operatorv1.ConditionTrue, "MaxUnavailableExceeded"),
+			oldCondition:   degraded(operatorv1.ConditionFalse, "AsExpected"),
+			elapsed:        4 * time.Minute,
+			expectedStatus: operatorv1.ConditionFalse,
+			expectedReason: "UnavailablePodsWithinGracePeriod",
+			expectedAfter:  6 * time.Minute,
+		},
+		{
+			description:    "unavailable past grace period",
+			suppression:    suppression,
+			condition:      degraded(operatorv1.ConditionTrue, "MaxUnavailableExceeded"),
+			oldCondition:   degraded(operatorv1.ConditionFalse, "UnavailablePodsWithinGracePeriod"),
+			elapsed:        10 * time.Minute,
+			expectedStatus: operatorv1.ConditionTrue,
+			expectedReason: "MaxUnavailableExceeded",
+		},
+		{
+			description:    "no pods available within grace period",
+			suppression:    suppression,
+			condition:      degraded(operatorv1.ConditionTrue, "NoPodsAvailable"),
+			oldCondition:   degraded(operatorv1.ConditionFalse, "AsExpected"),
+			elapsed:        time.Minute,
+			expectedStatus: operatorv1.ConditionTrue,
+			expectedReason: "NoPodsAvailable",
+		},
+		{
+			description:    "available within recovery period",
+			suppression:    suppression,
+			condition:      degraded(operatorv1.ConditionFalse, "AsExpected"),
+			oldCondition:   degraded(operatorv1.ConditionTrue, "MaxUnavailableExceeded"),
+			elapsed:        2 * time.Minute,
+			expectedStatus: operatorv1.ConditionTrue,
+			expectedReason: "MaxUnavailableExceeded",
+			expectedAfter:  3 * time.Minute,
+		},
+		{
+			description:    "available past recovery period",
+			suppression:    suppression,
+			condition:      degraded(operatorv1.ConditionFalse, "AsExpected"),
+			oldCondition:   degraded(operatorv1.ConditionTrue, "NoPodsAvailable"),
+			elapsed:        5 * time.Minute,
+			expectedStatus: operatorv1.ConditionFalse,
+			expectedReason: "AsExpected",
+		},
+		{
+			description:    "recovered from other degradation",
+			suppression:    suppression,
+			condition:      degraded(operatorv1.ConditionFalse, "AsExpected"),
+			oldCondition:   degraded(operatorv1.ConditionTrue, "NoClusterIP"),
+			elapsed:        time.Minute,
+			expectedStatus: operatorv1.ConditionFalse,
+			expectedReason: "AsExpected",
+		},
+	}
+	for _, tc := range testCases {
+		dns := &operatorv1.DNS{Spec: operatorv1.DNSSpec{DegradedSuppression: tc.suppression}}
+		actual, after := suppressDNSDegradedCondition(dns, tc.condition, tc.oldCondition, now.Add(-tc.elapsed), now)
+		if actual.Status != tc.expectedStatus || actual.Reason != tc.expectedReason {
+			t.Errorf("%q: expected %s/%s, got %s/%s", tc.description, tc.expectedStatus, tc.expectedReason, actual.Status, actual.Reason)
+		}
+		if after != tc.expectedAfter {
+			t.Errorf("%q: expected to be checked again after %v, got %v", tc.description, tc.expectedAfter, after)
+		}
+	}
+}
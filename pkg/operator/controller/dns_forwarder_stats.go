@@ -0,0 +1,187 @@
+package controller
+
+import (
+	"net"
+	"sort"
+	"strings"
+	"sync"
+
+	operatorv1 "github.com/openshift/api/operator/v1"
+
+	dto "github.com/prometheus/client_model/go"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// forwarderCounters holds the forward plugin counters for a single upstream
+// resolver of a dns pod.
+type forwarderCounters struct {
+	requests            float64
+	responses           float64
+	healthcheckFailures float64
+}
+
+// forwarderStatsTracker remembers the forward plugin counters from the previous
+// sample of the pods of each dns so that upstream health is measured over the
+// sample interval rather than over the lifetime of the pods.
+type forwarderStatsTracker struct {
+	lock sync.Mutex
+	// last maps the name of each dns to the counters of each of its pods,
+	// keyed by pod name and then by upstream address.
+	last map[string]map[string]map[string]forwarderCounters
+}
+
+// parseForwarderCounters returns the forward plugin counters from the given
+// metrics of a dns pod, keyed by upstream address.
+func parseForwarderCounters(families map[string]*dto.MetricFamily) map[string]forwarderCounters {
+	result := map[string]forwarderCounters{}
+	collect := func(name string, add func(*forwarderCounters, float64)) {
+		family, ok := families[name]
+		if !ok {
+			return
+		}
+		for _, m := range family.Metric {
+			to := metricLabel(m, "to")
+			if len(to) == 0 {
+				continue
+			}
+			counters := result[to]
+			add(&counters, metricValue(m))
+			result[to] = counters
+		}
+	}
+	// CoreDNS 1.7.0 renamed the forward plugin metrics.
+	for _, name := range []string{"coredns_forward_requests_total", "coredns_forward_request_count_total"} {
+		collect(name, func(c *forwarderCounters, v float64) { c.requests += v })
+	}
+	for _, name := range []string{"coredns_forward_responses_total", "coredns_forward_response_rcode_count_total"} {
+		collect(name, func(c *forwarderCounters, v float64) { c.responses += v })
+	}
+	for _, name := range []string{"coredns_forward_healthcheck_failures_total", "coredns_forward_healthcheck_failure_count_total"} {
+		collect(name, func(c *forwarderCounters, v float64) { c.healthcheckFailures += v })
+	}
+	return result
+}
+
+// update records the given forward plugin counters of the pods of the named
+// dns, keyed by pod name, and returns the counters of each upstream since the
+// previous sample, summed over the pods.  Pods that were not sampled before,
+// or whose counters were reset because the container restarted, are measured
+// over the lifetime of the container.
+func (t *forwarderStatsTracker) update(dns string, current map[string]map[string]forwarderCounters) map[string]forwarderCounters {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	if t.last == nil {
+		t.last = map[string]map[string]map[string]forwarderCounters{}
+	}
+	deltas := map[string]forwarderCounters{}
+	for pod, upstreams := range current {
+		for to, c := range upstreams {
+			delta := c
+			if last, ok := t.last[dns][pod][to]; ok && c.requests >= last.requests && c.responses >= last.responses && c.healthcheckFailures >= last.healthcheckFailures {
+				delta = forwarderCounters{
+					requests:            c.requests - last.requests,
+					responses:           c.responses - last.responses,
+					healthcheckFailures: c.healthcheckFailures - last.healthcheckFailures,
+				}
+			}
+			sum := deltas[to]
+			sum.requests += delta.requests
+			sum.responses += delta.responses
+			sum.healthcheckFailures += delta.healthcheckFailures
+			deltas[to] = sum
+		}
+	}
+	t.last[dns] = current
+	return deltas
+}
+
+// forget removes the counters of the named dns.
+func (t *forwarderStatsTracker) forget(dns string) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	delete(t.last, dns)
+}
+
+// sampleForwarderStats returns the health of the upstream resolvers of the
+// given dns from the given metrics of its pods, keyed by pod name.
+func (r *reconciler) sampleForwarderStats(dns *operatorv1.DNS, podMetrics map[string]map[string]*dto.MetricFamily, now metav1.Time) *operatorv1.DNSForwarderStats {
+	current := map[string]map[string]forwarderCounters{}
+	for pod, families := range podMetrics {
+		current[pod] = parseForwarderCounters(families)
+	}
+	deltas := r.forwarderStats.update(dns.Name, current)
+	return summarizeForwarderStats(dns, deltas, int32(len(podMetrics)), now)
+}
+
+// summarizeForwarderStats returns the health of each upstream resolver of the
+// given dns from the given counters, keyed by upstream address.
+func summarizeForwarderStats(dns *operatorv1.DNS, deltas map[string]forwarderCounters, podCount int32, now metav1.Time) *operatorv1.DNSForwarderStats {
+	servers := upstreamServers(dns)
+	upstreams := []operatorv1.DNSUpstreamStatus{}
+	for to, c := range deltas {
+		requests := int64(c.requests)
+		errors := int64(c.healthcheckFailures)
+		if unanswered := int64(c.requests - c.responses); unanswered > 0 {
+			errors += unanswered
+		}
+		health := operatorv1.DNSUpstreamHealthOK
+		switch {
+		case errors == 0:
+		case c.responses == 0:
+			health = operatorv1.DNSUpstreamHealthDown
+		default:
+			health = operatorv1.DNSUpstreamHealthFlaky
+		}
+		upstreams = append(upstreams, operatorv1.DNSUpstreamStatus{
+			Address:  to,
+			Servers:  servers[to],
+			Health:   health,
+			Requests: requests,
+			Errors:   errors,
+		})
+	}
+	sort.Slice(upstreams, func(i, j int) bool { return upstreams[i].Address < upstreams[j].Address })
+	return &operatorv1.DNSForwarderStats{
+		SampleTime: now,
+		PodCount:   podCount,
+		Upstreams:  upstreams,
+	}
+}
+
+// upstreamServers returns the names of the servers of the given dns that
+// forward to each upstream, keyed by the address and port that the forward
+// plugin reports in its metrics.
+func upstreamServers(dns *operatorv1.DNS) map[string][]string {
+	result := map[string][]string{}
+	for _, server := range dns.Spec.Servers {
+		seen := map[string]bool{}
+		for _, upstream := range forwardUpstreams(server) {
+			address := upstreamAddress(upstream)
+			if seen[address] {
+				continue
+			}
+			seen[address] = true
+			result[address] = append(result[address], server.Name)
+		}
+	}
+	return result
+}
+
+// upstreamAddress returns the address and port of the given upstream, which
+// may have a tls:// or dns:// prefix and may omit the port.
+func upstreamAddress(upstream string) string {
+	port := "53"
+	switch {
+	case strings.HasPrefix(upstream, "tls://"):
+		upstream = strings.TrimPrefix(upstream, "tls://")
+		port = "853"
+	case strings.HasPrefix(upstream, "dns://"):
+		upstream = strings.TrimPrefix(upstream, "dns://")
+	}
+	if _, _, err := net.SplitHostPort(upstream); err == nil {
+		return upstream
+	}
+	return net.JoinHostPort(strings.Trim(upstream, "[]"), port)
+}
@@ -0,0 +1,132 @@
+package controller
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+
+	operatorv1 "github.com/openshift/api/operator/v1"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestParseForwarderCounters(t *testing.T) {
+	metrics := `# TYPE coredns_forward_requests_total counter
+coredns_forward_requests_total{to="10.0.0.1:53"} 100
+coredns_forward_requests_total{to="10.0.0.2:53"} 10
+# TYPE coredns_forward_responses_total counter
+coredns_forward_responses_total{rcode="NOERROR",to="10.0.0.1:53"} 90
+coredns_forward_responses_total{rcode="NXDOMAIN",to="10.0.0.1:53"} 8
+# TYPE coredns_forward_healthcheck_failures_total counter
+coredns_forward_healthcheck_failures_total{to="10.0.0.2:53"} 4
+# TYPE coredns_forward_healthcheck_broken_total counter
+coredns_forward_healthcheck_broken_total 1
+`
+	families, err := parsePodMetrics(strings.NewReader(metrics))
+	if err != nil {
+		t.Fatalf("failed to parse metrics: %v", err)
+	}
+	expected := map[string]forwarderCounters{
+		"10.0.0.1:53": {requests: 100, responses: 98},
+		"10.0.0.2:53": {requests: 10, healthcheckFailures: 4},
+	}
+	actual := parseForwarderCounters(families)
+	if !cmp.Equal(actual, expected, cmp.AllowUnexported(forwarderCounters{})) {
+		t.Errorf("expected %v, got %v", expected, actual)
+	}
+
+	// CoreDNS before 1.7.0 uses different metric names.
+	metrics = `# TYPE coredns_forward_request_count_total counter
+coredns_forward_request_count_total{to="10.0.0.1:53"} 5
+# TYPE coredns_forward_response_rcode_count_total counter
+coredns_forward_response_rcode_count_total{rcode="NOERROR",to="10.0.0.1:53"} 5
+# TYPE coredns_forward_healthcheck_failure_count_total counter
+coredns_forward_healthcheck_failure_count_total{to="10.0.0.1:53"} 1
+`
+	families, err = parsePodMetrics(strings.NewReader(metrics))
+	if err != nil {
+		t.Fatalf("failed to parse metrics: %v", err)
+	}
+	expected = map[string]forwarderCounters{
+		"10.0.0.1:53": {requests: 5, responses: 5, healthcheckFailures: 1},
+	}
+	actual = parseForwarderCounters(families)
+	if !cmp.Equal(actual, expected, cmp.AllowUnexported(forwarderCounters{})) {
+		t.Errorf("expected %v, got %v", expected, actual)
+	}
+}
+
+func TestForwarderStatsTrackerUpdate(t *testing.T) {
+	tracker := &forwarderStatsTracker{}
+
+	// The first sample is measured over the lifetime of the pods.
+	deltas := tracker.update("default", map[string]map[string]forwarderCounters{
+		"a": {"10.0.0.1:53": {requests: 10, responses: 10}},
+		"b": {"10.0.0.1:53": {requests: 20, responses: 18}},
+	})
+	expected := map[string]forwarderCounters{
+		"10.0.0.1:53": {requests: 30, responses: 28},
+	}
+	if !cmp.Equal(deltas, expected, cmp.AllowUnexported(forwarderCounters{})) {
+		t.Errorf("expected %v, got %v", expected, deltas)
+	}
+
+	// Other dnses are tracked separately.
+	tracker.update("other", map[string]map[string]forwarderCounters{
+		"c": {"10.0.0.1:53": {requests: 1000, responses: 0}},
+	})
+
+	// Later samples are measured since the previous sample, except for
+	// pods whose counters were reset.
+	deltas = tracker.update("default", map[string]map[string]forwarderCounters{
+		"a": {"10.0.0.1:53": {requests: 15, responses: 15}},
+		"b": {"10.0.0.1:53": {requests: 5, responses: 5, healthcheckFailures: 1}},
+	})
+	expected = map[string]forwarderCounters{
+		"10.0.0.1:53": {requests: 10, responses: 10, healthcheckFailures: 1},
+	}
+	if !cmp.Equal(deltas, expected, cmp.AllowUnexported(forwarderCounters{})) {
+		t.Errorf("expected %v, got %v", expected, deltas)
+	}
+}
+
+func TestSummarizeForwarderStats(t *testing.T) {
+	dns := &operatorv1.DNS{
+		Spec: operatorv1.DNSSpec{
+			Servers: []operatorv1.Server{{
+				Name:          "foo",
+				Zones:         []string{"foo.com"},
+				ForwardPlugin: operatorv1.ForwardPlugin{Upstreams: []string{"10.0.0.1", "10.0.0.2:5353"}},
+			}, {
+				Name:          "bar",
+				Zones:         []string{"bar.com"},
+				ForwardPlugin: operatorv1.ForwardPlugin{Upstreams: []string{"10.0.0.1:53", "tls://10.0.0.3"}},
+			}},
+		},
+	}
+	now := metav1.NewTime(time.Date(2020, 6, 1, 12, 0, 0, 0, time.UTC))
+	deltas := map[string]forwarderCounters{
+		"10.0.0.1:53":   {requests: 100, responses: 100},
+		"10.0.0.2:5353": {requests: 100, responses: 90, healthcheckFailures: 2},
+		"10.0.0.3:853":  {requests: 10, responses: 0},
+		"10.0.0.4:53":   {healthcheckFailures: 3},
+		"10.0.0.5:53":   {},
+	}
+	expected := &operatorv1.DNSForwarderStats{
+		SampleTime: now,
+		PodCount:   2,
+		Upstreams: []operatorv1.DNSUpstreamStatus{
+			{Address: "10.0.0.1:53", Servers: []string{"foo", "bar"}, Health: operatorv1.DNSUpstreamHealthOK, Requests: 100},
+			{Address: "10.0.0.2:5353", Servers: []string{"foo"}, Health: operatorv1.DNSUpstreamHealthFlaky, Requests: 100, Errors: 12},
+			{Address: "10.0.0.3:853", Servers: []string{"bar"}, Health: operatorv1.DNSUpstreamHealthDown, Requests: 10, Errors: 10},
+			{Address: "10.0.0.4:53", Health: operatorv1.DNSUpstreamHealthDown, Errors: 3},
+			{Address: "10.0.0.5:53", Health: operatorv1.DNSUpstreamHealthOK},
+		},
+	}
+	actual := summarizeForwarderStats(dns, deltas, 2, now)
+	if !cmp.Equal(actual, expected) {
+		t.Errorf("unexpected forwarder stats:\n%s", cmp.Diff(expected, actual))
+	}
+}
@@ -0,0 +1,104 @@
+package controller
+
+import (
+	"sync"
+
+	operatorv1 "github.com/openshift/api/operator/v1"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	// maxDNSHistoryEntries is the number of entries that are kept in the
+	// history in the status of a dns.
+	maxDNSHistoryEntries = 20
+
+	// dnsHistoryCorefileUpdated is the type of a history entry for
+	// creating or updating the Corefile configmap.
+	dnsHistoryCorefileUpdated = "CorefileUpdated"
+
+	// dnsHistoryCorefileRejected is the type of a history entry for
+	// refusing to write a Corefile that the managed CoreDNS does not
+	// support.
+	dnsHistoryCorefileRejected = "CorefileRejected"
+
+	// dnsHistoryExtensionServerRejected is the type of a history entry for
+	// ignoring an invalid extension server configmap.
+	dnsHistoryExtensionServerRejected = "ExtensionServerRejected"
+
+	// dnsHistoryDaemonSetUpdated is the type of a history entry for
+	// creating or updating the dns daemonset, which starts a rollout.
+	dnsHistoryDaemonSetUpdated = "DaemonSetUpdated"
+
+	// dnsHistoryDaemonSetRolloutDeferred is the type of a history entry
+	// for deferring a rollout of the dns daemonset while too many dns pods
+	// are unavailable.
+	dnsHistoryDaemonSetRolloutDeferred = "DaemonSetRolloutDeferred"
+
+	// dnsHistoryReconciliationResumed is the type of a history entry for
+	// resuming reconciliation of the dns after it was paused.
+	dnsHistoryReconciliationResumed = "ReconciliationResumed"
+
+	// dnsHistoryProfileCollected is the type of a history entry for
+	// collecting CoreDNS profiles from a dns pod.
+	dnsHistoryProfileCollected = "ProfileCollected"
+)
+
+// dnsHistoryRecorder holds the history entries of each dns that have not yet
+// been written to the status of the dns.
+type dnsHistoryRecorder struct {
+	lock    sync.Mutex
+	pending map[string][]operatorv1.DNSHistoryEntry
+}
+
+// record records an action of the given type for the named dns.
+func (h *dnsHistoryRecorder) record(dns, entryType, message string) {
+	h.lock.Lock()
+	defer h.lock.Unlock()
+	if h.pending == nil {
+		h.pending = map[string][]operatorv1.DNSHistoryEntry{}
+	}
+	h.pending[dns] = append(h.pending[dns], operatorv1.DNSHistoryEntry{
+		Time:    metav1.Now(),
+		Type:    entryType,
+		Message: message,
+	})
+}
+
+// peek returns the pending entries of the named dns without removing them.
+func (h *dnsHistoryRecorder) peek(dns string) []operatorv1.DNSHistoryEntry {
+	h.lock.Lock()
+	defer h.lock.Unlock()
+	return append([]operatorv1.DNSHistoryEntry{}, h.pending[dns]...)
+}
+
+// discard removes the first n pending entries of the named dns once they have
+// been written to its status.
+func (h *dnsHistoryRecorder) discard(dns string, n int) {
+	h.lock.Lock()
+	defer h.lock.Unlock()
+	if n >= len(h.pending[dns]) {
+		delete(h.pending, dns)
+		return
+	}
+	h.pending[dns] = h.pending[dns][n:]
+}
+
+// appendDNSHistory returns the given history with the given entries appended.
+// An entry with the same type and message as the entry before it is skipped so
+// that an action that is repeated on every reconcile, such as rejecting the
+// same Corefile, is recorded once.  Only the most recent maxDNSHistoryEntries
+// entries are kept.
+func appendDNSHistory(history, entries []operatorv1.DNSHistoryEntry) []operatorv1.DNSHistoryEntry {
+	result := append([]operatorv1.DNSHistoryEntry{}, history...)
+	for _, entry := range entries {
+		if n := len(result); n != 0 && result[n-1].Type == entry.Type && result[n-1].Message == entry.Message {
+			continue
+		}
+		result = append(result, entry)
+	}
+	if len(result) > maxDNSHistoryEntries {
+		result = result[len(result)-maxDNSHistoryEntries:]
+	}
+	return result
+}
@@ -0,0 +1,72 @@
+package controller
+
+import (
+	"fmt"
+	"testing"
+
+	operatorv1 "github.com/openshift/api/operator/v1"
+)
+
+func TestAppendDNSHistory(t *testing.T) {
+	entry := func(entryType, message string) operatorv1.DNSHistoryEntry {
+		return operatorv1.DNSHistoryEntry{Type: entryType, Message: message}
+	}
+	history := appendDNSHistory(nil, []operatorv1.DNSHistoryEntry{
+		entry(dnsHistoryCorefileUpdated, "Created Corefile with hash a"),
+		entry(dnsHistoryDaemonSetUpdated, "Created DaemonSet"),
+		entry(dnsHistoryCorefileRejected, "CoreDNS 1.6.6 does not support plugin multisocket"),
+	})
+	if len(history) != 3 {
+		t.Fatalf("expected 3 entries, got %+v", history)
+	}
+
+	// A repeated rejection is recorded once, but a rejection after another
+	// action is recorded again.
+	history = appendDNSHistory(history, []operatorv1.DNSHistoryEntry{
+		entry(dnsHistoryCorefileRejected, "CoreDNS 1.6.6 does not support plugin multisocket"),
+	})
+	if len(history) != 3 {
+		t.Errorf("expected repeated entry to be skipped, got %+v", history)
+	}
+	history = appendDNSHistory(history, []operatorv1.DNSHistoryEntry{
+		entry(dnsHistoryCorefileUpdated, "Updated Corefile to hash b"),
+		entry(dnsHistoryCorefileRejected, "CoreDNS 1.6.6 does not support plugin multisocket"),
+	})
+	if len(history) != 5 {
+		t.Errorf("expected 5 entries, got %+v", history)
+	}
+
+	// Only the most recent entries are kept.
+	entries := []operatorv1.DNSHistoryEntry{}
+	for i := 0; i < maxDNSHistoryEntries; i++ {
+		entries = append(entries, entry(dnsHistoryDaemonSetUpdated, fmt.Sprintf("Updated DaemonSet to generation %d", i)))
+	}
+	history = appendDNSHistory(history, entries)
+	if len(history) != maxDNSHistoryEntries {
+		t.Fatalf("expected %d entries, got %d", maxDNSHistoryEntries, len(history))
+	}
+	if history[0] != entries[0] || history[len(history)-1] != entries[len(entries)-1] {
+		t.Errorf("expected the most recent entries to be kept, got %+v", history)
+	}
+}
+
+func TestDNSHistoryRecorder(t *testing.T) {
+	h := &dnsHistoryRecorder{}
+	h.record("default", dnsHistoryCorefileUpdated, "Created Corefile with hash a")
+	h.record("default", dnsHistoryDaemonSetUpdated, "Created DaemonSet")
+	pending := h.peek("default")
+	if len(pending) != 2 {
+		t.Fatalf("expected 2 pending entries, got %+v", pending)
+	}
+	// An entry that is recorded while the status is being written stays
+	// pending.
+	h.record("default", dnsHistoryDaemonSetUpdated, "Updated DaemonSet to generation 2")
+	h.discard("default", len(pending))
+	if pending := h.peek("default"); len(pending) != 1 || pending[0].Message != "Updated DaemonSet to generation 2" {
+		t.Errorf("expected only the later entry to be pending, got %+v", pending)
+	}
+	h.discard("default", 1)
+	if pending := h.peek("default"); len(pending) != 0 {
+		t.Errorf("expected no pending entries, got %+v", pending)
+	}
+}
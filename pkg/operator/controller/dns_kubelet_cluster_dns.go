@@ -0,0 +1,156 @@
+package controller
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	operatorv1 "github.com/openshift/api/operator/v1"
+
+	"github.com/openshift/cluster-dns-operator/pkg/util/conditions"
+
+	"github.com/sirupsen/logrus"
+
+	corev1 "k8s.io/api/core/v1"
+)
+
+const (
+	// DNSKubeletClusterDNSMismatchConditionType is the type of the dns
+	// status condition that reports whether the kubelet on some node is
+	// configured with a cluster DNS address other than the cluster IP of
+	// the dns service.
+	DNSKubeletClusterDNSMismatchConditionType = "KubeletClusterDNSMismatch"
+)
+
+// kubeletClusterDNSSample is the cluster DNS configuration of the kubelets
+// compared against the cluster IP of a dns service.
+type kubeletClusterDNSSample struct {
+	// sampledNodes is the number of nodes whose kubelet configuration was
+	// read.
+	sampledNodes int
+	// mismatchedNodes maps the name of each node whose kubelet is not
+	// configured with the cluster IP to the addresses that it is
+	// configured with.
+	mismatchedNodes map[string][]string
+}
+
+// kubeletConfigz is the part of the response of the kubelet configz endpoint
+// that is needed to check the cluster DNS configuration.
+type kubeletConfigz struct {
+	KubeletConfig struct {
+		ClusterDNS []string `json:"clusterDNS"`
+	} `json:"kubeletconfig"`
+}
+
+// sampleKubeletClusterDNS reads the kubelet configuration of each healthy node
+// and compares its cluster DNS addresses against the given cluster IP.  Nodes
+// whose configuration cannot be read are left out of the sample.
+func (r *reconciler) sampleKubeletClusterDNS(clusterIP string) (*kubeletClusterDNSSample, error) {
+	nodes := &corev1.NodeList{}
+	if err := r.client.List(context.TODO(), nodes); err != nil {
+		return nil, fmt.Errorf("failed to list nodes: %v", err)
+	}
+	configs := map[string][]string{}
+	for i := range nodes.Items {
+		node := &nodes.Items[i]
+		// The kubelet on a broken node does not answer, and a
+		// dns pod on it is not expected to be healthy either.
+		if nodeUnhealthy(node) {
+			continue
+		}
+		clusterDNS, err := r.getKubeletClusterDNS(node.Name)
+		if err != nil {
+			logrus.Infof("failed to get kubelet configuration from node %s: %v", node.Name, err)
+			continue
+		}
+		configs[node.Name] = clusterDNS
+	}
+	return compareKubeletClusterDNS(clusterIP, configs), nil
+}
+
+// getKubeletClusterDNS returns the cluster DNS addresses that the kubelet on
+// the given node is configured with, read through the API server's node proxy.
+func (r *reconciler) getKubeletClusterDNS(node string) ([]string, error) {
+	ctx, cancel := context.WithTimeout(context.TODO(), nodeMetricsScrapeTimeout)
+	defer cancel()
+	body, err := r.kubeClient.CoreV1().RESTClient().Get().
+		Resource("nodes").Name(node).SubResource("proxy").Suffix("configz").
+		DoRaw(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return parseKubeletClusterDNS(body)
+}
+
+// parseKubeletClusterDNS returns the cluster DNS addresses from the given
+// response of the kubelet configz endpoint.
+func parseKubeletClusterDNS(body []byte) ([]string, error) {
+	configz := &kubeletConfigz{}
+	if err := json.Unmarshal(body, configz); err != nil {
+		return nil, fmt.Errorf("failed to parse kubelet configuration: %v", err)
+	}
+	return configz.KubeletConfig.ClusterDNS, nil
+}
+
+// compareKubeletClusterDNS returns a sample of the given cluster DNS addresses
+// of the kubelets, keyed by node name, in which a node is mismatched if its
+// kubelet is not configured with the given cluster IP.
+func compareKubeletClusterDNS(clusterIP string, configs map[string][]string) *kubeletClusterDNSSample {
+	sample := &kubeletClusterDNSSample{
+		sampledNodes:    len(configs),
+		mismatchedNodes: map[string][]string{},
+	}
+	for node, clusterDNS := range configs {
+		found := false
+		for _, ip := range clusterDNS {
+			if ip == clusterIP {
+				found = true
+				break
+			}
+		}
+		if !found {
+			sample.mismatchedNodes[node] = clusterDNS
+		}
+	}
+	return sample
+}
+
+// computeDNSKubeletClusterDNSMismatchCondition computes the dns
+// KubeletClusterDNSMismatch status condition from the given sample.  If sample
+// is nil, the old condition is kept.
+func computeDNSKubeletClusterDNSMismatchCondition(oldConditions []operatorv1.OperatorCondition, sample *kubeletClusterDNSSample, clusterIP string) *operatorv1.OperatorCondition {
+	oldCondition := conditions.FindOperatorCondition(oldConditions, DNSKubeletClusterDNSMismatchConditionType)
+	if sample == nil {
+		return oldCondition
+	}
+
+	condition := &operatorv1.OperatorCondition{
+		Type: DNSKubeletClusterDNSMismatchConditionType,
+	}
+	if len(sample.mismatchedNodes) == 0 {
+		condition.Status = operatorv1.ConditionFalse
+		condition.Reason = "AsExpected"
+		condition.Message = fmt.Sprintf("The kubelets on all sampled nodes use cluster DNS address %s", clusterIP)
+	} else {
+		nodes := []string{}
+		for node := range sample.mismatchedNodes {
+			nodes = append(nodes, node)
+		}
+		sort.Strings(nodes)
+		mismatches := []string{}
+		for _, node := range nodes {
+			clusterDNS := strings.Join(sample.mismatchedNodes[node], ",")
+			if len(clusterDNS) == 0 {
+				clusterDNS = "none"
+			}
+			mismatches = append(mismatches, fmt.Sprintf("%s (%s)", node, clusterDNS))
+		}
+		condition.Status = operatorv1.ConditionTrue
+		condition.Reason = "ClusterDNSMismatch"
+		condition.Message = fmt.Sprintf("The kubelets on %d of %d nodes do not use cluster DNS address %s: %s", len(nodes), sample.sampledNodes, clusterIP, strings.Join(mismatches, ", "))
+	}
+	c := conditions.SetOperatorConditionTransitionTime(condition, oldCondition)
+	return &c
+}
@@ -0,0 +1,95 @@
+package controller
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	operatorv1 "github.com/openshift/api/operator/v1"
+)
+
+func TestParseKubeletClusterDNS(t *testing.T) {
+	testCases := []struct {
+		description string
+		body        string
+		expected    []string
+		expectErr   bool
+	}{
+		{
+			description: "cluster DNS configured",
+			body:        `{"kubeletconfig":{"clusterDomain":"cluster.local","clusterDNS":["172.30.0.10"]}}`,
+			expected:    []string{"172.30.0.10"},
+		},
+		{
+			description: "no cluster DNS",
+			body:        `{"kubeletconfig":{"clusterDomain":"cluster.local"}}`,
+		},
+		{
+			description: "invalid JSON",
+			body:        `404 page not found`,
+			expectErr:   true,
+		},
+	}
+	for _, tc := range testCases {
+		actual, err := parseKubeletClusterDNS([]byte(tc.body))
+		switch {
+		case tc.expectErr && err == nil:
+			t.Errorf("%q: expected error, got %v", tc.description, actual)
+		case !tc.expectErr && err != nil:
+			t.Errorf("%q: unexpected error: %v", tc.description, err)
+		case !cmp.Equal(actual, tc.expected):
+			t.Errorf("%q: expected %v, got %v", tc.description, tc.expected, actual)
+		}
+	}
+}
+
+func TestCompareKubeletClusterDNS(t *testing.T) {
+	sample := compareKubeletClusterDNS("172.30.0.10", map[string][]string{
+		"a": {"172.30.0.10"},
+		"b": {"169.254.20.10", "172.30.0.10"},
+		"c": {"172.30.0.11"},
+		"d": nil,
+	})
+	expected := &kubeletClusterDNSSample{
+		sampledNodes: 4,
+		mismatchedNodes: map[string][]string{
+			"c": {"172.30.0.11"},
+			"d": nil,
+		},
+	}
+	if !cmp.Equal(sample, expected, cmp.AllowUnexported(kubeletClusterDNSSample{})) {
+		t.Errorf("expected %+v, got %+v", expected, sample)
+	}
+}
+
+func TestComputeDNSKubeletClusterDNSMismatchCondition(t *testing.T) {
+	if c := computeDNSKubeletClusterDNSMismatchCondition(nil, nil, "172.30.0.10"); c != nil {
+		t.Errorf("expected no condition without a sample, got %+v", c)
+	}
+
+	mismatched := computeDNSKubeletClusterDNSMismatchCondition(nil, &kubeletClusterDNSSample{
+		sampledNodes: 3,
+		mismatchedNodes: map[string][]string{
+			"b": nil,
+			"a": {"172.30.0.11"},
+		},
+	}, "172.30.0.10")
+	if mismatched.Status != operatorv1.ConditionTrue {
+		t.Errorf("expected KubeletClusterDNSMismatch=True, got %s", mismatched.Status)
+	}
+	if expected := "on 2 of 3 nodes do not use cluster DNS address 172.30.0.10: a (172.30.0.11), b (none)"; !strings.HasSuffix(mismatched.Message, expected) {
+		t.Errorf("expected message to end with %q, got %q", expected, mismatched.Message)
+	}
+
+	// Without a new sample, the old condition is kept.
+	old := []operatorv1.OperatorCondition{*mismatched}
+	if c := computeDNSKubeletClusterDNSMismatchCondition(old, nil, "172.30.0.10"); !cmp.Equal(c, mismatched) {
+		t.Errorf("expected %+v, got %+v", mismatched, c)
+	}
+
+	recovered := computeDNSKubeletClusterDNSMismatchCondition(old, &kubeletClusterDNSSample{sampledNodes: 3}, "172.30.0.10")
+	if recovered.Status != operatorv1.ConditionFalse {
+		t.Errorf("expected KubeletClusterDNSMismatch=False, got %s", recovered.Status)
+	}
+}
@@ -0,0 +1,128 @@
+package controller
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	operatorv1 "github.com/openshift/api/operator/v1"
+
+	"github.com/openshift/cluster-dns-operator/pkg/manifests"
+	"github.com/openshift/cluster-dns-operator/pkg/util/conditions"
+
+	"github.com/sirupsen/logrus"
+
+	corev1 "k8s.io/api/core/v1"
+
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	// namespaceRecoveryCheckInterval is the interval at which the
+	// operator checks whether a terminating dns namespace is gone so that
+	// it can recreate the namespace and the resources in it.
+	namespaceRecoveryCheckInterval = 10 * time.Second
+
+	// namespaceTerminatingReason is the reason of the dns status
+	// conditions while the dns namespace is being deleted.
+	namespaceTerminatingReason = "NamespaceTerminating"
+)
+
+// terminatingDNSNamespace returns the dns namespace if it is being deleted.
+// Otherwise, including if the namespace does not exist, it returns nil.
+func (r *reconciler) terminatingDNSNamespace() (*corev1.Namespace, error) {
+	ns := &corev1.Namespace{}
+	if err := r.client.Get(context.TODO(), types.NamespacedName{Name: manifests.DNSNamespace().Name}, ns); err != nil {
+		if errors.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	if ns.DeletionTimestamp == nil {
+		return nil, nil
+	}
+	return ns, nil
+}
+
+// namespaceLabelsChanged returns a Boolean indicating whether the current dns
+// namespace lacks any of the labels of the expected one, or has a different
+// value for any of them, and the updated namespace if so.  Other labels are
+// kept.
+func namespaceLabelsChanged(current, expected *corev1.Namespace) (bool, *corev1.Namespace) {
+	changed := false
+	updated := current.DeepCopy()
+	for key, value := range expected.Labels {
+		if current.Labels[key] == value {
+			continue
+		}
+		if updated.Labels == nil {
+			updated.Labels = map[string]string{}
+		}
+		updated.Labels[key] = value
+		changed = true
+	}
+	return changed, updated
+}
+
+// syncDNSNamespaceTerminatingStatus reports in the status of the given dns
+// that the given dns namespace is being deleted and how many pods remain in
+// it.  Nothing can be created in the namespace until it is gone, at which
+// point the operator recreates the namespace and the resources in it.
+func (r *reconciler) syncDNSNamespaceTerminatingStatus(dns *operatorv1.DNS, ns *corev1.Namespace) error {
+	remainingPods := -1
+	pods := &corev1.PodList{}
+	if err := r.client.List(context.TODO(), pods, client.InNamespace(ns.Name)); err != nil {
+		logrus.Errorf("failed to list pods in terminating namespace %s: %v", ns.Name, err)
+	} else {
+		remainingPods = len(pods.Items)
+	}
+	updated := dns.DeepCopy()
+	updated.Status.Conditions = computeDNSNamespaceTerminatingConditions(dns.Status.Conditions, ns, remainingPods)
+	if !dnsStatusesEqual(updated.Status, dns.Status) {
+		if err := r.client.Status().Update(context.TODO(), updated); err != nil {
+			return fmt.Errorf("failed to update dns status: %v", err)
+		}
+		logrus.Infof("updated DNS %s status: old: %#v, new: %#v", dns.ObjectMeta.Name, dns.Status, updated.Status)
+	}
+	return nil
+}
+
+// computeDNSNamespaceTerminatingConditions returns the given dns status
+// conditions with the Degraded and Progressing conditions replaced to report
+// that the given namespace is being deleted and that the given number of pods
+// remain in it, or an unknown number if remainingPods is negative.  The other
+// conditions are kept.
+func computeDNSNamespaceTerminatingConditions(oldConditions []operatorv1.OperatorCondition, ns *corev1.Namespace, remainingPods int) []operatorv1.OperatorCondition {
+	remaining := "an unknown number of pods remain"
+	if remainingPods >= 0 {
+		remaining = fmt.Sprintf("%d pods remain", remainingPods)
+	}
+	degradedCondition := &operatorv1.OperatorCondition{
+		Type:    operatorv1.OperatorStatusTypeDegraded,
+		Status:  operatorv1.ConditionTrue,
+		Reason:  namespaceTerminatingReason,
+		Message: fmt.Sprintf("Namespace %s has been terminating since %s; the namespace and DNS resources will be recreated once it is gone", ns.Name, ns.DeletionTimestamp.UTC().Format(time.RFC3339)),
+	}
+	progressingCondition := &operatorv1.OperatorCondition{
+		Type:    operatorv1.OperatorStatusTypeProgressing,
+		Status:  operatorv1.ConditionTrue,
+		Reason:  namespaceTerminatingReason,
+		Message: fmt.Sprintf("Waiting for namespace %s to be deleted: %s", ns.Name, remaining),
+	}
+
+	kept := []operatorv1.OperatorCondition{}
+	for i := range oldConditions {
+		switch oldConditions[i].Type {
+		case operatorv1.OperatorStatusTypeDegraded, operatorv1.OperatorStatusTypeProgressing:
+		default:
+			kept = append(kept, oldConditions[i])
+		}
+	}
+	return append(kept,
+		conditions.SetOperatorConditionTransitionTime(degradedCondition, conditions.FindOperatorCondition(oldConditions, operatorv1.OperatorStatusTypeDegraded)),
+		conditions.SetOperatorConditionTransitionTime(progressingCondition, conditions.FindOperatorCondition(oldConditions, operatorv1.OperatorStatusTypeProgressing)),
+	)
+}
@@ -0,0 +1,105 @@
+package controller
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	operatorv1 "github.com/openshift/api/operator/v1"
+
+	"github.com/openshift/cluster-dns-operator/pkg/manifests"
+
+	corev1 "k8s.io/api/core/v1"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestComputeDNSNamespaceTerminatingConditions(t *testing.T) {
+	deleted := metav1.NewTime(time.Date(2020, 6, 1, 12, 0, 0, 0, time.UTC))
+	ns := &corev1.Namespace{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:              "openshift-dns",
+			DeletionTimestamp: &deleted,
+		},
+	}
+	oldConditions := []operatorv1.OperatorCondition{
+		{Type: operatorv1.OperatorStatusTypeDegraded, Status: operatorv1.ConditionFalse, Reason: "AsExpected"},
+		{Type: operatorv1.OperatorStatusTypeProgressing, Status: operatorv1.ConditionFalse, Reason: "AsExpected"},
+		{Type: operatorv1.OperatorStatusTypeAvailable, Status: operatorv1.ConditionTrue, Reason: "AsExpected"},
+	}
+
+	testCases := []struct {
+		description   string
+		remainingPods int
+		expectMessage string
+	}{
+		{
+			description:   "pods remain",
+			remainingPods: 3,
+			expectMessage: "Waiting for namespace openshift-dns to be deleted: 3 pods remain",
+		},
+		{
+			description:   "unknown number of pods",
+			remainingPods: -1,
+			expectMessage: "Waiting for namespace openshift-dns to be deleted: an unknown number of pods remain",
+		},
+	}
+	for _, tc := range testCases {
+		conditions := computeDNSNamespaceTerminatingConditions(oldConditions, ns, tc.remainingPods)
+		if len(conditions) != 3 {
+			t.Fatalf("%s: expected 3 conditions, got %+v", tc.description, conditions)
+		}
+		for _, c := range conditions {
+			switch c.Type {
+			case operatorv1.OperatorStatusTypeDegraded:
+				if c.Status != operatorv1.ConditionTrue || c.Reason != "NamespaceTerminating" {
+					t.Errorf("%s: expected Degraded=True with reason NamespaceTerminating, got %+v", tc.description, c)
+				}
+				if !strings.Contains(c.Message, "2020-06-01T12:00:00Z") {
+					t.Errorf("%s: expected Degraded message to contain the deletion time, got %q", tc.description, c.Message)
+				}
+			case operatorv1.OperatorStatusTypeProgressing:
+				if c.Status != operatorv1.ConditionTrue || c.Reason != "NamespaceTerminating" {
+					t.Errorf("%s: expected Progressing=True with reason NamespaceTerminating, got %+v", tc.description, c)
+				}
+				if c.Message != tc.expectMessage {
+					t.Errorf("%s: expected Progressing message %q, got %q", tc.description, tc.expectMessage, c.Message)
+				}
+			case operatorv1.OperatorStatusTypeAvailable:
+				if c != oldConditions[2] {
+					t.Errorf("%s: expected Available condition to be kept, got %+v", tc.description, c)
+				}
+			}
+		}
+	}
+}
+
+func TestNamespaceLabelsChanged(t *testing.T) {
+	expected := manifests.DNSNamespace()
+	for _, key := range []string{"openshift.io/cluster-monitoring", "pod-security.kubernetes.io/enforce"} {
+		if len(expected.Labels[key]) == 0 {
+			t.Fatalf("expected the dns namespace to have label %s", key)
+		}
+	}
+
+	current := expected.DeepCopy()
+	current.Labels["example.com/team"] = "network"
+	if changed, _ := namespaceLabelsChanged(current, expected); changed {
+		t.Errorf("expected an extra label to be ignored")
+	}
+
+	delete(current.Labels, "openshift.io/cluster-monitoring")
+	current.Labels["pod-security.kubernetes.io/enforce"] = "restricted"
+	changed, updated := namespaceLabelsChanged(current, expected)
+	if !changed {
+		t.Fatalf("expected removed and changed labels to be detected")
+	}
+	for key, value := range expected.Labels {
+		if updated.Labels[key] != value {
+			t.Errorf("expected label %s=%s to be restored, got %q", key, value, updated.Labels[key])
+		}
+	}
+	if updated.Labels["example.com/team"] != "network" {
+		t.Errorf("expected the extra label to be kept, got %v", updated.Labels)
+	}
+}
@@ -0,0 +1,143 @@
+package controller
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"strconv"
+	"time"
+
+	operatorv1 "github.com/openshift/api/operator/v1"
+
+	dto "github.com/prometheus/client_model/go"
+	"github.com/prometheus/common/expfmt"
+
+	"github.com/sirupsen/logrus"
+
+	corev1 "k8s.io/api/core/v1"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/selection"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// podMetricsScrapeTimeout is the timeout for scraping metrics from a single
+// dns pod.
+const podMetricsScrapeTimeout = 5 * time.Second
+
+var podMetricsClient = &http.Client{Timeout: podMetricsScrapeTimeout}
+
+// scrapeDNSPodMetrics scrapes the metrics of the ready pods of the given dns
+// and returns the metrics of each pod that could be scraped, keyed by pod name.
+func (r *reconciler) scrapeDNSPodMetrics(dns *operatorv1.DNS) (map[string]map[string]*dto.MetricFamily, error) {
+	pods, err := r.listDNSPods(dns)
+	if err != nil {
+		return nil, err
+	}
+
+	samples := map[string]map[string]*dto.MetricFamily{}
+	for i := range pods.Items {
+		pod := &pods.Items[i]
+		if len(pod.Status.PodIP) == 0 || !podReady(pod) {
+			continue
+		}
+		families, err := scrapePodMetrics(pod.Status.PodIP)
+		if err != nil {
+			logrus.Infof("failed to scrape metrics from pod %s/%s: %v", pod.Namespace, pod.Name, err)
+			continue
+		}
+		samples[pod.Name] = families
+	}
+	return samples, nil
+}
+
+// podMetricsSamples returns the metrics of the given pods as a slice.
+func podMetricsSamples(podMetrics map[string]map[string]*dto.MetricFamily) []map[string]*dto.MetricFamily {
+	samples := make([]map[string]*dto.MetricFamily, 0, len(podMetrics))
+	for _, families := range podMetrics {
+		samples = append(samples, families)
+	}
+	return samples
+}
+
+// listDNSPods returns the pods of the given dns.  The bootstrap dns pod is not
+// one of them because it does not serve metrics.
+func (r *reconciler) listDNSPods(dns *operatorv1.DNS) (*corev1.PodList, error) {
+	selector, err := metav1.LabelSelectorAsSelector(DNSDaemonSetPodSelector(dns))
+	if err != nil {
+		return nil, fmt.Errorf("failed to build pod selector: %v", err)
+	}
+	notBootstrap, err := labels.NewRequirement(BootstrapDNSPodLabel, selection.DoesNotExist, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build pod selector: %v", err)
+	}
+	selector = selector.Add(*notBootstrap)
+	pods := &corev1.PodList{}
+	if err := r.client.List(context.TODO(), pods, client.InNamespace(DNSDaemonSetName(dns).Namespace), client.MatchingLabelsSelector{Selector: selector}); err != nil {
+		return nil, fmt.Errorf("failed to list pods for dns %s: %v", dns.Name, err)
+	}
+	return pods, nil
+}
+
+// scrapePodMetrics scrapes the metrics endpoint of the dns pod with the given
+// IP address.
+func scrapePodMetrics(podIP string) (map[string]*dto.MetricFamily, error) {
+	url := "http://" + net.JoinHostPort(podIP, strconv.Itoa(int(metricsPort))) + "/metrics"
+	resp, err := podMetricsClient.Get(url)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status %q from %s", resp.Status, url)
+	}
+	return parsePodMetrics(resp.Body)
+}
+
+// parsePodMetrics parses metrics in the Prometheus text format.
+func parsePodMetrics(r io.Reader) (map[string]*dto.MetricFamily, error) {
+	var parser expfmt.TextParser
+	families, err := parser.TextToMetricFamilies(r)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse metrics: %v", err)
+	}
+	return families, nil
+}
+
+// metricValue returns the value of a gauge, counter, or untyped metric.
+func metricValue(m *dto.Metric) float64 {
+	switch {
+	case m.Gauge != nil:
+		return m.Gauge.GetValue()
+	case m.Counter != nil:
+		return m.Counter.GetValue()
+	case m.Untyped != nil:
+		return m.Untyped.GetValue()
+	}
+	return 0
+}
+
+// metricLabel returns the value of the named label of the given metric.
+func metricLabel(m *dto.Metric, name string) string {
+	for _, l := range m.Label {
+		if l.GetName() == name {
+			return l.GetValue()
+		}
+	}
+	return ""
+}
+
+// podReady returns a Boolean indicating whether the given pod has the Ready
+// condition.
+func podReady(pod *corev1.Pod) bool {
+	for _, c := range pod.Status.Conditions {
+		if c.Type == corev1.PodReady {
+			return c.Status == corev1.ConditionTrue
+		}
+	}
+	return false
+}
@@ -0,0 +1,143 @@
+package controller
+
+import (
+	"sort"
+	"strconv"
+	"sync"
+
+	operatorv1 "github.com/openshift/api/operator/v1"
+
+	"github.com/prometheus/client_golang/prometheus"
+
+	corev1 "k8s.io/api/core/v1"
+
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+)
+
+const (
+	// podTemplateGenerationLabel is the label that the daemonset
+	// controller sets on each pod to the generation of the daemonset that
+	// the pod was created from.
+	podTemplateGenerationLabel = "pod-template-generation"
+
+	// maxPodVersionNodes is the number of nodes that are listed for an
+	// outdated version.
+	maxPodVersionNodes = 5
+)
+
+// podVersionsGauge reports the number of dns pods that run each version.
+var podVersionsGauge = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+	Name: "dns_operator_pod_versions",
+	Help: "Number of DNS pods that run each version, by DaemonSet, CoreDNS image, and DaemonSet generation.",
+}, []string{"dns", "daemonset", "image", "generation"})
+
+// publishedPodVersions holds the label values of the pod versions that are
+// currently published for each dns, so that versions that no longer run can be
+// removed from podVersionsGauge.
+var publishedPodVersions = struct {
+	sync.Mutex
+	labels map[string][][]string
+}{labels: map[string][][]string{}}
+
+func init() {
+	metrics.Registry.MustRegister(podVersionsGauge)
+}
+
+// sampleDNSPodVersions returns the versions that the pods of the given dns run.
+func (r *reconciler) sampleDNSPodVersions(dns *operatorv1.DNS) ([]operatorv1.DNSPodVersion, error) {
+	pods, err := r.listDNSPods(dns)
+	if err != nil {
+		return nil, err
+	}
+	return computeDNSPodVersions(pods.Items), nil
+}
+
+// computeDNSPodVersions groups the given dns pods by daemonset, CoreDNS image,
+// and daemonset generation, sorted by daemonset and generation.  Pods that are
+// being deleted or that do not belong to a daemonset are not counted.
+func computeDNSPodVersions(pods []corev1.Pod) []operatorv1.DNSPodVersion {
+	type versionKey struct {
+		daemonset  string
+		image      string
+		generation int64
+	}
+	versions := map[versionKey]*operatorv1.DNSPodVersion{}
+	newest := map[string]int64{}
+	for i := range pods {
+		pod := &pods[i]
+		if pod.DeletionTimestamp != nil {
+			continue
+		}
+		daemonset := ""
+		for _, ref := range pod.OwnerReferences {
+			if ref.Kind == "DaemonSet" {
+				daemonset = ref.Name
+			}
+		}
+		if len(daemonset) == 0 {
+			continue
+		}
+		image := ""
+		for _, c := range pod.Spec.Containers {
+			if c.Name == "dns" {
+				image = c.Image
+			}
+		}
+		generation, _ := strconv.ParseInt(pod.Labels[podTemplateGenerationLabel], 10, 64)
+		key := versionKey{daemonset: daemonset, image: image, generation: generation}
+		version, ok := versions[key]
+		if !ok {
+			version = &operatorv1.DNSPodVersion{DaemonSet: daemonset, Image: image, Generation: generation}
+			versions[key] = version
+		}
+		version.Pods++
+		if len(pod.Spec.NodeName) != 0 {
+			version.Nodes = append(version.Nodes, pod.Spec.NodeName)
+		}
+		if generation > newest[daemonset] {
+			newest[daemonset] = generation
+		}
+	}
+
+	result := []operatorv1.DNSPodVersion{}
+	for _, version := range versions {
+		version.Outdated = version.Generation < newest[version.DaemonSet]
+		if version.Outdated {
+			sort.Strings(version.Nodes)
+			if len(version.Nodes) > maxPodVersionNodes {
+				version.Nodes = version.Nodes[:maxPodVersionNodes]
+			}
+		} else {
+			version.Nodes = nil
+		}
+		result = append(result, *version)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].DaemonSet != result[j].DaemonSet {
+			return result[i].DaemonSet < result[j].DaemonSet
+		}
+		if result[i].Generation != result[j].Generation {
+			return result[i].Generation < result[j].Generation
+		}
+		return result[i].Image < result[j].Image
+	})
+	return result
+}
+
+// recordPodVersionsMetrics publishes the given versions of the pods of the dns
+// with the given name as operator metrics, replacing the versions that were
+// previously published.
+func recordPodVersionsMetrics(name string, versions []operatorv1.DNSPodVersion) {
+	publishedPodVersions.Lock()
+	defer publishedPodVersions.Unlock()
+	for _, labels := range publishedPodVersions.labels[name] {
+		podVersionsGauge.DeleteLabelValues(labels...)
+	}
+	published := [][]string{}
+	for _, version := range versions {
+		labels := []string{name, version.DaemonSet, version.Image, strconv.FormatInt(version.Generation, 10)}
+		podVersionsGauge.WithLabelValues(labels...).Set(float64(version.Pods))
+		published = append(published, labels)
+	}
+	publishedPodVersions.labels[name] = published
+}
@@ -0,0 +1,56 @@
+package controller
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	operatorv1 "github.com/openshift/api/operator/v1"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// versionedPod returns a dns pod of the given daemonset on the given node that
+// runs the given image and daemonset generation.
+func versionedPod(daemonset, node, image, generation string) corev1.Pod {
+	return corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            daemonset + "-" + node,
+			Labels:          map[string]string{podTemplateGenerationLabel: generation},
+			OwnerReferences: []metav1.OwnerReference{{Kind: "DaemonSet", Name: daemonset}},
+		},
+		Spec: corev1.PodSpec{
+			NodeName:   node,
+			Containers: []corev1.Container{{Name: "dns", Image: image}, {Name: "kube-rbac-proxy", Image: "proxy"}},
+		},
+	}
+}
+
+func TestComputeDNSPodVersions(t *testing.T) {
+	deleted := versionedPod("dns-default", "deleted", "coredns:old", "1")
+	deleted.DeletionTimestamp = &metav1.Time{}
+	unowned := versionedPod("dns-default", "unowned", "coredns:old", "1")
+	unowned.OwnerReferences = nil
+	pods := []corev1.Pod{
+		versionedPod("dns-default", "f", "coredns:old", "1"),
+		versionedPod("dns-default", "a", "coredns:new", "2"),
+		versionedPod("dns-default", "b", "coredns:new", "2"),
+		versionedPod("dns-default", "e", "coredns:old", "1"),
+		versionedPod("dns-default", "d", "coredns:old", "1"),
+		versionedPod("dns-default", "c", "coredns:old", "1"),
+		versionedPod("dns-default", "g", "coredns:old", "1"),
+		versionedPod("dns-default", "h", "coredns:old", "1"),
+		versionedPod("dns-default-edge", "x", "coredns:new", "3"),
+		deleted,
+		unowned,
+	}
+	expected := []operatorv1.DNSPodVersion{
+		{DaemonSet: "dns-default", Image: "coredns:old", Generation: 1, Pods: 6, Outdated: true, Nodes: []string{"c", "d", "e", "f", "g"}},
+		{DaemonSet: "dns-default", Image: "coredns:new", Generation: 2, Pods: 2},
+		{DaemonSet: "dns-default-edge", Image: "coredns:new", Generation: 3, Pods: 1},
+	}
+	if actual := computeDNSPodVersions(pods); !cmp.Equal(actual, expected) {
+		t.Errorf("unexpected pod versions:\n%s", cmp.Diff(expected, actual))
+	}
+}
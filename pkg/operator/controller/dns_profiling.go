@@ -0,0 +1,438 @@
+package controller
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"path"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	operatorv1 "github.com/openshift/api/operator/v1"
+	"github.com/openshift/cluster-dns-operator/pkg/manifests"
+
+	"github.com/sirupsen/logrus"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/validation"
+)
+
+const (
+	// pprofPort is the port on which the pprof plugin of CoreDNS listens
+	// while profiling is enabled.
+	pprofPort = 6053
+
+	// pprofVolumeName is the name of the volume of the dns pods that holds
+	// the pprof configmap.
+	pprofVolumeName = "pprof"
+
+	// pprofMountPath is the directory in which the dns container mounts
+	// the pprof configmap.  It is not below the directory of the dns
+	// configmap because that volume is read-only.
+	pprofMountPath = "/etc/coredns-pprof"
+
+	// maxProfilingPeriod is the longest that the pprof plugin may be
+	// enabled for with the ProfileUntilAnnotation annotation, so that a
+	// forgotten request does not leave it enabled indefinitely.
+	maxProfilingPeriod = time.Hour
+
+	// cpuProfileDuration is how long the CPU profile is sampled for.
+	cpuProfileDuration = 30 * time.Second
+
+	// profilingCheckInterval is how often the operator checks whether the
+	// profiles can be collected while profiling is enabled.
+	profilingCheckInterval = 10 * time.Second
+
+	// profilePodAnnotation and profileCollectedAtAnnotation are set on the
+	// profile configmap to the pod that the profiles were collected from
+	// and the time at which they were collected.
+	profilePodAnnotation         = "dns.operator.openshift.io/profile-pod"
+	profileCollectedAtAnnotation = "dns.operator.openshift.io/profile-collected-at"
+)
+
+// profileClient fetches profiles from the pprof plugin.  The timeout leaves
+// room for the CPU profile to be sampled.
+var profileClient = &http.Client{Timeout: cpuProfileDuration + 15*time.Second}
+
+// dnsProfiles are the profiles that are collected, keyed by the name of the
+// key of the profile configmap, with the path of each profile.
+var dnsProfiles = []struct {
+	key  string
+	path string
+}{
+	{key: "cpu.pb.gz", path: "/debug/pprof/profile?seconds=" + strconv.Itoa(int(cpuProfileDuration/time.Second))},
+	{key: "heap.pb.gz", path: "/debug/pprof/heap"},
+	{key: "goroutine.pb.gz", path: "/debug/pprof/goroutine"},
+}
+
+// profilingRequest returns the node whose dns pod the ProfileNodeAnnotation and
+// ProfileUntilAnnotation annotations of the given dns request profiles from,
+// the time until which profiling is enabled, and a Boolean indicating whether
+// profiling is enabled at the given time.  Annotations that are incomplete or
+// invalid, or that request profiling for more than maxProfilingPeriod, are
+// ignored.
+func profilingRequest(dns *operatorv1.DNS, now time.Time) (string, time.Time, bool) {
+	node := dns.Annotations[manifests.ProfileNodeAnnotation]
+	value, ok := dns.Annotations[manifests.ProfileUntilAnnotation]
+	if len(node) == 0 || !ok {
+		return "", time.Time{}, false
+	}
+	until, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		logrus.Warningf("ignoring annotation %s of dns %s: %v", manifests.ProfileUntilAnnotation, dns.Name, err)
+		return "", time.Time{}, false
+	}
+	if until.Sub(now) > maxProfilingPeriod {
+		logrus.Warningf("ignoring annotation %s of dns %s: %s is more than %s in the future", manifests.ProfileUntilAnnotation, dns.Name, value, maxProfilingPeriod)
+		return "", time.Time{}, false
+	}
+	return node, until, now.Before(until)
+}
+
+// profilingNode returns the node whose dns pod the given dns enables profiling
+// for, and a Boolean indicating whether profiling is enabled.  A node whose
+// name does not make a valid configmap key is ignored.
+func profilingNode(dns *operatorv1.DNS) (string, bool) {
+	node, _, ok := profilingRequest(dns, time.Now())
+	if !ok {
+		return "", false
+	}
+	if msgs := validation.IsConfigMapKey(pprofSnippetKey(node)); len(msgs) != 0 {
+		logrus.Warningf("ignoring annotation %s of dns %s: %s", manifests.ProfileNodeAnnotation, dns.Name, strings.Join(msgs, ", "))
+		return "", false
+	}
+	return node, true
+}
+
+// pprofSnippetKey returns the key of the pprof configmap that holds the
+// snippet that enables the pprof plugin on the named node.
+func pprofSnippetKey(node string) string {
+	return node + ".pprof"
+}
+
+// corefilePprof returns the import of the snippet that enables the pprof
+// plugin for the given dns, or nil if profiling is not enabled.  The Corefile
+// is the same for all dns pods, so each pod imports the snippet of its own
+// node, and only the requested node has one; the pattern ends in a wildcard
+// so that CoreDNS ignores the missing snippet on the other nodes.  The
+// requested node is in the comment of the import so that CoreDNS reloads when
+// it changes.
+func corefilePprof(dns *operatorv1.DNS) *corefileImport {
+	node, ok := profilingNode(dns)
+	if !ok {
+		return nil
+	}
+	return &corefileImport{
+		Pattern: path.Join(pprofMountPath, pprofSnippetKey("{$"+nodeNameEnvVar+"}")) + "*",
+		Hash:    node,
+	}
+}
+
+// desiredDNSPprofConfigMap returns the desired pprof configmap for the given
+// dns, which holds the snippet that enables the pprof plugin on the requested
+// node, or nil if profiling is not enabled.
+func desiredDNSPprofConfigMap(dns *operatorv1.DNS) *corev1.ConfigMap {
+	node, ok := profilingNode(dns)
+	if !ok {
+		return nil
+	}
+	name := DNSPprofConfigMapName(dns)
+	cm := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name.Name,
+			Namespace: name.Namespace,
+			Labels: map[string]string{
+				manifests.OwningDNSLabel: DNSDaemonSetLabel(dns),
+			},
+		},
+		Data: map[string]string{
+			pprofSnippetKey(node): fmt.Sprintf("pprof :%d\n", pprofPort),
+		},
+	}
+	cm.SetOwnerReferences([]metav1.OwnerReference{dnsOwnerRef(dns)})
+	return cm
+}
+
+// ensureDNSPprofConfigMap ensures that the pprof configmap of the given dns
+// exists while profiling is enabled and that it does not exist otherwise.  It
+// must be ensured before the dns configmap so that the Corefile never imports
+// a snippet that the dns pods do not yet have.
+func (r *reconciler) ensureDNSPprofConfigMap(dns *operatorv1.DNS) error {
+	current := &corev1.ConfigMap{}
+	haveCM := true
+	if err := r.client.Get(context.TODO(), DNSPprofConfigMapName(dns), current); err != nil {
+		if !errors.IsNotFound(err) {
+			return fmt.Errorf("failed to get pprof configmap: %v", err)
+		}
+		haveCM = false
+	}
+	desired := desiredDNSPprofConfigMap(dns)
+	switch {
+	case desired == nil && haveCM:
+		if err := r.client.Delete(context.TODO(), current); err != nil && !errors.IsNotFound(err) {
+			return fmt.Errorf("failed to delete pprof configmap %s/%s: %v", current.Namespace, current.Name, err)
+		}
+		logrus.Infof("deleted pprof configmap: %s/%s", current.Namespace, current.Name)
+	case desired != nil && !haveCM:
+		if err := r.client.Create(context.TODO(), desired); err != nil {
+			return fmt.Errorf("failed to create pprof configmap %s/%s: %v", desired.Namespace, desired.Name, err)
+		}
+		logrus.Infof("created pprof configmap: %s/%s", desired.Namespace, desired.Name)
+	case desired != nil && !reflect.DeepEqual(current.Data, desired.Data):
+		updated := current.DeepCopy()
+		updated.Data = desired.Data
+		if err := r.client.Update(context.TODO(), updated); err != nil {
+			return fmt.Errorf("failed to update pprof configmap %s/%s: %v", updated.Namespace, updated.Name, err)
+		}
+		logrus.Infof("updated pprof configmap: %s/%s", updated.Namespace, updated.Name)
+	}
+	return nil
+}
+
+// setPprofVolume adds the volume with the pprof configmap of the given dns to
+// the given dns daemonset and mounts it in the dns container.  The configmap
+// is optional so that the pods start whether or not profiling is enabled, and
+// the volume is always there so that enabling profiling does not change the
+// pod template.
+func setPprofVolume(daemonset *appsv1.DaemonSet, dns *operatorv1.DNS) {
+	optional := true
+	daemonset.Spec.Template.Spec.Volumes = append(daemonset.Spec.Template.Spec.Volumes, corev1.Volume{
+		Name: pprofVolumeName,
+		VolumeSource: corev1.VolumeSource{
+			ConfigMap: &corev1.ConfigMapVolumeSource{
+				LocalObjectReference: corev1.LocalObjectReference{Name: DNSPprofConfigMapName(dns).Name},
+				Optional:             &optional,
+			},
+		},
+	})
+	for i, c := range daemonset.Spec.Template.Spec.Containers {
+		if c.Name != "dns" {
+			continue
+		}
+		daemonset.Spec.Template.Spec.Containers[i].VolumeMounts = append(daemonset.Spec.Template.Spec.Containers[i].VolumeMounts, corev1.VolumeMount{
+			Name:      pprofVolumeName,
+			MountPath: pprofMountPath,
+			ReadOnly:  true,
+		})
+	}
+}
+
+// profileCollectionTracker remembers the dnses whose profiles are being
+// collected so that a collection is not started twice.
+type profileCollectionTracker struct {
+	lock       sync.Mutex
+	collecting map[string]bool
+}
+
+// start marks the profiles of the named dns as being collected and returns a
+// Boolean indicating whether they were not already being collected.
+func (t *profileCollectionTracker) start(dns string) bool {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	if t.collecting == nil {
+		t.collecting = map[string]bool{}
+	}
+	if t.collecting[dns] {
+		return false
+	}
+	t.collecting[dns] = true
+	return true
+}
+
+// finish marks the profiles of the named dns as no longer being collected.
+func (t *profileCollectionTracker) finish(dns string) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	delete(t.collecting, dns)
+}
+
+// syncDNSProfiling collects profiles from the dns pod on the node that the
+// given dns requests them from, and removes the request once it has expired.
+// The CPU profile takes a while to sample, so the profiles are collected in the
+// background.  It returns how soon to check again, or zero if profiling is not
+// enabled.
+func (r *reconciler) syncDNSProfiling(dns *operatorv1.DNS) time.Duration {
+	node, _, ok := profilingRequest(dns, time.Now())
+	if !ok {
+		if requested, ok := dns.Annotations[manifests.ProfileNodeAnnotation]; ok {
+			r.recorder.Eventf(dns, corev1.EventTypeWarning, "ProfileNotCollected", "Profiling of the DNS pod on node %s ended without collecting profiles", requested)
+			if err := r.clearProfilingRequest(dns.Name); err != nil {
+				logrus.Errorf("failed to clear profiling request of dns %s: %v", dns.Name, err)
+				return profilingCheckInterval
+			}
+		}
+		return 0
+	}
+	pod, err := r.dnsPodOnNode(dns, node)
+	if err != nil {
+		logrus.Errorf("failed to get dns pod on node %s for dns %s: %v", node, dns.Name, err)
+		return profilingCheckInterval
+	}
+	if pod == nil {
+		logrus.Infof("waiting for a ready dns pod on node %s to profile for dns %s", node, dns.Name)
+		return profilingCheckInterval
+	}
+	if r.profiles.start(dns.Name) {
+		go r.collectDNSProfiles(dns.DeepCopy(), pod)
+	}
+	return profilingCheckInterval
+}
+
+// dnsPodOnNode returns the ready pod of the given dns on the named node, or nil
+// if there is none.
+func (r *reconciler) dnsPodOnNode(dns *operatorv1.DNS, node string) (*corev1.Pod, error) {
+	pods, err := r.listDNSPods(dns)
+	if err != nil {
+		return nil, err
+	}
+	for i := range pods.Items {
+		pod := &pods.Items[i]
+		if pod.Spec.NodeName == node && len(pod.Status.PodIP) != 0 && podReady(pod) {
+			return pod, nil
+		}
+	}
+	return nil, nil
+}
+
+// collectDNSProfiles fetches the profiles from the pprof plugin of the given
+// dns pod and stores them in the profile configmap of the given dns, and then
+// removes the profiling request, which disables the pprof plugin again.  If
+// the pod has not yet loaded the Corefile that enables the plugin, nothing is
+// stored, and the profiles are collected on a later check.
+func (r *reconciler) collectDNSProfiles(dns *operatorv1.DNS, pod *corev1.Pod) {
+	defer r.profiles.finish(dns.Name)
+
+	profiles := map[string][]byte{}
+	for _, p := range dnsProfiles {
+		data, err := fetchProfile(pod.Status.PodIP, p.path)
+		if err != nil {
+			logrus.Infof("failed to fetch profile from pod %s/%s: %v", pod.Namespace, pod.Name, err)
+			return
+		}
+		profiles[p.key] = data
+	}
+	cm, err := desiredDNSProfileConfigMap(dns, pod, profiles, time.Now())
+	if err != nil {
+		logrus.Errorf("failed to build profile configmap for dns %s: %v", dns.Name, err)
+		r.recorder.Eventf(dns, corev1.EventTypeWarning, "ProfileNotCollected", "Failed to store the profiles of pod %s: %v", pod.Name, err)
+		// Collecting the profiles again would fail the same way.
+		if err := r.clearProfilingRequest(dns.Name); err != nil {
+			logrus.Errorf("failed to clear profiling request of dns %s: %v", dns.Name, err)
+		}
+		return
+	}
+	if err := r.ensureDNSProfileConfigMap(cm); err != nil {
+		logrus.Errorf("failed to store profiles for dns %s: %v", dns.Name, err)
+		return
+	}
+	r.recorder.Eventf(dns, corev1.EventTypeNormal, "ProfileCollected", "Collected CoreDNS profiles from pod %s on node %s in configmap %s/%s", pod.Name, pod.Spec.NodeName, cm.Namespace, cm.Name)
+	r.history.record(dns.Name, dnsHistoryProfileCollected, fmt.Sprintf("Collected profiles from pod %s on node %s", pod.Name, pod.Spec.NodeName))
+	if err := r.clearProfilingRequest(dns.Name); err != nil {
+		logrus.Errorf("failed to clear profiling request of dns %s: %v", dns.Name, err)
+	}
+}
+
+// fetchProfile fetches the profile at the given path from the pprof plugin of
+// the dns pod with the given IP address.
+func fetchProfile(podIP, path string) ([]byte, error) {
+	url := "http://" + net.JoinHostPort(podIP, strconv.Itoa(pprofPort)) + path
+	resp, err := profileClient.Get(url)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status %q from %s", resp.Status, url)
+	}
+	return ioutil.ReadAll(resp.Body)
+}
+
+// desiredDNSProfileConfigMap returns the configmap that holds the given
+// profiles of the given dns pod, collected at the given time.  Profiles that
+// do not fit in a configmap are an error.
+func desiredDNSProfileConfigMap(dns *operatorv1.DNS, pod *corev1.Pod, profiles map[string][]byte, now time.Time) (*corev1.ConfigMap, error) {
+	size := 0
+	for _, data := range profiles {
+		size += len(data)
+	}
+	if size > corefileConfigMapSizeLimit {
+		return nil, fmt.Errorf("the profiles are %d bytes, which exceeds the limit of %d bytes", size, corefileConfigMapSizeLimit)
+	}
+	name := DNSProfileConfigMapName(dns)
+	cm := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name.Name,
+			Namespace: name.Namespace,
+			Labels: map[string]string{
+				manifests.OwningDNSLabel: DNSDaemonSetLabel(dns),
+			},
+			Annotations: map[string]string{
+				manifests.ProfileNodeAnnotation: pod.Spec.NodeName,
+				profilePodAnnotation:            pod.Name,
+				profileCollectedAtAnnotation:    now.UTC().Format(time.RFC3339),
+			},
+		},
+		BinaryData: profiles,
+	}
+	cm.SetOwnerReferences([]metav1.OwnerReference{dnsOwnerRef(dns)})
+	return cm, nil
+}
+
+// ensureDNSProfileConfigMap creates the given profile configmap or replaces the
+// profiles in the existing one.
+func (r *reconciler) ensureDNSProfileConfigMap(desired *corev1.ConfigMap) error {
+	current := &corev1.ConfigMap{}
+	if err := r.client.Get(context.TODO(), types.NamespacedName{Namespace: desired.Namespace, Name: desired.Name}, current); err != nil {
+		if !errors.IsNotFound(err) {
+			return fmt.Errorf("failed to get profile configmap %s/%s: %v", desired.Namespace, desired.Name, err)
+		}
+		if err := r.client.Create(context.TODO(), desired); err != nil {
+			return fmt.Errorf("failed to create profile configmap %s/%s: %v", desired.Namespace, desired.Name, err)
+		}
+		logrus.Infof("created profile configmap: %s/%s", desired.Namespace, desired.Name)
+		return nil
+	}
+	updated := current.DeepCopy()
+	updated.Labels = desired.Labels
+	updated.Annotations = desired.Annotations
+	updated.Data = nil
+	updated.BinaryData = desired.BinaryData
+	updated.OwnerReferences = desired.OwnerReferences
+	if err := r.client.Update(context.TODO(), updated); err != nil {
+		return fmt.Errorf("failed to update profile configmap %s/%s: %v", updated.Namespace, updated.Name, err)
+	}
+	logrus.Infof("updated profile configmap: %s/%s", updated.Namespace, updated.Name)
+	return nil
+}
+
+// clearProfilingRequest removes the profiling annotations from the named dns.
+// The dns is read again because the profiles are collected in the background.
+func (r *reconciler) clearProfilingRequest(name string) error {
+	dns := &operatorv1.DNS{}
+	if err := r.client.Get(context.TODO(), types.NamespacedName{Name: name}, dns); err != nil {
+		return fmt.Errorf("failed to get dns %s: %v", name, err)
+	}
+	_, hasNode := dns.Annotations[manifests.ProfileNodeAnnotation]
+	_, hasUntil := dns.Annotations[manifests.ProfileUntilAnnotation]
+	if !hasNode && !hasUntil {
+		return nil
+	}
+	updated := dns.DeepCopy()
+	delete(updated.Annotations, manifests.ProfileNodeAnnotation)
+	delete(updated.Annotations, manifests.ProfileUntilAnnotation)
+	if err := r.client.Update(context.TODO(), updated); err != nil {
+		return fmt.Errorf("failed to update dns %s: %v", name, err)
+	}
+	logrus.Infof("cleared profiling request of dns %s", name)
+	return nil
+}
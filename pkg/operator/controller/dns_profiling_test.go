@@ -0,0 +1,189 @@
+package controller
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	operatorv1 "github.com/openshift/api/operator/v1"
+	"github.com/openshift/cluster-dns-operator/pkg/manifests"
+
+	corev1 "k8s.io/api/core/v1"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestProfilingRequest(t *testing.T) {
+	now := time.Date(2026, 10, 15, 12, 0, 0, 0, time.UTC)
+	testCases := []struct {
+		name         string
+		annotations  map[string]string
+		expectNode   string
+		expectActive bool
+	}{
+		{
+			name: "no annotations",
+		},
+		{
+			name:        "node without time",
+			annotations: map[string]string{manifests.ProfileNodeAnnotation: "worker-1"},
+		},
+		{
+			name:        "time without node",
+			annotations: map[string]string{manifests.ProfileUntilAnnotation: "2026-10-15T12:30:00Z"},
+		},
+		{
+			name: "active",
+			annotations: map[string]string{
+				manifests.ProfileNodeAnnotation:  "worker-1",
+				manifests.ProfileUntilAnnotation: "2026-10-15T12:30:00Z",
+			},
+			expectNode:   "worker-1",
+			expectActive: true,
+		},
+		{
+			name: "expired",
+			annotations: map[string]string{
+				manifests.ProfileNodeAnnotation:  "worker-1",
+				manifests.ProfileUntilAnnotation: "2026-10-15T11:30:00Z",
+			},
+			expectNode: "worker-1",
+		},
+		{
+			name: "too far in the future",
+			annotations: map[string]string{
+				manifests.ProfileNodeAnnotation:  "worker-1",
+				manifests.ProfileUntilAnnotation: "2026-10-15T14:00:00Z",
+			},
+		},
+		{
+			name: "invalid time",
+			annotations: map[string]string{
+				manifests.ProfileNodeAnnotation:  "worker-1",
+				manifests.ProfileUntilAnnotation: "soon",
+			},
+		},
+	}
+	for _, tc := range testCases {
+		dns := &operatorv1.DNS{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        DefaultDNSController,
+				Annotations: tc.annotations,
+			},
+		}
+		node, _, active := profilingRequest(dns, now)
+		if node != tc.expectNode || active != tc.expectActive {
+			t.Errorf("%s: expected (%q, %t), got (%q, %t)", tc.name, tc.expectNode, tc.expectActive, node, active)
+		}
+	}
+}
+
+func TestCorefilePprof(t *testing.T) {
+	dns := &operatorv1.DNS{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: DefaultDNSController,
+		},
+	}
+	corefile, err := renderCorefile(dns, "cluster.local", nil, nil, nil, nil, nil, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if strings.Contains(corefile, "pprof") {
+		t.Errorf("expected no pprof plugin without a profiling request:\n%s", corefile)
+	}
+	dns.Annotations = map[string]string{
+		manifests.ProfileNodeAnnotation:  "worker-1",
+		manifests.ProfileUntilAnnotation: time.Now().Add(10 * time.Minute).UTC().Format(time.RFC3339),
+	}
+	dns.Spec.AdditionalNetworks = []operatorv1.DNSAdditionalNetwork{{Name: "storage"}}
+	corefile, err = renderCorefile(dns, "cluster.local", nil, nil, nil, nil, nil, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	// The plugin is process-wide, so only the usual listener imports the
+	// snippet, which only the pod on the requested node has.
+	if n := strings.Count(corefile, "import /etc/coredns-pprof/{$NODE_NAME}.pprof* # worker-1"); n != 1 {
+		t.Errorf("expected the pprof snippet to be imported once, got %d times:\n%s", n, corefile)
+	}
+	if strings.Contains(corefile, "pprof :") {
+		t.Errorf("expected the pprof plugin only in the snippet:\n%s", corefile)
+	}
+}
+
+func TestDesiredDNSPprofConfigMap(t *testing.T) {
+	dns := &operatorv1.DNS{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: DefaultDNSController,
+		},
+	}
+	if cm := desiredDNSPprofConfigMap(dns); cm != nil {
+		t.Errorf("expected no pprof configmap without a profiling request, got %v", cm.Data)
+	}
+	dns.Annotations = map[string]string{
+		manifests.ProfileNodeAnnotation:  "worker-1",
+		manifests.ProfileUntilAnnotation: time.Now().Add(10 * time.Minute).UTC().Format(time.RFC3339),
+	}
+	cm := desiredDNSPprofConfigMap(dns)
+	if cm == nil {
+		t.Fatal("expected a pprof configmap with a profiling request")
+	}
+	if cm.Name != "dns-default-pprof" || cm.Namespace != "openshift-dns" {
+		t.Errorf("unexpected configmap name %s/%s", cm.Namespace, cm.Name)
+	}
+	expected := map[string]string{"worker-1.pprof": "pprof :6053\n"}
+	if !reflect.DeepEqual(cm.Data, expected) {
+		t.Errorf("expected data %v, got %v", expected, cm.Data)
+	}
+
+	// A node name that does not make a configmap key is ignored.
+	dns.Annotations[manifests.ProfileNodeAnnotation] = "worker 1"
+	if cm := desiredDNSPprofConfigMap(dns); cm != nil {
+		t.Errorf("expected no pprof configmap for an invalid node name, got %v", cm.Data)
+	}
+}
+
+func TestDesiredDNSProfileConfigMap(t *testing.T) {
+	dns := &operatorv1.DNS{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: DefaultDNSController,
+		},
+	}
+	pod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: "dns-default-abcde"},
+		Spec:       corev1.PodSpec{NodeName: "worker-1"},
+	}
+	now := time.Date(2026, 10, 15, 12, 0, 0, 0, time.UTC)
+	cm, err := desiredDNSProfileConfigMap(dns, pod, map[string][]byte{"cpu.pb.gz": []byte("cpu")}, now)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cm.Name != "dns-default-profile" || cm.Namespace != "openshift-dns" {
+		t.Errorf("unexpected configmap name %s/%s", cm.Namespace, cm.Name)
+	}
+	if cm.Annotations[manifests.ProfileNodeAnnotation] != "worker-1" || cm.Annotations[profilePodAnnotation] != pod.Name || cm.Annotations[profileCollectedAtAnnotation] != "2026-10-15T12:00:00Z" {
+		t.Errorf("unexpected annotations %v", cm.Annotations)
+	}
+	if string(cm.BinaryData["cpu.pb.gz"]) != "cpu" {
+		t.Errorf("unexpected binary data %v", cm.BinaryData)
+	}
+
+	large := map[string][]byte{"heap.pb.gz": make([]byte, corefileConfigMapSizeLimit+1)}
+	if _, err := desiredDNSProfileConfigMap(dns, pod, large, now); err == nil {
+		t.Error("expected an error for profiles that do not fit in a configmap")
+	}
+}
+
+func TestProfileCollectionTracker(t *testing.T) {
+	tracker := &profileCollectionTracker{}
+	if !tracker.start("default") {
+		t.Fatal("expected the first collection to start")
+	}
+	if tracker.start("default") {
+		t.Error("expected a second collection not to start while the first runs")
+	}
+	tracker.finish("default")
+	if !tracker.start("default") {
+		t.Error("expected a collection to start after the previous one finished")
+	}
+}
@@ -3,6 +3,8 @@ package controller
 import (
 	"context"
 	"fmt"
+	"strings"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/go-cmp/cmp/cmpopts"
@@ -10,61 +12,120 @@ import (
 
 	operatorv1 "github.com/openshift/api/operator/v1"
 
+	"github.com/openshift/cluster-dns-operator/pkg/util/conditions"
+
 	appsv1 "k8s.io/api/apps/v1"
-	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
-// syncDNSStatus computes the current status of dns and
-// updates status upon any changes since last sync.
-func (r *reconciler) syncDNSStatus(dns *operatorv1.DNS, clusterIP, clusterDomain string, ds *appsv1.DaemonSet) error {
+// dnsStatusSamples are the observations of a dns, its pods, and its nodes from
+// which its status is computed.
+type dnsStatusSamples struct {
+	// unhealthyNodePods is the number of unavailable pods of the dns on
+	// unschedulable or NotReady nodes.
+	unhealthyNodePods int32
+	// rolloutDeferral is the reason why a rollout of the daemonset of the
+	// dns is deferred, or empty if it is not.
+	rolloutDeferral string
+
+	// If any of the following samples is nil, the previously recorded
+	// cache statistics, forwarder statistics, CPUThrottled condition,
+	// CacheHitRatioLow condition, KubeletClusterDNSMismatch condition,
+	// CorefileCompatible condition, or InsufficientNodeCoverage condition
+	// are kept.
+	cacheStats            *operatorv1.DNSCacheStats
+	forwarderStats        *operatorv1.DNSForwarderStats
+	cpuThrottling         *cpuThrottlingSample
+	cacheHitRatio         *cacheHitRatioSample
+	kubeletClusterDNS     *kubeletClusterDNSSample
+	corefileCompatibility *corefileCompatibility
+	nodeCoverage          *dnsNodeCoverage
+
+	// corefileStatus is the status of the Corefile of the dns.
+	corefileStatus *operatorv1.DNSCorefileStatus
+	// podVersions lists the versions that the dns pods run.
+	podVersions []operatorv1.DNSPodVersion
+}
+
+// syncDNSStatus computes the current status of dns from the given samples and
+// updates status upon any changes since last sync.  The dns is reported as
+// degraded if there are any resource conflicts, and paused lists the resources
+// that have reconciliation paused.  The UpdateConflict condition reports
+// resources over which the operator recently conflicted with another actor.
+// Pending history entries are appended to the history.  It returns the time
+// after which the Degraded condition may change because a degraded suppression
+// period ends, or zero if it may not.
+func (r *reconciler) syncDNSStatus(dns *operatorv1.DNS, clusterIP, clusterDomain string, ds *appsv1.DaemonSet, samples dnsStatusSamples, disabledCapabilities, conflicts, paused []string) (time.Duration, error) {
 	updated := dns.DeepCopy()
 	updated.Status.ClusterIP = clusterIP
 	updated.Status.ClusterDomain = clusterDomain
-	updated.Status.Conditions = computeDNSStatusConditions(dns.Status.Conditions, clusterIP, ds)
+	updated.Status.Conditions = computeDNSStatusConditions(dns.Status.Conditions, clusterIP, ds, samples.unhealthyNodePods, samples.rolloutDeferral, conflicts)
+	suppressedFor := r.suppressDNSDegraded(dns, updated.Status.Conditions, time.Now())
+	updated.Status.Conditions = append(updated.Status.Conditions, computeDNSReconciliationPausedCondition(dns.Status.Conditions, paused))
+	updated.Status.Conditions = append(updated.Status.Conditions, computeDNSUpdateConflictCondition(dns.Status.Conditions, r.updateConflicts.recurring(time.Now())))
+	updated.Status.Conditions = append(updated.Status.Conditions, computeDNSDaemonSetUpdateRejectedCondition(dns.Status.Conditions, r.updateRejections.rejected(dns.Name)))
+	if c := computeDNSCPUThrottledCondition(dns.Status.Conditions, samples.cpuThrottling); c != nil {
+		updated.Status.Conditions = append(updated.Status.Conditions, *c)
+	}
+	if c := computeDNSCacheHitRatioLowCondition(dns.Status.Conditions, samples.cacheHitRatio); c != nil {
+		updated.Status.Conditions = append(updated.Status.Conditions, *c)
+	}
+	if c := computeDNSKubeletClusterDNSMismatchCondition(dns.Status.Conditions, samples.kubeletClusterDNS, clusterIP); c != nil {
+		updated.Status.Conditions = append(updated.Status.Conditions, *c)
+	}
+	if c := computeDNSCorefileCompatibleCondition(dns.Status.Conditions, samples.corefileCompatibility); c != nil {
+		updated.Status.Conditions = append(updated.Status.Conditions, *c)
+	}
+	if c := computeDNSInsufficientNodeCoverageCondition(dns.Status.Conditions, samples.nodeCoverage); c != nil {
+		updated.Status.Conditions = append(updated.Status.Conditions, *c)
+	}
+	if samples.cacheStats != nil {
+		updated.Status.CacheStats = samples.cacheStats
+	}
+	if samples.forwarderStats != nil {
+		updated.Status.Forwarders = samples.forwarderStats
+	}
+	updated.Status.CorefileStatus = samples.corefileStatus
+	updated.Status.PodVersions = samples.podVersions
+	updated.Status.DisabledCapabilities = disabledCapabilities
+	history := r.history.peek(dns.Name)
+	updated.Status.History = appendDNSHistory(dns.Status.History, history)
 	if !dnsStatusesEqual(updated.Status, dns.Status) {
 		if err := r.client.Status().Update(context.TODO(), updated); err != nil {
-			return fmt.Errorf("failed to update dns status: %v", err)
+			return 0, fmt.Errorf("failed to update dns status: %v", err)
 		}
 		logrus.Infof("updated DNS %s status: old: %#v, new: %#v", dns.ObjectMeta.Name, dns.Status, updated.Status)
 	}
+	r.history.discard(dns.Name, len(history))
 
-	return nil
+	return suppressedFor, nil
 }
 
 // computeDNSStatusConditions computes dns status conditions based on
-// the status of ds and clusterIP.
+// the status of ds and clusterIP and on any resource conflicts.
 func computeDNSStatusConditions(oldConditions []operatorv1.OperatorCondition, clusterIP string,
-	ds *appsv1.DaemonSet) []operatorv1.OperatorCondition {
-	var oldDegradedCondition, oldProgressingCondition, oldAvailableCondition *operatorv1.OperatorCondition
-	for i := range oldConditions {
-		switch oldConditions[i].Type {
-		case operatorv1.OperatorStatusTypeDegraded:
-			oldDegradedCondition = &oldConditions[i]
-		case operatorv1.OperatorStatusTypeProgressing:
-			oldProgressingCondition = &oldConditions[i]
-		case operatorv1.OperatorStatusTypeAvailable:
-			oldAvailableCondition = &oldConditions[i]
-		}
-	}
-
-	conditions := []operatorv1.OperatorCondition{
-		computeDNSDegradedCondition(oldDegradedCondition, clusterIP, ds),
-		computeDNSProgressingCondition(oldProgressingCondition, ds),
-		computeDNSAvailableCondition(oldAvailableCondition, clusterIP, ds),
+	ds *appsv1.DaemonSet, unhealthyNodePods int32, rolloutDeferral string, conflicts []string) []operatorv1.OperatorCondition {
+	return []operatorv1.OperatorCondition{
+		computeDNSDegradedCondition(conditions.FindOperatorCondition(oldConditions, operatorv1.OperatorStatusTypeDegraded), clusterIP, ds, unhealthyNodePods, conflicts),
+		computeDNSProgressingCondition(conditions.FindOperatorCondition(oldConditions, operatorv1.OperatorStatusTypeProgressing), ds, rolloutDeferral),
+		computeDNSAvailableCondition(conditions.FindOperatorCondition(oldConditions, operatorv1.OperatorStatusTypeAvailable), clusterIP, ds),
 	}
-
-	return conditions
 }
 
 // computeDNSDegradedCondition computes the dns Degraded status condition
-// based on the status of clusterIP and ds.
+// based on the status of clusterIP and ds and on any resource conflicts.  The
+// unhealthyNodePods unavailable pods on unschedulable or NotReady nodes are not
+// counted as unavailable.
 func computeDNSDegradedCondition(oldCondition *operatorv1.OperatorCondition, clusterIP string,
-	ds *appsv1.DaemonSet) operatorv1.OperatorCondition {
+	ds *appsv1.DaemonSet, unhealthyNodePods int32, conflicts []string) operatorv1.OperatorCondition {
 	degradedCondition := &operatorv1.OperatorCondition{
 		Type: operatorv1.OperatorStatusTypeDegraded,
 	}
-	numberUnavailable := ds.Status.DesiredNumberScheduled - ds.Status.NumberAvailable
+	numberUnavailable := ds.Status.DesiredNumberScheduled - ds.Status.NumberAvailable - unhealthyNodePods
 	switch {
+	case len(conflicts) != 0:
+		degradedCondition.Status = operatorv1.ConditionTrue
+		degradedCondition.Reason = "ResourceConflict"
+		degradedCondition.Message = fmt.Sprintf("Resources exist that are not owned by the DNS: %s", strings.Join(conflicts, "; "))
 	case len(clusterIP) == 0 && ds.Status.NumberAvailable == 0:
 		degradedCondition.Status = operatorv1.ConditionTrue
 		degradedCondition.Reason = "NoClusterIPAndDaemonSet"
@@ -85,22 +146,29 @@ func computeDNSDegradedCondition(oldCondition *operatorv1.OperatorCondition, clu
 		degradedCondition.Status = operatorv1.ConditionTrue
 		degradedCondition.Reason = "MaxUnavailableExceeded"
 		degradedCondition.Message = fmt.Sprintf("Too many unavailable CoreDNS pods (%d > %d max unavailable)", numberUnavailable, ds.Spec.UpdateStrategy.RollingUpdate.MaxUnavailable.IntVal)
+		if unhealthyNodePods != 0 {
+			degradedCondition.Message += fmt.Sprintf("; %d unavailable pods on unschedulable or NotReady nodes are not counted", unhealthyNodePods)
+		}
 	default:
 		degradedCondition.Status = operatorv1.ConditionFalse
 		degradedCondition.Reason = "AsExpected"
 		degradedCondition.Message = "ClusterIP assigned to DNS Service and minimum DaemonSet pods running"
 	}
 
-	return setDNSLastTransitionTime(degradedCondition, oldCondition)
+	return conditions.SetOperatorConditionTransitionTime(degradedCondition, oldCondition)
 }
 
 // computeDNSProgressingCondition computes the dns Progressing status condition
-// based on the status of ds.
-func computeDNSProgressingCondition(oldCondition *operatorv1.OperatorCondition, ds *appsv1.DaemonSet) operatorv1.OperatorCondition {
+// based on the status of ds and on whether a rollout of ds is deferred.
+func computeDNSProgressingCondition(oldCondition *operatorv1.OperatorCondition, ds *appsv1.DaemonSet, rolloutDeferral string) operatorv1.OperatorCondition {
 	progressingCondition := &operatorv1.OperatorCondition{
 		Type: operatorv1.OperatorStatusTypeProgressing,
 	}
-	if ds.Status.NumberAvailable == ds.Status.DesiredNumberScheduled {
+	if len(rolloutDeferral) != 0 {
+		progressingCondition.Status = operatorv1.ConditionTrue
+		progressingCondition.Reason = "RolloutDeferred"
+		progressingCondition.Message = rolloutDeferral
+	} else if ds.Status.NumberAvailable == ds.Status.DesiredNumberScheduled {
 		progressingCondition.Status = operatorv1.ConditionFalse
 		progressingCondition.Reason = "AsExpected"
 		progressingCondition.Message = "All expected Nodes running DaemonSet pod"
@@ -111,7 +179,7 @@ func computeDNSProgressingCondition(oldCondition *operatorv1.OperatorCondition,
 			ds.Status.NumberAvailable, ds.Status.DesiredNumberScheduled)
 	}
 
-	return setDNSLastTransitionTime(progressingCondition, oldCondition)
+	return conditions.SetOperatorConditionTransitionTime(progressingCondition, oldCondition)
 }
 
 // computeDNSAvailableCondition computes the dns Available status condition
@@ -139,20 +207,7 @@ func computeDNSAvailableCondition(oldCondition *operatorv1.OperatorCondition, cl
 		availableCondition.Message = "Minimum number of Nodes running DaemonSet pod"
 	}
 
-	return setDNSLastTransitionTime(availableCondition, oldCondition)
-}
-
-// setDNSLastTransitionTime sets LastTransitionTime for the given condition.
-// If the condition has changed, it will assign a new timestamp otherwise keeps the old timestamp.
-func setDNSLastTransitionTime(condition, oldCondition *operatorv1.OperatorCondition) operatorv1.OperatorCondition {
-	if oldCondition != nil && condition.Status == oldCondition.Status &&
-		condition.Reason == oldCondition.Reason && condition.Message == oldCondition.Message {
-		condition.LastTransitionTime = oldCondition.LastTransitionTime
-	} else {
-		condition.LastTransitionTime = metav1.Now()
-	}
-
-	return *condition
+	return conditions.SetOperatorConditionTransitionTime(availableCondition, oldCondition)
 }
 
 // dnsStatusesEqual compares two DNSStatus values.  Returns true
@@ -172,6 +227,27 @@ func dnsStatusesEqual(a, b operatorv1.DNSStatus) bool {
 	if a.ClusterDomain != b.ClusterDomain {
 		return false
 	}
+	if !cmp.Equal(a.CacheStats, b.CacheStats) {
+		return false
+	}
+	if !cmp.Equal(a.Forwarders, b.Forwarders) {
+		return false
+	}
+	if !cmp.Equal(a.CorefileStatus, b.CorefileStatus) {
+		return false
+	}
+	if !cmp.Equal(a.PodVersions, b.PodVersions, cmpopts.EquateEmpty()) {
+		return false
+	}
+	if !cmp.Equal(a.DisabledCapabilities, b.DisabledCapabilities, cmpopts.EquateEmpty()) {
+		return false
+	}
+	if !cmp.Equal(a.LastFailure, b.LastFailure) {
+		return false
+	}
+	if !cmp.Equal(a.History, b.History, cmpopts.EquateEmpty()) {
+		return false
+	}
 
 	return true
 }
@@ -136,7 +136,7 @@ func TestDNSStatusConditions(t *testing.T) {
 				Status: available,
 			},
 		}
-		actual := computeDNSStatusConditions([]operatorv1.OperatorCondition{}, clusterIP, ds)
+		actual := computeDNSStatusConditions([]operatorv1.OperatorCondition{}, clusterIP, ds, 0, "", nil)
 		gotExpected := true
 		if len(actual) != len(expected) {
 			gotExpected = false
@@ -163,6 +163,32 @@ func TestDNSStatusConditions(t *testing.T) {
 	}
 }
 
+func TestDNSDegradedConditionResourceConflict(t *testing.T) {
+	maxUnavailable := intstr.FromInt(1)
+	ds := &appsv1.DaemonSet{
+		Spec: appsv1.DaemonSetSpec{
+			UpdateStrategy: appsv1.DaemonSetUpdateStrategy{
+				RollingUpdate: &appsv1.RollingUpdateDaemonSet{
+					MaxUnavailable: &maxUnavailable,
+				},
+			},
+		},
+		Status: appsv1.DaemonSetStatus{
+			DesiredNumberScheduled: 2,
+			NumberAvailable:        2,
+		},
+	}
+	conflicts := []string{"service openshift-dns/dns-default is not owned by dns default: controlled by deployment foo"}
+	condition := computeDNSDegradedCondition(nil, "1.2.3.4", ds, 0, conflicts)
+	if condition.Status != operatorv1.ConditionTrue || condition.Reason != "ResourceConflict" {
+		t.Errorf("expected Degraded=True with reason ResourceConflict, got %s with reason %s", condition.Status, condition.Reason)
+	}
+	condition = computeDNSDegradedCondition(nil, "1.2.3.4", ds, 0, nil)
+	if condition.Status != operatorv1.ConditionFalse {
+		t.Errorf("expected Degraded=False without conflicts, got %s with reason %s", condition.Status, condition.Reason)
+	}
+}
+
 func TestDNSStatusesEqual(t *testing.T) {
 	testCases := []struct {
 		description string
@@ -324,3 +350,54 @@ func TestDNSStatusesEqual(t *testing.T) {
 		}
 	}
 }
+
+func TestComputeDNSProgressingConditionRolloutDeferred(t *testing.T) {
+	ds := &appsv1.DaemonSet{
+		Status: appsv1.DaemonSetStatus{
+			DesiredNumberScheduled: 6,
+			NumberAvailable:        6,
+		},
+	}
+	deferral := "Deferring DaemonSet rollout: 2 of 6 DNS pods are unavailable, more than the 1 that a rollout may make unavailable"
+	c := computeDNSProgressingCondition(nil, ds, deferral)
+	if c.Status != operatorv1.ConditionTrue || c.Reason != "RolloutDeferred" || c.Message != deferral {
+		t.Errorf("expected Progressing=True with reason RolloutDeferred and message %q, got %+v", deferral, c)
+	}
+	c = computeDNSProgressingCondition(nil, ds, "")
+	if c.Status != operatorv1.ConditionFalse {
+		t.Errorf("expected Progressing=False without a deferral, got %+v", c)
+	}
+}
+
+func TestDNSDegradedConditionUnhealthyNodes(t *testing.T) {
+	maxUnavailable := intstr.FromInt(1)
+	ds := &appsv1.DaemonSet{
+		Spec: appsv1.DaemonSetSpec{
+			UpdateStrategy: appsv1.DaemonSetUpdateStrategy{
+				RollingUpdate: &appsv1.RollingUpdateDaemonSet{
+					MaxUnavailable: &maxUnavailable,
+				},
+			},
+		},
+		Status: appsv1.DaemonSetStatus{
+			DesiredNumberScheduled: 6,
+			NumberAvailable:        3,
+		},
+	}
+	testCases := []struct {
+		description       string
+		unhealthyNodePods int32
+		expected          operatorv1.ConditionStatus
+	}{
+		{"no pods on unhealthy nodes", 0, operatorv1.ConditionTrue},
+		{"some pods on unhealthy nodes", 1, operatorv1.ConditionTrue},
+		{"enough pods on unhealthy nodes", 2, operatorv1.ConditionFalse},
+		{"all unavailable pods on unhealthy nodes", 3, operatorv1.ConditionFalse},
+	}
+	for _, tc := range testCases {
+		condition := computeDNSDegradedCondition(nil, "1.2.3.4", ds, tc.unhealthyNodePods, nil)
+		if condition.Status != tc.expected {
+			t.Errorf("%q: expected Degraded=%s, got %s with reason %s and message %q", tc.description, tc.expected, condition.Status, condition.Reason, condition.Message)
+		}
+	}
+}
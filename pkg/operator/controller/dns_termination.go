@@ -0,0 +1,89 @@
+package controller
+
+import (
+	"math"
+	"strconv"
+	"time"
+
+	operatorv1 "github.com/openshift/api/operator/v1"
+
+	"github.com/sirupsen/logrus"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+)
+
+const (
+	// defaultPreStopDelay is how long a terminating dns pod keeps
+	// answering queries before CoreDNS is asked to stop if the dns does
+	// not specify a delay.  It covers the time that kube-proxy or OVN
+	// take to stop sending queries to a pod that was removed from the
+	// endpoints of the dns service.
+	defaultPreStopDelay = 5 * time.Second
+
+	// defaultShutdownGracePeriod is how long CoreDNS may take to stop
+	// after the pre-stop delay if the dns does not specify a grace period,
+	// which is the default grace period of a pod.
+	defaultShutdownGracePeriod = 30 * time.Second
+
+	// minShutdownGracePeriod is the least time that CoreDNS is given to
+	// stop after the pre-stop delay.
+	minShutdownGracePeriod = 5 * time.Second
+)
+
+// dnsPreStopDelaySeconds returns the time in seconds for which a terminating
+// pod of the given dns keeps answering queries before CoreDNS is asked to stop.
+func dnsPreStopDelaySeconds(dns *operatorv1.DNS) int64 {
+	delay := defaultPreStopDelay
+	if d := dns.Spec.Termination.PreStopDelay; d != nil {
+		if d.Duration < 0 {
+			logrus.Warningf("ignoring negative pre-stop delay %s of dns %s", d.Duration, dns.Name)
+		} else {
+			delay = d.Duration
+		}
+	}
+	return int64(math.Ceil(delay.Seconds()))
+}
+
+// dnsTerminationGracePeriodSeconds returns the termination grace period in
+// seconds of the pods of the given dns.  A grace period that does not leave
+// CoreDNS time to stop after the pre-stop delay is raised.
+func dnsTerminationGracePeriodSeconds(dns *operatorv1.DNS) int64 {
+	delay := dnsPreStopDelaySeconds(dns)
+	minimum := delay + int64(minShutdownGracePeriod/time.Second)
+	d := dns.Spec.Termination.GracePeriod
+	if d == nil {
+		return delay + int64(defaultShutdownGracePeriod/time.Second)
+	}
+	if seconds := int64(math.Ceil(d.Duration.Seconds())); seconds >= minimum {
+		return seconds
+	}
+	logrus.Warningf("raising grace period %s of dns %s to %ds to leave CoreDNS time to stop after the pre-stop delay", d.Duration, dns.Name, minimum)
+	return minimum
+}
+
+// setDNSTermination sets the termination grace period of the pods of the given
+// daemonset and a pre-stop hook that makes the dns container keep answering
+// queries for the pre-stop delay of the given dns after the pod starts
+// terminating.  The kubelet sends CoreDNS the TERM signal once the hook
+// returns.
+func setDNSTermination(daemonset *appsv1.DaemonSet, dns *operatorv1.DNS) {
+	gracePeriod := dnsTerminationGracePeriodSeconds(dns)
+	daemonset.Spec.Template.Spec.TerminationGracePeriodSeconds = &gracePeriod
+	delay := dnsPreStopDelaySeconds(dns)
+	if delay == 0 {
+		return
+	}
+	for i := range daemonset.Spec.Template.Spec.Containers {
+		if daemonset.Spec.Template.Spec.Containers[i].Name != "dns" {
+			continue
+		}
+		daemonset.Spec.Template.Spec.Containers[i].Lifecycle = &corev1.Lifecycle{
+			PreStop: &corev1.Handler{
+				Exec: &corev1.ExecAction{
+					Command: []string{"sleep", strconv.FormatInt(delay, 10)},
+				},
+			},
+		}
+	}
+}
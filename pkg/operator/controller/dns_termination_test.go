@@ -0,0 +1,77 @@
+package controller
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	operatorv1 "github.com/openshift/api/operator/v1"
+	"github.com/openshift/cluster-dns-operator/pkg/manifests"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestDNSTerminationSeconds(t *testing.T) {
+	testCases := []struct {
+		description         string
+		preStopDelay        *metav1.Duration
+		gracePeriod         *metav1.Duration
+		expectedDelay       int64
+		expectedGracePeriod int64
+	}{
+		{"defaults", nil, nil, 5, 35},
+		{"no delay", &metav1.Duration{}, nil, 0, 30},
+		{"negative delay", &metav1.Duration{Duration: -time.Second}, nil, 5, 35},
+		{"fractional delay", &metav1.Duration{Duration: 2500 * time.Millisecond}, nil, 3, 33},
+		{"grace period", &metav1.Duration{Duration: 10 * time.Second}, &metav1.Duration{Duration: time.Minute}, 10, 60},
+		{"grace period shorter than the delay", &metav1.Duration{Duration: 10 * time.Second}, &metav1.Duration{Duration: 12 * time.Second}, 10, 15},
+		{"zero grace period", nil, &metav1.Duration{}, 5, 10},
+	}
+	for _, tc := range testCases {
+		dns := &operatorv1.DNS{
+			Spec: operatorv1.DNSSpec{
+				Termination: operatorv1.DNSTermination{
+					PreStopDelay: tc.preStopDelay,
+					GracePeriod:  tc.gracePeriod,
+				},
+			},
+		}
+		if actual := dnsPreStopDelaySeconds(dns); actual != tc.expectedDelay {
+			t.Errorf("%s: expected a pre-stop delay of %d, got %d", tc.description, tc.expectedDelay, actual)
+		}
+		if actual := dnsTerminationGracePeriodSeconds(dns); actual != tc.expectedGracePeriod {
+			t.Errorf("%s: expected a grace period of %d, got %d", tc.description, tc.expectedGracePeriod, actual)
+		}
+	}
+}
+
+func TestSetDNSTermination(t *testing.T) {
+	dns := &operatorv1.DNS{}
+	daemonset := manifests.DNSDaemonSet()
+	setDNSTermination(daemonset, dns)
+	if gracePeriod := daemonset.Spec.Template.Spec.TerminationGracePeriodSeconds; gracePeriod == nil || *gracePeriod != 35 {
+		t.Errorf("expected a termination grace period of 35, got %v", gracePeriod)
+	}
+	for _, c := range daemonset.Spec.Template.Spec.Containers {
+		switch {
+		case c.Name == "dns":
+			if c.Lifecycle == nil || c.Lifecycle.PreStop == nil || c.Lifecycle.PreStop.Exec == nil {
+				t.Fatalf("expected a pre-stop hook on the dns container, got %v", c.Lifecycle)
+			}
+			if e, a := []string{"sleep", "5"}, c.Lifecycle.PreStop.Exec.Command; !reflect.DeepEqual(e, a) {
+				t.Errorf("expected pre-stop command %v, got %v", e, a)
+			}
+		case c.Lifecycle != nil:
+			t.Errorf("expected no pre-stop hook on the %s container", c.Name)
+		}
+	}
+
+	dns.Spec.Termination.PreStopDelay = &metav1.Duration{}
+	daemonset = manifests.DNSDaemonSet()
+	setDNSTermination(daemonset, dns)
+	for _, c := range daemonset.Spec.Template.Spec.Containers {
+		if c.Lifecycle != nil {
+			t.Errorf("expected no pre-stop hook on the %s container without a delay", c.Name)
+		}
+	}
+}
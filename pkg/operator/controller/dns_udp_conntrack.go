@@ -0,0 +1,125 @@
+package controller
+
+import (
+	"sync"
+	"time"
+
+	dto "github.com/prometheus/client_model/go"
+
+	"github.com/prometheus/client_golang/prometheus"
+
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+)
+
+// udpConntrackTimeout is the default of the nf_conntrack_udp_timeout kernel
+// setting, which is how long a node keeps the conntrack entry of a UDP query
+// to the dns service that got a single response.
+const udpConntrackTimeout = 30 * time.Second
+
+var (
+	// udpQueryRateGauge reports the rate of UDP queries to the pods of
+	// each dns.
+	udpQueryRateGauge = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "dns_operator_udp_queries_per_second",
+		Help: "Rate of UDP queries to the DNS pods between the two most recent samples.",
+	}, []string{"dns"})
+
+	// udpConntrackEntriesGauge reports an estimate of the conntrack
+	// entries across all nodes that UDP queries to each dns use.
+	udpConntrackEntriesGauge = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "dns_operator_udp_conntrack_entries_estimate",
+		Help: "Estimated number of conntrack entries across all nodes that UDP queries to the DNS Service use, assuming the default UDP conntrack timeout of 30s.",
+	}, []string{"dns"})
+)
+
+func init() {
+	metrics.Registry.MustRegister(udpQueryRateGauge, udpConntrackEntriesGauge)
+}
+
+// parseUDPQueries returns the number of UDP queries that a dns pod has served
+// from the given metrics of the pod.
+func parseUDPQueries(families map[string]*dto.MetricFamily) float64 {
+	var queries float64
+	// CoreDNS 1.7.0 renamed the request counter.
+	for _, name := range []string{"coredns_dns_requests_total", "coredns_dns_request_count_total"} {
+		family, ok := families[name]
+		if !ok {
+			continue
+		}
+		for _, m := range family.Metric {
+			if metricLabel(m, "proto") == "udp" {
+				queries += metricValue(m)
+			}
+		}
+	}
+	return queries
+}
+
+// udpQuerySample is the number of UDP queries that each pod of a dns has served
+// at a point in time.
+type udpQuerySample struct {
+	time    time.Time
+	queries map[string]float64
+}
+
+// udpQueryTracker remembers the UDP query counters from the previous sample of
+// the pods of each dns so that the query rate is measured over the sample
+// interval.
+type udpQueryTracker struct {
+	lock sync.Mutex
+	last map[string]udpQuerySample
+}
+
+// update records the given UDP query counters of the pods of the named dns,
+// keyed by pod name, and returns the rate of UDP queries since the previous
+// sample and a Boolean indicating whether there was a previous sample.  Pods
+// that were not sampled before, or whose counters were reset because the
+// container restarted, are not counted.
+func (t *udpQueryTracker) update(dns string, queries map[string]float64, now time.Time) (float64, bool) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	if t.last == nil {
+		t.last = map[string]udpQuerySample{}
+	}
+	last, ok := t.last[dns]
+	t.last[dns] = udpQuerySample{time: now, queries: queries}
+	elapsed := now.Sub(last.time).Seconds()
+	if !ok || elapsed <= 0 {
+		return 0, false
+	}
+	var delta float64
+	for pod, n := range queries {
+		if previous, ok := last.queries[pod]; ok && n >= previous {
+			delta += n - previous
+		}
+	}
+	return delta / elapsed, true
+}
+
+// forget removes the counters of the named dns.
+func (t *udpQueryTracker) forget(dns string) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	delete(t.last, dns)
+}
+
+// sampleUDPConntrackUsage publishes the UDP query rate of the named dns and an
+// estimate of the conntrack entries that the queries use from the given
+// metrics of its pods, keyed by pod name.  Each UDP query to the dns service
+// creates a conntrack entry on the node of the client that the node keeps for
+// udpConntrackTimeout, so the entries are estimated as the query rate times the
+// timeout.  Queries that clients send directly to the pods do not use conntrack
+// entries but are counted as well, so the estimate is an upper bound.
+func (r *reconciler) sampleUDPConntrackUsage(dns string, podMetrics map[string]map[string]*dto.MetricFamily, now time.Time) {
+	queries := map[string]float64{}
+	for pod, families := range podMetrics {
+		queries[pod] = parseUDPQueries(families)
+	}
+	rate, ok := r.udpQueries.update(dns, queries, now)
+	if !ok {
+		return
+	}
+	udpQueryRateGauge.WithLabelValues(dns).Set(rate)
+	udpConntrackEntriesGauge.WithLabelValues(dns).Set(rate * udpConntrackTimeout.Seconds())
+}
@@ -0,0 +1,39 @@
+package controller
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestParseUDPQueries(t *testing.T) {
+	metrics := `# TYPE coredns_dns_requests_total counter
+coredns_dns_requests_total{family="1",proto="udp",server="dns://:5353",type="A",zone="."} 100
+coredns_dns_requests_total{family="1",proto="udp",server="dns://:5353",type="AAAA",zone="."} 50
+coredns_dns_requests_total{family="1",proto="tcp",server="dns://:5353",type="A",zone="."} 7
+`
+	families, err := parsePodMetrics(strings.NewReader(metrics))
+	if err != nil {
+		t.Fatalf("failed to parse metrics: %v", err)
+	}
+	if actual := parseUDPQueries(families); actual != 150 {
+		t.Errorf("expected 150 UDP queries, got %v", actual)
+	}
+}
+
+func TestUDPQueryTracker(t *testing.T) {
+	tracker := &udpQueryTracker{}
+	now := time.Now()
+	if _, ok := tracker.update("default", map[string]float64{"a": 100, "b": 1000}, now); ok {
+		t.Fatalf("expected no rate from the first sample")
+	}
+	// Pod b restarted, and pod c is new, so only pod a is counted.
+	rate, ok := tracker.update("default", map[string]float64{"a": 700, "b": 10, "c": 500}, now.Add(30*time.Second))
+	if !ok || rate != 20 {
+		t.Errorf("expected a rate of 20 queries per second, got %v (%t)", rate, ok)
+	}
+	tracker.forget("default")
+	if _, ok := tracker.update("default", map[string]float64{"a": 800}, now.Add(time.Minute)); ok {
+		t.Errorf("expected no rate after the dns is forgotten")
+	}
+}
@@ -0,0 +1,68 @@
+package controller
+
+import (
+	"context"
+	"fmt"
+
+	operatorv1 "github.com/openshift/api/operator/v1"
+
+	corev1 "k8s.io/api/core/v1"
+
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+// nodeUnhealthy returns a Boolean indicating whether the given node is
+// unschedulable or not ready.  A dns pod on such a node is expected to be
+// unavailable regardless of the dns.
+func nodeUnhealthy(node *corev1.Node) bool {
+	if node.Spec.Unschedulable {
+		return true
+	}
+	for _, c := range node.Status.Conditions {
+		if c.Type == corev1.NodeReady {
+			return c.Status != corev1.ConditionTrue
+		}
+	}
+	return true
+}
+
+// countUnavailableDNSPodsOnUnhealthyNodes returns the number of dns pods of the
+// given dns that are not ready and are on nodes that are unschedulable or not
+// ready.  These pods are not counted when deciding whether the dns is degraded
+// so that a single broken node does not make the whole dns degraded.  A node
+// that no longer exists is considered unhealthy.
+func (r *reconciler) countUnavailableDNSPodsOnUnhealthyNodes(dns *operatorv1.DNS) (int32, error) {
+	pods, err := r.listDNSPods(dns)
+	if err != nil {
+		return 0, err
+	}
+	unhealthy := map[string]bool{}
+	count := int32(0)
+	for i := range pods.Items {
+		pod := &pods.Items[i]
+		// Pods of node overrides are not counted by the dns daemonset.
+		if _, ok := pod.Labels[nodeOverrideLabel]; ok {
+			continue
+		}
+		if podReady(pod) || len(pod.Spec.NodeName) == 0 {
+			continue
+		}
+		nodeName := pod.Spec.NodeName
+		if _, ok := unhealthy[nodeName]; !ok {
+			node := &corev1.Node{}
+			if err := r.client.Get(context.TODO(), types.NamespacedName{Name: nodeName}, node); err != nil {
+				if !errors.IsNotFound(err) {
+					return 0, fmt.Errorf("failed to get node %s: %v", nodeName, err)
+				}
+				unhealthy[nodeName] = true
+			} else {
+				unhealthy[nodeName] = nodeUnhealthy(node)
+			}
+		}
+		if unhealthy[nodeName] {
+			count++
+		}
+	}
+	return count, nil
+}
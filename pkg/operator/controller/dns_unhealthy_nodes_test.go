@@ -0,0 +1,52 @@
+package controller
+
+import (
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+)
+
+func TestNodeUnhealthy(t *testing.T) {
+	ready := func(status corev1.ConditionStatus) []corev1.NodeCondition {
+		return []corev1.NodeCondition{{Type: corev1.NodeReady, Status: status}}
+	}
+	testCases := []struct {
+		description string
+		node        corev1.Node
+		expected    bool
+	}{
+		{
+			description: "ready schedulable node",
+			node:        corev1.Node{Status: corev1.NodeStatus{Conditions: ready(corev1.ConditionTrue)}},
+			expected:    false,
+		},
+		{
+			description: "not ready node",
+			node:        corev1.Node{Status: corev1.NodeStatus{Conditions: ready(corev1.ConditionFalse)}},
+			expected:    true,
+		},
+		{
+			description: "node with unknown readiness",
+			node:        corev1.Node{Status: corev1.NodeStatus{Conditions: ready(corev1.ConditionUnknown)}},
+			expected:    true,
+		},
+		{
+			description: "node without ready condition",
+			node:        corev1.Node{},
+			expected:    true,
+		},
+		{
+			description: "unschedulable ready node",
+			node: corev1.Node{
+				Spec:   corev1.NodeSpec{Unschedulable: true},
+				Status: corev1.NodeStatus{Conditions: ready(corev1.ConditionTrue)},
+			},
+			expected: true,
+		},
+	}
+	for _, tc := range testCases {
+		if actual := nodeUnhealthy(&tc.node); actual != tc.expected {
+			t.Errorf("%q: expected %t, got %t", tc.description, tc.expected, actual)
+		}
+	}
+}
@@ -0,0 +1,149 @@
+package controller
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"sort"
+	"strings"
+
+	operatorv1 "github.com/openshift/api/operator/v1"
+
+	"github.com/sirupsen/logrus"
+
+	corev1 "k8s.io/api/core/v1"
+
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/apimachinery/pkg/util/yaml"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	// ExtensionServerLabel is the label of a configmap in the operand
+	// namespace through which another component, such as a multi-cluster
+	// service discovery component, requests an additional server in the
+	// Corefile of a dns.  The value of the label is the name of the dns.
+	ExtensionServerLabel = "dns.operator.openshift.io/extension-server"
+
+	// extensionServerKey is the key of the configmap data that holds the
+	// requested server, in the YAML or JSON form of a Server of the dns
+	// API.
+	extensionServerKey = "server"
+)
+
+// extensionServer is a server requested through a configmap.
+type extensionServer struct {
+	// source is the name of the configmap that requests the server.
+	source string
+	server operatorv1.Server
+}
+
+// getExtensionServers returns the servers that are requested for the given
+// dns through configmaps with the extension server label, sorted by the name
+// of the configmap.  Configmaps that do not hold a valid server are ignored.
+func (r *reconciler) getExtensionServers(dns *operatorv1.DNS) ([]extensionServer, error) {
+	configmaps := &corev1.ConfigMapList{}
+	if err := r.client.List(context.TODO(), configmaps,
+		client.InNamespace(DNSConfigMapName(dns).Namespace),
+		client.MatchingLabels{ExtensionServerLabel: dns.Name},
+	); err != nil {
+		return nil, fmt.Errorf("failed to list extension server configmaps: %v", err)
+	}
+	servers := []extensionServer{}
+	for i := range configmaps.Items {
+		cm := &configmaps.Items[i]
+		r.references.add(dns.Name, objectReference{kind: "configmap", name: types.NamespacedName{Namespace: cm.Namespace, Name: cm.Name}})
+		server, err := parseExtensionServer(cm)
+		if err != nil {
+			logrus.Warningf("ignoring extension server configmap %s/%s for dns %s: %v", cm.Namespace, cm.Name, dns.Name, err)
+			r.recorder.Eventf(dns, corev1.EventTypeWarning, "InvalidExtensionServer", "Ignoring configmap %s/%s: %v", cm.Namespace, cm.Name, err)
+			r.history.record(dns.Name, dnsHistoryExtensionServerRejected, fmt.Sprintf("Ignoring configmap %s/%s: %v", cm.Namespace, cm.Name, err))
+			continue
+		}
+		servers = append(servers, extensionServer{source: cm.Name, server: server})
+	}
+	sort.Slice(servers, func(i, j int) bool { return servers[i].source < servers[j].source })
+	return servers, nil
+}
+
+// parseExtensionServer parses and validates the server that the given
+// configmap requests.  If the server has no name, the name of the configmap is
+// used.
+func parseExtensionServer(cm *corev1.ConfigMap) (operatorv1.Server, error) {
+	server := operatorv1.Server{}
+	data, ok := cm.Data[extensionServerKey]
+	if !ok {
+		return server, fmt.Errorf("missing key %q", extensionServerKey)
+	}
+	if err := yaml.NewYAMLOrJSONDecoder(strings.NewReader(data), len(data)+1).Decode(&server); err != nil {
+		return server, fmt.Errorf("invalid server: %v", err)
+	}
+	if len(server.Name) == 0 {
+		server.Name = cm.Name
+	}
+	if errs := validation.IsDNS1123Label(server.Name); len(errs) != 0 {
+		return server, fmt.Errorf("invalid server name %q: %s", server.Name, strings.Join(errs, ", "))
+	}
+	if len(server.Zones) == 0 {
+		return server, fmt.Errorf("server %s has no zones", server.Name)
+	}
+	for _, zone := range server.Zones {
+		if errs := validation.IsDNS1123Subdomain(zone); len(errs) != 0 {
+			return server, fmt.Errorf("server %s has invalid zone %q: %s", server.Name, zone, strings.Join(errs, ", "))
+		}
+	}
+	if len(server.ForwardPlugin.Upstreams) == 0 {
+		return server, fmt.Errorf("server %s has no upstreams", server.Name)
+	}
+	for _, upstream := range server.ForwardPlugin.Upstreams {
+		host := upstream
+		if h, _, err := net.SplitHostPort(upstream); err == nil {
+			host = h
+		}
+		if net.ParseIP(host) == nil {
+			return server, fmt.Errorf("server %s has invalid upstream %q", server.Name, upstream)
+		}
+	}
+	return server, nil
+}
+
+// corefileExtensionServers returns the given extension servers for which the
+// Corefile of the given dns has server blocks.  A server with a zone that is
+// a cluster domain, or that is already served by a server or a cluster peer
+// of the dns or by another extension server, is ignored because the servers
+// and cluster peers in the dns spec take precedence.
+func corefileExtensionServers(dns *operatorv1.DNS, clusterDomain string, peers []operatorv1.DNSClusterPeer, extensions []extensionServer) []operatorv1.Server {
+	zones := map[string]struct{}{}
+	for _, domain := range corefileClusterDomains(dns, clusterDomain) {
+		zones[domain] = struct{}{}
+	}
+	for _, server := range dns.Spec.Servers {
+		for _, zone := range server.Zones {
+			zones[strings.ToLower(strings.TrimSuffix(zone, "."))] = struct{}{}
+		}
+	}
+	for _, peer := range peers {
+		zones[peer.ClusterDomain] = struct{}{}
+	}
+	servers := []operatorv1.Server{}
+	for _, extension := range extensions {
+		conflict := ""
+		for _, zone := range extension.server.Zones {
+			if _, ok := zones[strings.ToLower(strings.TrimSuffix(zone, "."))]; ok {
+				conflict = zone
+				break
+			}
+		}
+		if len(conflict) != 0 {
+			logrus.Warningf("ignoring extension server %s from configmap %s for dns %s: zone %s is already served", extension.server.Name, extension.source, dns.Name, conflict)
+			continue
+		}
+		for _, zone := range extension.server.Zones {
+			zones[strings.ToLower(strings.TrimSuffix(zone, "."))] = struct{}{}
+		}
+		servers = append(servers, extension.server)
+	}
+	return servers
+}
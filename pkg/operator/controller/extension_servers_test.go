@@ -0,0 +1,104 @@
+package controller
+
+import (
+	"reflect"
+	"testing"
+
+	operatorv1 "github.com/openshift/api/operator/v1"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestParseExtensionServer(t *testing.T) {
+	testCases := []struct {
+		description string
+		data        map[string]string
+		expect      *operatorv1.Server
+	}{
+		{
+			description: "yaml server without a name",
+			data: map[string]string{"server": `
+zones:
+- clusterset.local
+forwardPlugin:
+  upstreams:
+  - 172.30.10.10
+  - 172.30.10.11:5353
+`},
+			expect: &operatorv1.Server{
+				Name:  "lighthouse",
+				Zones: []string{"clusterset.local"},
+				ForwardPlugin: operatorv1.ForwardPlugin{
+					Upstreams: []string{"172.30.10.10", "172.30.10.11:5353"},
+				},
+			},
+		},
+		{
+			description: "json server with a name",
+			data:        map[string]string{"server": `{"name": "mcs", "zones": ["clusterset.local"], "forwardPlugin": {"upstreams": ["172.30.10.10"]}}`},
+			expect: &operatorv1.Server{
+				Name:  "mcs",
+				Zones: []string{"clusterset.local"},
+				ForwardPlugin: operatorv1.ForwardPlugin{
+					Upstreams: []string{"172.30.10.10"},
+				},
+			},
+		},
+		{
+			description: "missing key",
+			data:        map[string]string{},
+		},
+		{
+			description: "no zones",
+			data:        map[string]string{"server": `{"forwardPlugin": {"upstreams": ["172.30.10.10"]}}`},
+		},
+		{
+			description: "invalid zone",
+			data:        map[string]string{"server": `{"zones": ["foo {"], "forwardPlugin": {"upstreams": ["172.30.10.10"]}}`},
+		},
+		{
+			description: "no upstreams",
+			data:        map[string]string{"server": `{"zones": ["clusterset.local"]}`},
+		},
+		{
+			description: "upstream that is not an address",
+			data:        map[string]string{"server": `{"zones": ["clusterset.local"], "forwardPlugin": {"upstreams": ["lighthouse.svc"]}}`},
+		},
+	}
+	for _, tc := range testCases {
+		cm := &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Name: "lighthouse"},
+			Data:       tc.data,
+		}
+		server, err := parseExtensionServer(cm)
+		switch {
+		case tc.expect == nil && err == nil:
+			t.Errorf("%q: expected error, got %+v", tc.description, server)
+		case tc.expect != nil && err != nil:
+			t.Errorf("%q: unexpected error: %v", tc.description, err)
+		case tc.expect != nil && !reflect.DeepEqual(server, *tc.expect):
+			t.Errorf("%q: expected %+v, got %+v", tc.description, *tc.expect, server)
+		}
+	}
+}
+
+func TestCorefileExtensionServers(t *testing.T) {
+	dns := &operatorv1.DNS{
+		Spec: operatorv1.DNSSpec{
+			Servers: []operatorv1.Server{{Name: "foo", Zones: []string{"foo.com"}}},
+		},
+	}
+	peers := []operatorv1.DNSClusterPeer{{Name: "east", ClusterDomain: "east.local"}}
+	extensions := []extensionServer{
+		{source: "a", server: operatorv1.Server{Name: "clusterset", Zones: []string{"clusterset.local"}}},
+		{source: "b", server: operatorv1.Server{Name: "foo", Zones: []string{"bar.com", "foo.com"}}},
+		{source: "c", server: operatorv1.Server{Name: "east", Zones: []string{"east.local"}}},
+		{source: "d", server: operatorv1.Server{Name: "self", Zones: []string{"cluster.local"}}},
+		{source: "e", server: operatorv1.Server{Name: "clusterset-again", Zones: []string{"clusterset.local."}}},
+	}
+	servers := corefileExtensionServers(dns, "cluster.local", peers, extensions)
+	if len(servers) != 1 || servers[0].Name != "clusterset" {
+		t.Errorf("expected only server clusterset, got %+v", servers)
+	}
+}
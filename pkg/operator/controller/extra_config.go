@@ -0,0 +1,258 @@
+package controller
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"path"
+	"sort"
+	"strings"
+
+	operatorv1 "github.com/openshift/api/operator/v1"
+
+	"github.com/sirupsen/logrus"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/validation"
+)
+
+const (
+	// extraConfigMountPath is the directory in which the dns container
+	// mounts the configmap of each extra config reference of the dns, in a
+	// directory named after the configmap.
+	extraConfigMountPath = "/etc/coredns-extra"
+
+	// extraConfigImportPattern is the pattern of the files in the directory
+	// of a mounted configmap that the Corefile imports.  The kubelet keeps
+	// the data of a configmap volume in hidden directories, which the
+	// pattern excludes.
+	extraConfigImportPattern = "[^.]*"
+)
+
+// defaultServerDirectives are the directives of the server block of the root
+// zone that CoreDNS allows only once per server block.  A snippet that is
+// imported into that server block must not repeat them.
+var defaultServerDirectives = map[string]struct{}{
+	"bind":        {},
+	"cache":       {},
+	"cancel":      {},
+	"errors":      {},
+	"forward":     {},
+	"health":      {},
+	"kubernetes":  {},
+	"local":       {},
+	"metadata":    {},
+	"multisocket": {},
+	"nsid":        {},
+	"prometheus":  {},
+	"ready":       {},
+	"reload":      {},
+}
+
+// dnsExtraConfigRefs returns the extra config references of the given dns,
+// with the default extension point filled in where unset.  A reference whose
+// name is invalid, or that is listed earlier, is ignored.
+func dnsExtraConfigRefs(dns *operatorv1.DNS) []operatorv1.DNSExtraConfigReference {
+	refs := []operatorv1.DNSExtraConfigReference{}
+	seen := map[string]struct{}{}
+	for _, ref := range dns.Spec.ExtraConfigRefs {
+		if len(ref.ExtensionPoint) == 0 {
+			ref.ExtensionPoint = operatorv1.DNSExtensionPointDefaultServer
+		}
+		if msgs := validation.IsDNS1123Subdomain(ref.Name); len(msgs) != 0 {
+			logrus.Warningf("ignoring extra config reference %q of dns %s: invalid name: %s", ref.Name, dns.Name, strings.Join(msgs, ", "))
+			continue
+		}
+		if _, ok := seen[ref.Name]; ok {
+			logrus.Warningf("ignoring extra config reference %s of dns %s: configmap is already referenced", ref.Name, dns.Name)
+			continue
+		}
+		seen[ref.Name] = struct{}{}
+		refs = append(refs, ref)
+	}
+	return refs
+}
+
+// extraConfigVolumeName returns the name of the volume of the dns pods for
+// the extra config reference at the given index.
+func extraConfigVolumeName(i int) string {
+	return fmt.Sprintf("extra-config-%d", i)
+}
+
+// extraConfig is the configmap of an extra config reference whose snippets the
+// Corefile imports.
+type extraConfig struct {
+	ref operatorv1.DNSExtraConfigReference
+	// hash is a hash of the data of the configmap.
+	hash string
+}
+
+// getExtraConfigs returns the extra config references of the given dns whose
+// configmaps exist and hold valid snippets.  Other references are ignored.
+func (r *reconciler) getExtraConfigs(dns *operatorv1.DNS) ([]extraConfig, error) {
+	configs := []extraConfig{}
+	namespace := DNSConfigMapName(dns).Namespace
+	for _, ref := range dnsExtraConfigRefs(dns) {
+		cm, err := r.getReferencedConfigMap(dns, types.NamespacedName{Namespace: namespace, Name: ref.Name})
+		if err != nil {
+			return nil, fmt.Errorf("failed to get extra config configmap %s/%s: %v", namespace, ref.Name, err)
+		}
+		if cm == nil {
+			logrus.Warningf("ignoring extra config reference %s of dns %s: configmap %s/%s does not exist", ref.Name, dns.Name, namespace, ref.Name)
+			r.recorder.Eventf(dns, corev1.EventTypeWarning, "MissingExtraConfig", "Ignoring extra config reference %s: configmap %s/%s does not exist", ref.Name, namespace, ref.Name)
+			continue
+		}
+		if err := validateExtraConfig(ref, cm); err != nil {
+			logrus.Warningf("ignoring extra config reference %s of dns %s: %v", ref.Name, dns.Name, err)
+			r.recorder.Eventf(dns, corev1.EventTypeWarning, "InvalidExtraConfig", "Ignoring extra config reference %s: %v", ref.Name, err)
+			continue
+		}
+		configs = append(configs, extraConfig{ref: ref, hash: extraConfigHash(cm.Data)})
+	}
+	return configs, nil
+}
+
+// validateExtraConfig returns an error if the given configmap of the given
+// extra config reference does not hold snippets that can be imported at the
+// extension point of the reference.
+func validateExtraConfig(ref operatorv1.DNSExtraConfigReference, cm *corev1.ConfigMap) error {
+	if len(cm.Data) == 0 {
+		return fmt.Errorf("configmap %s/%s has no data", cm.Namespace, cm.Name)
+	}
+	keys := []string{}
+	for key := range cm.Data {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		if strings.HasPrefix(key, ".") {
+			return fmt.Errorf("key %q of configmap %s/%s starts with a dot", key, cm.Namespace, cm.Name)
+		}
+		directives, err := snippetDirectives(cm.Data[key])
+		if err != nil {
+			return fmt.Errorf("key %s of configmap %s/%s: %v", key, cm.Namespace, cm.Name, err)
+		}
+		if ref.ExtensionPoint != operatorv1.DNSExtensionPointDefaultServer {
+			continue
+		}
+		for _, directive := range directives {
+			if _, ok := defaultServerDirectives[directive]; ok {
+				return fmt.Errorf("key %s of configmap %s/%s repeats directive %s of the default server", key, cm.Namespace, cm.Name, directive)
+			}
+		}
+	}
+	return nil
+}
+
+// snippetDirectives returns the first word of each top-level line of the given
+// snippet, which is a directive if the snippet is imported into a server block.
+// It returns an error if the braces of the snippet are unbalanced.  Braces in
+// quoted strings and comments are not counted.
+func snippetDirectives(snippet string) ([]string, error) {
+	directives := []string{}
+	depth := 0
+	for n, line := range strings.Split(snippet, "\n") {
+		if fields := strings.Fields(line); depth == 0 && len(fields) != 0 && !strings.HasPrefix(fields[0], "#") {
+			directives = append(directives, fields[0])
+		}
+		quoted, escaped := false, false
+	scan:
+		for _, c := range line {
+			switch {
+			case escaped:
+				escaped = false
+			case c == '\\':
+				escaped = true
+			case c == '"':
+				quoted = !quoted
+			case quoted:
+			case c == '#':
+				break scan
+			case c == '{':
+				depth++
+			case c == '}':
+				depth--
+				if depth < 0 {
+					return nil, fmt.Errorf("line %d: unexpected }", n+1)
+				}
+			}
+		}
+	}
+	if depth != 0 {
+		return nil, fmt.Errorf("unbalanced braces")
+	}
+	return directives, nil
+}
+
+// extraConfigHash returns a hash of the given configmap data.
+func extraConfigHash(data map[string]string) string {
+	keys := []string{}
+	for key := range data {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	h := sha256.New()
+	for _, key := range keys {
+		fmt.Fprintf(h, "%s\x00%s\x00", key, data[key])
+	}
+	return hex.EncodeToString(h.Sum(nil)[:8])
+}
+
+// corefileImport is an import of snippets into the Corefile.
+type corefileImport struct {
+	// Pattern is the pattern of the imported files.
+	Pattern string
+	// Hash is a hash of the imported snippets.  The Corefile includes it
+	// in a comment because CoreDNS's reload plugin only watches the
+	// Corefile itself, so that CoreDNS reloads when a snippet changes.
+	Hash string
+}
+
+// corefileImports returns the imports of the given extra configs at the given
+// extension point.
+func corefileImports(configs []extraConfig, point operatorv1.DNSExtensionPoint) []corefileImport {
+	imports := []corefileImport{}
+	for _, config := range configs {
+		if config.ref.ExtensionPoint != point {
+			continue
+		}
+		imports = append(imports, corefileImport{
+			Pattern: path.Join(extraConfigMountPath, config.ref.Name, extraConfigImportPattern),
+			Hash:    config.hash,
+		})
+	}
+	return imports
+}
+
+// setExtraConfigVolumes adds a volume with the configmap of each extra config
+// reference of the given dns to the given dns daemonset and mounts it in the
+// dns container.  Each configmap is optional so that the pods start whether or
+// not it exists; the Corefile only imports the snippets of configmaps that do.
+func setExtraConfigVolumes(daemonset *appsv1.DaemonSet, dns *operatorv1.DNS) {
+	optional := true
+	for i, ref := range dnsExtraConfigRefs(dns) {
+		name := extraConfigVolumeName(i)
+		daemonset.Spec.Template.Spec.Volumes = append(daemonset.Spec.Template.Spec.Volumes, corev1.Volume{
+			Name: name,
+			VolumeSource: corev1.VolumeSource{
+				ConfigMap: &corev1.ConfigMapVolumeSource{
+					LocalObjectReference: corev1.LocalObjectReference{Name: ref.Name},
+					Optional:             &optional,
+				},
+			},
+		})
+		for j, c := range daemonset.Spec.Template.Spec.Containers {
+			if c.Name != "dns" {
+				continue
+			}
+			daemonset.Spec.Template.Spec.Containers[j].VolumeMounts = append(daemonset.Spec.Template.Spec.Containers[j].VolumeMounts, corev1.VolumeMount{
+				Name:      name,
+				MountPath: path.Join(extraConfigMountPath, ref.Name),
+				ReadOnly:  true,
+			})
+		}
+	}
+}
@@ -0,0 +1,168 @@
+package controller
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	operatorv1 "github.com/openshift/api/operator/v1"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestDNSExtraConfigRefs(t *testing.T) {
+	dns := &operatorv1.DNS{
+		ObjectMeta: metav1.ObjectMeta{Name: DefaultDNSController},
+		Spec: operatorv1.DNSSpec{
+			ExtraConfigRefs: []operatorv1.DNSExtraConfigReference{
+				{Name: "hosts"},
+				{Name: "Invalid_Name"},
+				{Name: "hosts", ExtensionPoint: operatorv1.DNSExtensionPointServerBlocks},
+				{Name: "zones", ExtensionPoint: operatorv1.DNSExtensionPointServerBlocks},
+			},
+		},
+	}
+	expected := []operatorv1.DNSExtraConfigReference{
+		{Name: "hosts", ExtensionPoint: operatorv1.DNSExtensionPointDefaultServer},
+		{Name: "zones", ExtensionPoint: operatorv1.DNSExtensionPointServerBlocks},
+	}
+	if actual := dnsExtraConfigRefs(dns); !cmp.Equal(actual, expected) {
+		t.Errorf("expected %+v, got %+v", expected, actual)
+	}
+}
+
+func TestValidateExtraConfig(t *testing.T) {
+	defaultServer := operatorv1.DNSExtraConfigReference{Name: "extra", ExtensionPoint: operatorv1.DNSExtensionPointDefaultServer}
+	serverBlocks := operatorv1.DNSExtraConfigReference{Name: "extra", ExtensionPoint: operatorv1.DNSExtensionPointServerBlocks}
+	testCases := []struct {
+		description string
+		ref         operatorv1.DNSExtraConfigReference
+		data        map[string]string
+		expectError bool
+	}{
+		{
+			description: "plugin directives",
+			ref:         defaultServer,
+			data: map[string]string{
+				"hosts":   "hosts {\n    10.0.0.1 db.example.com\n    fallthrough\n}\n",
+				"rewrite": "# Rewrite \"{legacy}\" names.\nrewrite name suffix .legacy.local .svc.cluster.local\n",
+			},
+		},
+		{
+			description: "server blocks",
+			ref:         serverBlocks,
+			data: map[string]string{
+				"corp": "corp.example.com:5353 {\n    forward . 10.0.0.1\n    cache 60\n}\n",
+			},
+		},
+		{
+			description: "no data",
+			ref:         defaultServer,
+			expectError: true,
+		},
+		{
+			description: "hidden key",
+			ref:         defaultServer,
+			data:        map[string]string{".hosts": "hosts\n"},
+			expectError: true,
+		},
+		{
+			description: "unbalanced braces",
+			ref:         defaultServer,
+			data:        map[string]string{"hosts": "hosts {\n    fallthrough\n"},
+			expectError: true,
+		},
+		{
+			description: "unexpected closing brace",
+			ref:         serverBlocks,
+			data:        map[string]string{"corp": "}\ncorp.example.com:5353 {\n"},
+			expectError: true,
+		},
+		{
+			description: "directive of the default server",
+			ref:         defaultServer,
+			data:        map[string]string{"cache": "cache 300\n"},
+			expectError: true,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.description, func(t *testing.T) {
+			cm := &corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "openshift-dns", Name: "extra"},
+				Data:       tc.data,
+			}
+			err := validateExtraConfig(tc.ref, cm)
+			switch {
+			case tc.expectError && err == nil:
+				t.Error("expected an error, got none")
+			case !tc.expectError && err != nil:
+				t.Errorf("unexpected error: %v", err)
+			}
+		})
+	}
+}
+
+func TestExtraConfigHash(t *testing.T) {
+	a := extraConfigHash(map[string]string{"a": "b", "c": "d"})
+	if b := extraConfigHash(map[string]string{"c": "d", "a": "b"}); a != b {
+		t.Errorf("expected equal hashes for equal data, got %s and %s", a, b)
+	}
+	if b := extraConfigHash(map[string]string{"a": "bc", "": "d"}); a == b {
+		t.Errorf("expected different hashes for different data, got %s", a)
+	}
+}
+
+func TestDesiredDNSDaemonSetExtraConfigVolumes(t *testing.T) {
+	dns := &operatorv1.DNS{
+		ObjectMeta: metav1.ObjectMeta{Name: DefaultDNSController},
+	}
+	current, err := desiredDNSDaemonSet(dns, "172.30.0.10", "cluster.local", "coredns", "cli", "proxy", false, nil)
+	if err != nil {
+		t.Fatalf("invalid dns daemonset: %v", err)
+	}
+
+	dns.Spec.ExtraConfigRefs = []operatorv1.DNSExtraConfigReference{
+		{Name: "hosts"},
+		{Name: "zones", ExtensionPoint: operatorv1.DNSExtensionPointServerBlocks},
+	}
+	desired, err := desiredDNSDaemonSet(dns, "172.30.0.10", "cluster.local", "coredns", "cli", "proxy", false, nil)
+	if err != nil {
+		t.Fatalf("invalid dns daemonset: %v", err)
+	}
+	volumes := map[string]string{}
+	for _, v := range desired.Spec.Template.Spec.Volumes {
+		if v.ConfigMap != nil {
+			volumes[v.Name] = v.ConfigMap.Name
+		}
+	}
+	mounts := map[string]string{}
+	for _, c := range desired.Spec.Template.Spec.Containers {
+		if c.Name != "dns" {
+			continue
+		}
+		for _, m := range c.VolumeMounts {
+			mounts[m.Name] = m.MountPath
+		}
+	}
+	for i, expected := range []struct{ configmap, path string }{
+		{"hosts", "/etc/coredns-extra/hosts"},
+		{"zones", "/etc/coredns-extra/zones"},
+	} {
+		name := extraConfigVolumeName(i)
+		if actual := volumes[name]; actual != expected.configmap {
+			t.Errorf("expected volume %s with configmap %q, got %q", name, expected.configmap, actual)
+		}
+		if actual := mounts[name]; actual != expected.path {
+			t.Errorf("expected volume %s mounted at %q, got %q", name, expected.path, actual)
+		}
+	}
+
+	changed, updated := daemonsetConfigChanged(current, desired)
+	if !changed {
+		t.Fatal("expected adding extra config references to change the daemonset")
+	}
+	if changed, _ := daemonsetConfigChanged(updated, desired); changed {
+		t.Error("expected no change after update")
+	}
+}
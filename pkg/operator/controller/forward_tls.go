@@ -0,0 +1,190 @@
+package controller
+
+import (
+	"fmt"
+	"path"
+	"sort"
+	"strings"
+
+	operatorv1 "github.com/openshift/api/operator/v1"
+
+	"github.com/sirupsen/logrus"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/validation"
+)
+
+const (
+	// forwardCABundleKey is the key of the configmap data that holds the
+	// CA bundle of a server that forwards over TLS.
+	forwardCABundleKey = "ca-bundle.crt"
+
+	// forwardCABundleMountPath is the directory in which the dns container
+	// mounts the configmap of each CA bundle that servers use to validate
+	// the certificates of their upstreams, in a directory named after the
+	// configmap.
+	forwardCABundleMountPath = "/etc/coredns-forward-ca"
+
+	// tlsUpstreamPrefix is the prefix of an upstream of the forward plugin
+	// that makes CoreDNS forward queries to it over TLS.
+	tlsUpstreamPrefix = "tls://"
+)
+
+// forwardsOverTLS returns a Boolean indicating whether the given server
+// forwards queries to its upstreams over TLS.
+func forwardsOverTLS(server operatorv1.Server) bool {
+	return server.ForwardPlugin.TransportConfig.Transport == operatorv1.TLSTransport
+}
+
+// forwardUpstreams returns the upstreams of the given server as the forward
+// plugin takes them.  Upstreams of a server that forwards over TLS have the
+// tls:// prefix, which makes the forward plugin use port 853 unless the
+// upstream has a port.
+func forwardUpstreams(server operatorv1.Server) []string {
+	if !forwardsOverTLS(server) {
+		return server.ForwardPlugin.Upstreams
+	}
+	upstreams := []string{}
+	for _, upstream := range server.ForwardPlugin.Upstreams {
+		upstreams = append(upstreams, tlsUpstreamPrefix+upstream)
+	}
+	return upstreams
+}
+
+// forwardCABundleName returns the name of the configmap with the CA bundle of
+// the given server, or an empty string if the server does not forward over TLS
+// or uses the system trust store.
+func forwardCABundleName(server operatorv1.Server) string {
+	tls := server.ForwardPlugin.TransportConfig.TLS
+	if !forwardsOverTLS(server) || tls == nil {
+		return ""
+	}
+	return tls.CABundle.Name
+}
+
+// dnsForwardCABundleRefs returns the names of the configmaps with the CA
+// bundles of the servers of the given dns and of its node overrides, sorted and
+// without duplicates.  A name that is invalid is ignored.
+func dnsForwardCABundleRefs(dns *operatorv1.DNS) []string {
+	servers := append([]operatorv1.Server{}, dns.Spec.Servers...)
+	for _, override := range dnsNodeOverrides(dns) {
+		servers = append(servers, override.Servers...)
+	}
+	names := []string{}
+	seen := map[string]struct{}{}
+	for _, server := range servers {
+		name := forwardCABundleName(server)
+		if len(name) == 0 {
+			continue
+		}
+		if _, ok := seen[name]; ok {
+			continue
+		}
+		seen[name] = struct{}{}
+		if msgs := validation.IsDNS1123Subdomain(name); len(msgs) != 0 {
+			logrus.Warningf("ignoring CA bundle %q of server %s of dns %s: invalid name: %s", name, server.Name, dns.Name, strings.Join(msgs, ", "))
+			continue
+		}
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// forwardCABundleVolumeName returns the name of the volume of the dns pods for
+// the CA bundle at the given index.
+func forwardCABundleVolumeName(i int) string {
+	return fmt.Sprintf("forward-ca-bundle-%d", i)
+}
+
+// getForwardCABundles returns the hash of each CA bundle of the servers of the
+// given dns whose configmap exists and has a CA bundle, keyed by the name of
+// the configmap.  Other CA bundles are ignored.
+func (r *reconciler) getForwardCABundles(dns *operatorv1.DNS) (map[string]string, error) {
+	bundles := map[string]string{}
+	namespace := DNSConfigMapName(dns).Namespace
+	for _, name := range dnsForwardCABundleRefs(dns) {
+		cm, err := r.getReferencedConfigMap(dns, types.NamespacedName{Namespace: namespace, Name: name})
+		if err != nil {
+			return nil, fmt.Errorf("failed to get CA bundle configmap %s/%s: %v", namespace, name, err)
+		}
+		switch {
+		case cm == nil:
+			logrus.Warningf("ignoring CA bundle %s of dns %s: configmap %s/%s does not exist", name, dns.Name, namespace, name)
+			r.recorder.Eventf(dns, corev1.EventTypeWarning, "MissingForwardCABundle", "Using the system trust store in place of CA bundle %s: configmap %s/%s does not exist", name, namespace, name)
+		case len(strings.TrimSpace(cm.Data[forwardCABundleKey])) == 0:
+			logrus.Warningf("ignoring CA bundle %s of dns %s: configmap %s/%s has no key %s", name, dns.Name, namespace, name, forwardCABundleKey)
+			r.recorder.Eventf(dns, corev1.EventTypeWarning, "InvalidForwardCABundle", "Using the system trust store in place of CA bundle %s: configmap %s/%s has no key %s", name, namespace, name, forwardCABundleKey)
+		default:
+			bundles[name] = extraConfigHash(map[string]string{forwardCABundleKey: cm.Data[forwardCABundleKey]})
+		}
+	}
+	return bundles, nil
+}
+
+// corefileForwardTLSOptions returns the options of the forward plugin for the
+// TLS transport of the given server of the given dns, given the hashes of the
+// CA bundles that the dns pods have.  A server that references a CA bundle
+// that the pods do not have uses the system trust store, which still encrypts
+// its queries but may fail to validate its upstreams.  The Corefile includes
+// the hash of the CA bundle in a comment because CoreDNS only reads the CA
+// bundle when it loads the Corefile.
+func corefileForwardTLSOptions(dns *operatorv1.DNS, server operatorv1.Server, caBundles map[string]string) []corefileDirective {
+	if !forwardsOverTLS(server) {
+		return nil
+	}
+	options := []corefileDirective{}
+	if name := forwardCABundleName(server); len(name) != 0 {
+		if hash, ok := caBundles[name]; ok {
+			tls := newCorefileDirective("tls", path.Join(forwardCABundleMountPath, name, forwardCABundleKey))
+			tls.Comment = hash
+			options = append(options, tls)
+		} else {
+			logrus.Warningf("ignoring CA bundle %s of server %s of dns %s: CA bundle is not available", name, server.Name, dns.Name)
+		}
+	}
+	if tls := server.ForwardPlugin.TransportConfig.TLS; tls != nil && len(tls.ServerName) != 0 {
+		options = append(options, newCorefileDirective("tls_servername", tls.ServerName))
+	} else {
+		logrus.Warningf("server %s of dns %s forwards over TLS without a server name; its upstreams can only be validated by their IP addresses", server.Name, dns.Name)
+	}
+	return options
+}
+
+// setForwardCABundleVolumes adds a volume with the configmap of each CA bundle
+// of the servers of the given dns to the given dns daemonset and mounts it in
+// the dns container.  Each configmap is optional so that the pods start whether
+// or not it exists; the Corefile only uses the CA bundles of configmaps that
+// do.
+func setForwardCABundleVolumes(daemonset *appsv1.DaemonSet, dns *operatorv1.DNS) {
+	optional := true
+	for i, name := range dnsForwardCABundleRefs(dns) {
+		volume := forwardCABundleVolumeName(i)
+		daemonset.Spec.Template.Spec.Volumes = append(daemonset.Spec.Template.Spec.Volumes, corev1.Volume{
+			Name: volume,
+			VolumeSource: corev1.VolumeSource{
+				ConfigMap: &corev1.ConfigMapVolumeSource{
+					LocalObjectReference: corev1.LocalObjectReference{Name: name},
+					Items: []corev1.KeyToPath{{
+						Key:  forwardCABundleKey,
+						Path: forwardCABundleKey,
+					}},
+					Optional: &optional,
+				},
+			},
+		})
+		for j, c := range daemonset.Spec.Template.Spec.Containers {
+			if c.Name != "dns" {
+				continue
+			}
+			daemonset.Spec.Template.Spec.Containers[j].VolumeMounts = append(daemonset.Spec.Template.Spec.Containers[j].VolumeMounts, corev1.VolumeMount{
+				Name:      volume,
+				MountPath: path.Join(forwardCABundleMountPath, name),
+				ReadOnly:  true,
+			})
+		}
+	}
+}
@@ -0,0 +1,167 @@
+package controller
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	configv1 "github.com/openshift/api/config/v1"
+	operatorv1 "github.com/openshift/api/operator/v1"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// tlsServer returns a server that forwards to the given upstreams over TLS with
+// the given server name and CA bundle.
+func tlsServer(name string, upstreams []string, serverName, caBundle string) operatorv1.Server {
+	return operatorv1.Server{
+		Name:  name,
+		Zones: []string{name + ".example.com"},
+		ForwardPlugin: operatorv1.ForwardPlugin{
+			Upstreams: upstreams,
+			TransportConfig: operatorv1.DNSTransportConfig{
+				Transport: operatorv1.TLSTransport,
+				TLS: &operatorv1.DNSOverTLSConfig{
+					ServerName: serverName,
+					CABundle:   configv1.ConfigMapNameReference{Name: caBundle},
+				},
+			},
+		},
+	}
+}
+
+func TestForwardUpstreams(t *testing.T) {
+	server := tlsServer("corp", []string{"10.0.0.53", "10.0.1.53:8853"}, "dns.corp.example.com", "")
+	expected := []string{"tls://10.0.0.53", "tls://10.0.1.53:8853"}
+	if actual := forwardUpstreams(server); !cmp.Equal(actual, expected) {
+		t.Errorf("expected %v, got %v", expected, actual)
+	}
+	server.ForwardPlugin.TransportConfig.Transport = operatorv1.CleartextTransport
+	expected = []string{"10.0.0.53", "10.0.1.53:8853"}
+	if actual := forwardUpstreams(server); !cmp.Equal(actual, expected) {
+		t.Errorf("expected %v, got %v", expected, actual)
+	}
+}
+
+func TestDNSForwardCABundleRefs(t *testing.T) {
+	cleartext := tlsServer("plain", []string{"1.1.1.1"}, "", "ignored")
+	cleartext.ForwardPlugin.TransportConfig.Transport = ""
+	dns := &operatorv1.DNS{
+		ObjectMeta: metav1.ObjectMeta{Name: DefaultDNSController},
+		Spec: operatorv1.DNSSpec{
+			Servers: []operatorv1.Server{
+				tlsServer("foo", []string{"10.0.0.53"}, "dns.example.com", "zeta-ca"),
+				tlsServer("bar", []string{"10.0.0.54"}, "dns.example.com", "alpha-ca"),
+				tlsServer("baz", []string{"10.0.0.55"}, "dns.example.com", "zeta-ca"),
+				tlsServer("qux", []string{"10.0.0.56"}, "dns.example.com", "Invalid_Name"),
+				tlsServer("quux", []string{"10.0.0.57"}, "dns.example.com", ""),
+				cleartext,
+			},
+		},
+	}
+	expected := []string{"alpha-ca", "zeta-ca"}
+	if actual := dnsForwardCABundleRefs(dns); !cmp.Equal(actual, expected) {
+		t.Errorf("expected %v, got %v", expected, actual)
+	}
+}
+
+func TestCorefileForwardTLSOptions(t *testing.T) {
+	dns := &operatorv1.DNS{ObjectMeta: metav1.ObjectMeta{Name: DefaultDNSController}}
+	server := tlsServer("corp", []string{"10.0.0.53"}, "dns.corp.example.com", "corp-ca")
+	testCases := []struct {
+		description string
+		caBundles   map[string]string
+		expected    []corefileDirective
+	}{
+		{
+			description: "available CA bundle",
+			caBundles:   map[string]string{"corp-ca": "abc"},
+			expected: []corefileDirective{
+				{Name: "tls", Args: []string{"/etc/coredns-forward-ca/corp-ca/ca-bundle.crt"}, Comment: "abc"},
+				{Name: "tls_servername", Args: []string{"dns.corp.example.com"}},
+			},
+		},
+		{
+			description: "unavailable CA bundle",
+			expected:    []corefileDirective{{Name: "tls_servername", Args: []string{"dns.corp.example.com"}}},
+		},
+	}
+	for _, tc := range testCases {
+		if actual := corefileForwardTLSOptions(dns, server, tc.caBundles); !cmp.Equal(actual, tc.expected) {
+			t.Errorf("%s: expected %v, got %v", tc.description, tc.expected, actual)
+		}
+	}
+	server.ForwardPlugin.TransportConfig.Transport = operatorv1.CleartextTransport
+	if actual := corefileForwardTLSOptions(dns, server, map[string]string{"corp-ca": "abc"}); len(actual) != 0 {
+		t.Errorf("expected no TLS options for cleartext, got %v", actual)
+	}
+}
+
+func TestUpstreamServersTLS(t *testing.T) {
+	dns := &operatorv1.DNS{
+		Spec: operatorv1.DNSSpec{
+			Servers: []operatorv1.Server{
+				tlsServer("corp", []string{"10.0.0.53", "10.0.1.53:8853"}, "dns.corp.example.com", ""),
+			},
+		},
+	}
+	actual := upstreamServers(dns)
+	for _, address := range []string{"10.0.0.53:853", "10.0.1.53:8853"} {
+		if !cmp.Equal(actual[address], []string{"corp"}) {
+			t.Errorf("expected upstream %s of server corp, got %v", address, actual)
+		}
+	}
+}
+
+func TestDesiredDNSDaemonSetForwardCABundleVolumes(t *testing.T) {
+	dns := &operatorv1.DNS{
+		ObjectMeta: metav1.ObjectMeta{Name: DefaultDNSController},
+	}
+	current, err := desiredDNSDaemonSet(dns, "172.30.0.10", "cluster.local", "coredns", "cli", "proxy", false, nil)
+	if err != nil {
+		t.Fatalf("invalid dns daemonset: %v", err)
+	}
+
+	dns.Spec.Servers = []operatorv1.Server{tlsServer("corp", []string{"10.0.0.53"}, "dns.corp.example.com", "corp-ca")}
+	desired, err := desiredDNSDaemonSet(dns, "172.30.0.10", "cluster.local", "coredns", "cli", "proxy", false, nil)
+	if err != nil {
+		t.Fatalf("invalid dns daemonset: %v", err)
+	}
+	name := forwardCABundleVolumeName(0)
+	found := false
+	for _, v := range desired.Spec.Template.Spec.Volumes {
+		if v.Name != name {
+			continue
+		}
+		found = true
+		if v.ConfigMap == nil || v.ConfigMap.Name != "corp-ca" || v.ConfigMap.Optional == nil || !*v.ConfigMap.Optional {
+			t.Errorf("expected volume %s with optional configmap corp-ca, got %+v", name, v.VolumeSource)
+		}
+	}
+	if !found {
+		t.Fatalf("expected volume %s", name)
+	}
+	for _, c := range desired.Spec.Template.Spec.Containers {
+		if c.Name != "dns" {
+			continue
+		}
+		mounted := false
+		for _, m := range c.VolumeMounts {
+			if m.Name == name {
+				mounted = strings.HasSuffix(m.MountPath, "/corp-ca")
+			}
+		}
+		if !mounted {
+			t.Errorf("expected volume %s to be mounted in the dns container, got %+v", name, c.VolumeMounts)
+		}
+	}
+
+	changed, updated := daemonsetConfigChanged(current, desired)
+	if !changed {
+		t.Fatal("expected adding a CA bundle to change the daemonset")
+	}
+	if changed, _ := daemonsetConfigChanged(updated, desired); changed {
+		t.Error("expected no change after update")
+	}
+}
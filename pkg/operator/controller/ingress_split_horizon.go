@@ -0,0 +1,184 @@
+package controller
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	operatorv1 "github.com/openshift/api/operator/v1"
+
+	"github.com/sirupsen/logrus"
+
+	corev1 "k8s.io/api/core/v1"
+	networkingv1beta1 "k8s.io/api/networking/v1beta1"
+
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+const (
+	// defaultRouterName is the name of the default ingress controller as
+	// it appears in the status of the routes that it admits.
+	defaultRouterName = "default"
+)
+
+var (
+	// routerInternalServiceName is the internal service of the default
+	// ingress controller.
+	routerInternalServiceName = types.NamespacedName{Namespace: "openshift-ingress", Name: "router-internal-default"}
+
+	// routeGVK is the group, version, and kind of routes.  The route API
+	// is not part of the operator's scheme, so routes are handled as
+	// unstructured objects.
+	routeGVK = schema.GroupVersionKind{Group: "route.openshift.io", Version: "v1", Kind: "Route"}
+)
+
+// ingressWatcher watches routes and ingresses in all namespaces.  The watch
+// is started the first time a dns enables ingress split-horizon so that the
+// operator does not cache every route and ingress in clusters that do not opt
+// in.
+type ingressWatcher struct {
+	lock      sync.Mutex
+	informers *sharedInformers
+	ctrl      controller.Controller
+	started   bool
+}
+
+// start watches routes and ingresses in the cluster-wide cache and requeues
+// the default dns on any change to them.  It does nothing if the watch is
+// already started.
+func (w *ingressWatcher) start() error {
+	w.lock.Lock()
+	defer w.lock.Unlock()
+	if w.started {
+		return nil
+	}
+
+	route := &unstructured.Unstructured{}
+	route.SetGroupVersionKind(routeGVK)
+	toDefaultDNS := &handler.EnqueueRequestsFromMapFunc{
+		ToRequests: handler.ToRequestsFunc(func(_ handler.MapObject) []reconcile.Request {
+			return []reconcile.Request{{NamespacedName: types.NamespacedName{Name: DefaultDNSController}}}
+		}),
+	}
+	for _, obj := range []runtime.Object{route, &networkingv1beta1.Ingress{}} {
+		if err := w.informers.watch(w.ctrl, metav1.NamespaceAll, obj, toDefaultDNS); err != nil {
+			return fmt.Errorf("failed to watch %T: %v", obj, err)
+		}
+	}
+	w.started = true
+	logrus.Infof("started watching routes and ingresses for ingress split-horizon")
+	return nil
+}
+
+// ingressSplitHorizonEnabled returns a Boolean indicating whether the given dns
+// resolves the host names of routes and ingresses to the internal service of
+// the default ingress controller.
+func ingressSplitHorizonEnabled(dns *operatorv1.DNS) bool {
+	return dns.Spec.IngressSplitHorizon == operatorv1.IngressSplitHorizonEnabled
+}
+
+// getIngressHosts returns the host names of the routes and ingresses that the
+// default ingress controller serves, if the given dns enables ingress
+// split-horizon, does not enable service mesh coexistence, and the internal
+// service of the default ingress controller exists.  Otherwise it returns nil
+// so that the host names are resolved using the upstream resolvers.
+func (r *reconciler) getIngressHosts(dns *operatorv1.DNS) ([]string, error) {
+	if !ingressSplitHorizonEnabled(dns) {
+		return nil, nil
+	}
+	// Service mesh DNS proxies resolve the host names of routes and
+	// ingresses themselves, and rewriting them in CoreDNS as well gives
+	// answers that differ depending on whether a pod has a proxy.
+	if serviceMeshCoexistenceEnabled(dns) {
+		logrus.Warningf("not resolving ingress host names internally for dns %s: service mesh coexistence is enabled", dns.Name)
+		return nil, nil
+	}
+	if err := r.ingressWatcher.start(); err != nil {
+		return nil, err
+	}
+	if err := r.client.Get(context.TODO(), routerInternalServiceName, &corev1.Service{}); err != nil {
+		if errors.IsNotFound(err) {
+			logrus.Infof("not resolving ingress host names internally for dns %s: service %s does not exist", dns.Name, routerInternalServiceName)
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to get service %s: %v", routerInternalServiceName, err)
+	}
+
+	routes := &unstructured.UnstructuredList{}
+	routes.SetGroupVersionKind(routeGVK.GroupVersion().WithKind("RouteList"))
+	if err := r.informers.list(metav1.NamespaceAll, routes); err != nil {
+		return nil, fmt.Errorf("failed to list routes: %v", err)
+	}
+	ingresses := &networkingv1beta1.IngressList{}
+	if err := r.informers.list(metav1.NamespaceAll, ingresses); err != nil {
+		return nil, fmt.Errorf("failed to list ingresses: %v", err)
+	}
+	return ingressHosts(routes.Items, ingresses.Items), nil
+}
+
+// ingressHosts returns the sorted, unique host names of the given routes that
+// are admitted by the default ingress controller and of the given ingresses.
+// Wildcard host names are ignored.
+func ingressHosts(routes []unstructured.Unstructured, ingresses []networkingv1beta1.Ingress) []string {
+	set := map[string]struct{}{}
+	add := func(host string) {
+		host = strings.ToLower(strings.TrimSuffix(host, "."))
+		if len(host) != 0 && !strings.HasPrefix(host, "*") {
+			set[host] = struct{}{}
+		}
+	}
+	for _, route := range routes {
+		if routeAdmittedByDefaultRouter(route) {
+			host, _, _ := unstructured.NestedString(route.Object, "spec", "host")
+			add(host)
+		}
+	}
+	for _, ingress := range ingresses {
+		for _, rule := range ingress.Spec.Rules {
+			add(rule.Host)
+		}
+	}
+	hosts := []string{}
+	for host := range set {
+		hosts = append(hosts, host)
+	}
+	sort.Strings(hosts)
+	return hosts
+}
+
+// routeAdmittedByDefaultRouter returns a Boolean indicating whether the given
+// route is admitted by the default ingress controller.
+func routeAdmittedByDefaultRouter(route unstructured.Unstructured) bool {
+	ingresses, _, _ := unstructured.NestedSlice(route.Object, "status", "ingress")
+	for _, i := range ingresses {
+		ingress, ok := i.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		if name, _, _ := unstructured.NestedString(ingress, "routerName"); name != defaultRouterName {
+			continue
+		}
+		conditions, _, _ := unstructured.NestedSlice(ingress, "conditions")
+		for _, c := range conditions {
+			condition, ok := c.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			if condition["type"] == "Admitted" && condition["status"] == string(corev1.ConditionTrue) {
+				return true
+			}
+		}
+	}
+	return false
+}
@@ -0,0 +1,58 @@
+package controller
+
+import (
+	"reflect"
+	"testing"
+
+	networkingv1beta1 "k8s.io/api/networking/v1beta1"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+// route returns a route with the given host that the given router has or has
+// not admitted.
+func route(host, routerName string, admitted bool) unstructured.Unstructured {
+	status := "False"
+	if admitted {
+		status = "True"
+	}
+	return unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "route.openshift.io/v1",
+		"kind":       "Route",
+		"spec":       map[string]interface{}{"host": host},
+		"status": map[string]interface{}{
+			"ingress": []interface{}{
+				map[string]interface{}{
+					"host":       host,
+					"routerName": routerName,
+					"conditions": []interface{}{
+						map[string]interface{}{"type": "Admitted", "status": status},
+					},
+				},
+			},
+		},
+	}}
+}
+
+func TestIngressHosts(t *testing.T) {
+	routes := []unstructured.Unstructured{
+		route("web.apps.example.com", "default", true),
+		route("Console.Apps.Example.com", "default", true),
+		route("pending.apps.example.com", "default", false),
+		route("sharded.apps.example.com", "shard", true),
+		route("*.wildcard.apps.example.com", "default", true),
+	}
+	ingresses := []networkingv1beta1.Ingress{{
+		Spec: networkingv1beta1.IngressSpec{
+			Rules: []networkingv1beta1.IngressRule{
+				{Host: "web.apps.example.com"},
+				{Host: "shop.example.com."},
+				{},
+			},
+		},
+	}}
+	expected := []string{"console.apps.example.com", "shop.example.com", "web.apps.example.com"}
+	if actual := ingressHosts(routes, ingresses); !reflect.DeepEqual(actual, expected) {
+		t.Errorf("expected %v, got %v", expected, actual)
+	}
+}
@@ -0,0 +1,173 @@
+package controller
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	configv1 "github.com/openshift/api/config/v1"
+	operatorv1 "github.com/openshift/api/operator/v1"
+
+	"github.com/sirupsen/logrus"
+
+	appsv1 "k8s.io/api/apps/v1"
+)
+
+const (
+	// InsightsReportAnnotation is the annotation on the dns clusteroperator
+	// that holds an anonymized report of the configuration and health of
+	// each dns.  The insights operator gathers clusteroperators, so the
+	// report is included in the archives that it uploads for support.
+	InsightsReportAnnotation = "dns.operator.openshift.io/insights-report"
+
+	// insightsReportVersion is the version of the format of the insights
+	// report, which is incremented when fields are changed or removed.
+	insightsReportVersion = 2
+)
+
+// insightsReport is the report in InsightsReportAnnotation.  It holds counts
+// and states only, and no addresses, domains, or other names that identify
+// the cluster's network, other than the names of the dnses.  It holds no
+// counters that grow with traffic either, so that the annotation, and with it
+// the clusteroperator, only changes when the posture of a dns does.
+type insightsReport struct {
+	Version int                 `json:"version"`
+	DNSes   []insightsDNSReport `json:"dnses"`
+}
+
+// insightsDNSReport is the anonymized configuration and health of a dns.
+type insightsDNSReport struct {
+	Name string `json:"name"`
+
+	// Configuration.
+	Servers                  int      `json:"servers"`
+	ServerUpstreams          int      `json:"serverUpstreams"`
+	ClusterPeers             int      `json:"clusterPeers"`
+	ServiceAliases           int      `json:"serviceAliases"`
+	AdditionalClusterDomains int      `json:"additionalClusterDomains"`
+	HostAliases              int      `json:"hostAliases"`
+	LogLevel                 string   `json:"logLevel,omitempty"`
+	DisabledCapabilities     []string `json:"disabledCapabilities,omitempty"`
+
+	// Topology.
+	DesiredPods   int32 `json:"desiredPods"`
+	AvailablePods int32 `json:"availablePods"`
+
+	// Health.
+	Conditions        map[string]operatorv1.ConditionStatus `json:"conditions,omitempty"`
+	UpstreamsDown     int                                   `json:"upstreamsDown"`
+	UpstreamsFlaky    int                                   `json:"upstreamsFlaky"`
+	ForwardErrorRatio insightsErrorRatio                    `json:"forwardErrorRatio,omitempty"`
+}
+
+// insightsErrorRatio is a bucket of the ratio of failed to forwarded requests.
+type insightsErrorRatio string
+
+const (
+	insightsErrorRatioNone           insightsErrorRatio = "None"
+	insightsErrorRatioBelow1Percent  insightsErrorRatio = "Below1Percent"
+	insightsErrorRatioBelow10Percent insightsErrorRatio = "Below10Percent"
+	insightsErrorRatioHigh           insightsErrorRatio = "AtLeast10Percent"
+)
+
+// insightsForwardErrorRatio returns the bucket of the ratio of the given
+// number of failed requests to the given number of forwarded requests, or
+// the empty string if no requests were forwarded.
+func insightsForwardErrorRatio(requests, errors int64) insightsErrorRatio {
+	switch {
+	case requests <= 0:
+		return ""
+	case errors <= 0:
+		return insightsErrorRatioNone
+	case errors*100 < requests:
+		return insightsErrorRatioBelow1Percent
+	case errors*10 < requests:
+		return insightsErrorRatioBelow10Percent
+	default:
+		return insightsErrorRatioHigh
+	}
+}
+
+// computeInsightsReport returns the value of InsightsReportAnnotation for the
+// given dnses and their daemonsets, keyed by dns name.  A dns without a
+// daemonset is reported with no pods.
+func computeInsightsReport(dnses []operatorv1.DNS, daemonsets map[string]*appsv1.DaemonSet) (string, error) {
+	report := insightsReport{
+		Version: insightsReportVersion,
+		DNSes:   []insightsDNSReport{},
+	}
+	for i := range dnses {
+		dns := &dnses[i]
+		r := insightsDNSReport{
+			Name:                     dns.Name,
+			Servers:                  len(dns.Spec.Servers),
+			ClusterPeers:             len(dns.Spec.ClusterPeers),
+			ServiceAliases:           len(dns.Spec.ServiceAliases),
+			AdditionalClusterDomains: len(dns.Spec.AdditionalClusterDomains),
+			HostAliases:              len(dns.Spec.NodeResolver.HostAliases),
+			LogLevel:                 string(dns.Spec.LogLevel),
+			DisabledCapabilities:     dns.Status.DisabledCapabilities,
+		}
+		for _, server := range dns.Spec.Servers {
+			r.ServerUpstreams += len(server.ForwardPlugin.Upstreams)
+		}
+		if ds, ok := daemonsets[dns.Name]; ok {
+			r.DesiredPods = ds.Status.DesiredNumberScheduled
+			r.AvailablePods = ds.Status.NumberAvailable
+		}
+		if len(dns.Status.Conditions) != 0 {
+			r.Conditions = map[string]operatorv1.ConditionStatus{}
+			for _, c := range dns.Status.Conditions {
+				r.Conditions[c.Type] = c.Status
+			}
+		}
+		if forwarders := dns.Status.Forwarders; forwarders != nil {
+			var requests, errors int64
+			for _, upstream := range forwarders.Upstreams {
+				switch upstream.Health {
+				case operatorv1.DNSUpstreamHealthDown:
+					r.UpstreamsDown++
+				case operatorv1.DNSUpstreamHealthFlaky:
+					r.UpstreamsFlaky++
+				}
+				requests += upstream.Requests
+				errors += upstream.Errors
+			}
+			r.ForwardErrorRatio = insightsForwardErrorRatio(requests, errors)
+		}
+		report.DNSes = append(report.DNSes, r)
+	}
+	value, err := json.Marshal(report)
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal insights report: %v", err)
+	}
+	return string(value), nil
+}
+
+// syncInsightsReport updates InsightsReportAnnotation on the given
+// clusteroperator for the given dnses.
+func (r *reconciler) syncInsightsReport(co *configv1.ClusterOperator, dnses []operatorv1.DNS) error {
+	daemonsets := map[string]*appsv1.DaemonSet{}
+	for i := range dnses {
+		if haveDS, ds, err := r.currentDNSDaemonSet(&dnses[i]); err != nil {
+			logrus.Infof("failed to get daemonset for insights report of dns %s: %v", dnses[i].Name, err)
+		} else if haveDS {
+			daemonsets[dnses[i].Name] = ds
+		}
+	}
+	value, err := computeInsightsReport(dnses, daemonsets)
+	if err != nil {
+		return err
+	}
+	if co.Annotations[InsightsReportAnnotation] == value {
+		return nil
+	}
+	if co.Annotations == nil {
+		co.Annotations = map[string]string{}
+	}
+	co.Annotations[InsightsReportAnnotation] = value
+	if err := r.client.Update(context.TODO(), co); err != nil {
+		return fmt.Errorf("failed to update insights report on clusteroperator %s: %v", co.Name, err)
+	}
+	return nil
+}
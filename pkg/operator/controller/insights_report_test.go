@@ -0,0 +1,116 @@
+package controller
+
+import (
+	"strings"
+	"testing"
+
+	operatorv1 "github.com/openshift/api/operator/v1"
+
+	appsv1 "k8s.io/api/apps/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestComputeInsightsReport(t *testing.T) {
+	dnses := []operatorv1.DNS{
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "default"},
+			Spec: operatorv1.DNSSpec{
+				Servers: []operatorv1.Server{
+					{
+						Name:          "foo",
+						Zones:         []string{"foo.com"},
+						ForwardPlugin: operatorv1.ForwardPlugin{Upstreams: []string{"1.1.1.1", "2.2.2.2"}},
+					},
+					{
+						Name:          "bar",
+						Zones:         []string{"bar.com"},
+						ForwardPlugin: operatorv1.ForwardPlugin{Upstreams: []string{"3.3.3.3"}},
+					},
+				},
+				LogLevel: operatorv1.DNSLogLevelDebug,
+			},
+			Status: operatorv1.DNSStatus{
+				ClusterIP:     "172.30.0.10",
+				ClusterDomain: "cluster.local",
+				Conditions: []operatorv1.OperatorCondition{
+					{Type: operatorv1.OperatorStatusTypeDegraded, Status: operatorv1.ConditionFalse, Message: "secret"},
+					{Type: operatorv1.OperatorStatusTypeAvailable, Status: operatorv1.ConditionTrue},
+				},
+				Forwarders: &operatorv1.DNSForwarderStats{
+					Upstreams: []operatorv1.DNSUpstreamStatus{
+						{Address: "1.1.1.1:53", Health: operatorv1.DNSUpstreamHealthOK, Requests: 100, Errors: 1},
+						{Address: "2.2.2.2:53", Health: operatorv1.DNSUpstreamHealthDown, Requests: 10, Errors: 10},
+						{Address: "3.3.3.3:53", Health: operatorv1.DNSUpstreamHealthFlaky, Requests: 20, Errors: 5},
+					},
+				},
+			},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "other"},
+		},
+	}
+	daemonsets := map[string]*appsv1.DaemonSet{
+		"default": {Status: appsv1.DaemonSetStatus{DesiredNumberScheduled: 6, NumberAvailable: 5}},
+	}
+	expected := `{"version":2,"dnses":[` +
+		`{"name":"default","servers":2,"serverUpstreams":3,"clusterPeers":0,"serviceAliases":0,"additionalClusterDomains":0,"hostAliases":0,"logLevel":"Debug",` +
+		`"desiredPods":6,"availablePods":5,` +
+		`"conditions":{"Available":"True","Degraded":"False"},"upstreamsDown":1,"upstreamsFlaky":1,"forwardErrorRatio":"AtLeast10Percent"},` +
+		`{"name":"other","servers":0,"serverUpstreams":0,"clusterPeers":0,"serviceAliases":0,"additionalClusterDomains":0,"hostAliases":0,` +
+		`"desiredPods":0,"availablePods":0,"upstreamsDown":0,"upstreamsFlaky":0}]}`
+	actual, err := computeInsightsReport(dnses, daemonsets)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if actual != expected {
+		t.Errorf("expected:\n%s\ngot:\n%s", expected, actual)
+	}
+	// The report must not identify the cluster's network.
+	for _, s := range []string{"1.1.1.1", "foo.com", "172.30.0.10", "cluster.local", "secret"} {
+		if strings.Contains(actual, s) {
+			t.Errorf("expected report not to contain %q, got %s", s, actual)
+		}
+	}
+}
+
+func TestInsightsForwardErrorRatio(t *testing.T) {
+	testCases := []struct {
+		requests, errors int64
+		expected         insightsErrorRatio
+	}{
+		{requests: 0, errors: 0, expected: ""},
+		{requests: 1000, errors: 0, expected: insightsErrorRatioNone},
+		{requests: 1000, errors: 9, expected: insightsErrorRatioBelow1Percent},
+		{requests: 1000, errors: 10, expected: insightsErrorRatioBelow10Percent},
+		{requests: 1000, errors: 99, expected: insightsErrorRatioBelow10Percent},
+		{requests: 1000, errors: 100, expected: insightsErrorRatioHigh},
+	}
+	for _, tc := range testCases {
+		if actual := insightsForwardErrorRatio(tc.requests, tc.errors); actual != tc.expected {
+			t.Errorf("%d errors in %d requests: expected %q, got %q", tc.errors, tc.requests, tc.expected, actual)
+		}
+	}
+
+	// The report does not change as more requests are forwarded at the
+	// same error ratio.
+	dns := operatorv1.DNS{
+		ObjectMeta: metav1.ObjectMeta{Name: "default"},
+		Status: operatorv1.DNSStatus{
+			Forwarders: &operatorv1.DNSForwarderStats{
+				Upstreams: []operatorv1.DNSUpstreamStatus{{Health: operatorv1.DNSUpstreamHealthOK, Requests: 1000, Errors: 1}},
+			},
+		},
+	}
+	before, err := computeInsightsReport([]operatorv1.DNS{dns}, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	dns.Status.Forwarders.Upstreams[0].Requests, dns.Status.Forwarders.Upstreams[0].Errors = 5000, 7
+	after, err := computeInsightsReport([]operatorv1.DNS{dns}, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if before != after {
+		t.Errorf("expected the report not to change, got:\n%s\nthen:\n%s", before, after)
+	}
+}
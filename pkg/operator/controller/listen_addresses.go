@@ -0,0 +1,112 @@
+package controller
+
+import (
+	"context"
+	"fmt"
+	"net"
+
+	operatorv1 "github.com/openshift/api/operator/v1"
+
+	configv1 "github.com/openshift/api/config/v1"
+
+	"github.com/sirupsen/logrus"
+
+	corev1 "k8s.io/api/core/v1"
+
+	"k8s.io/apimachinery/pkg/types"
+)
+
+// podIPEnvVar is the environment variable of the dns container that holds the
+// primary IP address of the dns pod.
+const podIPEnvVar = "POD_IP"
+
+// clusterIPFamilies are the IP families of the networks in the cluster network
+// config, in the order in which they are configured.  The first family of each
+// is the primary family.
+type clusterIPFamilies struct {
+	pod     []corev1.IPFamily
+	service []corev1.IPFamily
+}
+
+// ipFamilyOfCIDR returns the IP family of the given CIDR.
+func ipFamilyOfCIDR(cidr string) (corev1.IPFamily, error) {
+	ip, _, err := net.ParseCIDR(cidr)
+	if err != nil {
+		return "", fmt.Errorf("invalid cidr %s: %v", cidr, err)
+	}
+	if ip.To4() != nil {
+		return corev1.IPv4Protocol, nil
+	}
+	return corev1.IPv6Protocol, nil
+}
+
+// getClusterIPFamilies returns the IP families of the pod and service networks
+// in the cluster network config.
+func (r *reconciler) getClusterIPFamilies() (clusterIPFamilies, error) {
+	var families clusterIPFamilies
+	networkConfig := &configv1.Network{}
+	if err := r.client.Get(context.TODO(), types.NamespacedName{Name: "cluster"}, networkConfig); err != nil {
+		return families, fmt.Errorf("failed to get network 'cluster': %v", err)
+	}
+	for _, entry := range networkConfig.Status.ClusterNetwork {
+		family, err := ipFamilyOfCIDR(entry.CIDR)
+		if err != nil {
+			return families, fmt.Errorf("invalid cluster network: %v", err)
+		}
+		families.pod = append(families.pod, family)
+	}
+	for _, cidr := range networkConfig.Status.ServiceNetwork {
+		family, err := ipFamilyOfCIDR(cidr)
+		if err != nil {
+			return families, fmt.Errorf("invalid service network: %v", err)
+		}
+		families.service = append(families.service, family)
+	}
+	return families, nil
+}
+
+// getListenAddresses returns the addresses that the usual listener of the
+// given dns binds.  The cluster network config is only looked up if the dns
+// binds specific addresses.
+func (r *reconciler) getListenAddresses(dns *operatorv1.DNS) ([]string, error) {
+	if dns.Spec.ListenAddresses != operatorv1.DNSListenAddressesPodIP {
+		return nil, nil
+	}
+	families, err := r.getClusterIPFamilies()
+	if err != nil {
+		return nil, err
+	}
+	return corefileListenAddresses(dns, families), nil
+}
+
+// corefileListenAddresses returns the addresses that the usual listener of the
+// given dns binds in a cluster with the given IP families, or nil if it binds
+// all addresses.
+//
+// The dns service has the cluster IP of the primary service network, so its
+// endpoints are the pod IPs of that family, and the dns pods only need to
+// listen on those.  The downward API only exposes the primary IP of a pod,
+// which is in the family of the primary pod network, so the pod IP is only
+// bound if the primary networks are of the same family.  CoreDNS substitutes
+// the environment variable with the pod IP when it loads the Corefile.
+func corefileListenAddresses(dns *operatorv1.DNS, families clusterIPFamilies) []string {
+	if dns.Spec.ListenAddresses != operatorv1.DNSListenAddressesPodIP {
+		return nil
+	}
+	if len(families.pod) == 0 || len(families.service) == 0 {
+		logrus.Warningf("ignoring listen addresses %s of dns %s: the cluster network config has no pod or service networks", dns.Spec.ListenAddresses, dns.Name)
+		return nil
+	}
+	if families.pod[0] != families.service[0] {
+		logrus.Warningf("ignoring listen addresses %s of dns %s: the primary pod network is %s but the primary service network is %s", dns.Spec.ListenAddresses, dns.Name, families.pod[0], families.service[0])
+		return nil
+	}
+	return []string{"{$" + podIPEnvVar + "}"}
+}
+
+// dnsPodIPEnv returns the environment variable with the primary IP address of
+// the dns pod, which the Corefile refers to for the addresses that CoreDNS
+// listens on.
+func dnsPodIPEnv() corev1.EnvVar {
+	return fieldRefEnv(podIPEnvVar, "status.podIP")
+}
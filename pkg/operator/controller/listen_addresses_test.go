@@ -0,0 +1,141 @@
+package controller
+
+import (
+	"reflect"
+	"testing"
+
+	operatorv1 "github.com/openshift/api/operator/v1"
+
+	corev1 "k8s.io/api/core/v1"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestIPFamilyOfCIDR(t *testing.T) {
+	testCases := []struct {
+		cidr        string
+		expected    corev1.IPFamily
+		expectError bool
+	}{
+		{cidr: "10.128.0.0/14", expected: corev1.IPv4Protocol},
+		{cidr: "fd01::/48", expected: corev1.IPv6Protocol},
+		{cidr: "::ffff:10.0.0.0/104", expected: corev1.IPv4Protocol},
+		{cidr: "10.128.0.0", expectError: true},
+	}
+	for _, tc := range testCases {
+		actual, err := ipFamilyOfCIDR(tc.cidr)
+		switch {
+		case tc.expectError && err == nil:
+			t.Errorf("%s: expected an error", tc.cidr)
+		case !tc.expectError && err != nil:
+			t.Errorf("%s: unexpected error: %v", tc.cidr, err)
+		case actual != tc.expected:
+			t.Errorf("%s: expected %q, got %q", tc.cidr, tc.expected, actual)
+		}
+	}
+}
+
+func TestCorefileListenAddresses(t *testing.T) {
+	var (
+		ipv4      = []corev1.IPFamily{corev1.IPv4Protocol}
+		ipv6      = []corev1.IPFamily{corev1.IPv6Protocol}
+		dualStack = []corev1.IPFamily{corev1.IPv4Protocol, corev1.IPv6Protocol}
+		podIP     = []string{"{$POD_IP}"}
+	)
+	testCases := []struct {
+		name     string
+		policy   operatorv1.DNSListenAddressesPolicy
+		families clusterIPFamilies
+		expected []string
+	}{
+		{
+			name:     "unset",
+			families: clusterIPFamilies{pod: ipv4, service: ipv4},
+		},
+		{
+			name:     "wildcard",
+			policy:   operatorv1.DNSListenAddressesWildcard,
+			families: clusterIPFamilies{pod: ipv4, service: ipv4},
+		},
+		{
+			name:     "IPv4 single-stack",
+			policy:   operatorv1.DNSListenAddressesPodIP,
+			families: clusterIPFamilies{pod: ipv4, service: ipv4},
+			expected: podIP,
+		},
+		{
+			name:     "IPv6 single-stack",
+			policy:   operatorv1.DNSListenAddressesPodIP,
+			families: clusterIPFamilies{pod: ipv6, service: ipv6},
+			expected: podIP,
+		},
+		{
+			name:     "dual-stack",
+			policy:   operatorv1.DNSListenAddressesPodIP,
+			families: clusterIPFamilies{pod: dualStack, service: dualStack},
+			expected: podIP,
+		},
+		{
+			name:     "dual-stack pods with IPv4 services",
+			policy:   operatorv1.DNSListenAddressesPodIP,
+			families: clusterIPFamilies{pod: dualStack, service: ipv4},
+			expected: podIP,
+		},
+		{
+			name:     "primary families differ",
+			policy:   operatorv1.DNSListenAddressesPodIP,
+			families: clusterIPFamilies{pod: dualStack, service: ipv6},
+		},
+		{
+			name:     "no networks",
+			policy:   operatorv1.DNSListenAddressesPodIP,
+			families: clusterIPFamilies{},
+		},
+	}
+	for _, tc := range testCases {
+		dns := &operatorv1.DNS{
+			ObjectMeta: metav1.ObjectMeta{Name: DefaultDNSController},
+			Spec:       operatorv1.DNSSpec{ListenAddresses: tc.policy},
+		}
+		actual := corefileListenAddresses(dns, tc.families)
+		if !reflect.DeepEqual(actual, tc.expected) {
+			t.Errorf("%s: expected %v, got %v", tc.name, tc.expected, actual)
+		}
+	}
+}
+
+func TestDesiredDNSDaemonsetPodIP(t *testing.T) {
+	for _, policy := range []operatorv1.DNSListenAddressesPolicy{"", operatorv1.DNSListenAddressesWildcard, operatorv1.DNSListenAddressesPodIP} {
+		dns := &operatorv1.DNS{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: DefaultDNSController,
+			},
+			Spec: operatorv1.DNSSpec{
+				ListenAddresses: policy,
+			},
+		}
+		ds, err := desiredDNSDaemonSet(dns, "172.30.77.10", "cluster.local", "coredns", "cli", "kube-rbac-proxy", false, nil)
+		if err != nil {
+			t.Fatalf("%q: invalid dns daemonset: %v", policy, err)
+		}
+		var actual *corev1.EnvVar
+		for _, c := range ds.Spec.Template.Spec.Containers {
+			for i, e := range c.Env {
+				if e.Name == podIPEnvVar {
+					if c.Name != "dns" {
+						t.Errorf("%q: unexpected %s in container %q", policy, podIPEnvVar, c.Name)
+					}
+					actual = &c.Env[i]
+				}
+			}
+		}
+		var expected *corev1.EnvVar
+		if policy == operatorv1.DNSListenAddressesPodIP {
+			env := dnsPodIPEnv()
+			expected = &env
+		}
+		if !reflect.DeepEqual(actual, expected) {
+			t.Errorf("%q: expected %+v, got %+v", policy, expected, actual)
+		}
+	}
+}
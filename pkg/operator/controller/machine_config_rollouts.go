@@ -0,0 +1,130 @@
+package controller
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/openshift/cluster-dns-operator/pkg/manifests"
+
+	"github.com/sirupsen/logrus"
+
+	appsv1 "k8s.io/api/apps/v1"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+const (
+	// machineConfigRolloutDeferralPercent is the percentage of nodes that
+	// may be in updating machine config pools before dns daemonset
+	// rollouts are deferred.
+	machineConfigRolloutDeferralPercent = 25
+)
+
+// machineConfigPoolGVK is the group, version, and kind of machine config
+// pools.  The machine config API is not part of the operator's scheme, so
+// pools are handled as unstructured objects.
+var machineConfigPoolGVK = schema.GroupVersionKind{Group: "machineconfiguration.openshift.io", Version: "v1", Kind: "MachineConfigPool"}
+
+// watchMachineConfigPools requeues the default dns on any change to a machine
+// config pool so that a deferred rollout resumes once the pools finish
+// updating.  Clusters without the machine config API are not watched.
+func watchMachineConfigPools(mgr manager.Manager, c controller.Controller, informers *sharedInformers) error {
+	if _, err := mgr.GetRESTMapper().RESTMapping(machineConfigPoolGVK.GroupKind(), machineConfigPoolGVK.Version); err != nil {
+		if meta.IsNoMatchError(err) {
+			logrus.Infof("not watching machine config pools: %v", err)
+			return nil
+		}
+		return fmt.Errorf("failed to look up machine config pools: %v", err)
+	}
+	pool := &unstructured.Unstructured{}
+	pool.SetGroupVersionKind(machineConfigPoolGVK)
+	return informers.watch(c, manifests.DNSNamespace().Name, pool, &handler.EnqueueRequestsFromMapFunc{
+		ToRequests: handler.ToRequestsFunc(func(_ handler.MapObject) []reconcile.Request {
+			return []reconcile.Request{{NamespacedName: types.NamespacedName{Name: DefaultDNSController}}}
+		}),
+	})
+}
+
+// machineConfigRolloutDeferral returns a message that explains why a rollout
+// of the dns daemonset should be deferred because machine config pools are
+// updating a large fraction of the nodes, or an empty string if it need not
+// be.  Clusters without the machine config API have no pools.  The pools are
+// read from the cache that the watch of the pools fills, rather than listed
+// from the API on every reconcile.
+func (r *reconciler) machineConfigRolloutDeferral() (string, error) {
+	pools := &unstructured.UnstructuredList{}
+	pools.SetGroupVersionKind(machineConfigPoolGVK.GroupVersion().WithKind(machineConfigPoolGVK.Kind + "List"))
+	if err := r.informers.list(manifests.DNSNamespace().Name, pools); err != nil {
+		if meta.IsNoMatchError(err) {
+			return "", nil
+		}
+		return "", fmt.Errorf("failed to list machine config pools: %v", err)
+	}
+	return machineConfigPoolsRolloutDeferral(pools.Items), nil
+}
+
+// machineConfigPoolsRolloutDeferral returns a message that explains why a
+// rollout of the dns daemonset should be deferred if the given machine config
+// pools that are updating have at least machineConfigRolloutDeferralPercent of
+// the nodes of all pools, or an empty string otherwise.  Rolling out new dns
+// pods while the machine config operator drains and reboots that many nodes
+// would compound the disruption.
+func machineConfigPoolsRolloutDeferral(pools []unstructured.Unstructured) string {
+	var total, updating int64
+	names := []string{}
+	for _, pool := range pools {
+		machines, _, _ := unstructured.NestedInt64(pool.Object, "status", "machineCount")
+		total += machines
+		if machineConfigPoolUpdating(pool) {
+			updating += machines
+			names = append(names, pool.GetName())
+		}
+	}
+	if total == 0 || updating*100 < total*machineConfigRolloutDeferralPercent {
+		return ""
+	}
+	sort.Strings(names)
+	return fmt.Sprintf("Deferring DaemonSet rollout: MachineConfigPools %s are updating %d of %d nodes", strings.Join(names, ", "), updating, total)
+}
+
+// machineConfigPoolUpdating returns a Boolean indicating whether the given
+// machine config pool reports that it is updating its nodes.
+func machineConfigPoolUpdating(pool unstructured.Unstructured) bool {
+	conditions, _, _ := unstructured.NestedSlice(pool.Object, "status", "conditions")
+	for _, c := range conditions {
+		condition, ok := c.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		if condition["type"] == "Updating" {
+			return condition["status"] == "True"
+		}
+	}
+	return false
+}
+
+// daemonsetRolloutUrgent returns a Boolean indicating whether updating the
+// current daemonset to the updated one changes the image of a container.  An
+// image change is part of an upgrade, which must not wait for the machine
+// config pools because they are updated after the operator.
+func daemonsetRolloutUrgent(current, updated *appsv1.DaemonSet) bool {
+	images := map[string]string{}
+	for _, c := range current.Spec.Template.Spec.Containers {
+		images[c.Name] = c.Image
+	}
+	for _, c := range updated.Spec.Template.Spec.Containers {
+		if image, ok := images[c.Name]; ok && image != c.Image {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,89 @@
+package controller
+
+import (
+	"testing"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func machineConfigPool(name string, machines int64, updating string) unstructured.Unstructured {
+	pool := unstructured.Unstructured{Object: map[string]interface{}{
+		"status": map[string]interface{}{
+			"machineCount": machines,
+			"conditions": []interface{}{
+				map[string]interface{}{"type": "Updated", "status": "False"},
+				map[string]interface{}{"type": "Updating", "status": updating},
+			},
+		},
+	}}
+	pool.SetGroupVersionKind(machineConfigPoolGVK)
+	pool.SetName(name)
+	return pool
+}
+
+func TestMachineConfigPoolsRolloutDeferral(t *testing.T) {
+	testCases := []struct {
+		description string
+		pools       []unstructured.Unstructured
+		expected    string
+	}{
+		{
+			description: "no pools",
+		},
+		{
+			description: "no pools updating",
+			pools: []unstructured.Unstructured{
+				machineConfigPool("master", 3, "False"),
+				machineConfigPool("worker", 9, "False"),
+			},
+		},
+		{
+			description: "updating pools have fewer than a quarter of the nodes",
+			pools: []unstructured.Unstructured{
+				machineConfigPool("master", 3, "False"),
+				machineConfigPool("infra", 2, "True"),
+				machineConfigPool("worker", 9, "False"),
+			},
+		},
+		{
+			description: "updating pools have a quarter of the nodes",
+			pools: []unstructured.Unstructured{
+				machineConfigPool("master", 3, "True"),
+				machineConfigPool("worker", 9, "False"),
+			},
+			expected: "Deferring DaemonSet rollout: MachineConfigPools master are updating 3 of 12 nodes",
+		},
+		{
+			description: "several updating pools",
+			pools: []unstructured.Unstructured{
+				machineConfigPool("worker", 9, "True"),
+				machineConfigPool("master", 3, "True"),
+			},
+			expected: "Deferring DaemonSet rollout: MachineConfigPools master, worker are updating 12 of 12 nodes",
+		},
+	}
+	for _, tc := range testCases {
+		if actual := machineConfigPoolsRolloutDeferral(tc.pools); actual != tc.expected {
+			t.Errorf("%q: expected %q, got %q", tc.description, tc.expected, actual)
+		}
+	}
+}
+
+func TestDaemonSetRolloutUrgent(t *testing.T) {
+	daemonset := func(image string, args ...string) *appsv1.DaemonSet {
+		ds := &appsv1.DaemonSet{}
+		ds.Spec.Template.Spec.Containers = []corev1.Container{
+			{Name: "dns", Image: image, Args: args},
+			{Name: "kube-rbac-proxy", Image: "proxy"},
+		}
+		return ds
+	}
+	if daemonsetRolloutUrgent(daemonset("coredns:1"), daemonset("coredns:1", "-dns.port=5353")) {
+		t.Errorf("expected a change of arguments not to be urgent")
+	}
+	if !daemonsetRolloutUrgent(daemonset("coredns:1"), daemonset("coredns:2")) {
+		t.Errorf("expected a change of image to be urgent")
+	}
+}
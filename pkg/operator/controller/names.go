@@ -1,6 +1,8 @@
 package controller
 
 import (
+	"fmt"
+
 	operatorv1 "github.com/openshift/api/operator/v1"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -12,6 +14,11 @@ const (
 	// daemonset, and the value is the name of the owning dns.
 	controllerDaemonSetLabel = "dns.operator.openshift.io/daemonset-dns"
 
+	// BootstrapDNSPodLabel identifies a pod as the bootstrap dns static
+	// pod, which carries the label of the dns pods so that the dns service
+	// selects it until the dns daemonset is available.
+	BootstrapDNSPodLabel = "dns.operator.openshift.io/bootstrap"
+
 	// MetricsServingCertAnnotation is the annotation needed to generate
 	// the certificates for secure DNS metrics.
 	MetricsServingCertAnnotation = "service.beta.openshift.io/serving-cert-secret-name"
@@ -25,6 +32,15 @@ func DNSDaemonSetName(dns *operatorv1.DNS) types.NamespacedName {
 	}
 }
 
+// DNSNodeOverrideDaemonSetName returns the namespaced name for the daemonset of
+// the named node override of the dns.
+func DNSNodeOverrideDaemonSetName(dns *operatorv1.DNS, override string) types.NamespacedName {
+	return types.NamespacedName{
+		Namespace: "openshift-dns",
+		Name:      "dns-" + dns.Name + "-" + override,
+	}
+}
+
 func DNSDaemonSetLabel(dns *operatorv1.DNS) string {
 	return dns.Name
 }
@@ -37,6 +53,15 @@ func DNSDaemonSetPodSelector(dns *operatorv1.DNS) *metav1.LabelSelector {
 	}
 }
 
+// DNSPodDisruptionBudgetName returns the namespaced name for the pod
+// disruption budget of the dns pods.
+func DNSPodDisruptionBudgetName(dns *operatorv1.DNS) types.NamespacedName {
+	return types.NamespacedName{
+		Namespace: "openshift-dns",
+		Name:      "dns-" + dns.Name,
+	}
+}
+
 func DNSServiceName(dns *operatorv1.DNS) types.NamespacedName {
 	return types.NamespacedName{
 		Namespace: "openshift-dns",
@@ -44,6 +69,16 @@ func DNSServiceName(dns *operatorv1.DNS) types.NamespacedName {
 	}
 }
 
+// KubeDNSServiceName returns the namespaced name for the kube-dns alias
+// service.  Only the default dns is reconciled, so the name does not depend on
+// the dns.
+func KubeDNSServiceName(dns *operatorv1.DNS) types.NamespacedName {
+	return types.NamespacedName{
+		Namespace: "openshift-dns",
+		Name:      "kube-dns",
+	}
+}
+
 func DNSConfigMapName(dns *operatorv1.DNS) types.NamespacedName {
 	return types.NamespacedName{
 		Namespace: "openshift-dns",
@@ -51,6 +86,60 @@ func DNSConfigMapName(dns *operatorv1.DNS) types.NamespacedName {
 	}
 }
 
+// DNSCorefilePartConfigMapName returns the namespaced name for the configmap
+// that holds the part at the given index of the split Corefiles of the dns.
+func DNSCorefilePartConfigMapName(dns *operatorv1.DNS, i int) types.NamespacedName {
+	return types.NamespacedName{
+		Namespace: "openshift-dns",
+		Name:      fmt.Sprintf("dns-%s-part-%d", dns.Name, i+1),
+	}
+}
+
+func DNSTrustedCAConfigMapName(dns *operatorv1.DNS) types.NamespacedName {
+	return types.NamespacedName{
+		Namespace: "openshift-dns",
+		Name:      "dns-" + dns.Name + "-trusted-ca",
+	}
+}
+
+// DNSProfileConfigMapName returns the namespaced name for the configmap in
+// which the operator stores the CoreDNS profiles that it collects for the dns.
+func DNSProfileConfigMapName(dns *operatorv1.DNS) types.NamespacedName {
+	return types.NamespacedName{
+		Namespace: "openshift-dns",
+		Name:      "dns-" + dns.Name + "-profile",
+	}
+}
+
+// DNSPprofConfigMapName returns the namespaced name for the configmap with the
+// Corefile snippet that enables the pprof plugin of CoreDNS on the node that
+// is being profiled.
+func DNSPprofConfigMapName(dns *operatorv1.DNS) types.NamespacedName {
+	return types.NamespacedName{
+		Namespace: "openshift-dns",
+		Name:      "dns-" + dns.Name + "-pprof",
+	}
+}
+
+// DNSServiceMeshConfigMapName returns the namespaced name for the configmap in
+// which the address and domains of the dns are published for a service mesh.
+func DNSServiceMeshConfigMapName(dns *operatorv1.DNS) types.NamespacedName {
+	return types.NamespacedName{
+		Namespace: "openshift-dns",
+		Name:      "dns-" + dns.Name + "-service-mesh",
+	}
+}
+
+// DNSClientSettingsConfigMapName returns the namespaced name for the configmap
+// in which the resolver options that are recommended for clients of the dns are
+// published.
+func DNSClientSettingsConfigMapName(dns *operatorv1.DNS) types.NamespacedName {
+	return types.NamespacedName{
+		Namespace: "openshift-dns",
+		Name:      "dns-" + dns.Name + "-client-settings",
+	}
+}
+
 func DNSServiceMonitorName(dns *operatorv1.DNS) types.NamespacedName {
 	return types.NamespacedName{
 		Namespace: "openshift-dns",
@@ -61,3 +150,12 @@ func DNSServiceMonitorName(dns *operatorv1.DNS) types.NamespacedName {
 func DNSMetricsSecretName(dns *operatorv1.DNS) string {
 	return "dns-" + dns.Name + "-metrics-tls"
 }
+
+// BootstrapDNSPodName returns the namespaced name for the bootstrap dns static
+// pod.
+func BootstrapDNSPodName() types.NamespacedName {
+	return types.NamespacedName{
+		Namespace: "openshift-dns",
+		Name:      "bootstrap-dns",
+	}
+}
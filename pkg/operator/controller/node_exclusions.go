@@ -0,0 +1,161 @@
+package controller
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	operatorv1 "github.com/openshift/api/operator/v1"
+
+	"github.com/openshift/cluster-dns-operator/pkg/util/conditions"
+
+	"github.com/sirupsen/logrus"
+
+	corev1 "k8s.io/api/core/v1"
+
+	"k8s.io/apimachinery/pkg/util/validation"
+)
+
+const (
+	// DNSInsufficientNodeCoverageConditionType is the type of the dns
+	// status condition that reports that the node exclusions of the dns
+	// leave too few nodes to run dns pods.
+	DNSInsufficientNodeCoverageConditionType = "InsufficientNodeCoverage"
+
+	// minDNSCoveredNodes is the number of ready nodes that should run dns
+	// pods, so that losing a node does not lose cluster dns.  Clusters
+	// with fewer ready nodes should run dns pods on all of them.
+	minDNSCoveredNodes = 2
+)
+
+// dnsNodeExclusions returns the node exclusions of the given dns.  An
+// exclusion whose node selector does not have exactly one valid label, or has
+// the label of an exclusion listed earlier, is ignored.
+func dnsNodeExclusions(dns *operatorv1.DNS) []operatorv1.DNSNodeExclusion {
+	exclusions := []operatorv1.DNSNodeExclusion{}
+	labels := map[string]struct{}{}
+	for _, exclusion := range dns.Spec.NodeExclusions {
+		if len(exclusion.NodeSelector) != 1 {
+			logrus.Warningf("ignoring node exclusion of dns %s: node selector must have exactly one label", dns.Name)
+			continue
+		}
+		key, value := nodeExclusionNodeLabel(exclusion)
+		if msgs := append(validation.IsQualifiedName(key), validation.IsValidLabelValue(value)...); len(msgs) != 0 {
+			logrus.Warningf("ignoring node exclusion %s=%s of dns %s: invalid node selector: %s", key, value, dns.Name, strings.Join(msgs, ", "))
+			continue
+		}
+		if _, ok := labels[key+"="+value]; ok {
+			logrus.Warningf("ignoring node exclusion %s=%s of dns %s: node selector is already used", key, value, dns.Name)
+			continue
+		}
+		labels[key+"="+value] = struct{}{}
+		exclusions = append(exclusions, exclusion)
+	}
+	return exclusions
+}
+
+// nodeExclusionNodeLabel returns the key and value of the node label that
+// selects the nodes of the given node exclusion.
+func nodeExclusionNodeLabel(exclusion operatorv1.DNSNodeExclusion) (string, string) {
+	for key, value := range exclusion.NodeSelector {
+		return key, value
+	}
+	return "", ""
+}
+
+// dnsNodeCoverage is the number of ready nodes that can run dns pods and the
+// number of them that do, given the node exclusions of the dns.
+type dnsNodeCoverage struct {
+	// exclusions is the number of node exclusions of the dns.
+	exclusions int
+	// readyNodes is the number of ready, schedulable linux nodes.
+	readyNodes int
+	// coveredNodes is the number of ready, schedulable linux nodes that are
+	// not excluded.
+	coveredNodes int
+}
+
+// requiredNodes returns the number of ready nodes that should run dns pods.
+func (c *dnsNodeCoverage) requiredNodes() int {
+	if c.readyNodes < minDNSCoveredNodes {
+		return c.readyNodes
+	}
+	return minDNSCoveredNodes
+}
+
+// insufficient returns a Boolean indicating whether the node exclusions leave
+// too few ready nodes to run dns pods.
+func (c *dnsNodeCoverage) insufficient() bool {
+	return c.exclusions != 0 && c.coveredNodes < c.requiredNodes()
+}
+
+// computeDNSNodeCoverage counts the given nodes that can run dns pods and those
+// that are not excluded by the given node exclusions.  The dns daemonset runs
+// on linux nodes and by default tolerates all taints, so only the os label and
+// health of a node matter; a node placement of the dns is not accounted for.
+func computeDNSNodeCoverage(nodes []corev1.Node, exclusions []operatorv1.DNSNodeExclusion) *dnsNodeCoverage {
+	coverage := &dnsNodeCoverage{exclusions: len(exclusions)}
+	for i := range nodes {
+		node := &nodes[i]
+		if node.Labels["kubernetes.io/os"] != "linux" || nodeUnhealthy(node) {
+			continue
+		}
+		coverage.readyNodes++
+		excluded := false
+		for _, exclusion := range exclusions {
+			key, value := nodeExclusionNodeLabel(exclusion)
+			if v, ok := node.Labels[key]; ok && v == value {
+				excluded = true
+				break
+			}
+		}
+		if !excluded {
+			coverage.coveredNodes++
+		}
+	}
+	return coverage
+}
+
+// sampleDNSNodeCoverage returns the node coverage of the given dns.  The nodes
+// are only listed if the dns has node exclusions.
+func (r *reconciler) sampleDNSNodeCoverage(dns *operatorv1.DNS) (*dnsNodeCoverage, error) {
+	exclusions := dnsNodeExclusions(dns)
+	if len(exclusions) == 0 {
+		return &dnsNodeCoverage{}, nil
+	}
+	nodes := &corev1.NodeList{}
+	if err := r.client.List(context.TODO(), nodes); err != nil {
+		return nil, fmt.Errorf("failed to list nodes: %v", err)
+	}
+	return computeDNSNodeCoverage(nodes.Items, exclusions), nil
+}
+
+// computeDNSInsufficientNodeCoverageCondition computes the dns
+// InsufficientNodeCoverage status condition from the given coverage.  If
+// coverage is nil, the old condition is kept.
+func computeDNSInsufficientNodeCoverageCondition(oldConditions []operatorv1.OperatorCondition, coverage *dnsNodeCoverage) *operatorv1.OperatorCondition {
+	oldCondition := conditions.FindOperatorCondition(oldConditions, DNSInsufficientNodeCoverageConditionType)
+	if coverage == nil {
+		return oldCondition
+	}
+
+	condition := &operatorv1.OperatorCondition{
+		Type: DNSInsufficientNodeCoverageConditionType,
+	}
+	switch {
+	case coverage.exclusions == 0:
+		condition.Status = operatorv1.ConditionFalse
+		condition.Reason = "NoExclusions"
+		condition.Message = "CoreDNS runs on all nodes"
+	case coverage.insufficient():
+		condition.Status = operatorv1.ConditionTrue
+		condition.Reason = "TooManyNodesExcluded"
+		condition.Message = fmt.Sprintf("The node exclusions leave %d of %d ready nodes to run CoreDNS, fewer than the %d that should run it", coverage.coveredNodes, coverage.readyNodes, coverage.requiredNodes())
+	default:
+		condition.Status = operatorv1.ConditionFalse
+		condition.Reason = "AsExpected"
+		condition.Message = "The node exclusions leave enough ready nodes to run CoreDNS"
+	}
+	c := conditions.SetOperatorConditionTransitionTime(condition, oldCondition)
+	return &c
+}
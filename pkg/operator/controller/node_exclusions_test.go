@@ -0,0 +1,167 @@
+package controller
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	operatorv1 "github.com/openshift/api/operator/v1"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestDNSNodeExclusions(t *testing.T) {
+	dns := &operatorv1.DNS{
+		ObjectMeta: metav1.ObjectMeta{Name: DefaultDNSController},
+		Spec: operatorv1.DNSSpec{
+			NodeExclusions: []operatorv1.DNSNodeExclusion{
+				{NodeSelector: map[string]string{"pool": "gpu"}},
+				{NodeSelector: map[string]string{}},
+				{NodeSelector: map[string]string{"a": "1", "b": "2"}},
+				{NodeSelector: map[string]string{"bad key!": "x"}},
+				{NodeSelector: map[string]string{"pool": "gpu"}},
+				{NodeSelector: map[string]string{"node-role.kubernetes.io/storage": ""}},
+			},
+		},
+	}
+	expected := []operatorv1.DNSNodeExclusion{
+		{NodeSelector: map[string]string{"pool": "gpu"}},
+		{NodeSelector: map[string]string{"node-role.kubernetes.io/storage": ""}},
+	}
+	if actual := dnsNodeExclusions(dns); !cmp.Equal(actual, expected) {
+		t.Errorf("expected %v, got %v", expected, actual)
+	}
+}
+
+// coverageNode returns a linux node with the given name, labels, and
+// readiness.
+func coverageNode(name string, labels map[string]string, ready bool) corev1.Node {
+	node := corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: name, Labels: map[string]string{"kubernetes.io/os": "linux"}}}
+	for key, value := range labels {
+		node.Labels[key] = value
+	}
+	status := corev1.ConditionFalse
+	if ready {
+		status = corev1.ConditionTrue
+	}
+	node.Status.Conditions = []corev1.NodeCondition{{Type: corev1.NodeReady, Status: status}}
+	return node
+}
+
+func TestComputeDNSNodeCoverage(t *testing.T) {
+	gpu := []operatorv1.DNSNodeExclusion{{NodeSelector: map[string]string{"pool": "gpu"}}}
+	windows := coverageNode("windows", nil, true)
+	windows.Labels["kubernetes.io/os"] = "windows"
+	testCases := []struct {
+		description          string
+		nodes                []corev1.Node
+		exclusions           []operatorv1.DNSNodeExclusion
+		expectedCovered      int
+		expectedReady        int
+		expectedInsufficient bool
+	}{
+		{
+			description: "no exclusions",
+			nodes: []corev1.Node{
+				coverageNode("a", nil, true),
+			},
+			expectedCovered: 1,
+			expectedReady:   1,
+		},
+		{
+			description: "enough nodes remain",
+			nodes: []corev1.Node{
+				coverageNode("a", nil, true),
+				coverageNode("b", nil, true),
+				coverageNode("gpu", map[string]string{"pool": "gpu"}, true),
+				windows,
+			},
+			exclusions:      gpu,
+			expectedCovered: 2,
+			expectedReady:   3,
+		},
+		{
+			description: "too few ready nodes remain",
+			nodes: []corev1.Node{
+				coverageNode("a", nil, true),
+				coverageNode("b", nil, false),
+				coverageNode("gpu-1", map[string]string{"pool": "gpu"}, true),
+				coverageNode("gpu-2", map[string]string{"pool": "gpu"}, true),
+			},
+			exclusions:           gpu,
+			expectedCovered:      1,
+			expectedReady:        3,
+			expectedInsufficient: true,
+		},
+		{
+			description: "single-node cluster that is not excluded",
+			nodes: []corev1.Node{
+				coverageNode("a", nil, true),
+			},
+			exclusions:      gpu,
+			expectedCovered: 1,
+			expectedReady:   1,
+		},
+		{
+			description: "all nodes excluded",
+			nodes: []corev1.Node{
+				coverageNode("gpu", map[string]string{"pool": "gpu"}, true),
+			},
+			exclusions:           gpu,
+			expectedCovered:      0,
+			expectedReady:        1,
+			expectedInsufficient: true,
+		},
+	}
+	for _, tc := range testCases {
+		coverage := computeDNSNodeCoverage(tc.nodes, tc.exclusions)
+		if coverage.coveredNodes != tc.expectedCovered || coverage.readyNodes != tc.expectedReady {
+			t.Errorf("%q: expected %d of %d nodes covered, got %d of %d", tc.description, tc.expectedCovered, tc.expectedReady, coverage.coveredNodes, coverage.readyNodes)
+		}
+		if actual := coverage.insufficient(); actual != tc.expectedInsufficient {
+			t.Errorf("%q: expected insufficient %t, got %t", tc.description, tc.expectedInsufficient, actual)
+		}
+		condition := computeDNSInsufficientNodeCoverageCondition(nil, coverage)
+		if expected := tc.expectedInsufficient; (condition.Status == operatorv1.ConditionTrue) != expected {
+			t.Errorf("%q: expected condition status True to be %t, got %+v", tc.description, expected, condition)
+		}
+	}
+}
+
+func TestDesiredDNSDaemonsetNodeExclusions(t *testing.T) {
+	dns := &operatorv1.DNS{
+		ObjectMeta: metav1.ObjectMeta{Name: DefaultDNSController},
+		Spec: operatorv1.DNSSpec{
+			NodeExclusions: []operatorv1.DNSNodeExclusion{
+				{NodeSelector: map[string]string{"pool": "gpu"}},
+			},
+			NodeOverrides: []operatorv1.DNSNodeOverride{
+				{Name: "edge", NodeSelector: map[string]string{"pool": "edge"}},
+			},
+		},
+	}
+	base, err := desiredDNSDaemonSet(dns, "172.30.0.10", "cluster.local", "coredns", "cli", "proxy", false, nil)
+	if err != nil {
+		t.Fatalf("invalid dns daemonset: %v", err)
+	}
+	expected := []corev1.NodeSelectorTerm{{
+		MatchExpressions: []corev1.NodeSelectorRequirement{
+			{Key: "pool", Operator: corev1.NodeSelectorOpNotIn, Values: []string{"edge", "gpu"}},
+		},
+	}}
+	if actual := base.Spec.Template.Spec.Affinity.NodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution.NodeSelectorTerms; !cmp.Equal(actual, expected) {
+		t.Errorf("expected dns daemonset terms %+v, got %+v", expected, actual)
+	}
+
+	// The daemonset of a node override stays off excluded nodes too.
+	ds := desiredNodeOverrideDaemonSet(dns, base, dnsNodeOverrides(dns), 0)
+	expected = []corev1.NodeSelectorTerm{{
+		MatchExpressions: []corev1.NodeSelectorRequirement{
+			{Key: "pool", Operator: corev1.NodeSelectorOpNotIn, Values: []string{"gpu"}},
+		},
+	}}
+	if actual := ds.Spec.Template.Spec.Affinity.NodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution.NodeSelectorTerms; !cmp.Equal(actual, expected) {
+		t.Errorf("expected node override daemonset terms %+v, got %+v", expected, actual)
+	}
+}
@@ -0,0 +1,243 @@
+package controller
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	operatorv1 "github.com/openshift/api/operator/v1"
+	"github.com/openshift/cluster-dns-operator/pkg/manifests"
+
+	"github.com/sirupsen/logrus"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	utilerrors "k8s.io/apimachinery/pkg/util/errors"
+	"k8s.io/apimachinery/pkg/util/validation"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// nodeOverrideLabel identifies a daemonset and its pods as those of a node
+// override of a dns, and the value is the name of the override.
+const nodeOverrideLabel = "dns.operator.openshift.io/node-override"
+
+// dnsNodeOverrides returns the node overrides of the given dns for which
+// daemonsets are run.  An override whose name is not a valid label, whose node
+// selector does not have exactly one valid label, or whose name or node
+// selector is that of an override listed earlier, is ignored.
+func dnsNodeOverrides(dns *operatorv1.DNS) []operatorv1.DNSNodeOverride {
+	overrides := []operatorv1.DNSNodeOverride{}
+	names := map[string]struct{}{}
+	labels := map[string]struct{}{}
+	for _, override := range dns.Spec.NodeOverrides {
+		if msgs := validation.IsDNS1123Label(override.Name); len(msgs) != 0 {
+			logrus.Warningf("ignoring node override %q of dns %s: invalid name: %s", override.Name, dns.Name, strings.Join(msgs, ", "))
+			continue
+		}
+		if _, ok := names[override.Name]; ok {
+			logrus.Warningf("ignoring node override %s of dns %s: name is already used", override.Name, dns.Name)
+			continue
+		}
+		if len(override.NodeSelector) != 1 {
+			logrus.Warningf("ignoring node override %s of dns %s: node selector must have exactly one label", override.Name, dns.Name)
+			continue
+		}
+		key, value := nodeOverrideNodeLabel(override)
+		if msgs := append(validation.IsQualifiedName(key), validation.IsValidLabelValue(value)...); len(msgs) != 0 {
+			logrus.Warningf("ignoring node override %s of dns %s: invalid node selector: %s", override.Name, dns.Name, strings.Join(msgs, ", "))
+			continue
+		}
+		if _, ok := labels[key+"="+value]; ok {
+			logrus.Warningf("ignoring node override %s of dns %s: node selector %s=%s is already used", override.Name, dns.Name, key, value)
+			continue
+		}
+		names[override.Name] = struct{}{}
+		labels[key+"="+value] = struct{}{}
+		overrides = append(overrides, override)
+	}
+	return overrides
+}
+
+// nodeOverrideNodeLabel returns the key and value of the node label that
+// selects the nodes of the given node override.
+func nodeOverrideNodeLabel(override operatorv1.DNSNodeOverride) (string, string) {
+	for key, value := range override.NodeSelector {
+		return key, value
+	}
+	return "", ""
+}
+
+// nodeOverrideCorefileKey returns the key of the Corefile of the given node
+// override in the dns configmap.
+func nodeOverrideCorefileKey(override operatorv1.DNSNodeOverride) string {
+	return "Corefile-" + override.Name
+}
+
+// allCorefiles returns the Corefiles in the given dns configmap, including
+// those of node overrides, concatenated in key order.
+func allCorefiles(cm *corev1.ConfigMap) string {
+	keys := []string{}
+	for key := range cm.Data {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	corefiles := []string{}
+	for _, key := range keys {
+		corefiles = append(corefiles, cm.Data[key])
+	}
+	return strings.Join(corefiles, "\n")
+}
+
+// nodeOverrideCorefileHashes returns the hashes of the Corefiles of node
+// overrides in the given dns configmap.
+func nodeOverrideCorefileHashes(cm *corev1.ConfigMap) []string {
+	hashes := []string{}
+	for key, corefile := range cm.Data {
+		if strings.HasPrefix(key, "Corefile-") {
+			hashes = append(hashes, corefileHash(corefile))
+		}
+	}
+	sort.Strings(hashes)
+	return hashes
+}
+
+// nodeOverridesAffinity returns the node affinity that keeps dns pods off the
+// nodes of the given node overrides and node exclusions, or nil if there are
+// none.  Each override and exclusion has a single label, so the nodes that have
+// none of the labels are selected by a single term.
+func nodeOverridesAffinity(overrides []operatorv1.DNSNodeOverride, exclusions []operatorv1.DNSNodeExclusion) *corev1.Affinity {
+	if len(overrides) == 0 && len(exclusions) == 0 {
+		return nil
+	}
+	keys := []string{}
+	values := map[string][]string{}
+	add := func(key, value string) {
+		if _, ok := values[key]; !ok {
+			keys = append(keys, key)
+		}
+		values[key] = append(values[key], value)
+	}
+	for _, override := range overrides {
+		add(nodeOverrideNodeLabel(override))
+	}
+	for _, exclusion := range exclusions {
+		add(nodeExclusionNodeLabel(exclusion))
+	}
+	term := corev1.NodeSelectorTerm{}
+	for _, key := range keys {
+		term.MatchExpressions = append(term.MatchExpressions, corev1.NodeSelectorRequirement{
+			Key:      key,
+			Operator: corev1.NodeSelectorOpNotIn,
+			Values:   values[key],
+		})
+	}
+	return &corev1.Affinity{
+		NodeAffinity: &corev1.NodeAffinity{
+			RequiredDuringSchedulingIgnoredDuringExecution: &corev1.NodeSelector{
+				NodeSelectorTerms: []corev1.NodeSelectorTerm{term},
+			},
+		},
+	}
+}
+
+// desiredNodeOverrideDaemonSet returns the desired daemonset for the node
+// override at the given index of the given node overrides of the given dns,
+// based on the given desired dns daemonset.  The daemonset runs on the nodes
+// that have the label of the override but not that of an earlier override or of
+// a node exclusion, and its pods load the Corefile of the override.
+func desiredNodeOverrideDaemonSet(dns *operatorv1.DNS, base *appsv1.DaemonSet, overrides []operatorv1.DNSNodeOverride, i int) *appsv1.DaemonSet {
+	override := overrides[i]
+	daemonset := base.DeepCopy()
+	name := DNSNodeOverrideDaemonSetName(dns, override.Name)
+	daemonset.Name = name.Name
+	daemonset.Namespace = name.Namespace
+	daemonset.Labels[nodeOverrideLabel] = override.Name
+
+	// The pods keep the label of the dns pods so that the dns service
+	// selects them, and the selector adds the override so that each
+	// daemonset adopts only its own pods.
+	daemonset.Spec.Selector.MatchLabels[nodeOverrideLabel] = override.Name
+	daemonset.Spec.Template.Labels = map[string]string{}
+	for key, value := range daemonset.Spec.Selector.MatchLabels {
+		daemonset.Spec.Template.Labels[key] = value
+	}
+
+	if daemonset.Spec.Template.Spec.NodeSelector == nil {
+		daemonset.Spec.Template.Spec.NodeSelector = map[string]string{}
+	}
+	key, value := nodeOverrideNodeLabel(override)
+	daemonset.Spec.Template.Spec.NodeSelector[key] = value
+	daemonset.Spec.Template.Spec.Affinity = nodeOverridesAffinity(overrides[:i], dnsNodeExclusions(dns))
+
+	for j, volume := range daemonset.Spec.Template.Spec.Volumes {
+		if volume.Name == "config-volume" {
+			daemonset.Spec.Template.Spec.Volumes[j].ConfigMap.Items = []corev1.KeyToPath{{
+				Key:  nodeOverrideCorefileKey(override),
+				Path: "Corefile",
+			}}
+		}
+	}
+	return daemonset
+}
+
+// ensureNodeOverrideDaemonSets ensures that a daemonset exists for each node
+// override of the given dns and that the daemonsets of removed overrides are
+// deleted.  An update that rolls out new pods is deferred like an
+// update of the dns daemonset while too many dns pods are unavailable.
+func (r *reconciler) ensureNodeOverrideDaemonSets(dns *operatorv1.DNS, clusterIP, clusterDomain string, haveTrustedCA bool, disabledCapabilities []string) error {
+	base, err := desiredDNSDaemonSet(dns, clusterIP, clusterDomain, r.CoreDNSImage, r.OpenshiftCLIImage, r.KubeRBACProxyImage, haveTrustedCA, disabledCapabilities)
+	if err != nil {
+		return fmt.Errorf("failed to build dns daemonset: %v", err)
+	}
+	overrides := dnsNodeOverrides(dns)
+	desiredNames := map[string]struct{}{}
+	errs := []error{}
+	for i := range overrides {
+		desired := desiredNodeOverrideDaemonSet(dns, base, overrides, i)
+		desiredNames[desired.Name] = struct{}{}
+		current := &appsv1.DaemonSet{}
+		if err := r.client.Get(context.TODO(), types.NamespacedName{Namespace: desired.Namespace, Name: desired.Name}, current); err != nil {
+			if !errors.IsNotFound(err) {
+				errs = append(errs, fmt.Errorf("failed to get daemonset %s/%s: %v", desired.Namespace, desired.Name, err))
+				continue
+			}
+			if err := r.createDNSDaemonSet(desired); err != nil {
+				errs = append(errs, err)
+			}
+			continue
+		}
+		if changed, updated := daemonsetConfigChanged(current, desired); changed && daemonsetRolloutRequired(current, updated) {
+			if deferral := r.dnsRolloutDeferral(dns, current); len(deferral) != 0 {
+				logrus.Warningf("not updating dns daemonset %s/%s: %s", current.Namespace, current.Name, deferral)
+				r.history.record(dns.Name, dnsHistoryDaemonSetRolloutDeferred, deferral)
+				continue
+			}
+		}
+		if _, err := r.updateDNSDaemonSet(current, desired); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	daemonsets := &appsv1.DaemonSetList{}
+	if err := r.client.List(context.TODO(), daemonsets, client.InNamespace(DNSDaemonSetName(dns).Namespace), client.MatchingLabels{manifests.OwningDNSLabel: DNSDaemonSetLabel(dns)}, client.HasLabels{nodeOverrideLabel}); err != nil {
+		errs = append(errs, fmt.Errorf("failed to list node override daemonsets: %v", err))
+	} else {
+		for i := range daemonsets.Items {
+			daemonset := &daemonsets.Items[i]
+			if _, ok := desiredNames[daemonset.Name]; ok {
+				continue
+			}
+			if err := r.client.Delete(context.TODO(), daemonset); err != nil && !errors.IsNotFound(err) {
+				errs = append(errs, fmt.Errorf("failed to delete daemonset %s/%s: %v", daemonset.Namespace, daemonset.Name, err))
+				continue
+			}
+			logrus.Infof("deleted dns daemonset of removed node override: %s/%s", daemonset.Namespace, daemonset.Name)
+		}
+	}
+	return utilerrors.NewAggregate(errs)
+}
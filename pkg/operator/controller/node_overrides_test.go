@@ -0,0 +1,151 @@
+package controller
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	operatorv1 "github.com/openshift/api/operator/v1"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestDNSNodeOverrides(t *testing.T) {
+	dns := &operatorv1.DNS{
+		ObjectMeta: metav1.ObjectMeta{Name: DefaultDNSController},
+		Spec: operatorv1.DNSSpec{
+			NodeOverrides: []operatorv1.DNSNodeOverride{
+				{Name: "edge-a", NodeSelector: map[string]string{"site": "a"}},
+				{Name: "Edge_B", NodeSelector: map[string]string{"site": "b"}},
+				{Name: "edge-a", NodeSelector: map[string]string{"site": "c"}},
+				{Name: "edge-c", NodeSelector: map[string]string{"site": "c", "zone": "1"}},
+				{Name: "edge-d", NodeSelector: map[string]string{}},
+				{Name: "edge-e", NodeSelector: map[string]string{"site": "not valid"}},
+				{Name: "edge-f", NodeSelector: map[string]string{"site": "a"}},
+				{Name: "edge-g", NodeSelector: map[string]string{"pool": "edge"}},
+			},
+		},
+	}
+	names := []string{}
+	for _, override := range dnsNodeOverrides(dns) {
+		names = append(names, override.Name)
+	}
+	if expected := []string{"edge-a", "edge-g"}; !cmp.Equal(names, expected) {
+		t.Errorf("expected %v, got %v", expected, names)
+	}
+}
+
+func TestNodeOverridesAffinity(t *testing.T) {
+	if affinity := nodeOverridesAffinity(nil, nil); affinity != nil {
+		t.Errorf("expected no affinity without overrides, got %+v", affinity)
+	}
+	affinity := nodeOverridesAffinity([]operatorv1.DNSNodeOverride{
+		{Name: "a", NodeSelector: map[string]string{"site": "a"}},
+		{Name: "edge", NodeSelector: map[string]string{"pool": "edge"}},
+		{Name: "b", NodeSelector: map[string]string{"site": "b"}},
+	}, []operatorv1.DNSNodeExclusion{
+		{NodeSelector: map[string]string{"pool": "gpu"}},
+		{NodeSelector: map[string]string{"storage": "true"}},
+	})
+	expected := []corev1.NodeSelectorTerm{{
+		MatchExpressions: []corev1.NodeSelectorRequirement{
+			{Key: "site", Operator: corev1.NodeSelectorOpNotIn, Values: []string{"a", "b"}},
+			{Key: "pool", Operator: corev1.NodeSelectorOpNotIn, Values: []string{"edge", "gpu"}},
+			{Key: "storage", Operator: corev1.NodeSelectorOpNotIn, Values: []string{"true"}},
+		},
+	}}
+	if actual := affinity.NodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution.NodeSelectorTerms; !cmp.Equal(actual, expected) {
+		t.Errorf("expected %+v, got %+v", expected, actual)
+	}
+}
+
+func TestDesiredNodeOverrideDaemonSet(t *testing.T) {
+	dns := &operatorv1.DNS{
+		ObjectMeta: metav1.ObjectMeta{Name: DefaultDNSController},
+		Spec: operatorv1.DNSSpec{
+			NodeOverrides: []operatorv1.DNSNodeOverride{
+				{Name: "edge", NodeSelector: map[string]string{"pool": "edge"}},
+				{Name: "site-b", NodeSelector: map[string]string{"site": "b"}},
+			},
+		},
+	}
+	base, err := desiredDNSDaemonSet(dns, "172.30.0.10", "cluster.local", "coredns", "cli", "proxy", false, nil)
+	if err != nil {
+		t.Fatalf("invalid dns daemonset: %v", err)
+	}
+	// The dns daemonset stays off the nodes of all overrides.
+	if terms := base.Spec.Template.Spec.Affinity.NodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution.NodeSelectorTerms; len(terms) != 1 || len(terms[0].MatchExpressions) != 2 {
+		t.Errorf("expected dns daemonset to avoid the nodes of both overrides, got %+v", terms)
+	}
+
+	overrides := dnsNodeOverrides(dns)
+	ds := desiredNodeOverrideDaemonSet(dns, base, overrides, 1)
+	if expected := "dns-default-site-b"; ds.Name != expected {
+		t.Errorf("expected name %s, got %s", expected, ds.Name)
+	}
+	expectedLabels := map[string]string{controllerDaemonSetLabel: "default", nodeOverrideLabel: "site-b"}
+	if !cmp.Equal(ds.Spec.Selector.MatchLabels, expectedLabels) || !cmp.Equal(ds.Spec.Template.Labels, expectedLabels) {
+		t.Errorf("expected selector and pod labels %v, got %v and %v", expectedLabels, ds.Spec.Selector.MatchLabels, ds.Spec.Template.Labels)
+	}
+	if _, ok := base.Spec.Selector.MatchLabels[nodeOverrideLabel]; ok {
+		t.Errorf("expected dns daemonset selector to be unchanged, got %v", base.Spec.Selector.MatchLabels)
+	}
+	if ds.Spec.Template.Spec.NodeSelector["site"] != "b" || ds.Spec.Template.Spec.NodeSelector["kubernetes.io/os"] != "linux" {
+		t.Errorf("expected node selector to add the override label, got %v", ds.Spec.Template.Spec.NodeSelector)
+	}
+	// The second override stays off the nodes of the first.
+	expectedTerms := []corev1.NodeSelectorTerm{{
+		MatchExpressions: []corev1.NodeSelectorRequirement{
+			{Key: "pool", Operator: corev1.NodeSelectorOpNotIn, Values: []string{"edge"}},
+		},
+	}}
+	if terms := ds.Spec.Template.Spec.Affinity.NodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution.NodeSelectorTerms; !cmp.Equal(terms, expectedTerms) {
+		t.Errorf("expected %+v, got %+v", expectedTerms, terms)
+	}
+	if first := desiredNodeOverrideDaemonSet(dns, base, overrides, 0); first.Spec.Template.Spec.Affinity != nil {
+		t.Errorf("expected no affinity for the first override, got %+v", first.Spec.Template.Spec.Affinity)
+	}
+	for _, volume := range ds.Spec.Template.Spec.Volumes {
+		if volume.Name != "config-volume" {
+			continue
+		}
+		expected := []corev1.KeyToPath{{Key: "Corefile-site-b", Path: "Corefile"}}
+		if !cmp.Equal(volume.ConfigMap.Items, expected) {
+			t.Errorf("expected config volume items %+v, got %+v", expected, volume.ConfigMap.Items)
+		}
+	}
+}
+
+func TestDesiredDNSConfigMapNodeOverrides(t *testing.T) {
+	dns := &operatorv1.DNS{
+		ObjectMeta: metav1.ObjectMeta{Name: DefaultDNSController},
+		Spec: operatorv1.DNSSpec{
+			Servers: []operatorv1.Server{{
+				Name:          "corp",
+				Zones:         []string{"corp.example.com"},
+				ForwardPlugin: operatorv1.ForwardPlugin{Upstreams: []string{"10.0.0.1"}},
+			}},
+			NodeOverrides: []operatorv1.DNSNodeOverride{{
+				Name:         "edge",
+				NodeSelector: map[string]string{"pool": "edge"},
+				Servers: []operatorv1.Server{{
+					Name:          "corp-edge",
+					Zones:         []string{"corp.example.com"},
+					ForwardPlugin: operatorv1.ForwardPlugin{Upstreams: []string{"10.1.0.1"}},
+				}},
+			}},
+		},
+	}
+	cm, err := desiredDNSConfigMap(dns, "cluster.local", nil, nil, nil, nil, nil, "")
+	if err != nil {
+		t.Fatalf("invalid dns configmap: %v", err)
+	}
+	if corefile := cm.Data["Corefile"]; !strings.Contains(corefile, "forward . 10.0.0.1") || strings.Contains(corefile, "10.1.0.1") {
+		t.Errorf("expected Corefile to forward to the servers of the dns, got:\n%s", corefile)
+	}
+	if corefile := cm.Data["Corefile-edge"]; !strings.Contains(corefile, "forward . 10.1.0.1") || strings.Contains(corefile, "10.0.0.1") {
+		t.Errorf("expected Corefile of the override to forward to the servers of the override, got:\n%s", corefile)
+	}
+}
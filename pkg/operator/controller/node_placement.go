@@ -0,0 +1,93 @@
+package controller
+
+import (
+	"strings"
+
+	operatorv1 "github.com/openshift/api/operator/v1"
+
+	"github.com/sirupsen/logrus"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+
+	"k8s.io/apimachinery/pkg/util/validation"
+)
+
+// dnsNodeSelector returns the node selector of the dns pods: the given default
+// node selector with the labels of the node placement of the given dns added.
+// A label whose key or value is invalid is ignored.
+func dnsNodeSelector(dns *operatorv1.DNS, defaults map[string]string) map[string]string {
+	selector := map[string]string{}
+	for key, value := range defaults {
+		selector[key] = value
+	}
+	for key, value := range dns.Spec.NodePlacement.NodeSelector {
+		if msgs := append(validation.IsQualifiedName(key), validation.IsValidLabelValue(value)...); len(msgs) != 0 {
+			logrus.Warningf("ignoring node placement label %s=%s of dns %s: %s", key, value, dns.Name, strings.Join(msgs, ", "))
+			continue
+		}
+		selector[key] = value
+	}
+	return selector
+}
+
+// validateToleration returns the reasons why the given toleration is invalid,
+// or nil if it is valid.
+func validateToleration(toleration corev1.Toleration) []string {
+	msgs := []string{}
+	if len(toleration.Key) != 0 {
+		msgs = append(msgs, validation.IsQualifiedName(toleration.Key)...)
+	}
+	switch toleration.Operator {
+	case corev1.TolerationOpExists:
+		if len(toleration.Value) != 0 {
+			msgs = append(msgs, "value must be empty when operator is Exists")
+		}
+	case "", corev1.TolerationOpEqual:
+		if len(toleration.Key) == 0 {
+			msgs = append(msgs, "operator must be Exists when key is empty")
+		}
+	default:
+		msgs = append(msgs, "unknown operator "+string(toleration.Operator))
+	}
+	switch toleration.Effect {
+	case "", corev1.TaintEffectNoSchedule, corev1.TaintEffectPreferNoSchedule, corev1.TaintEffectNoExecute:
+	default:
+		msgs = append(msgs, "unknown effect "+string(toleration.Effect))
+	}
+	if toleration.TolerationSeconds != nil && toleration.Effect != corev1.TaintEffectNoExecute {
+		msgs = append(msgs, "effect must be NoExecute when tolerationSeconds is set")
+	}
+	if len(msgs) == 0 {
+		return nil
+	}
+	return msgs
+}
+
+// dnsTolerations returns the tolerations of the dns pods: the tolerations of
+// the node placement of the given dns, or the given default tolerations if it
+// has none.  An invalid toleration is ignored, and if every toleration is
+// invalid, the defaults are used so that CoreDNS is not evicted from nodes by
+// a mistake.
+func dnsTolerations(dns *operatorv1.DNS, defaults []corev1.Toleration) []corev1.Toleration {
+	tolerations := []corev1.Toleration{}
+	for _, toleration := range dns.Spec.NodePlacement.Tolerations {
+		if msgs := validateToleration(toleration); len(msgs) != 0 {
+			logrus.Warningf("ignoring node placement toleration %+v of dns %s: %s", toleration, dns.Name, strings.Join(msgs, ", "))
+			continue
+		}
+		tolerations = append(tolerations, toleration)
+	}
+	if len(tolerations) == 0 {
+		return defaults
+	}
+	return tolerations
+}
+
+// setDNSNodePlacement merges the node placement of the given dns into the pod
+// template of the given dns daemonset.
+func setDNSNodePlacement(daemonset *appsv1.DaemonSet, dns *operatorv1.DNS) {
+	spec := &daemonset.Spec.Template.Spec
+	spec.NodeSelector = dnsNodeSelector(dns, spec.NodeSelector)
+	spec.Tolerations = dnsTolerations(dns, spec.Tolerations)
+}
@@ -0,0 +1,148 @@
+package controller
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	operatorv1 "github.com/openshift/api/operator/v1"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestDNSNodeSelector(t *testing.T) {
+	defaults := map[string]string{"kubernetes.io/os": "linux"}
+	testCases := []struct {
+		description string
+		selector    map[string]string
+		expected    map[string]string
+	}{
+		{
+			description: "no node selector",
+			expected:    map[string]string{"kubernetes.io/os": "linux"},
+		},
+		{
+			description: "labels are added to the default",
+			selector:    map[string]string{"node-role.kubernetes.io/worker": ""},
+			expected:    map[string]string{"kubernetes.io/os": "linux", "node-role.kubernetes.io/worker": ""},
+		},
+		{
+			description: "label replaces a default label",
+			selector:    map[string]string{"kubernetes.io/os": "custom"},
+			expected:    map[string]string{"kubernetes.io/os": "custom"},
+		},
+		{
+			description: "invalid labels are ignored",
+			selector:    map[string]string{"bad key!": "x", "pool": "bad value!", "zone": "a"},
+			expected:    map[string]string{"kubernetes.io/os": "linux", "zone": "a"},
+		},
+	}
+	for _, tc := range testCases {
+		dns := &operatorv1.DNS{
+			ObjectMeta: metav1.ObjectMeta{Name: DefaultDNSController},
+			Spec: operatorv1.DNSSpec{
+				NodePlacement: operatorv1.DNSNodePlacement{NodeSelector: tc.selector},
+			},
+		}
+		if actual := dnsNodeSelector(dns, defaults); !cmp.Equal(actual, tc.expected) {
+			t.Errorf("%q: expected %v, got %v", tc.description, tc.expected, actual)
+		}
+	}
+	if defaults["kubernetes.io/os"] != "linux" || len(defaults) != 1 {
+		t.Errorf("expected the default node selector not to change, got %v", defaults)
+	}
+}
+
+func TestDNSTolerations(t *testing.T) {
+	defaults := []corev1.Toleration{{Operator: corev1.TolerationOpExists}}
+	seconds := int64(30)
+	infra := corev1.Toleration{Key: "node-role.kubernetes.io/infra", Operator: corev1.TolerationOpExists, Effect: corev1.TaintEffectNoSchedule}
+	testCases := []struct {
+		description string
+		tolerations []corev1.Toleration
+		expected    []corev1.Toleration
+	}{
+		{
+			description: "no tolerations",
+			expected:    defaults,
+		},
+		{
+			description: "tolerations replace the default",
+			tolerations: []corev1.Toleration{
+				infra,
+				{Key: "edge", Value: "true", Effect: corev1.TaintEffectNoExecute, TolerationSeconds: &seconds},
+			},
+			expected: []corev1.Toleration{
+				infra,
+				{Key: "edge", Value: "true", Effect: corev1.TaintEffectNoExecute, TolerationSeconds: &seconds},
+			},
+		},
+		{
+			description: "invalid tolerations are ignored",
+			tolerations: []corev1.Toleration{
+				{Key: "bad key!", Operator: corev1.TolerationOpExists},
+				{Key: "edge", Operator: corev1.TolerationOpExists, Value: "true"},
+				{Value: "true"},
+				{Key: "edge", Operator: "In"},
+				{Key: "edge", Effect: "Sometimes"},
+				{Key: "edge", Effect: corev1.TaintEffectNoSchedule, TolerationSeconds: &seconds},
+				infra,
+			},
+			expected: []corev1.Toleration{infra},
+		},
+		{
+			description: "only invalid tolerations",
+			tolerations: []corev1.Toleration{{Key: "bad key!", Operator: corev1.TolerationOpExists}},
+			expected:    defaults,
+		},
+	}
+	for _, tc := range testCases {
+		dns := &operatorv1.DNS{
+			ObjectMeta: metav1.ObjectMeta{Name: DefaultDNSController},
+			Spec: operatorv1.DNSSpec{
+				NodePlacement: operatorv1.DNSNodePlacement{Tolerations: tc.tolerations},
+			},
+		}
+		if actual := dnsTolerations(dns, defaults); !cmp.Equal(actual, tc.expected) {
+			t.Errorf("%q: expected %v, got %v", tc.description, tc.expected, actual)
+		}
+	}
+}
+
+func TestDesiredDNSDaemonsetNodePlacement(t *testing.T) {
+	infra := corev1.Toleration{Key: "node-role.kubernetes.io/infra", Operator: corev1.TolerationOpExists}
+	dns := &operatorv1.DNS{
+		ObjectMeta: metav1.ObjectMeta{Name: DefaultDNSController},
+		Spec: operatorv1.DNSSpec{
+			NodePlacement: operatorv1.DNSNodePlacement{
+				NodeSelector: map[string]string{"node-role.kubernetes.io/worker": ""},
+				Tolerations:  []corev1.Toleration{infra},
+			},
+			NodeOverrides: []operatorv1.DNSNodeOverride{
+				{Name: "edge", NodeSelector: map[string]string{"pool": "edge"}},
+			},
+		},
+	}
+	base, err := desiredDNSDaemonSet(dns, "172.30.0.10", "cluster.local", "coredns", "cli", "proxy", false, nil)
+	if err != nil {
+		t.Fatalf("invalid dns daemonset: %v", err)
+	}
+	expectedSelector := map[string]string{"kubernetes.io/os": "linux", "node-role.kubernetes.io/worker": ""}
+	if actual := base.Spec.Template.Spec.NodeSelector; !cmp.Equal(actual, expectedSelector) {
+		t.Errorf("expected dns daemonset node selector %v, got %v", expectedSelector, actual)
+	}
+	if actual := base.Spec.Template.Spec.Tolerations; !cmp.Equal(actual, []corev1.Toleration{infra}) {
+		t.Errorf("expected dns daemonset tolerations %v, got %v", []corev1.Toleration{infra}, actual)
+	}
+
+	// The daemonset of a node override keeps the placement.
+	ds := desiredNodeOverrideDaemonSet(dns, base, dnsNodeOverrides(dns), 0)
+	expectedSelector = map[string]string{"kubernetes.io/os": "linux", "node-role.kubernetes.io/worker": "", "pool": "edge"}
+	if actual := ds.Spec.Template.Spec.NodeSelector; !cmp.Equal(actual, expectedSelector) {
+		t.Errorf("expected node override daemonset node selector %v, got %v", expectedSelector, actual)
+	}
+	if actual := ds.Spec.Template.Spec.Tolerations; !cmp.Equal(actual, []corev1.Toleration{infra}) {
+		t.Errorf("expected node override daemonset tolerations %v, got %v", []corev1.Toleration{infra}, actual)
+	}
+}
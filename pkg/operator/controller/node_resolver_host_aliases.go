@@ -0,0 +1,88 @@
+package controller
+
+import (
+	"fmt"
+	"net"
+	"strings"
+
+	operatorv1 "github.com/openshift/api/operator/v1"
+
+	corev1 "k8s.io/api/core/v1"
+
+	utilerrors "k8s.io/apimachinery/pkg/util/errors"
+	"k8s.io/apimachinery/pkg/util/validation"
+)
+
+// nodeResolverServices returns the names that the given node-resolver container
+// resolves, as listed in its SERVICES environment variable.
+func nodeResolverServices(container corev1.Container) []string {
+	for _, e := range container.Env {
+		if e.Name == "SERVICES" {
+			return strings.FieldsFunc(e.Value, func(r rune) bool { return r == ',' || r == ' ' })
+		}
+	}
+	return nil
+}
+
+// nodeResolverHostnames returns the hostnames that the node-resolver writes into
+// /etc/hosts for the given services.  Relative names are written both as-is
+// and in the cluster domain, and absolute names without the trailing dot.
+func nodeResolverHostnames(services []string, clusterDomain string) map[string]struct{} {
+	hostnames := map[string]struct{}{}
+	for _, svc := range services {
+		svc = strings.ToLower(svc)
+		if strings.HasSuffix(svc, ".") {
+			hostnames[strings.TrimSuffix(svc, ".")] = struct{}{}
+			continue
+		}
+		hostnames[svc] = struct{}{}
+		if len(clusterDomain) > 0 {
+			hostnames[svc+"."+clusterDomain] = struct{}{}
+		}
+	}
+	return hostnames
+}
+
+// validateNodeResolverHostAliases returns an error if a host alias of the given
+// dns has an invalid IP address or hostname, or has a hostname that is also in
+// another host alias or that the node-resolver writes for the given services.
+func validateNodeResolverHostAliases(dns *operatorv1.DNS, services []string, clusterDomain string) error {
+	errs := []error{}
+	resolved := nodeResolverHostnames(services, clusterDomain)
+	seen := map[string]string{}
+	for _, alias := range dns.Spec.NodeResolver.HostAliases {
+		if net.ParseIP(alias.IP) == nil {
+			errs = append(errs, fmt.Errorf("host alias IP %q is not a valid IP address", alias.IP))
+		}
+		if len(alias.Hostnames) == 0 {
+			errs = append(errs, fmt.Errorf("host alias for IP %s has no hostnames", alias.IP))
+		}
+		for _, hostname := range alias.Hostnames {
+			if msgs := validation.IsDNS1123Subdomain(hostname); len(msgs) != 0 {
+				errs = append(errs, fmt.Errorf("host alias hostname %q is invalid: %s", hostname, strings.Join(msgs, ", ")))
+				continue
+			}
+			if _, ok := resolved[hostname]; ok {
+				errs = append(errs, fmt.Errorf("host alias hostname %q conflicts with a name that the node-resolver resolves", hostname))
+				continue
+			}
+			if ip, ok := seen[hostname]; ok {
+				errs = append(errs, fmt.Errorf("host alias hostname %q is specified for both %s and %s", hostname, ip, alias.IP))
+				continue
+			}
+			seen[hostname] = alias.IP
+		}
+	}
+	return utilerrors.NewAggregate(errs)
+}
+
+// nodeResolverHostAliases returns the value of the HOST_ALIASES environment
+// variable of the node-resolver for the given dns, which has one /etc/hosts
+// line for each host alias.
+func nodeResolverHostAliases(dns *operatorv1.DNS) string {
+	lines := []string{}
+	for _, alias := range dns.Spec.NodeResolver.HostAliases {
+		lines = append(lines, strings.Join(append([]string{alias.IP}, alias.Hostnames...), " "))
+	}
+	return strings.Join(lines, "\n")
+}
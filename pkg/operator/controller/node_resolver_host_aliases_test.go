@@ -0,0 +1,87 @@
+package controller
+
+import (
+	"strings"
+	"testing"
+
+	operatorv1 "github.com/openshift/api/operator/v1"
+)
+
+func TestValidateNodeResolverHostAliases(t *testing.T) {
+	services := []string{"image-registry.openshift-image-registry.svc", "mirror.example.com."}
+	testCases := []struct {
+		description string
+		aliases     []operatorv1.NodeResolverHostAlias
+		expectErr   string
+	}{
+		{
+			description: "no host aliases",
+		},
+		{
+			description: "valid host aliases",
+			aliases: []operatorv1.NodeResolverHostAlias{
+				{IP: "10.0.0.1", Hostnames: []string{"a.example.com", "b.example.com"}},
+				{IP: "fd00::1", Hostnames: []string{"c.example.com"}},
+			},
+		},
+		{
+			description: "invalid IP address",
+			aliases: []operatorv1.NodeResolverHostAlias{
+				{IP: "10.0.0.256", Hostnames: []string{"a.example.com"}},
+			},
+			expectErr: `host alias IP "10.0.0.256" is not a valid IP address`,
+		},
+		{
+			description: "no hostnames",
+			aliases: []operatorv1.NodeResolverHostAlias{
+				{IP: "10.0.0.1"},
+			},
+			expectErr: "host alias for IP 10.0.0.1 has no hostnames",
+		},
+		{
+			description: "invalid hostname",
+			aliases: []operatorv1.NodeResolverHostAlias{
+				{IP: "10.0.0.1", Hostnames: []string{"A_B.example.com"}},
+			},
+			expectErr: `host alias hostname "A_B.example.com" is invalid`,
+		},
+		{
+			description: "hostname in two host aliases",
+			aliases: []operatorv1.NodeResolverHostAlias{
+				{IP: "10.0.0.1", Hostnames: []string{"a.example.com"}},
+				{IP: "10.0.0.2", Hostnames: []string{"a.example.com"}},
+			},
+			expectErr: `host alias hostname "a.example.com" is specified for both 10.0.0.1 and 10.0.0.2`,
+		},
+		{
+			description: "hostname of a relative service in the cluster domain",
+			aliases: []operatorv1.NodeResolverHostAlias{
+				{IP: "10.0.0.1", Hostnames: []string{"image-registry.openshift-image-registry.svc.cluster.local"}},
+			},
+			expectErr: "conflicts with a name that the node-resolver resolves",
+		},
+		{
+			description: "hostname of an absolute service",
+			aliases: []operatorv1.NodeResolverHostAlias{
+				{IP: "10.0.0.1", Hostnames: []string{"mirror.example.com"}},
+			},
+			expectErr: "conflicts with a name that the node-resolver resolves",
+		},
+	}
+	for _, tc := range testCases {
+		dns := &operatorv1.DNS{
+			Spec: operatorv1.DNSSpec{
+				NodeResolver: operatorv1.NodeResolverConfig{HostAliases: tc.aliases},
+			},
+		}
+		err := validateNodeResolverHostAliases(dns, services, "cluster.local")
+		switch {
+		case len(tc.expectErr) == 0 && err != nil:
+			t.Errorf("%q: unexpected error: %v", tc.description, err)
+		case len(tc.expectErr) != 0 && err == nil:
+			t.Errorf("%q: expected error containing %q, got nil", tc.description, tc.expectErr)
+		case len(tc.expectErr) != 0 && !strings.Contains(err.Error(), tc.expectErr):
+			t.Errorf("%q: expected error containing %q, got %v", tc.description, tc.expectErr, err)
+		}
+	}
+}
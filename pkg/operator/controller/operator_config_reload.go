@@ -0,0 +1,135 @@
+package controller
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"sync"
+
+	operatorconfig "github.com/openshift/cluster-dns-operator/pkg/operator/config"
+
+	"github.com/sirupsen/logrus"
+
+	appsv1 "k8s.io/api/apps/v1"
+
+	"k8s.io/apimachinery/pkg/types"
+
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+const (
+	// operatorNamespace is the namespace of the operator's deployment.
+	operatorNamespace = "openshift-dns-operator"
+
+	// operatorDeploymentName is the name of the operator's deployment.
+	operatorDeploymentName = "dns-operator"
+
+	// operatorContainerName is the name of the container in the
+	// operator's deployment that runs the operator.
+	operatorContainerName = "dns-operator"
+)
+
+// operatorConfigWatcher reloads the operator configuration when the
+// environment of the operator container in the operator's deployment changes,
+// so that an upgrade that changes the operand images or the release version
+// is rolled out without waiting for the operator pod to be replaced.
+type operatorConfigWatcher struct {
+	lock sync.Mutex
+	// loaded is the operator configuration that the process loaded at
+	// startup.
+	loaded operatorconfig.Config
+	// getenv returns the environment of the process, against which the
+	// environment in the deployment is compared.
+	getenv func(string) string
+	// current is the controller configuration for the most recently
+	// observed deployment.
+	current Config
+}
+
+// newOperatorConfigWatcher returns a watcher that starts with the given
+// controller configuration, which must have been loaded from its
+// OperatorConfig and the process's environment.
+func newOperatorConfigWatcher(config Config) *operatorConfigWatcher {
+	return &operatorConfigWatcher{
+		loaded:  *config.OperatorConfig,
+		getenv:  os.Getenv,
+		current: config,
+	}
+}
+
+// config returns the current controller configuration.
+func (w *operatorConfigWatcher) config() Config {
+	w.lock.Lock()
+	defer w.lock.Unlock()
+	return w.current
+}
+
+// observe reloads the configuration from the given deployment of the operator
+// and returns a Boolean indicating whether the configuration changed.  An
+// invalid configuration in the deployment is ignored.
+func (w *operatorConfigWatcher) observe(deployment *appsv1.Deployment) bool {
+	env := operatorContainerEnv(deployment)
+	if env == nil {
+		return false
+	}
+	reloaded, changed, err := w.loaded.Reload(w.getenv, func(name string) string { return env[name] })
+	if err != nil {
+		logrus.Warningf("ignoring invalid operator configuration in deployment %s/%s: %v", deployment.Namespace, deployment.Name, err)
+		return false
+	}
+
+	w.lock.Lock()
+	defer w.lock.Unlock()
+	next := w.current
+	next.CoreDNSImage = reloaded.CoreDNSImage
+	next.CoreDNSVersion = reloaded.CoreDNSVersion
+	next.CoreDNSExternalPlugins = reloaded.CoreDNSExternalPlugins
+	next.OpenshiftCLIImage = reloaded.OpenshiftCLIImage
+	next.OperatorReleaseVersion = reloaded.OperatorReleaseVersion
+	next.KubeRBACProxyImage = reloaded.KubeRBACProxyImage
+	if next == w.current {
+		return false
+	}
+	w.current = next
+	logrus.Infof("reloaded operator configuration from deployment %s/%s; environment variables that differ from the operator process: %s", deployment.Namespace, deployment.Name, strings.Join(changed, ", "))
+	return true
+}
+
+// operatorContainerEnv returns the environment variables with literal values
+// of the operator container in the given deployment, or nil if the deployment
+// has no operator container.
+func operatorContainerEnv(deployment *appsv1.Deployment) map[string]string {
+	for _, c := range deployment.Spec.Template.Spec.Containers {
+		if c.Name != operatorContainerName {
+			continue
+		}
+		env := map[string]string{}
+		for _, v := range c.Env {
+			if v.ValueFrom == nil {
+				env[v.Name] = v.Value
+			}
+		}
+		return env
+	}
+	return nil
+}
+
+// watchOperatorDeployment watches the deployments in the operator's namespace
+// and requeues the default dns when the operator's deployment changes the
+// operator configuration.
+func watchOperatorDeployment(c controller.Controller, informers *sharedInformers, w *operatorConfigWatcher) error {
+	if err := informers.watch(c, operatorNamespace, &appsv1.Deployment{}, &handler.EnqueueRequestsFromMapFunc{
+		ToRequests: handler.ToRequestsFunc(func(o handler.MapObject) []reconcile.Request {
+			deployment, ok := o.Object.(*appsv1.Deployment)
+			if !ok || deployment.Name != operatorDeploymentName || !w.observe(deployment) {
+				return nil
+			}
+			return []reconcile.Request{{NamespacedName: types.NamespacedName{Name: DefaultDNSController}}}
+		}),
+	}); err != nil {
+		return fmt.Errorf("failed to watch the operator deployment: %v", err)
+	}
+	return nil
+}
@@ -0,0 +1,80 @@
+package controller
+
+import (
+	"testing"
+
+	operatorconfig "github.com/openshift/cluster-dns-operator/pkg/operator/config"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+)
+
+// operatorDeployment returns an operator deployment whose operator container
+// has the given environment.
+func operatorDeployment(env map[string]string) *appsv1.Deployment {
+	deployment := &appsv1.Deployment{}
+	deployment.Namespace = operatorNamespace
+	deployment.Name = operatorDeploymentName
+	container := corev1.Container{Name: operatorContainerName}
+	for name, value := range env {
+		container.Env = append(container.Env, corev1.EnvVar{Name: name, Value: value})
+	}
+	deployment.Spec.Template.Spec.Containers = []corev1.Container{{Name: "kube-rbac-proxy"}, container}
+	return deployment
+}
+
+func TestOperatorConfigWatcher(t *testing.T) {
+	processEnv := map[string]string{
+		"RELEASE_VERSION":       "4.6.0",
+		"IMAGE":                 "coredns:4.6",
+		"OPENSHIFT_CLI_IMAGE":   "cli:4.6",
+		"KUBE_RBAC_PROXY_IMAGE": "proxy:4.6",
+	}
+	loaded := &operatorconfig.Config{
+		OperatorReleaseVersion: "4.6.0",
+		CoreDNSImage:           "coredns:4.6",
+		OpenshiftCLIImage:      "cli:4.6",
+		KubeRBACProxyImage:     "proxy:4.6",
+	}
+	w := newOperatorConfigWatcher(Config{
+		OperatorReleaseVersion: loaded.OperatorReleaseVersion,
+		CoreDNSImage:           loaded.CoreDNSImage,
+		OpenshiftCLIImage:      loaded.OpenshiftCLIImage,
+		KubeRBACProxyImage:     loaded.KubeRBACProxyImage,
+		OperatorConfig:         loaded,
+	})
+	w.getenv = func(name string) string { return processEnv[name] }
+
+	// The deployment that started the process does not change anything.
+	if w.observe(operatorDeployment(processEnv)) {
+		t.Errorf("expected no change for the environment of the process")
+	}
+
+	// An upgrade changes the images and the release version.
+	upgraded := map[string]string{
+		"RELEASE_VERSION":       "4.7.0",
+		"IMAGE":                 "coredns:4.7",
+		"OPENSHIFT_CLI_IMAGE":   "cli:4.7",
+		"KUBE_RBAC_PROXY_IMAGE": "proxy:4.6",
+	}
+	if !w.observe(operatorDeployment(upgraded)) {
+		t.Fatalf("expected the upgrade to change the configuration")
+	}
+	config := w.config()
+	if config.OperatorReleaseVersion != "4.7.0" || config.CoreDNSImage != "coredns:4.7" || config.OpenshiftCLIImage != "cli:4.7" || config.KubeRBACProxyImage != "proxy:4.6" {
+		t.Errorf("unexpected configuration after the upgrade: %#v", config)
+	}
+	if w.observe(operatorDeployment(upgraded)) {
+		t.Errorf("expected no change for the same deployment")
+	}
+
+	// A deployment without the operator container is ignored.
+	if w.observe(&appsv1.Deployment{}) {
+		t.Errorf("expected a deployment without the operator container to be ignored")
+	}
+
+	// Reverting the deployment reverts the configuration.
+	if !w.observe(operatorDeployment(processEnv)) || w.config().CoreDNSImage != "coredns:4.6" {
+		t.Errorf("expected reverting the deployment to revert the configuration, got %#v", w.config())
+	}
+}
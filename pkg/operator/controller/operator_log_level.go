@@ -0,0 +1,35 @@
+package controller
+
+import (
+	operatorv1 "github.com/openshift/api/operator/v1"
+
+	"github.com/sirupsen/logrus"
+)
+
+// operatorLogLevel returns the level of the logger of the operator for the
+// given operator log level of the default dns.  An unknown level is ignored.
+func operatorLogLevel(level operatorv1.DNSLogLevel) logrus.Level {
+	switch level {
+	case operatorv1.DNSLogLevelDebug:
+		return logrus.DebugLevel
+	case operatorv1.DNSLogLevelTrace:
+		return logrus.TraceLevel
+	case "", operatorv1.DNSLogLevelNormal:
+		return logrus.InfoLevel
+	default:
+		logrus.Warningf("ignoring unknown operator log level %q", level)
+		return logrus.InfoLevel
+	}
+}
+
+// setOperatorLogLevel sets the level of the logger of the operator to the
+// operator log level of the given dns.  The level of the logger is global, so
+// the operator log level only takes effect at runtime, without restarting the
+// operator.
+func setOperatorLogLevel(dns *operatorv1.DNS) {
+	level := operatorLogLevel(dns.Spec.OperatorLogLevel)
+	if current := logrus.GetLevel(); current != level {
+		logrus.Infof("changing operator log level from %s to %s", current, level)
+		logrus.SetLevel(level)
+	}
+}
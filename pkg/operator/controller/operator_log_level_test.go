@@ -0,0 +1,31 @@
+package controller
+
+import (
+	"testing"
+
+	operatorv1 "github.com/openshift/api/operator/v1"
+
+	"github.com/sirupsen/logrus"
+)
+
+func TestSetOperatorLogLevel(t *testing.T) {
+	defer logrus.SetLevel(logrus.GetLevel())
+	testCases := []struct {
+		level    operatorv1.DNSLogLevel
+		expected logrus.Level
+	}{
+		{operatorv1.DNSLogLevelDebug, logrus.DebugLevel},
+		{operatorv1.DNSLogLevelTrace, logrus.TraceLevel},
+		{operatorv1.DNSLogLevelNormal, logrus.InfoLevel},
+		{operatorv1.DNSLogLevelTrace, logrus.TraceLevel},
+		{"", logrus.InfoLevel},
+		{"Verbose", logrus.InfoLevel},
+	}
+	for _, tc := range testCases {
+		dns := &operatorv1.DNS{Spec: operatorv1.DNSSpec{OperatorLogLevel: tc.level}}
+		setOperatorLogLevel(dns)
+		if actual := logrus.GetLevel(); actual != tc.expected {
+			t.Errorf("operator log level %q: expected %s, got %s", tc.level, tc.expected, actual)
+		}
+	}
+}
@@ -0,0 +1,159 @@
+package controller
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	operatorv1 "github.com/openshift/api/operator/v1"
+	"github.com/openshift/cluster-dns-operator/pkg/manifests"
+
+	"github.com/sirupsen/logrus"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	policyv1beta1 "k8s.io/api/policy/v1beta1"
+
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	utilerrors "k8s.io/apimachinery/pkg/util/errors"
+)
+
+// ownedObject is an object that the operator manages for a dns.
+type ownedObject interface {
+	metav1.Object
+	runtime.Object
+}
+
+// managedResource identifies a namespaced resource that the operator manages
+// for a dns.
+type managedResource struct {
+	kind string
+	name types.NamespacedName
+	obj  ownedObject
+}
+
+// dnsManagedResources returns the namespaced resources that the operator
+// manages for the given dns.  Cluster-scoped RBAC resources are shared by all
+// dnses and are not included.  The kube-dns alias service and the service mesh
+// configmap are included only if they are enabled so that a resource of the
+// same name created by something else is not adopted otherwise.
+func dnsManagedResources(dns *operatorv1.DNS) []managedResource {
+	resources := []managedResource{
+		{kind: "daemonset", name: DNSDaemonSetName(dns), obj: &appsv1.DaemonSet{}},
+		{kind: "service", name: DNSServiceName(dns), obj: &corev1.Service{}},
+		{kind: "configmap", name: DNSConfigMapName(dns), obj: &corev1.ConfigMap{}},
+		{kind: "configmap", name: DNSTrustedCAConfigMapName(dns), obj: &corev1.ConfigMap{}},
+		{kind: "configmap", name: DNSClientSettingsConfigMapName(dns), obj: &corev1.ConfigMap{}},
+		{kind: "poddisruptionbudget", name: DNSPodDisruptionBudgetName(dns), obj: &policyv1beta1.PodDisruptionBudget{}},
+	}
+	if kubeDNSAliasEnabled(dns) {
+		resources = append(resources, managedResource{kind: "service", name: KubeDNSServiceName(dns), obj: &corev1.Service{}})
+	}
+	if serviceMeshCoexistenceEnabled(dns) {
+		resources = append(resources, managedResource{kind: "configmap", name: DNSServiceMeshConfigMapName(dns), obj: &corev1.ConfigMap{}})
+	}
+	return resources
+}
+
+// managedResourceSet records managed resources of a dns that the operator must
+// not update, such as resources that are owned by something other than the
+// dns or that have reconciliation paused.  The keys are the namespaced names
+// of the resources, and the values describe why each resource is recorded.
+type managedResourceSet map[types.NamespacedName]string
+
+// has returns a Boolean indicating whether the named resource is recorded.
+func (c managedResourceSet) has(name types.NamespacedName) bool {
+	_, ok := c[name]
+	return ok
+}
+
+// messages returns the descriptions of the resources in a stable order.
+func (c managedResourceSet) messages() []string {
+	var messages []string
+	for _, m := range c {
+		messages = append(messages, m)
+	}
+	sort.Strings(messages)
+	return messages
+}
+
+// ensureDNSOwnership checks that each existing managed resource of the given
+// dns is owned by the dns.  A resource without the owning-dns label that is
+// not controlled by another object, for example one created by an installer
+// or a user, is adopted by adding the label and the dns owner reference.  Any
+// other resource is reported as a conflict and must not be updated.  A
+// resource that has reconciliation paused is neither adopted nor updated and
+// is returned separately.
+func (r *reconciler) ensureDNSOwnership(dns *operatorv1.DNS) (managedResourceSet, managedResourceSet, error) {
+	conflicts, paused := managedResourceSet{}, managedResourceSet{}
+	errs := []error{}
+	for _, res := range dnsManagedResources(dns) {
+		if err := r.client.Get(context.TODO(), res.name, res.obj); err != nil {
+			if !errors.IsNotFound(err) {
+				errs = append(errs, fmt.Errorf("failed to get %s %s: %v", res.kind, res.name, err))
+			}
+			continue
+		}
+		adopt, reason := checkOwnership(dns, res.obj)
+		switch {
+		case len(reason) == 0 && reconciliationPaused(res.obj):
+			paused[res.name] = fmt.Sprintf("%s %s has annotation %s", res.kind, res.name, manifests.PauseReconciliationAnnotation)
+			logrus.Infof("skipping %s %s for dns %s: reconciliation is paused", res.kind, res.name, dns.Name)
+		case len(reason) != 0:
+			message := fmt.Sprintf("%s %s is not owned by dns %s: %s", res.kind, res.name, dns.Name, reason)
+			conflicts[res.name] = message
+			logrus.Warningf("skipping %s", message)
+			r.recorder.Eventf(dns, corev1.EventTypeWarning, "ResourceConflict", "Not updating %s %s: %s", res.kind, res.name, reason)
+		case adopt:
+			adoptObject(dns, res.obj)
+			if err := r.client.Update(context.TODO(), res.obj); err != nil {
+				errs = append(errs, fmt.Errorf("failed to adopt %s %s: %v", res.kind, res.name, err))
+				continue
+			}
+			logrus.Infof("adopted %s %s for dns %s", res.kind, res.name, dns.Name)
+			r.recorder.Eventf(dns, corev1.EventTypeNormal, "ResourceAdopted", "Adopted existing %s %s", res.kind, res.name)
+		}
+	}
+	return conflicts, paused, utilerrors.NewAggregate(errs)
+}
+
+// checkOwnership returns a Boolean indicating whether the given existing
+// object should be adopted by the given dns, or a non-empty reason if the
+// object is owned by something else.
+func checkOwnership(dns *operatorv1.DNS, obj metav1.Object) (bool, string) {
+	if owner, ok := obj.GetLabels()[manifests.OwningDNSLabel]; ok {
+		if owner != DNSDaemonSetLabel(dns) {
+			return false, fmt.Sprintf("label %s has value %q", manifests.OwningDNSLabel, owner)
+		}
+		return false, ""
+	}
+	if ref := metav1.GetControllerOf(obj); ref != nil && ref.UID != dns.UID {
+		return false, fmt.Sprintf("controlled by %s %s", strings.ToLower(ref.Kind), ref.Name)
+	}
+	return true, ""
+}
+
+// adoptObject labels the given object as owned by the given dns and makes the
+// dns its controller, keeping any other owner references.
+func adoptObject(dns *operatorv1.DNS, obj metav1.Object) {
+	labels := obj.GetLabels()
+	if labels == nil {
+		labels = map[string]string{}
+	}
+	labels[manifests.OwningDNSLabel] = DNSDaemonSetLabel(dns)
+	obj.SetLabels(labels)
+
+	if metav1.GetControllerOf(obj) == nil {
+		refs := []metav1.OwnerReference{}
+		for _, ref := range obj.GetOwnerReferences() {
+			if ref.UID != dns.UID {
+				refs = append(refs, ref)
+			}
+		}
+		obj.SetOwnerReferences(append(refs, dnsOwnerRef(dns)))
+	}
+}
@@ -0,0 +1,98 @@
+package controller
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	operatorv1 "github.com/openshift/api/operator/v1"
+	"github.com/openshift/cluster-dns-operator/pkg/manifests"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestCheckOwnership(t *testing.T) {
+	trueVar := true
+	dns := &operatorv1.DNS{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: DefaultDNSController,
+			UID:  "1",
+		},
+	}
+	testCases := []struct {
+		description  string
+		labels       map[string]string
+		owners       []metav1.OwnerReference
+		expectAdopt  bool
+		expectReason bool
+	}{
+		{
+			description: "owned by the dns",
+			labels:      map[string]string{manifests.OwningDNSLabel: "default"},
+			owners:      []metav1.OwnerReference{dnsOwnerRef(dns)},
+		},
+		{
+			description:  "labeled for another dns",
+			labels:       map[string]string{manifests.OwningDNSLabel: "foo"},
+			expectReason: true,
+		},
+		{
+			description: "created without owner",
+			expectAdopt: true,
+		},
+		{
+			description: "created with a non-controller owner",
+			owners:      []metav1.OwnerReference{{Kind: "ConfigMap", Name: "foo", UID: "2"}},
+			expectAdopt: true,
+		},
+		{
+			description:  "controlled by another object",
+			owners:       []metav1.OwnerReference{{Kind: "Deployment", Name: "foo", UID: "2", Controller: &trueVar}},
+			expectReason: true,
+		},
+	}
+	for _, tc := range testCases {
+		cm := &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Labels:          tc.labels,
+				OwnerReferences: tc.owners,
+			},
+		}
+		adopt, reason := checkOwnership(dns, cm)
+		if adopt != tc.expectAdopt {
+			t.Errorf("%q: expected adopt to be %t, got %t", tc.description, tc.expectAdopt, adopt)
+		}
+		if (len(reason) != 0) != tc.expectReason {
+			t.Errorf("%q: unexpected conflict reason %q", tc.description, reason)
+		}
+	}
+}
+
+func TestAdoptObject(t *testing.T) {
+	dns := &operatorv1.DNS{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: DefaultDNSController,
+			UID:  "1",
+		},
+	}
+	other := metav1.OwnerReference{Kind: "ConfigMap", Name: "foo", UID: "2"}
+	cm := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Labels:          map[string]string{"app": "foo"},
+			OwnerReferences: []metav1.OwnerReference{other},
+		},
+	}
+	adoptObject(dns, cm)
+	expectLabels := map[string]string{"app": "foo", manifests.OwningDNSLabel: "default"}
+	if !cmp.Equal(cm.Labels, expectLabels) {
+		t.Errorf("expected labels %v, got %v", expectLabels, cm.Labels)
+	}
+	expectOwners := []metav1.OwnerReference{other, dnsOwnerRef(dns)}
+	if !cmp.Equal(cm.OwnerReferences, expectOwners) {
+		t.Errorf("expected owner references %v, got %v", expectOwners, cm.OwnerReferences)
+	}
+	if adopt, reason := checkOwnership(dns, cm); adopt || len(reason) != 0 {
+		t.Errorf("expected adopted object to be owned, got adopt=%t, reason=%q", adopt, reason)
+	}
+}
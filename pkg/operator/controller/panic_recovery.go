@@ -0,0 +1,167 @@
+package controller
+
+import (
+	"context"
+	"fmt"
+	"runtime/debug"
+	"sync"
+	"time"
+
+	operatorv1 "github.com/openshift/api/operator/v1"
+
+	"github.com/openshift/cluster-dns-operator/pkg/util/conditions"
+
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/sirupsen/logrus"
+
+	corev1 "k8s.io/api/core/v1"
+
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+const (
+	// DNSReconcilePanickingConditionType is the type of the dns status
+	// condition that reports that reconciling the dns keeps panicking.
+	DNSReconcilePanickingConditionType = "ReconcilePanicking"
+
+	// reconcilePanicWindow is the interval over which panics are counted
+	// to decide whether they recur.
+	reconcilePanicWindow = 10 * time.Minute
+
+	// reconcilePanicThreshold is the number of panics within
+	// reconcilePanicWindow at which panics are considered to recur.
+	reconcilePanicThreshold = 3
+)
+
+// reconcilePanicsCounter counts the panics that were recovered in each
+// controller.
+var reconcilePanicsCounter = prometheus.NewCounterVec(prometheus.CounterOpts{
+	Name: "dns_operator_reconcile_panics_total",
+	Help: "Number of panics that were recovered while reconciling.",
+}, []string{"controller"})
+
+func init() {
+	metrics.Registry.MustRegister(reconcilePanicsCounter)
+}
+
+// recoverPanic converts a panic into an error, logging a crash report with
+// the given fields and the stack of the panicking goroutine.  It must be
+// called directly by a deferred function.
+func recoverPanic(controller string, fields logrus.Fields, err *error) {
+	v := recover()
+	if v == nil {
+		return
+	}
+	reconcilePanicsCounter.WithLabelValues(controller).Inc()
+	logrus.WithFields(fields).WithFields(logrus.Fields{
+		"controller": controller,
+		"panic":      fmt.Sprint(v),
+		"stack":      string(debug.Stack()),
+	}).Error("recovered from panic")
+	*err = fmt.Errorf("recovered from panic: %v", v)
+}
+
+// panicTracker counts recent panics.
+type panicTracker struct {
+	lock  sync.Mutex
+	times []time.Time
+}
+
+// record records a panic at the given time and returns the number of panics
+// within reconcilePanicWindow.
+func (t *panicTracker) record(now time.Time) int {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	recent := []time.Time{}
+	for _, p := range t.times {
+		if now.Sub(p) < reconcilePanicWindow {
+			recent = append(recent, p)
+		}
+	}
+	t.times = append(recent, now)
+	return len(t.times)
+}
+
+// recoveringReconciler wraps a reconciler so that a panic is logged and counted
+// and the request is requeued with backoff rather than crashing the operator.
+// If panics recur, onRecurringPanics is called with the request, the number of
+// recent panics, and the error.
+type recoveringReconciler struct {
+	controller        string
+	reconciler        reconcile.Reconciler
+	panics            *panicTracker
+	onRecurringPanics func(request reconcile.Request, count int, err error)
+}
+
+// newRecoveringReconciler returns a reconciler that recovers from panics in the
+// given reconciler of the named controller.
+func newRecoveringReconciler(controller string, reconciler reconcile.Reconciler, onRecurringPanics func(reconcile.Request, int, error)) reconcile.Reconciler {
+	return &recoveringReconciler{
+		controller:        controller,
+		reconciler:        reconciler,
+		panics:            &panicTracker{},
+		onRecurringPanics: onRecurringPanics,
+	}
+}
+
+// Reconcile calls the wrapped reconciler and recovers from a panic in it.
+func (r *recoveringReconciler) Reconcile(request reconcile.Request) (result reconcile.Result, err error) {
+	var panicErr error
+	defer func() {
+		if panicErr == nil {
+			return
+		}
+		if count := r.panics.record(time.Now()); count >= reconcilePanicThreshold && r.onRecurringPanics != nil {
+			r.onRecurringPanics(request, count, panicErr)
+		}
+		result, err = reconcile.Result{}, panicErr
+	}()
+	defer recoverPanic(r.controller, logrus.Fields{"request": request.String()}, &panicErr)
+	return r.reconciler.Reconcile(request)
+}
+
+// reportRecurringPanics sets the ReconcilePanicking condition on the requested
+// dns and emits a warning event.  The condition is removed the next time that
+// the status of the dns is synced after a reconcile that does not panic.
+func (r *reconciler) reportRecurringPanics(request reconcile.Request, count int, err error) {
+	dns := &operatorv1.DNS{}
+	if err := r.client.Get(context.TODO(), request.NamespacedName, dns); err != nil {
+		logrus.Errorf("failed to get dns %s to report recurring panics: %v", request.Name, err)
+		return
+	}
+	message := fmt.Sprintf("Reconciling the DNS panicked %d times in the last %s: %v", count, reconcilePanicWindow, err)
+	r.recorder.Event(dns, corev1.EventTypeWarning, "ReconcilePanicked", message)
+
+	updated := dns.DeepCopy()
+	updated.Status.Conditions = computeDNSReconcilePanickingConditions(dns.Status.Conditions, err)
+	if dnsStatusesEqual(updated.Status, dns.Status) {
+		return
+	}
+	if err := r.client.Status().Update(context.TODO(), updated); err != nil {
+		logrus.Errorf("failed to update status of dns %s to report recurring panics: %v", dns.Name, err)
+		return
+	}
+	logrus.Infof("reported recurring panics in status of dns %s", dns.Name)
+}
+
+// computeDNSReconcilePanickingConditions returns the given dns status
+// conditions with the ReconcilePanicking condition set for the given error.
+// The message leaves out the number of panics so that the status is not
+// updated for every panic.
+func computeDNSReconcilePanickingConditions(oldConditions []operatorv1.OperatorCondition, err error) []operatorv1.OperatorCondition {
+	condition := &operatorv1.OperatorCondition{
+		Type:    DNSReconcilePanickingConditionType,
+		Status:  operatorv1.ConditionTrue,
+		Reason:  "RecurringPanics",
+		Message: fmt.Sprintf("Reconciling the DNS panicked at least %d times in %s: %v", reconcilePanicThreshold, reconcilePanicWindow, err),
+	}
+	kept := []operatorv1.OperatorCondition{}
+	for i := range oldConditions {
+		if oldConditions[i].Type != DNSReconcilePanickingConditionType {
+			kept = append(kept, oldConditions[i])
+		}
+	}
+	return append(kept, conditions.SetOperatorConditionTransitionTime(condition, conditions.FindOperatorCondition(oldConditions, DNSReconcilePanickingConditionType)))
+}
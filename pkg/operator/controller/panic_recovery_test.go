@@ -0,0 +1,99 @@
+package controller
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	operatorv1 "github.com/openshift/api/operator/v1"
+
+	"k8s.io/apimachinery/pkg/types"
+
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+// fakeReconciler returns the given result and error, or panics with the given
+// value if it is not nil.
+type fakeReconciler struct {
+	result  reconcile.Result
+	err     error
+	panicOn interface{}
+}
+
+func (r *fakeReconciler) Reconcile(request reconcile.Request) (reconcile.Result, error) {
+	if r.panicOn != nil {
+		panic(r.panicOn)
+	}
+	return r.result, r.err
+}
+
+func TestRecoveringReconciler(t *testing.T) {
+	request := reconcile.Request{NamespacedName: types.NamespacedName{Name: "default"}}
+	fake := &fakeReconciler{result: reconcile.Result{RequeueAfter: time.Minute}, err: fmt.Errorf("foo")}
+	recurring := 0
+	r := newRecoveringReconciler("test", fake, func(_ reconcile.Request, count int, _ error) {
+		recurring = count
+	})
+
+	// Results and errors of the wrapped reconciler are passed through.
+	result, err := r.Reconcile(request)
+	if result.RequeueAfter != time.Minute || err == nil || err.Error() != "foo" {
+		t.Errorf("expected the result and error of the wrapped reconciler, got %v and %v", result, err)
+	}
+
+	// A panic is returned as an error so that the request is requeued,
+	// and recurring panics are reported.
+	fake.panicOn = "boom"
+	for i := 1; i <= reconcilePanicThreshold; i++ {
+		result, err = r.Reconcile(request)
+		if err == nil || !strings.Contains(err.Error(), "recovered from panic: boom") {
+			t.Fatalf("expected an error for the panic, got %v", err)
+		}
+		if result != (reconcile.Result{}) {
+			t.Errorf("expected an empty result for the panic, got %v", result)
+		}
+		if i < reconcilePanicThreshold && recurring != 0 {
+			t.Errorf("expected recurring panics not to be reported after %d panics", i)
+		}
+	}
+	if recurring != reconcilePanicThreshold {
+		t.Errorf("expected recurring panics to be reported with count %d, got %d", reconcilePanicThreshold, recurring)
+	}
+}
+
+func TestPanicTracker(t *testing.T) {
+	tracker := &panicTracker{}
+	start := time.Date(2020, 6, 1, 12, 0, 0, 0, time.UTC)
+	if count := tracker.record(start); count != 1 {
+		t.Errorf("expected 1 panic, got %d", count)
+	}
+	if count := tracker.record(start.Add(time.Minute)); count != 2 {
+		t.Errorf("expected 2 panics, got %d", count)
+	}
+	if count := tracker.record(start.Add(reconcilePanicWindow + 30*time.Second)); count != 2 {
+		t.Errorf("expected panics outside the window to be forgotten, got %d", count)
+	}
+}
+
+func TestComputeDNSReconcilePanickingConditions(t *testing.T) {
+	old := []operatorv1.OperatorCondition{
+		{Type: operatorv1.OperatorStatusTypeDegraded, Status: operatorv1.ConditionFalse},
+	}
+	conditions := computeDNSReconcilePanickingConditions(old, fmt.Errorf("recovered from panic: boom"))
+	if len(conditions) != 2 {
+		t.Fatalf("expected 2 conditions, got %v", conditions)
+	}
+	if conditions[0] != old[0] {
+		t.Errorf("expected other conditions to be kept, got %v", conditions[0])
+	}
+	c := conditions[1]
+	if c.Type != DNSReconcilePanickingConditionType || c.Status != operatorv1.ConditionTrue || !strings.Contains(c.Message, "boom") {
+		t.Errorf("unexpected condition %v", c)
+	}
+
+	// Reporting again does not add another condition.
+	if again := computeDNSReconcilePanickingConditions(conditions, fmt.Errorf("recovered from panic: boom")); len(again) != 2 {
+		t.Errorf("expected 2 conditions, got %v", again)
+	}
+}
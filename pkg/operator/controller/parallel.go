@@ -0,0 +1,28 @@
+package controller
+
+import (
+	"sync"
+
+	utilerrors "k8s.io/apimachinery/pkg/util/errors"
+)
+
+// runConcurrently calls the given functions concurrently, waits for all of
+// them to return, and returns the aggregate of their errors.  Unlike an
+// errgroup, it does not stop at the first error so that one failing resource
+// does not hide failures of the others.  Errors are reported in the order in
+// which the functions were given.  A panic in a function is recovered and
+// returned as its error because it cannot be recovered by the caller.
+func runConcurrently(fns ...func() error) error {
+	var wg sync.WaitGroup
+	errs := make([]error, len(fns))
+	for i := range fns {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			defer recoverPanic(controllerName, nil, &errs[i])
+			errs[i] = fns[i]()
+		}(i)
+	}
+	wg.Wait()
+	return utilerrors.NewAggregate(errs)
+}
@@ -0,0 +1,42 @@
+package controller
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+)
+
+func TestRunConcurrently(t *testing.T) {
+	if err := runConcurrently(); err != nil {
+		t.Errorf("expected no error for no functions, got %v", err)
+	}
+
+	// Each function blocks until all of them have started, so this only
+	// returns if they run concurrently.
+	var started sync.WaitGroup
+	started.Add(3)
+	fn := func(err error) func() error {
+		return func() error {
+			started.Done()
+			started.Wait()
+			return err
+		}
+	}
+	err := runConcurrently(fn(fmt.Errorf("foo")), fn(nil), fn(fmt.Errorf("bar")))
+	if err == nil {
+		t.Fatalf("expected an error")
+	}
+	if expected := "[foo, bar]"; err.Error() != expected {
+		t.Errorf("expected %q, got %q", expected, err.Error())
+	}
+}
+
+func TestRunConcurrentlyRecoversPanics(t *testing.T) {
+	err := runConcurrently(
+		func() error { return nil },
+		func() error { panic("boom") },
+	)
+	if err == nil || err.Error() != "recovered from panic: boom" {
+		t.Errorf("expected an error for the panic, got %v", err)
+	}
+}
@@ -0,0 +1,78 @@
+package controller
+
+import (
+	"fmt"
+
+	operatorv1 "github.com/openshift/api/operator/v1"
+
+	"github.com/openshift/cluster-dns-operator/pkg/manifests"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// OptionalCapabilities returns the cluster capabilities that gate optional dns
+// components.
+func OptionalCapabilities() []string {
+	capabilities := []string{}
+	for _, c := range dnsCapabilities {
+		capabilities = append(capabilities, string(c))
+	}
+	return capabilities
+}
+
+// ProfileManifests returns the resources that the operator deploys for the
+// default dns with an empty spec when the given cluster capabilities are
+// disabled, rendered with the images and CoreDNS version in the given config
+// and the given cluster IP and cluster domain.  They are meant for comparing what the operator
+// deploys across changes to the operator, so they include the resources of
+// optional components, such as the metrics integration, when those are
+// enabled, and they carry the owner references that the operator sets, without
+// UIDs.
+func ProfileManifests(config Config, clusterIP, clusterDomain string, disabledCapabilities []string) ([]runtime.Object, error) {
+	dns := &operatorv1.DNS{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: DefaultDNSController,
+		},
+	}
+	supported, _ := withoutUnsupportedFeatures(dns, config.CoreDNSVersion, config.CoreDNSExternalPlugins)
+	cm, err := desiredDNSConfigMap(supported, clusterDomain, nil, nil, nil, nil, nil, config.CoreDNSVersion)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build configmap: %v", err)
+	}
+	ds, err := desiredDNSDaemonSet(dns, clusterIP, clusterDomain, config.CoreDNSImage, config.OpenshiftCLIImage, config.KubeRBACProxyImage, false, disabledCapabilities)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build daemonset: %v", err)
+	}
+	trueVar := true
+	daemonsetRef := metav1.OwnerReference{
+		APIVersion: "apps/v1",
+		Kind:       "DaemonSet",
+		Name:       ds.Name,
+		Controller: &trueVar,
+	}
+	svc := desiredDNSService(dns, clusterIP, daemonsetRef)
+	objects := []runtime.Object{
+		manifests.DNSNamespace(),
+		desiredDNSClusterRole(),
+		manifests.DNSClusterRoleBinding(),
+		manifests.DNSServiceAccount(),
+		manifests.ViewerClusterRole(),
+		manifests.ViewerRole(),
+		manifests.ViewerRoleBinding(),
+		cm,
+		ds,
+		svc,
+		desiredKubeDNSService(dns),
+	}
+	if !capabilityDisabled(disabledCapabilities, MetricsCapability) {
+		objects = append(objects,
+			manifests.MetricsClusterRole(),
+			manifests.MetricsClusterRoleBinding(),
+			manifests.MetricsRole(),
+			manifests.MetricsRoleBinding(),
+			desiredServiceMonitor(dns, svc, daemonsetRef),
+		)
+	}
+	return objects, nil
+}
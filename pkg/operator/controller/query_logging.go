@@ -0,0 +1,102 @@
+package controller
+
+import (
+	"sort"
+	"strings"
+
+	operatorv1 "github.com/openshift/api/operator/v1"
+
+	"github.com/sirupsen/logrus"
+
+	"k8s.io/apimachinery/pkg/util/validation"
+)
+
+// corefileQueryLogRule is a query logging rule of a dns as it is rendered in the
+// Corefile.
+type corefileQueryLogRule struct {
+	// Zone is the zone to whose names the rule applies.
+	Zone string
+	// Class is the classes of responses that the log plugin logs for the
+	// names in the zone.
+	Class string
+}
+
+// corefileQueryLogClass returns the classes of the log plugin for the given
+// classes of a query logging rule, or an empty string if none of the classes
+// is known.
+func corefileQueryLogClass(classes []operatorv1.DNSQueryLogClass, name string) string {
+	names := []string{}
+	seen := map[string]struct{}{}
+	for _, class := range classes {
+		var c string
+		switch class {
+		case operatorv1.DNSQueryLogClassSuccess:
+			c = "success"
+		case operatorv1.DNSQueryLogClassDenial:
+			c = "denial"
+		case operatorv1.DNSQueryLogClassError:
+			c = "error"
+		case operatorv1.DNSQueryLogClassAll:
+			return "all"
+		default:
+			logrus.Warningf("ignoring unknown query log class %q of dns %s", class, name)
+			continue
+		}
+		if _, ok := seen[c]; ok {
+			continue
+		}
+		seen[c] = struct{}{}
+		names = append(names, c)
+	}
+	return strings.Join(names, " ")
+}
+
+// corefileQueryLogRules returns the query logging rules of the given dns, with
+// the rules for more specific zones first, because the log plugin logs each
+// query by the first rule that matches its name.  A zone that is not a valid
+// subdomain, or that an earlier rule already has, is ignored, as is a rule
+// without known classes.
+func corefileQueryLogRules(dns *operatorv1.DNS) []corefileQueryLogRule {
+	rules := []corefileQueryLogRule{}
+	seen := map[string]struct{}{}
+	for _, rule := range dns.Spec.QueryLogging {
+		class := corefileQueryLogClass(rule.Classes, dns.Name)
+		if len(class) == 0 {
+			logrus.Warningf("ignoring query logging rule for zones %s of dns %s: the rule has no valid classes", strings.Join(rule.Zones, ", "), dns.Name)
+			continue
+		}
+		for _, zone := range rule.Zones {
+			zone = strings.ToLower(strings.TrimSuffix(zone, "."))
+			if msgs := validation.IsDNS1123Subdomain(zone); len(msgs) != 0 {
+				logrus.Warningf("ignoring query logging zone %q of dns %s: %s", zone, dns.Name, strings.Join(msgs, ", "))
+				continue
+			}
+			if _, ok := seen[zone]; ok {
+				logrus.Warningf("ignoring duplicate query logging zone %s of dns %s", zone, dns.Name)
+				continue
+			}
+			seen[zone] = struct{}{}
+			rules = append(rules, corefileQueryLogRule{Zone: zone, Class: class})
+		}
+	}
+	sort.SliceStable(rules, func(i, j int) bool {
+		return strings.Count(rules[i].Zone, ".") > strings.Count(rules[j].Zone, ".")
+	})
+	return rules
+}
+
+// zoneOverlaps returns a Boolean indicating whether the given zone of a query
+// logging rule has names in common with any of the given zones of a server
+// block.
+func zoneOverlaps(zone string, zones []string) bool {
+	for _, z := range zones {
+		z = strings.ToLower(strings.TrimSuffix(z, "."))
+		switch {
+		case len(z) == 0, z == zone:
+			return true
+		case strings.HasSuffix(zone, "."+z), strings.HasSuffix(z, "."+zone):
+			return true
+		}
+	}
+	return false
+}
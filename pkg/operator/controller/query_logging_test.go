@@ -0,0 +1,57 @@
+package controller
+
+import (
+	"reflect"
+	"testing"
+
+	operatorv1 "github.com/openshift/api/operator/v1"
+)
+
+func TestCorefileQueryLogRules(t *testing.T) {
+	dns := &operatorv1.DNS{
+		Spec: operatorv1.DNSSpec{
+			QueryLogging: []operatorv1.DNSQueryLogRule{
+				{
+					Zones:   []string{"example.com.", "Cluster.Local", "not_a_zone"},
+					Classes: []operatorv1.DNSQueryLogClass{operatorv1.DNSQueryLogClassDenial, operatorv1.DNSQueryLogClassError, operatorv1.DNSQueryLogClassDenial},
+				},
+				{
+					Zones:   []string{"svc.cluster.local", "example.com"},
+					Classes: []operatorv1.DNSQueryLogClass{operatorv1.DNSQueryLogClassSuccess, operatorv1.DNSQueryLogClassAll},
+				},
+				{
+					Zones:   []string{"ignored.example.com"},
+					Classes: []operatorv1.DNSQueryLogClass{"Verbose"},
+				},
+			},
+		},
+	}
+	expected := []corefileQueryLogRule{
+		{Zone: "svc.cluster.local", Class: "all"},
+		{Zone: "example.com", Class: "denial error"},
+		{Zone: "cluster.local", Class: "denial error"},
+	}
+	if actual := corefileQueryLogRules(dns); !reflect.DeepEqual(actual, expected) {
+		t.Errorf("expected %v, got %v", expected, actual)
+	}
+}
+
+func TestZoneOverlaps(t *testing.T) {
+	testCases := []struct {
+		zone     string
+		zones    []string
+		expected bool
+	}{
+		{"example.com", []string{"."}, true},
+		{"example.com", []string{"example.com."}, true},
+		{"a.example.com", []string{"example.com"}, true},
+		{"example.com", []string{"a.example.com"}, true},
+		{"example.com", []string{"notexample.com", "example.org"}, false},
+		{"example.com", []string{"cluster.local", "Example.COM"}, true},
+	}
+	for _, tc := range testCases {
+		if actual := zoneOverlaps(tc.zone, tc.zones); actual != tc.expected {
+			t.Errorf("zone %s and zones %v: expected %t, got %t", tc.zone, tc.zones, tc.expected, actual)
+		}
+	}
+}
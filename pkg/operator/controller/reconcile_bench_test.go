@@ -0,0 +1,187 @@
+package controller
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	operatorv1 "github.com/openshift/api/operator/v1"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// largeServerCount is the number of servers of the synthetic dns spec against
+// which the performance budgets are checked.
+const largeServerCount = 1000
+
+// Allocation budgets for a dns with largeServerCount servers.  Rendering the
+// Corefile allocates about 29,000 times and computing the desired state of a
+// reconcile about 88,000 times, or somewhat more with the race detector, so
+// the budgets leave about twice that as headroom and only substantial
+// regressions, such as a render that becomes quadratic in the number of
+// servers, fail TestAllocationBudgets.  Allocations do not depend on the
+// machine, unlike time, whose budgets are only checked by "make test-perf".
+const (
+	renderCorefileAllocsBudget        = 60000
+	reconcileDesiredStateAllocsBudget = 180000
+)
+
+// largeDNS returns a dns with the given number of servers, each with two zones,
+// two upstreams, and forward options, and with a cluster peer, a service
+// alias, and an internal zone for every tenth server and a node override with
+// half of the servers, to approximate the spec of a cluster with a large
+// configuration.
+func largeDNS(servers int) *operatorv1.DNS {
+	dns := &operatorv1.DNS{
+		ObjectMeta: metav1.ObjectMeta{Name: DefaultDNSController},
+	}
+	for i := 0; i < servers; i++ {
+		dns.Spec.Servers = append(dns.Spec.Servers, operatorv1.Server{
+			Name:  fmt.Sprintf("server-%d", i),
+			Zones: []string{fmt.Sprintf("zone-%d.example.com", i), fmt.Sprintf("zone-%d.example.org", i)},
+			ForwardPlugin: operatorv1.ForwardPlugin{
+				Upstreams:          []string{fmt.Sprintf("10.%d.%d.1", i/256, i%256), fmt.Sprintf("10.%d.%d.2:5353", i/256, i%256)},
+				Expire:             &metav1.Duration{Duration: time.Minute},
+				ProtocolPreference: operatorv1.DNSProtocolPreferencePreferUDP,
+			},
+		})
+		if i%10 != 0 {
+			continue
+		}
+		dns.Spec.ClusterPeers = append(dns.Spec.ClusterPeers, operatorv1.DNSClusterPeer{
+			Name:          fmt.Sprintf("peer-%d", i),
+			ClusterDomain: fmt.Sprintf("peer-%d.local", i),
+			Nameservers:   []string{fmt.Sprintf("172.16.%d.%d", i/256, i%256)},
+		})
+		dns.Spec.ServiceAliases = append(dns.Spec.ServiceAliases, operatorv1.DNSServiceAlias{
+			Name:    fmt.Sprintf("alias-%d.example.net", i),
+			Service: operatorv1.DNSServiceReference{Namespace: "apps", Name: fmt.Sprintf("service-%d", i)},
+		})
+		dns.Spec.InternalNames.Zones = append(dns.Spec.InternalNames.Zones, fmt.Sprintf("internal-%d.example.com", i))
+	}
+	dns.Spec.NodeOverrides = []operatorv1.DNSNodeOverride{{
+		Name:         "edge",
+		NodeSelector: map[string]string{"node-role.kubernetes.io/edge": ""},
+		Servers:      dns.Spec.Servers[:servers/2],
+	}}
+	return dns
+}
+
+// reconcileDesiredState computes the desired state of the given dns as a
+// reconcile does, without the API calls: it renders the Corefiles, checks their
+// compatibility, splits them, builds the daemonset, and compares the configmap
+// and daemonset with the given current ones.
+func reconcileDesiredState(dns *operatorv1.DNS, currentCM *corev1.ConfigMap, currentDS *appsv1.DaemonSet) error {
+	cm, err := desiredDNSConfigMap(dns, "cluster.local", nil, nil, nil, nil, nil, "1.8.4")
+	if err != nil {
+		return err
+	}
+	checkCorefileCompatibility(allCorefiles(cm), "1.8.4", "")
+	if _, _, err := splitCorefiles(cm.Data, corefileConfigMapSizeLimit); err != nil {
+		return err
+	}
+	corefileChanged(currentCM, cm)
+	ds, err := desiredDNSDaemonSet(dns, "172.30.0.10", "cluster.local", "coredns", "cli", "proxy", false, nil)
+	if err != nil {
+		return err
+	}
+	daemonsetConfigChanged(currentDS, ds)
+	return nil
+}
+
+func BenchmarkRenderCorefile(b *testing.B) {
+	for _, servers := range []int{10, 100, largeServerCount} {
+		dns := largeDNS(servers)
+		b.Run(fmt.Sprintf("servers=%d", servers), func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				if _, err := renderCorefile(dns, "cluster.local", nil, nil, nil, nil, nil, ""); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
+
+func BenchmarkReconcileDesiredState(b *testing.B) {
+	for _, servers := range []int{10, 100, largeServerCount} {
+		dns, cm, ds := reconcileBenchmarkState(b, servers)
+		b.Run(fmt.Sprintf("servers=%d", servers), func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				if err := reconcileDesiredState(dns, cm, ds); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
+
+// reconcileBenchmarkState returns a large dns with the given number of servers
+// and the configmap and daemonset of a dns with one server less, so that the
+// comparisons find a change as in a reconcile after an update of the spec.
+func reconcileBenchmarkState(tb testing.TB, servers int) (*operatorv1.DNS, *corev1.ConfigMap, *appsv1.DaemonSet) {
+	dns := largeDNS(servers)
+	previous := dns.DeepCopy()
+	previous.Spec.Servers = previous.Spec.Servers[1:]
+	cm, err := desiredDNSConfigMap(previous, "cluster.local", nil, nil, nil, nil, nil, "")
+	if err != nil {
+		tb.Fatal(err)
+	}
+	ds, err := desiredDNSDaemonSet(previous, "172.30.0.10", "cluster.local", "coredns", "cli", "proxy", false, nil)
+	if err != nil {
+		tb.Fatal(err)
+	}
+	return dns, cm, ds
+}
+
+// performanceBudgetCase is an operation whose cost is budgeted.
+type performanceBudgetCase struct {
+	name string
+	run  func() error
+}
+
+// performanceBudgetCases returns the operations whose cost is budgeted for a
+// dns with largeServerCount servers.
+func performanceBudgetCases(t *testing.T) []performanceBudgetCase {
+	dns, cm, ds := reconcileBenchmarkState(t, largeServerCount)
+	return []performanceBudgetCase{
+		{
+			name: "render Corefile",
+			run: func() error {
+				_, err := renderCorefile(dns, "cluster.local", nil, nil, nil, nil, nil, "")
+				return err
+			},
+		},
+		{
+			name: "reconcile desired state",
+			run: func() error {
+				return reconcileDesiredState(dns, cm, ds)
+			},
+		},
+	}
+}
+
+// TestAllocationBudgets fails if rendering the Corefile or computing the
+// desired state of a reconcile for a dns with largeServerCount servers
+// allocates more than its budget.
+func TestAllocationBudgets(t *testing.T) {
+	budgets := map[string]float64{
+		"render Corefile":         renderCorefileAllocsBudget,
+		"reconcile desired state": reconcileDesiredStateAllocsBudget,
+	}
+	for _, tc := range performanceBudgetCases(t) {
+		t.Run(tc.name, func(t *testing.T) {
+			if err := tc.run(); err != nil {
+				t.Fatal(err)
+			}
+			allocs := testing.AllocsPerRun(3, func() { _ = tc.run() })
+			t.Logf("%.0f allocations (budget %.0f)", allocs, budgets[tc.name])
+			if allocs > budgets[tc.name] {
+				t.Errorf("%.0f allocations exceed the budget of %.0f", allocs, budgets[tc.name])
+			}
+		})
+	}
+}
@@ -0,0 +1,98 @@
+package controller
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	operatorv1 "github.com/openshift/api/operator/v1"
+
+	"github.com/sirupsen/logrus"
+
+	corev1 "k8s.io/api/core/v1"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+// reconcileFailureEventInterval is the minimum interval between events for
+// repeated failures to reconcile a dns with the same error.
+const reconcileFailureEventInterval = 10 * time.Minute
+
+// reconcileFailureTracker summarizes repeated failures to reconcile the dns so
+// that a persistent error is reported in an occasional event with a count
+// rather than in an event for every retry.
+type reconcileFailureTracker struct {
+	lock sync.Mutex
+	// message is the error of the current run of failures.
+	message string
+	// count is the number of failures with message.
+	count int
+	// since is the time of the first failure with message.
+	since time.Time
+	// lastEvent is the time at which the last event for message was
+	// emitted.
+	lastEvent time.Time
+}
+
+// record records a failure with the given error message at the given time.  It
+// returns the message of the event to emit for the failure, or an empty string
+// if an event for the same error was emitted within
+// reconcileFailureEventInterval.
+func (t *reconcileFailureTracker) record(message string, now time.Time) string {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	if message != t.message {
+		t.message = message
+		t.count = 0
+		t.since = now
+		t.lastEvent = time.Time{}
+	}
+	t.count++
+	if !t.lastEvent.IsZero() && now.Sub(t.lastEvent) < reconcileFailureEventInterval {
+		return ""
+	}
+	t.lastEvent = now
+	if t.count == 1 {
+		return fmt.Sprintf("Failed to reconcile: %s", message)
+	}
+	return fmt.Sprintf("Failed to reconcile %d times since %s: %s", t.count, t.since.UTC().Format(time.RFC3339), message)
+}
+
+// reset forgets the current run of failures after a successful reconcile.
+func (t *reconcileFailureTracker) reset() {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	t.message = ""
+	t.count = 0
+	t.since = time.Time{}
+	t.lastEvent = time.Time{}
+}
+
+// reportReconcileFailure records a failure to reconcile the given dns.  If an
+// event is due for the failure, a warning event is emitted and the last failure
+// time in the status of the dns is updated.  The status is not updated for
+// failures that are summarized into an earlier event so that the status update
+// does not trigger another reconcile and bypass the backoff for the failure.
+func (r *reconciler) reportReconcileFailure(dns *operatorv1.DNS, err error) error {
+	now := time.Now()
+	message := r.reconcileFailures.record(err.Error(), now)
+	if len(message) == 0 {
+		return nil
+	}
+	r.recorder.Event(dns, corev1.EventTypeWarning, "ReconcileFailed", message)
+
+	current := &operatorv1.DNS{}
+	if err := r.client.Get(context.TODO(), types.NamespacedName{Name: dns.Name}, current); err != nil {
+		return fmt.Errorf("failed to get dns %s: %v", dns.Name, err)
+	}
+	updated := current.DeepCopy()
+	t := metav1.NewTime(now)
+	updated.Status.LastFailure = &t
+	if err := r.client.Status().Update(context.TODO(), updated); err != nil {
+		return fmt.Errorf("failed to update last failure time of dns %s: %v", dns.Name, err)
+	}
+	logrus.Infof("updated last failure time of dns %s to %s", dns.Name, t.UTC().Format(time.RFC3339))
+	return nil
+}
@@ -0,0 +1,61 @@
+package controller
+
+import (
+	"testing"
+	"time"
+)
+
+func TestReconcileFailureTracker(t *testing.T) {
+	start := time.Date(2020, 6, 1, 12, 0, 0, 0, time.UTC)
+	tracker := &reconcileFailureTracker{}
+
+	steps := []struct {
+		description string
+		message     string
+		after       time.Duration
+		expect      string
+	}{
+		{
+			description: "first failure",
+			message:     "foo",
+			after:       0,
+			expect:      "Failed to reconcile: foo",
+		},
+		{
+			description: "same failure within the interval",
+			message:     "foo",
+			after:       time.Minute,
+			expect:      "",
+		},
+		{
+			description: "same failure again within the interval",
+			message:     "foo",
+			after:       5 * time.Minute,
+			expect:      "",
+		},
+		{
+			description: "same failure after the interval",
+			message:     "foo",
+			after:       reconcileFailureEventInterval,
+			expect:      "Failed to reconcile 4 times since 2020-06-01T12:00:00Z: foo",
+		},
+		{
+			description: "different failure within the interval",
+			message:     "bar",
+			after:       reconcileFailureEventInterval + time.Minute,
+			expect:      "Failed to reconcile: bar",
+		},
+	}
+	for _, step := range steps {
+		if actual := tracker.record(step.message, start.Add(step.after)); actual != step.expect {
+			t.Errorf("%s: expected %q, got %q", step.description, step.expect, actual)
+		}
+	}
+
+	// After a successful reconcile, the same failure is reported again
+	// right away.
+	tracker.reset()
+	if actual, expect := tracker.record("bar", start.Add(reconcileFailureEventInterval+2*time.Minute)), "Failed to reconcile: bar"; actual != expect {
+		t.Errorf("after reset: expected %q, got %q", expect, actual)
+	}
+}
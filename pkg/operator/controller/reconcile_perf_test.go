@@ -0,0 +1,45 @@
+//go:build perf
+// +build perf
+
+package controller
+
+import (
+	"testing"
+	"time"
+)
+
+// Time budgets for a dns with largeServerCount servers.  They are generous
+// enough for slow or shared machines, but wall-clock time still varies too
+// much to check in every test run, so they are only checked with the perf
+// build tag, which "make test-perf" sets.
+const (
+	renderCorefileTimeBudget        = 100 * time.Millisecond
+	reconcileDesiredStateTimeBudget = 200 * time.Millisecond
+)
+
+// TestTimeBudgets fails if rendering the Corefile or computing the desired
+// state of a reconcile for a dns with largeServerCount servers takes more than
+// its budget.
+func TestTimeBudgets(t *testing.T) {
+	budgets := map[string]time.Duration{
+		"render Corefile":         renderCorefileTimeBudget,
+		"reconcile desired state": reconcileDesiredStateTimeBudget,
+	}
+	for _, tc := range performanceBudgetCases(t) {
+		t.Run(tc.name, func(t *testing.T) {
+			if err := tc.run(); err != nil {
+				t.Fatal(err)
+			}
+			result := testing.Benchmark(func(b *testing.B) {
+				for i := 0; i < b.N; i++ {
+					_ = tc.run()
+				}
+			})
+			elapsed := time.Duration(result.NsPerOp())
+			t.Logf("%v per run (budget %v)", elapsed, budgets[tc.name])
+			if elapsed > budgets[tc.name] {
+				t.Errorf("%v per run exceeds the budget of %v", elapsed, budgets[tc.name])
+			}
+		})
+	}
+}
@@ -0,0 +1,44 @@
+package controller
+
+import (
+	"fmt"
+	"strings"
+
+	operatorv1 "github.com/openshift/api/operator/v1"
+	"github.com/openshift/cluster-dns-operator/pkg/manifests"
+	"github.com/openshift/cluster-dns-operator/pkg/util/conditions"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// DNSReconciliationPausedConditionType is the type of the dns status condition
+// that reports whether reconciliation of any managed resource of the dns is
+// paused.
+const DNSReconciliationPausedConditionType = "ReconciliationPaused"
+
+// reconciliationPaused returns a Boolean indicating whether the given object
+// has the annotation that pauses its reconciliation.
+func reconciliationPaused(obj metav1.Object) bool {
+	return obj.GetAnnotations()[manifests.PauseReconciliationAnnotation] == "true"
+}
+
+// computeDNSReconciliationPausedCondition computes the dns
+// ReconciliationPaused status condition from the descriptions of the paused
+// resources.
+func computeDNSReconciliationPausedCondition(oldConditions []operatorv1.OperatorCondition, paused []string) operatorv1.OperatorCondition {
+	oldCondition := conditions.FindOperatorCondition(oldConditions, DNSReconciliationPausedConditionType)
+
+	condition := &operatorv1.OperatorCondition{
+		Type: DNSReconciliationPausedConditionType,
+	}
+	if len(paused) == 0 {
+		condition.Status = operatorv1.ConditionFalse
+		condition.Reason = "AsExpected"
+		condition.Message = "Reconciliation of all resources is enabled"
+	} else {
+		condition.Status = operatorv1.ConditionTrue
+		condition.Reason = "Paused"
+		condition.Message = fmt.Sprintf("Reconciliation is paused for %s", strings.Join(paused, "; "))
+	}
+	return conditions.SetOperatorConditionTransitionTime(condition, oldCondition)
+}
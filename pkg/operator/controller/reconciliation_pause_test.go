@@ -0,0 +1,60 @@
+package controller
+
+import (
+	"testing"
+
+	operatorv1 "github.com/openshift/api/operator/v1"
+	"github.com/openshift/cluster-dns-operator/pkg/manifests"
+
+	appsv1 "k8s.io/api/apps/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestReconciliationPaused(t *testing.T) {
+	testCases := []struct {
+		description string
+		annotations map[string]string
+		expect      bool
+	}{
+		{
+			description: "no annotations",
+			expect:      false,
+		},
+		{
+			description: "annotation set to true",
+			annotations: map[string]string{manifests.PauseReconciliationAnnotation: "true"},
+			expect:      true,
+		},
+		{
+			description: "annotation set to false",
+			annotations: map[string]string{manifests.PauseReconciliationAnnotation: "false"},
+			expect:      false,
+		},
+	}
+	for _, tc := range testCases {
+		ds := &appsv1.DaemonSet{ObjectMeta: metav1.ObjectMeta{Annotations: tc.annotations}}
+		if actual := reconciliationPaused(ds); actual != tc.expect {
+			t.Errorf("%q: expected %t, got %t", tc.description, tc.expect, actual)
+		}
+	}
+}
+
+func TestComputeDNSReconciliationPausedCondition(t *testing.T) {
+	condition := computeDNSReconciliationPausedCondition(nil, nil)
+	if condition.Status != operatorv1.ConditionFalse {
+		t.Errorf("expected status %s with no paused resources, got %s", operatorv1.ConditionFalse, condition.Status)
+	}
+
+	paused := []string{"daemonset openshift-dns/dns-default has annotation " + manifests.PauseReconciliationAnnotation}
+	condition = computeDNSReconciliationPausedCondition([]operatorv1.OperatorCondition{condition}, paused)
+	if condition.Status != operatorv1.ConditionTrue {
+		t.Errorf("expected status %s with paused resources, got %s", operatorv1.ConditionTrue, condition.Status)
+	}
+
+	// The transition time must not change while the same resources stay
+	// paused.
+	again := computeDNSReconciliationPausedCondition([]operatorv1.OperatorCondition{condition}, paused)
+	if !again.LastTransitionTime.Equal(&condition.LastTransitionTime) {
+		t.Errorf("expected last transition time %v, got %v", condition.LastTransitionTime, again.LastTransitionTime)
+	}
+}
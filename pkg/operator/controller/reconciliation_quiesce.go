@@ -0,0 +1,99 @@
+package controller
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	operatorv1 "github.com/openshift/api/operator/v1"
+	"github.com/openshift/cluster-dns-operator/pkg/manifests"
+	"github.com/openshift/cluster-dns-operator/pkg/util/conditions"
+
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	// maxReconciliationQuiescePeriod is the longest that reconciliation of
+	// a dns may be paused for with the PauseReconciliationUntilAnnotation
+	// annotation, so that a component that fails to remove the annotation
+	// does not stop reconciliation indefinitely.
+	maxReconciliationQuiescePeriod = 24 * time.Hour
+
+	// reconciliationQuiescedReason is the reason of the
+	// ReconciliationPaused status condition while reconciliation of the
+	// whole dns is paused.
+	reconciliationQuiescedReason = "Quiesced"
+)
+
+// reconciliationPausedUntil returns the time until which the
+// PauseReconciliationUntilAnnotation annotation of the given dns pauses its
+// reconciliation and a Boolean indicating whether reconciliation is paused at
+// the given time.  An annotation that is not a valid time, or that is more than
+// maxReconciliationQuiescePeriod in the future, is ignored.
+func reconciliationPausedUntil(dns *operatorv1.DNS, now time.Time) (time.Time, bool) {
+	value, ok := dns.Annotations[manifests.PauseReconciliationUntilAnnotation]
+	if !ok {
+		return time.Time{}, false
+	}
+	until, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		logrus.Warningf("ignoring annotation %s of dns %s: %v", manifests.PauseReconciliationUntilAnnotation, dns.Name, err)
+		return time.Time{}, false
+	}
+	if until.Sub(now) > maxReconciliationQuiescePeriod {
+		logrus.Warningf("ignoring annotation %s of dns %s: %s is more than %s in the future", manifests.PauseReconciliationUntilAnnotation, dns.Name, value, maxReconciliationQuiescePeriod)
+		return time.Time{}, false
+	}
+	return until, now.Before(until)
+}
+
+// reconciliationQuiesced returns a Boolean indicating whether the status of the
+// given dns reports that reconciliation of the whole dns is paused.
+func reconciliationQuiesced(dns *operatorv1.DNS) bool {
+	c := conditions.FindOperatorCondition(dns.Status.Conditions, DNSReconciliationPausedConditionType)
+	return c != nil && c.Status == operatorv1.ConditionTrue && c.Reason == reconciliationQuiescedReason
+}
+
+// syncDNSQuiescedStatus reports in the status of the given dns that its
+// reconciliation is paused until the given time.
+func (r *reconciler) syncDNSQuiescedStatus(dns *operatorv1.DNS, until time.Time) error {
+	updated := dns.DeepCopy()
+	updated.Status.Conditions = computeDNSQuiescedConditions(dns.Status.Conditions, until)
+	if !dnsStatusesEqual(updated.Status, dns.Status) {
+		if err := r.client.Status().Update(context.TODO(), updated); err != nil {
+			return fmt.Errorf("failed to update dns status: %v", err)
+		}
+		logrus.Infof("updated DNS %s status: old: %#v, new: %#v", dns.ObjectMeta.Name, dns.Status, updated.Status)
+	}
+	return nil
+}
+
+// computeDNSQuiescedConditions returns the given dns status conditions with the
+// ReconciliationPaused condition replaced to report that reconciliation of the
+// whole dns is paused until the given time.  The other conditions are kept, as
+// the operator does not check the resources that they report on.
+func computeDNSQuiescedConditions(oldConditions []operatorv1.OperatorCondition, until time.Time) []operatorv1.OperatorCondition {
+	condition := &operatorv1.OperatorCondition{
+		Type:    DNSReconciliationPausedConditionType,
+		Status:  operatorv1.ConditionTrue,
+		Reason:  reconciliationQuiescedReason,
+		Message: fmt.Sprintf("Reconciliation of the DNS is paused until %s by annotation %s", until.UTC().Format(time.RFC3339), manifests.PauseReconciliationUntilAnnotation),
+	}
+	kept := []operatorv1.OperatorCondition{}
+	for i := range oldConditions {
+		if oldConditions[i].Type != DNSReconciliationPausedConditionType {
+			kept = append(kept, oldConditions[i])
+		}
+	}
+	return append(kept, conditions.SetOperatorConditionTransitionTime(condition, conditions.FindOperatorCondition(oldConditions, DNSReconciliationPausedConditionType)))
+}
+
+// resumeReconciliation prepares for a full resync of the given dns after its
+// reconciliation was paused.  Its resources may have been changed, or restored
+// to older revisions, in the meantime, so what the operator remembers of its
+// updates is forgotten rather than taken as evidence of conflicts.
+func (r *reconciler) resumeReconciliation(dns *operatorv1.DNS) {
+	logrus.Infof("resuming reconciliation of dns %s with a full resync", dns.Name)
+	r.updateConflicts.forget()
+	r.history.record(dns.Name, dnsHistoryReconciliationResumed, "Resumed reconciliation after it was paused")
+}
@@ -0,0 +1,72 @@
+package controller
+
+import (
+	"testing"
+	"time"
+
+	operatorv1 "github.com/openshift/api/operator/v1"
+	"github.com/openshift/cluster-dns-operator/pkg/manifests"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestReconciliationPausedUntil(t *testing.T) {
+	now := time.Date(2020, 6, 1, 12, 0, 0, 0, time.UTC)
+	testCases := []struct {
+		description string
+		annotations map[string]string
+		expect      bool
+	}{
+		{
+			description: "no annotation",
+			expect:      false,
+		},
+		{
+			description: "time in the future",
+			annotations: map[string]string{manifests.PauseReconciliationUntilAnnotation: "2020-06-01T13:00:00Z"},
+			expect:      true,
+		},
+		{
+			description: "time in the past",
+			annotations: map[string]string{manifests.PauseReconciliationUntilAnnotation: "2020-06-01T11:00:00Z"},
+			expect:      false,
+		},
+		{
+			description: "time too far in the future",
+			annotations: map[string]string{manifests.PauseReconciliationUntilAnnotation: "2020-06-03T12:00:00Z"},
+			expect:      false,
+		},
+		{
+			description: "invalid time",
+			annotations: map[string]string{manifests.PauseReconciliationUntilAnnotation: "true"},
+			expect:      false,
+		},
+	}
+	for _, tc := range testCases {
+		dns := &operatorv1.DNS{ObjectMeta: metav1.ObjectMeta{Name: DefaultDNSController, Annotations: tc.annotations}}
+		if _, actual := reconciliationPausedUntil(dns, now); actual != tc.expect {
+			t.Errorf("%q: expected %t, got %t", tc.description, tc.expect, actual)
+		}
+	}
+}
+
+func TestComputeDNSQuiescedConditions(t *testing.T) {
+	until := time.Date(2020, 6, 1, 13, 0, 0, 0, time.UTC)
+	old := []operatorv1.OperatorCondition{
+		{Type: operatorv1.OperatorStatusTypeAvailable, Status: operatorv1.ConditionTrue},
+		computeDNSReconciliationPausedCondition(nil, nil),
+	}
+	dns := &operatorv1.DNS{Status: operatorv1.DNSStatus{Conditions: computeDNSQuiescedConditions(old, until)}}
+	if len(dns.Status.Conditions) != 2 || dns.Status.Conditions[0].Type != operatorv1.OperatorStatusTypeAvailable {
+		t.Fatalf("expected the Available condition to be kept, got %+v", dns.Status.Conditions)
+	}
+	if !reconciliationQuiesced(dns) {
+		t.Errorf("expected the conditions to report that reconciliation is paused, got %+v", dns.Status.Conditions)
+	}
+
+	// Once reconciliation resumes, the condition no longer reports it.
+	dns.Status.Conditions = []operatorv1.OperatorCondition{computeDNSReconciliationPausedCondition(dns.Status.Conditions, nil)}
+	if reconciliationQuiesced(dns) {
+		t.Errorf("expected the conditions to report that reconciliation is not paused, got %+v", dns.Status.Conditions)
+	}
+}
@@ -0,0 +1,161 @@
+package controller
+
+import (
+	"context"
+	"sort"
+	"sync"
+
+	operatorv1 "github.com/openshift/api/operator/v1"
+
+	corev1 "k8s.io/api/core/v1"
+
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+// objectReference identifies a configmap or secret that a dns refers to but
+// does not own, such as a configmap that requests an extension server.
+type objectReference struct {
+	// kind is "configmap" or "secret".
+	kind string
+	name types.NamespacedName
+}
+
+// referenceIndex records the configmaps and secrets that each dns refers to so
+// that a change to one of them requeues the dnses that refer to it.  The
+// references of a dns are recorded while the dns is reconciled: begin starts
+// recording, add records a reference, and end replaces the references from the
+// previous reconcile with the recorded ones.  References from the previous
+// reconcile are kept until end so that a change during the reconcile is not
+// missed.
+type referenceIndex struct {
+	lock sync.Mutex
+	// current maps the name of each dns to the objects that it referred to
+	// as of its last reconcile, plus any recorded since.
+	current map[string]map[objectReference]struct{}
+	// pending maps the name of each dns that is being reconciled to the
+	// objects that it has referred to so far.
+	pending map[string]map[objectReference]struct{}
+}
+
+func newReferenceIndex() *referenceIndex {
+	return &referenceIndex{
+		current: map[string]map[objectReference]struct{}{},
+		pending: map[string]map[objectReference]struct{}{},
+	}
+}
+
+// begin starts recording the references of the named dns.
+func (i *referenceIndex) begin(dns string) {
+	i.lock.Lock()
+	defer i.lock.Unlock()
+	i.pending[dns] = map[objectReference]struct{}{}
+}
+
+// add records that the named dns refers to the given object.
+func (i *referenceIndex) add(dns string, ref objectReference) {
+	i.lock.Lock()
+	defer i.lock.Unlock()
+	if i.current[dns] == nil {
+		i.current[dns] = map[objectReference]struct{}{}
+	}
+	i.current[dns][ref] = struct{}{}
+	if refs, ok := i.pending[dns]; ok {
+		refs[ref] = struct{}{}
+	}
+}
+
+// end replaces the references of the named dns with those recorded since
+// begin.  It does nothing if recording was not started.
+func (i *referenceIndex) end(dns string) {
+	i.lock.Lock()
+	defer i.lock.Unlock()
+	if refs, ok := i.pending[dns]; ok {
+		i.current[dns] = refs
+		delete(i.pending, dns)
+	}
+}
+
+// forget removes the references of the named dns.
+func (i *referenceIndex) forget(dns string) {
+	i.lock.Lock()
+	defer i.lock.Unlock()
+	delete(i.current, dns)
+	delete(i.pending, dns)
+}
+
+// referrers returns the sorted names of the dnses that refer to the given
+// object.
+func (i *referenceIndex) referrers(ref objectReference) []string {
+	i.lock.Lock()
+	defer i.lock.Unlock()
+	names := []string{}
+	for dns, refs := range i.current {
+		if _, ok := refs[ref]; ok {
+			names = append(names, dns)
+		}
+	}
+	sort.Strings(names)
+	return names
+}
+
+// enqueueReferrers returns an event handler that requeues the dnses that refer
+// to a changed object of the given kind.  For configmaps, the dns named by the
+// extension server label is requeued as well so that a configmap that newly
+// requests an extension server is noticed.
+func (r *reconciler) enqueueReferrers(kind string) handler.EventHandler {
+	return &handler.EnqueueRequestsFromMapFunc{
+		ToRequests: handler.ToRequestsFunc(func(o handler.MapObject) []reconcile.Request {
+			ref := objectReference{
+				kind: kind,
+				name: types.NamespacedName{Namespace: o.Meta.GetNamespace(), Name: o.Meta.GetName()},
+			}
+			names := r.references.referrers(ref)
+			if kind == "configmap" {
+				if name, ok := o.Meta.GetLabels()[ExtensionServerLabel]; ok {
+					names = append(names, name)
+				}
+			}
+			requests := []reconcile.Request{}
+			for _, name := range names {
+				requests = append(requests, reconcile.Request{NamespacedName: types.NamespacedName{Name: name}})
+			}
+			return requests
+		}),
+	}
+}
+
+// getReferencedConfigMap gets the named configmap on behalf of the given dns
+// and records the reference so that the dns is reconciled when the configmap
+// changes, including when it is created later.  It returns nil if the
+// configmap does not exist.
+func (r *reconciler) getReferencedConfigMap(dns *operatorv1.DNS, name types.NamespacedName) (*corev1.ConfigMap, error) {
+	r.references.add(dns.Name, objectReference{kind: "configmap", name: name})
+	cm := &corev1.ConfigMap{}
+	if err := r.client.Get(context.TODO(), name, cm); err != nil {
+		if errors.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return cm, nil
+}
+
+// getReferencedSecret gets the named secret on behalf of the given dns and
+// records the reference so that the dns is reconciled when the secret changes,
+// including when it is created later.  It returns nil if the secret does not
+// exist.
+func (r *reconciler) getReferencedSecret(dns *operatorv1.DNS, name types.NamespacedName) (*corev1.Secret, error) {
+	r.references.add(dns.Name, objectReference{kind: "secret", name: name})
+	secret := &corev1.Secret{}
+	if err := r.client.Get(context.TODO(), name, secret); err != nil {
+		if errors.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return secret, nil
+}
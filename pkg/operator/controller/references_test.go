@@ -0,0 +1,50 @@
+package controller
+
+import (
+	"reflect"
+	"testing"
+
+	"k8s.io/apimachinery/pkg/types"
+)
+
+func TestReferenceIndex(t *testing.T) {
+	foo := objectReference{kind: "configmap", name: types.NamespacedName{Namespace: "openshift-dns", Name: "foo"}}
+	bar := objectReference{kind: "secret", name: types.NamespacedName{Namespace: "openshift-dns", Name: "bar"}}
+	// A configmap and a secret with the same name are different objects.
+	barConfigMap := objectReference{kind: "configmap", name: bar.name}
+
+	index := newReferenceIndex()
+	expectReferrers := func(description string, ref objectReference, expected []string) {
+		t.Helper()
+		if actual := index.referrers(ref); !reflect.DeepEqual(actual, expected) {
+			t.Errorf("%s: expected referrers of %v to be %v, got %v", description, ref, expected, actual)
+		}
+	}
+
+	index.begin("default")
+	index.add("default", foo)
+	index.add("default", bar)
+	index.end("default")
+	index.begin("other")
+	index.add("other", foo)
+	index.end("other")
+	expectReferrers("after first reconcile", foo, []string{"default", "other"})
+	expectReferrers("after first reconcile", bar, []string{"default"})
+	expectReferrers("after first reconcile", barConfigMap, []string{})
+
+	// References from the previous reconcile are kept until it ends.
+	index.begin("default")
+	index.add("default", foo)
+	expectReferrers("during second reconcile", bar, []string{"default"})
+	index.end("default")
+	expectReferrers("after second reconcile", bar, []string{})
+	expectReferrers("after second reconcile", foo, []string{"default", "other"})
+
+	// References recorded outside of a reconcile are added.
+	index.add("other", bar)
+	expectReferrers("after adding outside of a reconcile", bar, []string{"other"})
+
+	index.forget("other")
+	expectReferrers("after forgetting", foo, []string{"default"})
+	expectReferrers("after forgetting", bar, []string{})
+}
@@ -0,0 +1,169 @@
+package controller
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/openshift/cluster-dns-operator/pkg/manifests"
+
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/sirupsen/logrus"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	toolscache "k8s.io/client-go/tools/cache"
+
+	"sigs.k8s.io/controller-runtime/pkg/cache"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+)
+
+// cachedObjects is the number of objects in each informer of the operator's
+// caches, so that the memory and watch load of the operator can be attributed
+// to the resources that it watches.
+var cachedObjects = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+	Name: "dns_operator_cached_objects",
+	Help: "Number of objects in the informer caches of the DNS operator, by cache namespace and kind.",
+}, []string{"namespace", "kind"})
+
+func init() {
+	metrics.Registry.MustRegister(cachedObjects)
+}
+
+// sharedInformerKey identifies an informer by the namespace of its cache and
+// the kind of its objects.
+type sharedInformerKey struct {
+	namespace string
+	gvk       schema.GroupVersionKind
+}
+
+// sharedInformers is the set of caches from which every watch and cached read
+// of the operator is served, keyed by the namespace to which a cache is
+// limited, or by the empty string for the cluster-wide cache.  The cache of
+// the operand namespace, which also holds the cluster-scoped resources that
+// the operator watches, is the manager's cache.  Any other cache is created
+// and added to the manager, which starts it, the first time it is needed, so
+// that the operator does not list and watch resources that no dns needs, and
+// each resource is listed and watched once however many watches need it.
+type sharedInformers struct {
+	lock      sync.Mutex
+	mgr       manager.Manager
+	caches    map[string]cache.Cache
+	informers map[sharedInformerKey]cache.Informer
+}
+
+// newSharedInformers returns the shared informers of the given manager.
+func newSharedInformers(mgr manager.Manager) *sharedInformers {
+	return &sharedInformers{
+		mgr:       mgr,
+		caches:    map[string]cache.Cache{manifests.DNSNamespace().Name: mgr.GetCache()},
+		informers: map[sharedInformerKey]cache.Informer{},
+	}
+}
+
+// cache returns the cache of the given namespace, creating it if it does not
+// exist.  The caller must hold the lock.
+func (s *sharedInformers) cache(namespace string) (cache.Cache, error) {
+	if c, ok := s.caches[namespace]; ok {
+		return c, nil
+	}
+	c, err := cache.New(s.mgr.GetConfig(), cache.Options{
+		Scheme:    s.mgr.GetScheme(),
+		Mapper:    s.mgr.GetRESTMapper(),
+		Namespace: namespace,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create cache for namespace %q: %v", namespace, err)
+	}
+	if err := s.mgr.Add(c); err != nil {
+		return nil, fmt.Errorf("failed to start cache for namespace %q: %v", namespace, err)
+	}
+	s.caches[namespace] = c
+	logrus.Infof("started cache for namespace %q", namespace)
+	return c, nil
+}
+
+// informer returns the informer for the given kind of object in the cache of
+// the given namespace, creating the cache and the informer if they do not
+// exist.
+func (s *sharedInformers) informer(namespace string, obj runtime.Object) (cache.Informer, error) {
+	gvk, err := apiutil.GVKForObject(obj, s.mgr.GetScheme())
+	if err != nil {
+		return nil, err
+	}
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	key := sharedInformerKey{namespace: namespace, gvk: gvk}
+	if informer, ok := s.informers[key]; ok {
+		return informer, nil
+	}
+	c, err := s.cache(namespace)
+	if err != nil {
+		return nil, err
+	}
+	informer, err := c.GetInformer(context.TODO(), obj)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get informer for %s in namespace %q: %v", gvk.Kind, namespace, err)
+	}
+	informer.AddEventHandler(objectCounter{gauge: cachedObjects.WithLabelValues(namespace, gvk.Kind)})
+	s.informers[key] = informer
+	return informer, nil
+}
+
+// watch makes the given controller handle the events of the informer for the
+// given kind of object in the cache of the given namespace with the given
+// handler.
+func (s *sharedInformers) watch(c controller.Controller, namespace string, obj runtime.Object, h handler.EventHandler) error {
+	informer, err := s.informer(namespace, obj)
+	if err != nil {
+		return err
+	}
+	return c.Watch(&source.Informer{Informer: informer}, h)
+}
+
+// list lists the given kind of objects from the cache of the given namespace,
+// starting an informer for them if none exists.
+func (s *sharedInformers) list(namespace string, list runtime.Object, opts ...client.ListOption) error {
+	gvk, err := apiutil.GVKForObject(list, s.mgr.GetScheme())
+	if err != nil {
+		return err
+	}
+	gvk.Kind = strings.TrimSuffix(gvk.Kind, "List")
+	var obj runtime.Object
+	if _, ok := list.(*unstructured.UnstructuredList); ok {
+		u := &unstructured.Unstructured{}
+		u.SetGroupVersionKind(gvk)
+		obj = u
+	} else if obj, err = s.mgr.GetScheme().New(gvk); err != nil {
+		return err
+	}
+	if _, err := s.informer(namespace, obj); err != nil {
+		return err
+	}
+	s.lock.Lock()
+	c := s.caches[namespace]
+	s.lock.Unlock()
+	return c.List(context.TODO(), list, opts...)
+}
+
+// objectCounter is an informer event handler that keeps a gauge at the number
+// of objects in the informer.
+type objectCounter struct {
+	gauge prometheus.Gauge
+}
+
+var _ toolscache.ResourceEventHandler = objectCounter{}
+
+func (c objectCounter) OnAdd(_ interface{})       { c.gauge.Inc() }
+func (c objectCounter) OnUpdate(_, _ interface{}) {}
+func (c objectCounter) OnDelete(_ interface{})    { c.gauge.Dec() }
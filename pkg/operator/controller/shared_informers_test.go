@@ -0,0 +1,28 @@
+package controller
+
+import (
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus"
+
+	dto "github.com/prometheus/client_model/go"
+)
+
+// TestObjectCounter verifies that objectCounter keeps its gauge at the number
+// of objects that are added to an informer and not yet deleted.
+func TestObjectCounter(t *testing.T) {
+	gauge := prometheus.NewGauge(prometheus.GaugeOpts{Name: "test"})
+	counter := objectCounter{gauge: gauge}
+	counter.OnAdd("a")
+	counter.OnAdd("b")
+	counter.OnUpdate("a", "a")
+	counter.OnAdd("c")
+	counter.OnDelete("b")
+	metric := &dto.Metric{}
+	if err := gauge.Write(metric); err != nil {
+		t.Fatal(err)
+	}
+	if value := metric.GetGauge().GetValue(); value != 2 {
+		t.Errorf("expected 2 objects, got %v", value)
+	}
+}
@@ -11,7 +11,9 @@ import (
 	configv1 "github.com/openshift/api/config/v1"
 	operatorv1 "github.com/openshift/api/operator/v1"
 
+	"github.com/openshift/cluster-dns-operator/pkg/dnsstatus"
 	"github.com/openshift/cluster-dns-operator/pkg/manifests"
+	"github.com/openshift/cluster-dns-operator/pkg/util/conditions"
 
 	"github.com/sirupsen/logrus"
 
@@ -35,6 +37,7 @@ type dnsStatusConditionsCounts struct {
 	available   int
 	progressing int
 	degraded    int
+	paused      int
 	total       int
 }
 
@@ -88,7 +91,7 @@ func (r *reconciler) syncOperatorStatus() error {
 		}
 	}
 
-	return nil
+	return r.syncInsightsReport(co, dnses)
 }
 
 // Populate versions and conditions in cluster operator status as CVO expects these fields.
@@ -120,6 +123,10 @@ func initializeClusterOperator(co *configv1.ClusterOperator) {
 			Type:   configv1.OperatorAvailable,
 			Status: configv1.ConditionUnknown,
 		},
+		{
+			Type:   configv1.OperatorUpgradeable,
+			Status: configv1.ConditionUnknown,
+		},
 	}
 }
 
@@ -148,20 +155,17 @@ func computeDNSStatusConditionCounts(dnses []operatorv1.DNS) dnsStatusConditions
 	dnsStatusConditionsCounts := dnsStatusConditionsCounts{}
 	for _, dns := range dnses {
 		var (
-			// Assume the DNS is unavailable, degraded, and
-			// progressing unless its conditions indicate otherwise.
-			available   = false
-			degraded    = true
-			progressing = true
+			available   = dnsstatus.IsDNSAvailable(&dns)
+			degraded    = dnsstatus.IsDNSDegraded(&dns)
+			progressing = dnsstatus.IsDNSProgressing(&dns)
+			paused      = false
 		)
 		for _, c := range dns.Status.Conditions {
 			switch {
-			case c.Type == operatorv1.OperatorStatusTypeAvailable && c.Status == operatorv1.ConditionTrue:
-				available = true
-			case c.Type == operatorv1.OperatorStatusTypeProgressing && c.Status == operatorv1.ConditionFalse:
-				progressing = false
-			case c.Type == operatorv1.OperatorStatusTypeDegraded && c.Status == operatorv1.ConditionFalse:
-				degraded = false
+			case c.Type == DNSReconciliationPausedConditionType && c.Status == operatorv1.ConditionTrue:
+				paused = true
+			case c.Type == DNSReconcilePanickingConditionType && c.Status == operatorv1.ConditionTrue:
+				degraded = true
 			}
 		}
 		dnsStatusConditionsCounts.total++
@@ -174,6 +178,9 @@ func computeDNSStatusConditionCounts(dnses []operatorv1.DNS) dnsStatusConditions
 		if progressing {
 			dnsStatusConditionsCounts.progressing++
 		}
+		if paused {
+			dnsStatusConditionsCounts.paused++
+		}
 	}
 	return dnsStatusConditionsCounts
 }
@@ -206,25 +213,12 @@ func (r *reconciler) computeOperatorStatusVersions(oldVersions []configv1.Operan
 func (r *reconciler) computeOperatorStatusConditions(oldConditions []configv1.ClusterOperatorStatusCondition,
 	ns *corev1.Namespace, dnses dnsStatusConditionsCounts,
 	oldVersions, curVersions []configv1.OperandVersion) []configv1.ClusterOperatorStatusCondition {
-	var oldDegradedCondition, oldProgressingCondition, oldAvailableCondition *configv1.ClusterOperatorStatusCondition
-	for i := range oldConditions {
-		switch oldConditions[i].Type {
-		case configv1.OperatorDegraded:
-			oldDegradedCondition = &oldConditions[i]
-		case configv1.OperatorProgressing:
-			oldProgressingCondition = &oldConditions[i]
-		case configv1.OperatorAvailable:
-			oldAvailableCondition = &oldConditions[i]
-		}
+	return []configv1.ClusterOperatorStatusCondition{
+		computeOperatorDegradedCondition(conditions.FindClusterOperatorCondition(oldConditions, configv1.OperatorDegraded), dnses, ns),
+		r.computeOperatorProgressingCondition(conditions.FindClusterOperatorCondition(oldConditions, configv1.OperatorProgressing), dnses, oldVersions, curVersions),
+		computeOperatorAvailableCondition(conditions.FindClusterOperatorCondition(oldConditions, configv1.OperatorAvailable), dnses),
+		computeOperatorUpgradeableCondition(conditions.FindClusterOperatorCondition(oldConditions, configv1.OperatorUpgradeable), dnses),
 	}
-
-	conditions := []configv1.ClusterOperatorStatusCondition{
-		computeOperatorDegradedCondition(oldDegradedCondition, dnses, ns),
-		r.computeOperatorProgressingCondition(oldProgressingCondition, dnses, oldVersions, curVersions),
-		computeOperatorAvailableCondition(oldAvailableCondition, dnses),
-	}
-
-	return conditions
 }
 
 // computeOperatorDegradedCondition computes the operator's current Degraded status state.
@@ -251,7 +245,7 @@ func computeOperatorDegradedCondition(oldCondition *configv1.ClusterOperatorStat
 		degradedCondition.Message = "All desired DNS DaemonSets available and operand Namespace exists"
 	}
 
-	setOperatorLastTransitionTime(&degradedCondition, oldCondition)
+	conditions.SetClusterOperatorConditionTransitionTime(&degradedCondition, oldCondition)
 	return degradedCondition
 }
 
@@ -313,7 +307,7 @@ func (r *reconciler) computeOperatorProgressingCondition(oldCondition *configv1.
 		progressingCondition.Message = strings.Join(messages, "\n")
 	}
 
-	setOperatorLastTransitionTime(&progressingCondition, oldCondition)
+	conditions.SetClusterOperatorConditionTransitionTime(&progressingCondition, oldCondition)
 	return progressingCondition
 }
 
@@ -333,19 +327,30 @@ func computeOperatorAvailableCondition(oldCondition *configv1.ClusterOperatorSta
 		availableCondition.Message = "No DNS DaemonSets available"
 	}
 
-	setOperatorLastTransitionTime(&availableCondition, oldCondition)
+	conditions.SetClusterOperatorConditionTransitionTime(&availableCondition, oldCondition)
 	return availableCondition
 }
 
-// setOperatorLastTransitionTime sets LastTransitionTime for the given condition.
-// If the condition has changed, it will assign a new timestamp otherwise keeps the old timestamp.
-func setOperatorLastTransitionTime(condition, oldCondition *configv1.ClusterOperatorStatusCondition) {
-	if oldCondition != nil && condition.Status == oldCondition.Status &&
-		condition.Reason == oldCondition.Reason && condition.Message == oldCondition.Message {
-		condition.LastTransitionTime = oldCondition.LastTransitionTime
+// computeOperatorUpgradeableCondition computes the operator's current
+// Upgradeable status state.  An upgrade could replace a resource that has
+// reconciliation paused, so upgrades are blocked while any is paused.
+func computeOperatorUpgradeableCondition(oldCondition *configv1.ClusterOperatorStatusCondition,
+	dnses dnsStatusConditionsCounts) configv1.ClusterOperatorStatusCondition {
+	upgradeableCondition := configv1.ClusterOperatorStatusCondition{
+		Type: configv1.OperatorUpgradeable,
+	}
+	if dnses.paused > 0 {
+		upgradeableCondition.Status = configv1.ConditionFalse
+		upgradeableCondition.Reason = "ReconciliationPaused"
+		upgradeableCondition.Message = fmt.Sprintf("Reconciliation of resources is paused for %d DNS(es)", dnses.paused)
 	} else {
-		condition.LastTransitionTime = metav1.Now()
+		upgradeableCondition.Status = configv1.ConditionTrue
+		upgradeableCondition.Reason = "AsExpected"
+		upgradeableCondition.Message = "Reconciliation of resources is enabled for all DNSes"
 	}
+
+	conditions.SetClusterOperatorConditionTransitionTime(&upgradeableCondition, oldCondition)
+	return upgradeableCondition
 }
 
 // operatorStatusesEqual compares two ClusterOperatorStatus values.  Returns true
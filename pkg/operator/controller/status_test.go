@@ -14,7 +14,7 @@ import (
 
 func TestComputeOperatorStatusConditions(t *testing.T) {
 	type conditions struct {
-		degraded, progressing, available bool
+		degraded, progressing, available, notUpgradeable bool
 	}
 	type versions struct {
 		operator, coreDNSOperand, openshiftCLIOperand string
@@ -121,6 +121,11 @@ func TestComputeOperatorStatusConditions(t *testing.T) {
 			curVersions:      versions{"v2", "dns-v2", "cli-v2"},
 			expected:         conditions{available: true, progressing: false, degraded: false},
 		},
+		{
+			description: "reconciliation paused for 1/2 dns resources",
+			dnses:       dnsStatusConditionsCounts{available: 2, progressing: 0, degraded: 0, paused: 1, total: 2},
+			expected:    conditions{available: true, progressing: false, degraded: false, notUpgradeable: true},
+		},
 		{
 			description:      "operator upgrade in progress, coredns upgrade done",
 			dnses:            dnsStatusConditionsCounts{available: 2, progressing: 2, degraded: 2, total: 2},
@@ -186,6 +191,10 @@ func TestComputeOperatorStatusConditions(t *testing.T) {
 				Type:   configv1.OperatorAvailable,
 				Status: configv1.ConditionFalse,
 			},
+			{
+				Type:   configv1.OperatorUpgradeable,
+				Status: configv1.ConditionTrue,
+			},
 		}
 		if tc.expected.degraded {
 			expectedConditions[0].Status = configv1.ConditionTrue
@@ -196,6 +205,9 @@ func TestComputeOperatorStatusConditions(t *testing.T) {
 		if tc.expected.available {
 			expectedConditions[2].Status = configv1.ConditionTrue
 		}
+		if tc.expected.notUpgradeable {
+			expectedConditions[3].Status = configv1.ConditionFalse
+		}
 
 		conditions := r.computeOperatorStatusConditions([]configv1.ClusterOperatorStatusCondition{}, namespace,
 			tc.dnses, oldVersions, reportedVersions)
@@ -0,0 +1,304 @@
+package controller
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	operatorv1 "github.com/openshift/api/operator/v1"
+
+	"github.com/openshift/cluster-dns-operator/pkg/util/conditions"
+
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/sirupsen/logrus"
+
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+)
+
+const (
+	// DNSUpdateConflictConditionType is the type of the dns status
+	// condition that reports that another actor keeps changing resources
+	// that the operator manages for the dns, such as a GitOps tool that
+	// applies a different configuration.
+	DNSUpdateConflictConditionType = "UpdateConflict"
+
+	// updateConflictWindow is the interval over which conflicts are
+	// counted to decide whether they recur.
+	updateConflictWindow = 10 * time.Minute
+
+	// updateConflictThreshold is the number of conflicts for a resource
+	// within updateConflictWindow at which the operator is considered to
+	// be fighting another actor over the resource.
+	updateConflictThreshold = 3
+
+	// updateConflictReasonConflict is the reason of a conflict in which
+	// an update by the operator failed because another actor had updated
+	// the resource since the operator read it.
+	updateConflictReasonConflict = "Conflict"
+
+	// updateConflictReasonReverted is the reason of a conflict in which
+	// the operator restored a resource that another actor had changed
+	// since the operator last wrote it.
+	updateConflictReasonReverted = "Reverted"
+)
+
+// updateConflictsCounter counts the conflicts for each resource that the
+// operator manages, by reason and by the field manager that last changed the
+// resource.
+var updateConflictsCounter = prometheus.NewCounterVec(prometheus.CounterOpts{
+	Name: "dns_operator_update_conflicts_total",
+	Help: "Number of times that an update of a managed resource conflicted with another actor or restored a change by another actor.",
+}, []string{"kind", "resource", "reason", "manager"})
+
+func init() {
+	metrics.Registry.MustRegister(updateConflictsCounter)
+}
+
+// updateConflictKey identifies a resource that the operator updates.
+type updateConflictKey struct {
+	kind string
+	name types.NamespacedName
+}
+
+// updateConflict is a conflict over a resource that the operator manages.
+type updateConflict struct {
+	time    time.Time
+	reason  string
+	manager string
+}
+
+// updateConflictSummary describes the recent conflicts over a resource.
+type updateConflictSummary struct {
+	kind string
+	name types.NamespacedName
+	// count is the number of recent conflicts.
+	count int
+	// managers are the field managers that last changed the resource
+	// before the recent conflicts, in order of their last conflict.
+	managers []string
+}
+
+// updateConflictTracker remembers what the operator last wrote to each
+// resource that it updates so that it can tell when another actor changes the
+// resource back, and counts recent conflicts.
+type updateConflictTracker struct {
+	lock sync.Mutex
+	// written maps each resource to the revision and the fingerprint of
+	// the state that the operator last wrote.
+	written map[updateConflictKey]writtenState
+	// self is the field manager of the operator, which is learned from
+	// the first successful update.
+	self string
+	// conflicts maps each resource to its conflicts within
+	// updateConflictWindow.
+	conflicts map[updateConflictKey][]updateConflict
+}
+
+// writtenState is the state of a resource that the operator wrote.
+type writtenState struct {
+	revision    string
+	fingerprint string
+}
+
+// forget discards what the tracker remembers about the updates of the
+// operator, so that changes to the resources since then are not taken for
+// conflicts.
+func (t *updateConflictTracker) forget() {
+	if t == nil {
+		return
+	}
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	t.written = nil
+	t.conflicts = nil
+}
+
+// observe records the result err of an update of the given current object of
+// the given kind to updated, where fingerprint identifies the state that the
+// operator wrote.  It returns the reason of the conflict that the update
+// revealed and the field manager that last changed the resource, or empty
+// strings if the update did not reveal a conflict.
+//
+// An update reveals a conflict if it fails with a conflict error, or if the
+// resource changed since the operator last wrote it and the operator writes the
+// same state again, which means that it restores a change by another actor.
+func (t *updateConflictTracker) observe(kind string, current, updated metav1.Object, fingerprint string, err error, now time.Time) (string, string) {
+	if t == nil {
+		return "", ""
+	}
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	if t.written == nil {
+		t.written = map[updateConflictKey]writtenState{}
+		t.conflicts = map[updateConflictKey][]updateConflict{}
+	}
+	key := updateConflictKey{kind: kind, name: types.NamespacedName{Namespace: current.GetNamespace(), Name: current.GetName()}}
+	reason := ""
+	switch {
+	case err != nil && errors.IsConflict(err):
+		reason = updateConflictReasonConflict
+	case err != nil:
+		return "", ""
+	default:
+		last, ok := t.written[key]
+		if ok && last.revision != objectRevision(current) && last.fingerprint == fingerprint {
+			reason = updateConflictReasonReverted
+		}
+		t.written[key] = writtenState{revision: objectRevision(updated), fingerprint: fingerprint}
+		if len(t.self) == 0 {
+			t.self = newestFieldManager(updated)
+		}
+	}
+	if len(reason) == 0 {
+		return "", ""
+	}
+	manager := lastOtherFieldManager(current, t.self)
+	recent := []updateConflict{}
+	for _, c := range t.conflicts[key] {
+		if now.Sub(c.time) < updateConflictWindow {
+			recent = append(recent, c)
+		}
+	}
+	t.conflicts[key] = append(recent, updateConflict{time: now, reason: reason, manager: manager})
+	return reason, manager
+}
+
+// recurring returns the resources with at least updateConflictThreshold
+// conflicts within updateConflictWindow of the given time, sorted by kind and
+// name.
+func (t *updateConflictTracker) recurring(now time.Time) []updateConflictSummary {
+	if t == nil {
+		return nil
+	}
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	summaries := []updateConflictSummary{}
+	for key, conflicts := range t.conflicts {
+		summary := updateConflictSummary{kind: key.kind, name: key.name}
+		for _, c := range conflicts {
+			if now.Sub(c.time) >= updateConflictWindow {
+				continue
+			}
+			summary.count++
+			if len(c.manager) == 0 {
+				continue
+			}
+			for i, m := range summary.managers {
+				if m == c.manager {
+					summary.managers = append(summary.managers[:i], summary.managers[i+1:]...)
+					break
+				}
+			}
+			summary.managers = append(summary.managers, c.manager)
+		}
+		if summary.count >= updateConflictThreshold {
+			summaries = append(summaries, summary)
+		}
+	}
+	sort.Slice(summaries, func(i, j int) bool {
+		if summaries[i].kind != summaries[j].kind {
+			return summaries[i].kind < summaries[j].kind
+		}
+		return summaries[i].name.String() < summaries[j].name.String()
+	})
+	return summaries
+}
+
+// objectRevision returns a revision of the given object that changes when
+// another actor changes the object but not when its status changes: the
+// generation of a resource that has one, such as a daemonset, or the resource
+// version of one that does not, such as a configmap.
+func objectRevision(obj metav1.Object) string {
+	if generation := obj.GetGeneration(); generation != 0 {
+		return fmt.Sprintf("generation %d", generation)
+	}
+	return "resourceVersion " + obj.GetResourceVersion()
+}
+
+// newestFieldManager returns the field manager of the most recent update of the
+// given object, or an empty string if the object has no managed fields.
+func newestFieldManager(obj metav1.Object) string {
+	return lastOtherFieldManager(obj, "")
+}
+
+// lastOtherFieldManager returns the field manager other than self that most
+// recently updated the given object, or an empty string if there is none.
+func lastOtherFieldManager(obj metav1.Object, self string) string {
+	manager := ""
+	var latest time.Time
+	for _, entry := range obj.GetManagedFields() {
+		if entry.Manager == self || entry.Time == nil {
+			continue
+		}
+		if len(manager) == 0 || entry.Time.After(latest) {
+			manager, latest = entry.Manager, entry.Time.Time
+		}
+	}
+	return manager
+}
+
+// updateFingerprint returns a fingerprint of the given state that the operator
+// writes to a resource.
+func updateFingerprint(state interface{}) string {
+	data, err := json.Marshal(state)
+	if err != nil {
+		return ""
+	}
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
+
+// trackUpdate records the result err of an update of the given current object
+// of the given kind to updated, where state is the part of the object that the
+// operator manages, and counts and logs the conflict that the update revealed,
+// if any.
+func (r *reconciler) trackUpdate(kind string, current, updated metav1.Object, state interface{}, err error) {
+	reason, manager := r.updateConflicts.observe(kind, current, updated, updateFingerprint(state), err, time.Now())
+	if len(reason) == 0 {
+		return
+	}
+	name := current.GetNamespace() + "/" + current.GetName()
+	updateConflictsCounter.WithLabelValues(kind, name, reason, manager).Inc()
+	if len(manager) == 0 {
+		manager = "unknown"
+	}
+	logrus.Infof("update of %s %s conflicted with another actor (reason: %s, last field manager: %s)", kind, name, reason, manager)
+}
+
+// computeDNSUpdateConflictCondition computes the dns UpdateConflict status
+// condition for the given resources with recurring conflicts.  The message
+// leaves out the number of conflicts so that the status is not updated for
+// every conflict.
+func computeDNSUpdateConflictCondition(oldConditions []operatorv1.OperatorCondition, recurring []updateConflictSummary) operatorv1.OperatorCondition {
+	condition := &operatorv1.OperatorCondition{
+		Type: DNSUpdateConflictConditionType,
+	}
+	if len(recurring) == 0 {
+		condition.Status = operatorv1.ConditionFalse
+		condition.Reason = "AsExpected"
+		condition.Message = "No other actor keeps changing the resources of the DNS"
+	} else {
+		descriptions := []string{}
+		for _, s := range recurring {
+			description := fmt.Sprintf("%s %s", s.kind, s.name)
+			if len(s.managers) != 0 {
+				description += fmt.Sprintf(" (last changed by field manager %s)", strings.Join(s.managers, ", "))
+			}
+			descriptions = append(descriptions, description)
+		}
+		condition.Status = operatorv1.ConditionTrue
+		condition.Reason = "RecurringConflicts"
+		condition.Message = fmt.Sprintf("Another actor changed or conflicted with updates of resources that the operator manages at least %d times in %s: %s", updateConflictThreshold, updateConflictWindow, strings.Join(descriptions, "; "))
+	}
+	return conditions.SetOperatorConditionTransitionTime(condition, conditions.FindOperatorCondition(oldConditions, DNSUpdateConflictConditionType))
+}
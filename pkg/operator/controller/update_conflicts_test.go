@@ -0,0 +1,116 @@
+package controller
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	operatorv1 "github.com/openshift/api/operator/v1"
+
+	corev1 "k8s.io/api/core/v1"
+
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// fakeConfigMap returns a configmap with the given resource version that the
+// given field managers updated, in order.
+func fakeConfigMap(resourceVersion string, managers ...string) *corev1.ConfigMap {
+	cm := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace:       "openshift-dns",
+			Name:            "dns-default",
+			ResourceVersion: resourceVersion,
+		},
+	}
+	start := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	for i, manager := range managers {
+		when := metav1.NewTime(start.Add(time.Duration(i) * time.Minute))
+		cm.ManagedFields = append(cm.ManagedFields, metav1.ManagedFieldsEntry{Manager: manager, Time: &when})
+	}
+	return cm
+}
+
+func TestUpdateConflictTracker(t *testing.T) {
+	tracker := &updateConflictTracker{}
+	now := time.Now()
+
+	// The first update neither conflicts nor reverts anything, and the
+	// tracker learns the field manager of the operator from it.
+	if reason, _ := tracker.observe("configmap", fakeConfigMap("1", "dns-operator"), fakeConfigMap("2", "dns-operator"), "a", nil, now); reason != "" {
+		t.Errorf("expected no conflict for the first update, got %q", reason)
+	}
+	if tracker.self != "dns-operator" {
+		t.Errorf("expected the operator's field manager to be learned, got %q", tracker.self)
+	}
+
+	// Writing a different state after another actor changed the resource
+	// is not a revert.
+	if reason, _ := tracker.observe("configmap", fakeConfigMap("3", "dns-operator", "argocd"), fakeConfigMap("4", "argocd", "dns-operator"), "b", nil, now); reason != "" {
+		t.Errorf("expected no conflict for a new state, got %q", reason)
+	}
+
+	// Writing the same state again after another actor changed the
+	// resource is a revert, which names the other actor.
+	for i := 0; i < updateConflictThreshold-1; i++ {
+		rv := 5 + 2*i
+		reason, manager := tracker.observe("configmap", fakeConfigMap(fmt.Sprint(rv), "dns-operator", "argocd"), fakeConfigMap(fmt.Sprint(rv+1), "argocd", "dns-operator"), "b", nil, now)
+		if reason != updateConflictReasonReverted || manager != "argocd" {
+			t.Errorf("expected a revert by argocd, got %q by %q", reason, manager)
+		}
+	}
+	if recurring := tracker.recurring(now); len(recurring) != 0 {
+		t.Errorf("expected no recurring conflicts below the threshold, got %v", recurring)
+	}
+
+	// A conflict error counts too, and other errors do not.
+	conflict := errors.NewConflict(schema.GroupResource{Resource: "configmaps"}, "dns-default", fmt.Errorf("modified"))
+	if reason, _ := tracker.observe("configmap", fakeConfigMap("9", "dns-operator", "kubectl"), fakeConfigMap("9"), "b", conflict, now); reason != updateConflictReasonConflict {
+		t.Errorf("expected a conflict, got %q", reason)
+	}
+	if reason, _ := tracker.observe("configmap", fakeConfigMap("9"), fakeConfigMap("9"), "b", fmt.Errorf("boom"), now); reason != "" {
+		t.Errorf("expected no conflict for another error, got %q", reason)
+	}
+
+	recurring := tracker.recurring(now)
+	if len(recurring) != 1 {
+		t.Fatalf("expected recurring conflicts for one resource, got %v", recurring)
+	}
+	if s := recurring[0]; s.kind != "configmap" || s.name.String() != "openshift-dns/dns-default" || s.count != updateConflictThreshold || strings.Join(s.managers, ",") != "argocd,kubectl" {
+		t.Errorf("unexpected summary %+v", s)
+	}
+
+	// Conflicts expire after the window.
+	if recurring := tracker.recurring(now.Add(updateConflictWindow)); len(recurring) != 0 {
+		t.Errorf("expected conflicts to expire, got %v", recurring)
+	}
+
+	// A nil tracker records nothing.
+	var nilTracker *updateConflictTracker
+	if reason, _ := nilTracker.observe("configmap", fakeConfigMap("1"), fakeConfigMap("2"), "a", conflict, now); reason != "" || nilTracker.recurring(now) != nil {
+		t.Errorf("expected a nil tracker to record nothing")
+	}
+}
+
+func TestComputeDNSUpdateConflictCondition(t *testing.T) {
+	condition := computeDNSUpdateConflictCondition(nil, nil)
+	if condition.Type != DNSUpdateConflictConditionType || condition.Status != operatorv1.ConditionFalse {
+		t.Errorf("expected UpdateConflict=False without conflicts, got %+v", condition)
+	}
+
+	tracker := &updateConflictTracker{}
+	now := time.Now()
+	conflict := errors.NewConflict(schema.GroupResource{Resource: "configmaps"}, "dns-default", fmt.Errorf("modified"))
+	for i := 0; i < updateConflictThreshold; i++ {
+		tracker.observe("configmap", fakeConfigMap("1", "argocd"), fakeConfigMap("1"), "a", conflict, now)
+	}
+	condition = computeDNSUpdateConflictCondition([]operatorv1.OperatorCondition{condition}, tracker.recurring(now))
+	if condition.Status != operatorv1.ConditionTrue || condition.Reason != "RecurringConflicts" {
+		t.Errorf("expected UpdateConflict=True with recurring conflicts, got %+v", condition)
+	}
+	if !strings.Contains(condition.Message, "configmap openshift-dns/dns-default (last changed by field manager argocd)") {
+		t.Errorf("expected the message to name the resource and the field manager, got %q", condition.Message)
+	}
+}
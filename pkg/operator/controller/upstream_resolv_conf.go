@@ -0,0 +1,124 @@
+package controller
+
+import (
+	operatorv1 "github.com/openshift/api/operator/v1"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+
+	"k8s.io/apimachinery/pkg/api/resource"
+)
+
+const (
+	// upstreamResolvConfVolumeName is the name of the volume in which the
+	// node-resolver keeps a copy of the node's resolv.conf for CoreDNS.
+	upstreamResolvConfVolumeName = "upstream-resolv-conf"
+	// upstreamResolvConfMountPath is the path at which the copy of the
+	// node's resolv.conf is mounted.
+	upstreamResolvConfMountPath = "/etc/coredns-upstream"
+	// upstreamResolvConfPath is the path of the copy of the node's
+	// resolv.conf that CoreDNS forwards to the nameservers of.
+	upstreamResolvConfPath = upstreamResolvConfMountPath + "/resolv.conf"
+
+	// hostEtcVolumeName is the name of the volume with the node's /etc
+	// directory.  The directory rather than the file is mounted so that
+	// the node-resolver sees a resolv.conf that is replaced by renaming a
+	// new file over it.
+	hostEtcVolumeName = "host-etc"
+	// hostEtcMountPath is the path at which the node's /etc directory is
+	// mounted.
+	hostEtcMountPath = "/host/etc"
+
+	// upstreamResolvConfInitContainerName is the name of the init
+	// container that copies the node's resolv.conf before CoreDNS starts.
+	upstreamResolvConfInitContainerName = "upstream-resolv-conf"
+)
+
+// resolvConfReloadEnabled returns a Boolean indicating whether CoreDNS picks up
+// changes to the node's resolv.conf for the given dns.
+func resolvConfReloadEnabled(dns *operatorv1.DNS) bool {
+	return dns.Spec.NodeResolver.ResolvConfReload == operatorv1.NodeResolverResolvConfReloadEnabled
+}
+
+// corefileUpstreamResolvConf returns the resolv.conf whose nameservers the
+// default server forwards to for the given dns.
+func corefileUpstreamResolvConf(dns *operatorv1.DNS) string {
+	if resolvConfReloadEnabled(dns) {
+		return upstreamResolvConfPath
+	}
+	return "/etc/resolv.conf"
+}
+
+// setUpstreamResolvConfReload configures the given daemonset so that CoreDNS
+// picks up changes to the node's resolv.conf if the given dns enables it.  An
+// init container copies the node's resolv.conf into a volume that it shares
+// with CoreDNS, so that the copy exists when CoreDNS starts, and the
+// node-resolver refreshes the copy and signals CoreDNS to reload, which
+// requires the containers to share the process namespace of the pod.  The
+// dns container only sees the copy rather than the node's /etc directory.
+func setUpstreamResolvConfReload(daemonset *appsv1.DaemonSet, dns *operatorv1.DNS, openshiftCLIImage string) {
+	if !resolvConfReloadEnabled(dns) {
+		return
+	}
+	spec := &daemonset.Spec.Template.Spec
+	shareProcessNamespace := true
+	spec.ShareProcessNamespace = &shareProcessNamespace
+	hostPathDirectory := corev1.HostPathDirectory
+	spec.Volumes = append(spec.Volumes, corev1.Volume{
+		Name: upstreamResolvConfVolumeName,
+		VolumeSource: corev1.VolumeSource{
+			EmptyDir: &corev1.EmptyDirVolumeSource{},
+		},
+	}, corev1.Volume{
+		Name: hostEtcVolumeName,
+		VolumeSource: corev1.VolumeSource{
+			HostPath: &corev1.HostPathVolumeSource{
+				Path: "/etc",
+				Type: &hostPathDirectory,
+			},
+		},
+	})
+	mounts := []corev1.VolumeMount{{
+		Name:      upstreamResolvConfVolumeName,
+		MountPath: upstreamResolvConfMountPath,
+	}, {
+		Name:      hostEtcVolumeName,
+		MountPath: hostEtcMountPath,
+		ReadOnly:  true,
+	}}
+	privileged := true
+	spec.InitContainers = append(spec.InitContainers, corev1.Container{
+		Name:                     upstreamResolvConfInitContainerName,
+		Image:                    openshiftCLIImage,
+		ImagePullPolicy:          corev1.PullIfNotPresent,
+		TerminationMessagePolicy: corev1.TerminationMessageFallbackToLogsOnError,
+		Command:                  []string{"cp", "-f", hostEtcMountPath + "/resolv.conf", upstreamResolvConfPath},
+		SecurityContext:          &corev1.SecurityContext{Privileged: &privileged},
+		VolumeMounts:             mounts,
+		Resources: corev1.ResourceRequirements{
+			Requests: corev1.ResourceList{
+				corev1.ResourceCPU: resource.MustParse("5m"),
+			},
+		},
+	})
+	for i := range spec.Containers {
+		c := &spec.Containers[i]
+		switch c.Name {
+		case "dns":
+			c.VolumeMounts = append(c.VolumeMounts, corev1.VolumeMount{
+				Name:      upstreamResolvConfVolumeName,
+				MountPath: upstreamResolvConfMountPath,
+				ReadOnly:  true,
+			})
+		case "dns-node-resolver":
+			c.VolumeMounts = append(c.VolumeMounts, mounts...)
+			c.Env = append(c.Env,
+				corev1.EnvVar{Name: "HOST_RESOLV_CONF", Value: hostEtcMountPath + "/resolv.conf"},
+				corev1.EnvVar{Name: "UPSTREAM_RESOLV_CONF", Value: upstreamResolvConfPath},
+				fieldRefEnv(nodeNameEnvVar, "spec.nodeName"),
+				fieldRefEnv("POD_NAME", "metadata.name"),
+				fieldRefEnv("POD_NAMESPACE", "metadata.namespace"),
+			)
+		}
+	}
+}
@@ -0,0 +1,108 @@
+package controller
+
+import (
+	"testing"
+
+	operatorv1 "github.com/openshift/api/operator/v1"
+
+	corev1 "k8s.io/api/core/v1"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// hasVolumeMount returns a Boolean indicating whether the given container
+// mounts the named volume at the given path.
+func hasVolumeMount(c corev1.Container, name, path string) bool {
+	for _, m := range c.VolumeMounts {
+		if m.Name == name && m.MountPath == path {
+			return true
+		}
+	}
+	return false
+}
+
+func TestDesiredDNSDaemonsetResolvConfReload(t *testing.T) {
+	for _, state := range []operatorv1.NodeResolverResolvConfReloadState{"", operatorv1.NodeResolverResolvConfReloadDisabled, operatorv1.NodeResolverResolvConfReloadEnabled} {
+		dns := &operatorv1.DNS{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: DefaultDNSController,
+			},
+			Spec: operatorv1.DNSSpec{
+				NodeResolver: operatorv1.NodeResolverConfig{
+					ResolvConfReload: state,
+				},
+			},
+		}
+		ds, err := desiredDNSDaemonSet(dns, "172.30.77.10", "cluster.local", "coredns", "cli", "kube-rbac-proxy", false, nil)
+		if err != nil {
+			t.Fatalf("%q: invalid dns daemonset: %v", state, err)
+		}
+		spec := ds.Spec.Template.Spec
+		enabled := state == operatorv1.NodeResolverResolvConfReloadEnabled
+		if actual := spec.ShareProcessNamespace != nil && *spec.ShareProcessNamespace; actual != enabled {
+			t.Errorf("%q: expected shareProcessNamespace %t, got %t", state, enabled, actual)
+		}
+		if !enabled {
+			if len(spec.InitContainers) != 0 {
+				t.Errorf("%q: unexpected init containers: %v", state, spec.InitContainers)
+			}
+			continue
+		}
+		if len(spec.InitContainers) != 1 || spec.InitContainers[0].Image != "cli" || !hasVolumeMount(spec.InitContainers[0], hostEtcVolumeName, hostEtcMountPath) {
+			t.Errorf("%q: unexpected init containers: %v", state, spec.InitContainers)
+		}
+		for _, c := range spec.Containers {
+			switch c.Name {
+			case "dns":
+				if !hasVolumeMount(c, upstreamResolvConfVolumeName, upstreamResolvConfMountPath) {
+					t.Errorf("%q: expected dns container to mount %s", state, upstreamResolvConfVolumeName)
+				}
+				if hasVolumeMount(c, hostEtcVolumeName, hostEtcMountPath) {
+					t.Errorf("%q: unexpected mount of %s in dns container", state, hostEtcVolumeName)
+				}
+			case "dns-node-resolver":
+				if !hasVolumeMount(c, upstreamResolvConfVolumeName, upstreamResolvConfMountPath) || !hasVolumeMount(c, hostEtcVolumeName, hostEtcMountPath) {
+					t.Errorf("%q: expected node-resolver to mount %s and %s, got %v", state, upstreamResolvConfVolumeName, hostEtcVolumeName, c.VolumeMounts)
+				}
+				found := false
+				for _, e := range c.Env {
+					if e.Name == "UPSTREAM_RESOLV_CONF" && e.Value == upstreamResolvConfPath {
+						found = true
+					}
+				}
+				if !found {
+					t.Errorf("%q: expected node-resolver env UPSTREAM_RESOLV_CONF=%s", state, upstreamResolvConfPath)
+				}
+			}
+		}
+		// Desiring the same daemonset again must not be reported as a
+		// change.
+		if changed, _ := daemonsetConfigChanged(ds, ds.DeepCopy()); changed {
+			t.Errorf("%q: expected daemonset not to change", state)
+		}
+	}
+}
+
+func TestDaemonsetConfigChangedResolvConfReload(t *testing.T) {
+	dns := &operatorv1.DNS{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: DefaultDNSController,
+		},
+	}
+	current, err := desiredDNSDaemonSet(dns, "172.30.77.10", "cluster.local", "coredns", "cli", "kube-rbac-proxy", false, nil)
+	if err != nil {
+		t.Fatalf("invalid dns daemonset: %v", err)
+	}
+	dns.Spec.NodeResolver.ResolvConfReload = operatorv1.NodeResolverResolvConfReloadEnabled
+	expected, err := desiredDNSDaemonSet(dns, "172.30.77.10", "cluster.local", "coredns", "cli", "kube-rbac-proxy", false, nil)
+	if err != nil {
+		t.Fatalf("invalid dns daemonset: %v", err)
+	}
+	changed, updated := daemonsetConfigChanged(current, expected)
+	if !changed {
+		t.Fatal("expected enabling resolv.conf reload to change the daemonset")
+	}
+	if changed, _ := daemonsetConfigChanged(updated, expected); changed {
+		t.Errorf("expected the updated daemonset to match: %#v", updated.Spec.Template.Spec)
+	}
+}
@@ -0,0 +1,83 @@
+package controller
+
+import (
+	"net"
+	"strconv"
+
+	operatorv1 "github.com/openshift/api/operator/v1"
+
+	"github.com/sirupsen/logrus"
+)
+
+// defaultUpstreamPort is the port of an upstream resolver of the default server
+// that does not specify one.
+const defaultUpstreamPort = 53
+
+// corefileDefaultUpstreams returns the upstreams that the default server of
+// the given dns forwards to, in the order of its upstream resolvers.  The
+// resolv.conf upstream stands for the nameservers of the resolv.conf that
+// CoreDNS reads.  An upstream whose type is unknown or whose address is not an
+// IP address, or that is listed earlier, is ignored.  If the dns has no valid
+// upstream resolvers, the default server forwards to the nameservers of the
+// resolv.conf.
+func corefileDefaultUpstreams(dns *operatorv1.DNS) []string {
+	upstreams := []string{}
+	seen := map[string]struct{}{}
+	for _, upstream := range dns.Spec.UpstreamResolvers.Upstreams {
+		var address string
+		switch upstream.Type {
+		case operatorv1.SystemResolveConfType:
+			address = corefileUpstreamResolvConf(dns)
+		case operatorv1.NetworkResolverType:
+			ip := net.ParseIP(upstream.Address)
+			if ip == nil {
+				logrus.Warningf("ignoring upstream resolver %q of dns %s: invalid IP address", upstream.Address, dns.Name)
+				continue
+			}
+			port := upstream.Port
+			if port == 0 {
+				port = defaultUpstreamPort
+			}
+			if port > 65535 {
+				logrus.Warningf("ignoring upstream resolver %s of dns %s: invalid port %d", upstream.Address, dns.Name, port)
+				continue
+			}
+			address = net.JoinHostPort(ip.String(), strconv.Itoa(int(port)))
+		default:
+			logrus.Warningf("ignoring upstream resolver of dns %s: unknown type %q", dns.Name, upstream.Type)
+			continue
+		}
+		if _, ok := seen[address]; ok {
+			continue
+		}
+		seen[address] = struct{}{}
+		upstreams = append(upstreams, address)
+	}
+	if len(upstreams) == 0 {
+		return []string{corefileUpstreamResolvConf(dns)}
+	}
+	return upstreams
+}
+
+// corefileDefaultForwardPolicy returns the policy of the forward plugin of the
+// default server of the given dns.
+func corefileDefaultForwardPolicy(dns *operatorv1.DNS) string {
+	if name := corefileForwardPolicy(dns.Spec.UpstreamResolvers.Policy); len(name) != 0 {
+		return name
+	}
+	return "sequential"
+}
+
+// corefileDefaultForceTCP returns a Boolean indicating whether the forward
+// plugin of the default server of the given dns forces TCP.
+func corefileDefaultForceTCP(dns *operatorv1.DNS) bool {
+	switch strategy := dns.Spec.UpstreamResolvers.ProtocolStrategy; strategy {
+	case operatorv1.ProtocolStrategyTCP:
+		return true
+	case operatorv1.ProtocolStrategyDefault:
+		return false
+	default:
+		logrus.Warningf("ignoring unknown protocol strategy %q of the upstream resolvers of dns %s", strategy, dns.Name)
+		return false
+	}
+}
@@ -0,0 +1,99 @@
+package controller
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	operatorv1 "github.com/openshift/api/operator/v1"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestCorefileDefaultUpstreams(t *testing.T) {
+	testCases := []struct {
+		description string
+		resolvers   operatorv1.UpstreamResolvers
+		reload      bool
+		expected    []string
+	}{
+		{
+			description: "default",
+			expected:    []string{"/etc/resolv.conf"},
+		},
+		{
+			description: "network and resolv.conf upstreams",
+			resolvers: operatorv1.UpstreamResolvers{Upstreams: []operatorv1.Upstream{
+				{Type: operatorv1.NetworkResolverType, Address: "10.0.0.53"},
+				{Type: operatorv1.NetworkResolverType, Address: "2001:db8::53", Port: 5353},
+				{Type: operatorv1.SystemResolveConfType},
+			}},
+			expected: []string{"10.0.0.53:53", "[2001:db8::53]:5353", "/etc/resolv.conf"},
+		},
+		{
+			description: "resolv.conf with reload",
+			resolvers: operatorv1.UpstreamResolvers{Upstreams: []operatorv1.Upstream{
+				{Type: operatorv1.SystemResolveConfType},
+			}},
+			reload:   true,
+			expected: []string{upstreamResolvConfPath},
+		},
+		{
+			description: "invalid and duplicate upstreams",
+			resolvers: operatorv1.UpstreamResolvers{Upstreams: []operatorv1.Upstream{
+				{Type: operatorv1.NetworkResolverType, Address: "dns.example.com"},
+				{Type: operatorv1.NetworkResolverType, Address: "10.0.0.53", Port: 70000},
+				{Type: "Unknown", Address: "10.0.0.54"},
+				{Type: operatorv1.NetworkResolverType, Address: "10.0.0.53", Port: 53},
+				{Type: operatorv1.NetworkResolverType, Address: "10.0.0.53"},
+			}},
+			expected: []string{"10.0.0.53:53"},
+		},
+		{
+			description: "no valid upstreams",
+			resolvers: operatorv1.UpstreamResolvers{Upstreams: []operatorv1.Upstream{
+				{Type: operatorv1.NetworkResolverType},
+			}},
+			expected: []string{"/etc/resolv.conf"},
+		},
+	}
+	for _, tc := range testCases {
+		dns := &operatorv1.DNS{
+			ObjectMeta: metav1.ObjectMeta{Name: DefaultDNSController},
+			Spec:       operatorv1.DNSSpec{UpstreamResolvers: tc.resolvers},
+		}
+		if tc.reload {
+			dns.Spec.NodeResolver.ResolvConfReload = operatorv1.NodeResolverResolvConfReloadEnabled
+		}
+		if actual := corefileDefaultUpstreams(dns); !cmp.Equal(actual, tc.expected) {
+			t.Errorf("%s: expected %v, got %v", tc.description, tc.expected, actual)
+		}
+	}
+}
+
+func TestCorefileDefaultForwardPolicy(t *testing.T) {
+	for policy, expected := range map[operatorv1.ForwardingPolicy]string{
+		"":                                    "sequential",
+		operatorv1.SequentialForwardingPolicy: "sequential",
+		operatorv1.RandomForwardingPolicy:     "random",
+		operatorv1.RoundRobinForwardingPolicy: "round_robin",
+	} {
+		dns := &operatorv1.DNS{Spec: operatorv1.DNSSpec{UpstreamResolvers: operatorv1.UpstreamResolvers{Policy: policy}}}
+		if actual := corefileDefaultForwardPolicy(dns); actual != expected {
+			t.Errorf("policy %q: expected %s, got %s", policy, expected, actual)
+		}
+	}
+}
+
+func TestCorefileDefaultForceTCP(t *testing.T) {
+	for strategy, expected := range map[operatorv1.ProtocolStrategy]bool{
+		operatorv1.ProtocolStrategyDefault: false,
+		operatorv1.ProtocolStrategyTCP:     true,
+		"QUIC":                             false,
+	} {
+		dns := &operatorv1.DNS{Spec: operatorv1.DNSSpec{UpstreamResolvers: operatorv1.UpstreamResolvers{ProtocolStrategy: strategy}}}
+		if actual := corefileDefaultForceTCP(dns); actual != expected {
+			t.Errorf("protocol strategy %q: expected %t, got %t", strategy, expected, actual)
+		}
+	}
+}
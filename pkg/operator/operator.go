@@ -29,7 +29,6 @@ import (
 // them together.
 type Operator struct {
 	manager manager.Manager
-	caches  []cache.Cache
 	client  client.Client
 }
 
@@ -56,9 +55,12 @@ func New(config operatorconfig.Config, kubeConfig *rest.Config) (*Operator, erro
 	// Create and register the operator controller with the operator manager.
 	cfg := operatorcontroller.Config{
 		CoreDNSImage:           config.CoreDNSImage,
+		CoreDNSVersion:         config.CoreDNSVersion,
+		CoreDNSExternalPlugins: config.CoreDNSExternalPlugins,
 		OpenshiftCLIImage:      config.OpenshiftCLIImage,
 		KubeRBACProxyImage:     config.KubeRBACProxyImage,
 		OperatorReleaseVersion: config.OperatorReleaseVersion,
+		OperatorConfig:         &config,
 	}
 	if _, err := operatorcontroller.New(operatorManager, cfg); err != nil {
 		return nil, fmt.Errorf("failed to create operator controller: %v", err)
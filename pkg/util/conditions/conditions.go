@@ -0,0 +1,84 @@
+// Package conditions manages the status conditions that the operator reports
+// on dnses and on its cluster operator.
+package conditions
+
+import (
+	configv1 "github.com/openshift/api/config/v1"
+	operatorv1 "github.com/openshift/api/operator/v1"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// now returns the current time.  The API serializes timestamps with a
+// precision of seconds, so the time is truncated to seconds; otherwise a
+// condition would differ from itself once it is read back from the API, and
+// every reconcile would update the status.  Tests replace it.
+var now = func() metav1.Time {
+	return metav1.Now().Rfc3339Copy()
+}
+
+// transitionTime returns the last transition time of a condition with the
+// given status.  If the condition had a previous status, hadOld is true, and
+// oldStatus and oldTime are the status and last transition time that it had.
+//
+// A condition transitions only when its status changes; a change of its reason
+// or message is not a transition.  The previous time is kept unless it is
+// missing or lies in the future.  A time in the future was written by an
+// operator whose clock was ahead, such as a previous instance of the operator
+// on another node; it is replaced with the current time, which is the
+// earliest time that is known to be correct, so that the condition does not
+// appear to have transitioned after it is observed.
+func transitionTime(status, oldStatus string, hadOld bool, oldTime metav1.Time) metav1.Time {
+	current := now()
+	switch {
+	case !hadOld, status != oldStatus, oldTime.IsZero():
+		return current
+	case oldTime.After(current.Time):
+		return current
+	}
+	return oldTime
+}
+
+// FindOperatorCondition returns the condition of the given type in the given
+// conditions, or nil if there is none.
+func FindOperatorCondition(conditions []operatorv1.OperatorCondition, conditionType string) *operatorv1.OperatorCondition {
+	for i := range conditions {
+		if conditions[i].Type == conditionType {
+			return &conditions[i]
+		}
+	}
+	return nil
+}
+
+// SetOperatorConditionTransitionTime sets the last transition time of the given
+// condition from the given old condition, which may be nil, and returns the
+// condition.
+func SetOperatorConditionTransitionTime(condition, oldCondition *operatorv1.OperatorCondition) operatorv1.OperatorCondition {
+	if oldCondition == nil {
+		condition.LastTransitionTime = transitionTime(string(condition.Status), "", false, metav1.Time{})
+	} else {
+		condition.LastTransitionTime = transitionTime(string(condition.Status), string(oldCondition.Status), true, oldCondition.LastTransitionTime)
+	}
+	return *condition
+}
+
+// FindClusterOperatorCondition returns the condition of the given type in the
+// given conditions, or nil if there is none.
+func FindClusterOperatorCondition(conditions []configv1.ClusterOperatorStatusCondition, conditionType configv1.ClusterStatusConditionType) *configv1.ClusterOperatorStatusCondition {
+	for i := range conditions {
+		if conditions[i].Type == conditionType {
+			return &conditions[i]
+		}
+	}
+	return nil
+}
+
+// SetClusterOperatorConditionTransitionTime sets the last transition time of
+// the given condition from the given old condition, which may be nil.
+func SetClusterOperatorConditionTransitionTime(condition, oldCondition *configv1.ClusterOperatorStatusCondition) {
+	if oldCondition == nil {
+		condition.LastTransitionTime = transitionTime(string(condition.Status), "", false, metav1.Time{})
+	} else {
+		condition.LastTransitionTime = transitionTime(string(condition.Status), string(oldCondition.Status), true, oldCondition.LastTransitionTime)
+	}
+}
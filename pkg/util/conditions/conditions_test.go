@@ -0,0 +1,123 @@
+package conditions
+
+import (
+	"testing"
+	"time"
+
+	configv1 "github.com/openshift/api/config/v1"
+	operatorv1 "github.com/openshift/api/operator/v1"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestSetOperatorConditionTransitionTime(t *testing.T) {
+	current := metav1.NewTime(time.Date(2021, 3, 1, 12, 0, 0, 0, time.UTC))
+	earlier := metav1.NewTime(current.Add(-time.Hour))
+	later := metav1.NewTime(current.Add(time.Hour))
+	defer func(f func() metav1.Time) { now = f }(now)
+	now = func() metav1.Time { return current }
+
+	testCases := []struct {
+		description string
+		condition   operatorv1.OperatorCondition
+		old         *operatorv1.OperatorCondition
+		expected    metav1.Time
+	}{
+		{
+			description: "new condition",
+			condition:   operatorv1.OperatorCondition{Type: "Degraded", Status: operatorv1.ConditionFalse},
+			expected:    current,
+		},
+		{
+			description: "unchanged condition",
+			condition:   operatorv1.OperatorCondition{Type: "Degraded", Status: operatorv1.ConditionFalse, Reason: "AsExpected", Message: "ok"},
+			old:         &operatorv1.OperatorCondition{Type: "Degraded", Status: operatorv1.ConditionFalse, Reason: "AsExpected", Message: "ok", LastTransitionTime: earlier},
+			expected:    earlier,
+		},
+		{
+			description: "changed status",
+			condition:   operatorv1.OperatorCondition{Type: "Degraded", Status: operatorv1.ConditionTrue, Reason: "NoPodsAvailable"},
+			old:         &operatorv1.OperatorCondition{Type: "Degraded", Status: operatorv1.ConditionFalse, Reason: "AsExpected", LastTransitionTime: earlier},
+			expected:    current,
+		},
+		{
+			description: "changed reason and message",
+			condition:   operatorv1.OperatorCondition{Type: "Degraded", Status: operatorv1.ConditionTrue, Reason: "NoPodsAvailable", Message: "none"},
+			old:         &operatorv1.OperatorCondition{Type: "Degraded", Status: operatorv1.ConditionTrue, Reason: "MaxUnavailableExceeded", Message: "some", LastTransitionTime: earlier},
+			expected:    earlier,
+		},
+		{
+			description: "old condition without a time",
+			condition:   operatorv1.OperatorCondition{Type: "Degraded", Status: operatorv1.ConditionFalse},
+			old:         &operatorv1.OperatorCondition{Type: "Degraded", Status: operatorv1.ConditionFalse},
+			expected:    current,
+		},
+		{
+			description: "old time in the future",
+			condition:   operatorv1.OperatorCondition{Type: "Degraded", Status: operatorv1.ConditionFalse},
+			old:         &operatorv1.OperatorCondition{Type: "Degraded", Status: operatorv1.ConditionFalse, LastTransitionTime: later},
+			expected:    current,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.description, func(t *testing.T) {
+			condition := tc.condition
+			actual := SetOperatorConditionTransitionTime(&condition, tc.old)
+			if !actual.LastTransitionTime.Equal(&tc.expected) {
+				t.Errorf("expected last transition time %v, got %v", tc.expected, actual.LastTransitionTime)
+			}
+			if !condition.LastTransitionTime.Equal(&tc.expected) {
+				t.Errorf("expected the condition to be updated to %v, got %v", tc.expected, condition.LastTransitionTime)
+			}
+		})
+	}
+}
+
+func TestSetClusterOperatorConditionTransitionTime(t *testing.T) {
+	current := metav1.NewTime(time.Date(2021, 3, 1, 12, 0, 0, 0, time.UTC))
+	earlier := metav1.NewTime(current.Add(-time.Hour))
+	defer func(f func() metav1.Time) { now = f }(now)
+	now = func() metav1.Time { return current }
+
+	old := &configv1.ClusterOperatorStatusCondition{Type: configv1.OperatorAvailable, Status: configv1.ConditionTrue, Message: "1 of 2 DNSes available", LastTransitionTime: earlier}
+	condition := &configv1.ClusterOperatorStatusCondition{Type: configv1.OperatorAvailable, Status: configv1.ConditionTrue, Message: "2 of 2 DNSes available"}
+	SetClusterOperatorConditionTransitionTime(condition, old)
+	if !condition.LastTransitionTime.Equal(&earlier) {
+		t.Errorf("expected a message change to keep last transition time %v, got %v", earlier, condition.LastTransitionTime)
+	}
+
+	condition = &configv1.ClusterOperatorStatusCondition{Type: configv1.OperatorAvailable, Status: configv1.ConditionFalse}
+	SetClusterOperatorConditionTransitionTime(condition, old)
+	if !condition.LastTransitionTime.Equal(&current) {
+		t.Errorf("expected a status change to set last transition time %v, got %v", current, condition.LastTransitionTime)
+	}
+
+	condition = &configv1.ClusterOperatorStatusCondition{Type: configv1.OperatorAvailable, Status: configv1.ConditionTrue}
+	SetClusterOperatorConditionTransitionTime(condition, nil)
+	if !condition.LastTransitionTime.Equal(&current) {
+		t.Errorf("expected a new condition to have last transition time %v, got %v", current, condition.LastTransitionTime)
+	}
+}
+
+func TestNowHasSecondPrecision(t *testing.T) {
+	if ns := now().Nanosecond(); ns != 0 {
+		t.Errorf("expected a time with second precision, got %d nanoseconds", ns)
+	}
+}
+
+func TestFindOperatorCondition(t *testing.T) {
+	conditions := []operatorv1.OperatorCondition{
+		{Type: "Available", Status: operatorv1.ConditionTrue},
+		{Type: "Degraded", Status: operatorv1.ConditionFalse},
+	}
+	if c := FindOperatorCondition(conditions, "Degraded"); c == nil || c != &conditions[1] {
+		t.Errorf("expected the Degraded condition, got %v", c)
+	}
+	if c := FindOperatorCondition(conditions, "Progressing"); c != nil {
+		t.Errorf("expected no Progressing condition, got %v", c)
+	}
+	clusterConditions := []configv1.ClusterOperatorStatusCondition{{Type: configv1.OperatorUpgradeable}}
+	if c := FindClusterOperatorCondition(clusterConditions, configv1.OperatorUpgradeable); c != &clusterConditions[0] {
+		t.Errorf("expected the Upgradeable condition, got %v", c)
+	}
+}
@@ -0,0 +1,164 @@
+// +build e2e
+
+package e2e
+
+import (
+	"context"
+	"sort"
+	"testing"
+	"time"
+
+	operatorv1 "github.com/openshift/api/operator/v1"
+
+	operatorcontroller "github.com/openshift/cluster-dns-operator/pkg/operator/controller"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/wait"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	// maxChaosLookupFailurePercent is the percentage of lookups that may
+	// fail while dns pods are deleted.  Lameduck and graceful termination
+	// should keep it at zero, but a lookup can still fail if it is sent to
+	// a pod in the window before its endpoint is removed everywhere.
+	maxChaosLookupFailurePercent = 1
+
+	// maxChaosNodes is the number of nodes whose dns pods are deleted, to
+	// bound the duration of the test on large clusters.
+	maxChaosNodes = 6
+)
+
+// dnsPodsByNode returns the dns pods of the given dns grouped by node name,
+// along with the sorted node names.
+func dnsPodsByNode(cl client.Client, dns *operatorv1.DNS) (map[string][]corev1.Pod, []string, error) {
+	selector, err := metav1.LabelSelectorAsSelector(operatorcontroller.DNSDaemonSetPodSelector(dns))
+	if err != nil {
+		return nil, nil, err
+	}
+	pods := &corev1.PodList{}
+	if err := cl.List(context.TODO(), pods, client.InNamespace(operatorcontroller.DNSDaemonSetName(dns).Namespace), client.MatchingLabelsSelector{Selector: selector}); err != nil {
+		return nil, nil, err
+	}
+	byNode := map[string][]corev1.Pod{}
+	for _, pod := range pods.Items {
+		if len(pod.Spec.NodeName) == 0 || pod.DeletionTimestamp != nil {
+			continue
+		}
+		byNode[pod.Spec.NodeName] = append(byNode[pod.Spec.NodeName], pod)
+	}
+	nodes := []string{}
+	for node := range byNode {
+		nodes = append(nodes, node)
+	}
+	sort.Strings(nodes)
+	return byNode, nodes, nil
+}
+
+// waitForDNSDaemonSetAvailable waits for all pods of the given daemonset to be
+// available and for the given pods to be gone.
+func waitForDNSDaemonSetAvailable(cl client.Client, name types.NamespacedName, deleted []corev1.Pod, timeout time.Duration) error {
+	return wait.PollImmediate(2*time.Second, timeout, func() (bool, error) {
+		for _, pod := range deleted {
+			current := &corev1.Pod{}
+			if err := cl.Get(context.TODO(), types.NamespacedName{Namespace: pod.Namespace, Name: pod.Name}, current); err == nil && current.UID == pod.UID {
+				return false, nil
+			}
+		}
+		ds := &appsv1.DaemonSet{}
+		if err := cl.Get(context.TODO(), name, ds); err != nil {
+			return false, nil
+		}
+		return ds.Status.DesiredNumberScheduled != 0 &&
+			ds.Status.NumberAvailable == ds.Status.DesiredNumberScheduled, nil
+	})
+}
+
+// TestDNSPodChurn resolves a name continuously while it deletes the dns pods
+// of the default dns one node at a time, waiting for the daemonset to become
+// fully available again before moving on to the next node, and verifies that
+// lameduck and graceful termination keep failed lookups within bounds.
+func TestDNSPodChurn(t *testing.T) {
+	defer lockDefaultDNS(t)()
+
+	cl, err := getClient()
+	if err != nil {
+		t.Fatal(err)
+	}
+	ns, deleteNamespace := createTestNamespace(t, cl)
+	defer deleteNamespace()
+
+	defaultDNS := &operatorv1.DNS{}
+	if err := cl.Get(context.TODO(), types.NamespacedName{Name: operatorcontroller.DefaultDNSController}, defaultDNS); err != nil {
+		t.Fatalf("failed to get default dns: %v", err)
+	}
+	dsName := operatorcontroller.DNSDaemonSetName(defaultDNS)
+	if err := waitForDNSDaemonSetAvailable(cl, dsName, nil, 5*time.Minute); err != nil {
+		t.Fatalf("failed to observe daemonset %s to be available: %v", dsName, err)
+	}
+	byNode, nodes, err := dnsPodsByNode(cl, defaultDNS)
+	if err != nil {
+		t.Fatalf("failed to list dns pods: %v", err)
+	}
+	if len(nodes) < 2 {
+		t.Skipf("dns pods run on %d nodes; deleting them would leave no pod to resolve names", len(nodes))
+	}
+	if len(nodes) > maxChaosNodes {
+		nodes = nodes[:maxChaosNodes]
+	}
+
+	kubernetesSvc := &corev1.Service{}
+	if err := cl.Get(context.TODO(), types.NamespacedName{Namespace: "default", Name: "kubernetes"}, kubernetesSvc); err != nil {
+		t.Fatalf("failed to get service default/kubernetes: %v", err)
+	}
+	cliImage, err := clusterOperatorVersion(cl, operatorcontroller.OpenshiftCLIVersionName)
+	if err != nil {
+		t.Fatal(err)
+	}
+	testClient := buildPod("test-client-churn", ns, cliImage, []string{"sleep", "3600"})
+	if err := cl.Create(context.TODO(), testClient); err != nil {
+		t.Fatalf("failed to create pod %s/%s: %v", testClient.Namespace, testClient.Name, err)
+	}
+	defer func() {
+		if err := cl.Delete(context.TODO(), testClient); err != nil {
+			t.Fatalf("failed to delete pod %s/%s: %v", testClient.Namespace, testClient.Name, err)
+		}
+	}()
+	if err := waitForPodReady(cl, testClient, 60*time.Second); err != nil {
+		t.Fatal(err)
+	}
+	monitor := &lookupMonitor{}
+	stop := make(chan struct{})
+	if err := monitor.run(testClient.Namespace, testClient.Name, "kubernetes.default.svc.cluster.local", kubernetesSvc.Spec.ClusterIP, stop); err != nil {
+		t.Fatalf("failed to start lookups: %v", err)
+	}
+	defer close(stop)
+
+	for _, node := range nodes {
+		for i := range byNode[node] {
+			pod := &byNode[node][i]
+			if err := cl.Delete(context.TODO(), pod); err != nil {
+				t.Fatalf("failed to delete pod %s/%s on node %s: %v", pod.Namespace, pod.Name, node, err)
+			}
+		}
+		if err := waitForDNSDaemonSetAvailable(cl, dsName, byNode[node], 5*time.Minute); err != nil {
+			t.Fatalf("failed to observe daemonset %s to recover after deleting dns pods on node %s: %v", dsName, node, err)
+		}
+		t.Logf("deleted dns pods on node %s", node)
+	}
+
+	lookups, failures, consecutiveFailures := monitor.result()
+	if lookups == 0 {
+		t.Fatalf("no lookups were made from pod %s/%s", testClient.Namespace, testClient.Name)
+	}
+	if failures*100 > lookups*maxChaosLookupFailurePercent {
+		t.Errorf("observed %d failed lookups out of %d while deleting dns pods on %d nodes, more than %d%%", failures, lookups, len(nodes), maxChaosLookupFailurePercent)
+	}
+	if consecutiveFailures >= maxConsecutiveLookupFailures {
+		t.Errorf("observed %d consecutive failed lookups out of %d while deleting dns pods", consecutiveFailures, lookups)
+	}
+}
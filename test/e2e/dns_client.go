@@ -0,0 +1,387 @@
+// +build e2e
+
+package e2e
+
+import (
+	"bufio"
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"math/rand"
+	"net"
+	"os/exec"
+	"regexp"
+	"strings"
+	"time"
+
+	"k8s.io/apimachinery/pkg/util/wait"
+)
+
+// DNS record types and response codes that the tests query for and assert on.
+const (
+	dnsTypeA     uint16 = 1
+	dnsTypeCNAME uint16 = 5
+	dnsTypePTR   uint16 = 12
+	dnsTypeTXT   uint16 = 16
+	dnsTypeAAAA  uint16 = 28
+	dnsTypeSRV   uint16 = 33
+
+	dnsRcodeSuccess  = 0
+	dnsRcodeNXDomain = 3
+)
+
+// dnsRecord is a resource record in the answer section of a DNS response.
+type dnsRecord struct {
+	Name string
+	Type uint16
+	TTL  uint32
+	// Data is the record data in presentation format for the record types
+	// that the tests use, or in hexadecimal for other types.  The strings
+	// of a TXT record are joined without separators.
+	Data string
+}
+
+// dnsResponse is the part of a DNS response that the tests assert on.
+type dnsResponse struct {
+	Rcode         int
+	Authoritative bool
+	Truncated     bool
+	Answers       []dnsRecord
+}
+
+// answerData returns the data of the answers of the given type.
+func (r *dnsResponse) answerData(qtype uint16) []string {
+	var data []string
+	for _, rr := range r.Answers {
+		if rr.Type == qtype {
+			data = append(data, rr.Data)
+		}
+	}
+	return data
+}
+
+// queryDNS sends a query for name and qtype to the DNS server at addr over TCP
+// and returns its response.  TCP is used because the tests reach the DNS
+// service through a port-forward, which only forwards TCP.
+func queryDNS(addr, name string, qtype uint16, timeout time.Duration) (*dnsResponse, error) {
+	id := uint16(rand.Intn(1 << 16))
+	query, err := buildDNSQuery(id, name, qtype)
+	if err != nil {
+		return nil, err
+	}
+	conn, err := net.DialTimeout("tcp", addr, timeout)
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+	if err := conn.SetDeadline(time.Now().Add(timeout)); err != nil {
+		return nil, err
+	}
+	// Over TCP, each message is prefixed with its length.
+	msg := make([]byte, 2, 2+len(query))
+	binary.BigEndian.PutUint16(msg, uint16(len(query)))
+	if _, err := conn.Write(append(msg, query...)); err != nil {
+		return nil, fmt.Errorf("failed to send query for %s: %v", name, err)
+	}
+	var length [2]byte
+	if _, err := io.ReadFull(conn, length[:]); err != nil {
+		return nil, fmt.Errorf("failed to read response for %s: %v", name, err)
+	}
+	resp := make([]byte, binary.BigEndian.Uint16(length[:]))
+	if _, err := io.ReadFull(conn, resp); err != nil {
+		return nil, fmt.Errorf("failed to read response for %s: %v", name, err)
+	}
+	return parseDNSResponse(resp, id)
+}
+
+// buildDNSQuery returns a recursive query message with the given id for name
+// and qtype in the IN class.
+func buildDNSQuery(id uint16, name string, qtype uint16) ([]byte, error) {
+	// Header: id, flags with RD set, one question, no other records.
+	msg := make([]byte, 12)
+	binary.BigEndian.PutUint16(msg[0:], id)
+	binary.BigEndian.PutUint16(msg[2:], 0x0100)
+	binary.BigEndian.PutUint16(msg[4:], 1)
+	for _, label := range strings.Split(strings.TrimSuffix(name, "."), ".") {
+		if len(label) == 0 || len(label) > 63 {
+			return nil, fmt.Errorf("invalid name %q", name)
+		}
+		msg = append(msg, byte(len(label)))
+		msg = append(msg, label...)
+	}
+	msg = append(msg, 0, byte(qtype>>8), byte(qtype), 0, 1)
+	return msg, nil
+}
+
+// parseDNSResponse parses the header and the answer section of the given
+// response message, which must have the given id.
+func parseDNSResponse(msg []byte, id uint16) (*dnsResponse, error) {
+	if len(msg) < 12 {
+		return nil, errors.New("response is shorter than a header")
+	}
+	if actual := binary.BigEndian.Uint16(msg[0:]); actual != id {
+		return nil, fmt.Errorf("response has id %d, expected %d", actual, id)
+	}
+	flags := binary.BigEndian.Uint16(msg[2:])
+	if flags&0x8000 == 0 {
+		return nil, errors.New("message is not a response")
+	}
+	resp := &dnsResponse{
+		Rcode:         int(flags & 0xf),
+		Authoritative: flags&0x0400 != 0,
+		Truncated:     flags&0x0200 != 0,
+	}
+	qdcount := int(binary.BigEndian.Uint16(msg[4:]))
+	ancount := int(binary.BigEndian.Uint16(msg[6:]))
+	off := 12
+	for i := 0; i < qdcount; i++ {
+		_, next, err := readDNSName(msg, off)
+		if err != nil {
+			return nil, err
+		}
+		// Skip the type and class of the question.
+		off = next + 4
+	}
+	for i := 0; i < ancount; i++ {
+		name, next, err := readDNSName(msg, off)
+		if err != nil {
+			return nil, err
+		}
+		if next+10 > len(msg) {
+			return nil, errors.New("answer is truncated")
+		}
+		rr := dnsRecord{
+			Name: name,
+			Type: binary.BigEndian.Uint16(msg[next:]),
+			TTL:  binary.BigEndian.Uint32(msg[next+4:]),
+		}
+		rdlength := int(binary.BigEndian.Uint16(msg[next+8:]))
+		start := next + 10
+		if start+rdlength > len(msg) {
+			return nil, errors.New("answer data is truncated")
+		}
+		if rr.Data, err = dnsRecordData(msg, rr.Type, start, rdlength); err != nil {
+			return nil, fmt.Errorf("failed to parse answer for %s: %v", name, err)
+		}
+		resp.Answers = append(resp.Answers, rr)
+		off = start + rdlength
+	}
+	return resp, nil
+}
+
+// dnsRecordData returns the presentation format of the data of a record of
+// the given type at the given offset and length in msg.
+func dnsRecordData(msg []byte, rrtype uint16, off, length int) (string, error) {
+	rdata := msg[off : off+length]
+	switch rrtype {
+	case dnsTypeA, dnsTypeAAAA:
+		if len(rdata) != net.IPv4len && len(rdata) != net.IPv6len {
+			return "", fmt.Errorf("invalid address length %d", len(rdata))
+		}
+		return net.IP(rdata).String(), nil
+	case dnsTypeCNAME, dnsTypePTR:
+		name, _, err := readDNSName(msg, off)
+		return name, err
+	case dnsTypeSRV:
+		if len(rdata) < 7 {
+			return "", errors.New("srv record is too short")
+		}
+		target, _, err := readDNSName(msg, off+6)
+		if err != nil {
+			return "", err
+		}
+		return fmt.Sprintf("%d %d %d %s", binary.BigEndian.Uint16(rdata[0:]), binary.BigEndian.Uint16(rdata[2:]), binary.BigEndian.Uint16(rdata[4:]), target), nil
+	case dnsTypeTXT:
+		var txt strings.Builder
+		for i := 0; i < len(rdata); {
+			n := int(rdata[i])
+			if i+1+n > len(rdata) {
+				return "", errors.New("txt string is truncated")
+			}
+			txt.Write(rdata[i+1 : i+1+n])
+			i += 1 + n
+		}
+		return txt.String(), nil
+	}
+	return hex.EncodeToString(rdata), nil
+}
+
+// readDNSName reads the possibly compressed domain name at the given offset in
+// msg and returns it in fully qualified form, along with the offset that
+// follows the name.
+func readDNSName(msg []byte, off int) (string, int, error) {
+	var labels []string
+	next := -1
+	limit := off
+	for {
+		if off >= len(msg) {
+			return "", 0, errors.New("name is truncated")
+		}
+		n := int(msg[off])
+		switch {
+		case n == 0:
+			if next < 0 {
+				next = off + 1
+			}
+			return strings.Join(labels, ".") + ".", next, nil
+		case n&0xc0 == 0xc0:
+			if off+1 >= len(msg) {
+				return "", 0, errors.New("name pointer is truncated")
+			}
+			if next < 0 {
+				next = off + 2
+			}
+			// Each pointer must point before the previous one,
+			// which rules out loops.
+			ptr := int(binary.BigEndian.Uint16(msg[off:]) & 0x3fff)
+			if ptr >= limit {
+				return "", 0, errors.New("invalid name pointer")
+			}
+			off, limit = ptr, ptr
+		case n&0xc0 != 0:
+			return "", 0, fmt.Errorf("invalid label length %#x", n)
+		default:
+			if off+1+n > len(msg) {
+				return "", 0, errors.New("label is truncated")
+			}
+			labels = append(labels, string(msg[off+1:off+1+n]))
+			off += 1 + n
+		}
+	}
+}
+
+// dnsPortForward is an "oc port-forward" to a DNS server in the cluster.
+type dnsPortForward struct {
+	cmd *exec.Cmd
+	// addr is the local address that forwards to the DNS server.
+	addr string
+}
+
+// forwardingFromRE matches the line that "oc port-forward" prints when it
+// starts listening, for example "Forwarding from 127.0.0.1:34567 -> 5353".
+var forwardingFromRE = regexp.MustCompile(`Forwarding from 127\.0\.0\.1:(\d+) ->`)
+
+// startDNSPortForward forwards a random local port to the given port of the
+// given resource, such as "svc/dns-default" or "pod/dns-default-abcde", in the
+// given namespace.  The caller must stop the port-forward.
+func startDNSPortForward(ns, resource string, port int) (*dnsPortForward, error) {
+	cmdPath, err := exec.LookPath("oc")
+	if err != nil {
+		return nil, err
+	}
+	cmd := exec.Command(cmdPath, "port-forward", resource, fmt.Sprintf(":%d", port), fmt.Sprintf("--namespace=%v", ns), "--address=127.0.0.1")
+	stdout, err := cmd.StdoutPipe()
+	if err != nil {
+		return nil, err
+	}
+	if err := cmd.Start(); err != nil {
+		return nil, fmt.Errorf("failed to start port-forward to %s/%s: %v", ns, resource, err)
+	}
+	found := make(chan string, 1)
+	go func() {
+		scanner := bufio.NewScanner(stdout)
+		for scanner.Scan() {
+			if m := forwardingFromRE.FindStringSubmatch(scanner.Text()); m != nil {
+				found <- m[1]
+				break
+			}
+		}
+		close(found)
+		// Drain the output so that the port-forward does not block on
+		// writing the lines it logs for each connection.
+		io.Copy(ioutil.Discard, stdout)
+	}()
+	pf := &dnsPortForward{cmd: cmd}
+	select {
+	case localPort, ok := <-found:
+		if !ok {
+			pf.stop()
+			return nil, fmt.Errorf("port-forward to %s/%s exited before it started listening", ns, resource)
+		}
+		pf.addr = net.JoinHostPort("127.0.0.1", localPort)
+	case <-time.After(30 * time.Second):
+		pf.stop()
+		return nil, fmt.Errorf("timed out waiting for port-forward to %s/%s to start listening", ns, resource)
+	}
+	return pf, nil
+}
+
+// stop stops the port-forward.
+func (pf *dnsPortForward) stop() {
+	if pf.cmd.Process != nil {
+		pf.cmd.Process.Kill()
+	}
+	pf.cmd.Wait()
+}
+
+// lookForDNSAnswer queries the DNS server at addr for name and qtype every 2
+// seconds until the timeout is reached or check accepts the response.  Returns
+// an error with the reason of the last rejection if check never accepted a
+// response.
+func lookForDNSAnswer(addr, name string, qtype uint16, check func(*dnsResponse) error, timeout time.Duration) error {
+	var lastErr error
+	err := wait.PollImmediate(2*time.Second, timeout, func() (bool, error) {
+		resp, err := queryDNS(addr, name, qtype, 5*time.Second)
+		if err != nil {
+			lastErr = err
+			return false, nil
+		}
+		if err := check(resp); err != nil {
+			lastErr = err
+			return false, nil
+		}
+		return true, nil
+	})
+	if err != nil {
+		return fmt.Errorf("failed to get the expected answer for %s: %v", name, lastErr)
+	}
+	return nil
+}
+
+// expectDNSAnswer returns a check for lookForDNSAnswer that accepts a
+// successful response with an answer of the given type that has the given
+// data and a nonzero TTL.
+func expectDNSAnswer(qtype uint16, data string) func(*dnsResponse) error {
+	return func(resp *dnsResponse) error {
+		if resp.Rcode != dnsRcodeSuccess {
+			return fmt.Errorf("response has rcode %d", resp.Rcode)
+		}
+		for _, rr := range resp.Answers {
+			if rr.Type != qtype || !strings.Contains(rr.Data, data) {
+				continue
+			}
+			if rr.TTL == 0 {
+				return fmt.Errorf("answer %q has a TTL of 0", rr.Data)
+			}
+			return nil
+		}
+		return fmt.Errorf("response has no answer of type %d with %q, got %v", qtype, data, resp.answerData(qtype))
+	}
+}
+
+// expectNoDNSAnswer returns a check for lookForDNSAnswer that accepts a
+// response without an answer of the given type that has the given data.
+func expectNoDNSAnswer(qtype uint16, data string) func(*dnsResponse) error {
+	return func(resp *dnsResponse) error {
+		for _, rr := range resp.Answers {
+			if rr.Type == qtype && strings.Contains(rr.Data, data) {
+				return fmt.Errorf("response has answer %q", rr.Data)
+			}
+		}
+		return nil
+	}
+}
+
+// expectDNSRcode returns a check for lookForDNSAnswer that accepts a response
+// with the given response code.
+func expectDNSRcode(rcode int) func(*dnsResponse) error {
+	return func(resp *dnsResponse) error {
+		if resp.Rcode != rcode {
+			return fmt.Errorf("response has rcode %d, expected %d", resp.Rcode, rcode)
+		}
+		return nil
+	}
+}
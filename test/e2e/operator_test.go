@@ -11,6 +11,7 @@ import (
 	configv1 "github.com/openshift/api/config/v1"
 	operatorv1 "github.com/openshift/api/operator/v1"
 
+	"github.com/openshift/cluster-dns-operator/pkg/dnsstatus"
 	operatorclient "github.com/openshift/cluster-dns-operator/pkg/operator/client"
 	operatorcontroller "github.com/openshift/cluster-dns-operator/pkg/operator/controller"
 
@@ -28,9 +29,6 @@ const (
 	// upstreamPodName is the name of the upstream CoreDNS server
 	// used for testing DNS forwarding.
 	upstreamPodName = "test-upstream"
-	// upstreamPodNs is the namespace of the upstream CoreDNS server
-	// used for testing DNS forwarding.
-	upstreamPodNs = "openshift-dns"
 	// upstreamCorefile is the Corefile used by the upstream CoreDNS server
 	// used for testing DNS forwarding.
 	upstreamCorefile = `.:5353 {
@@ -41,6 +39,32 @@ const (
     errors
     log
 }
+`
+	// upstreamLargeName is the name of the upstream CoreDNS server used
+	// for testing responses that do not fit in a UDP message.
+	upstreamLargeName = "test-upstream-large"
+	// upstreamLargeZone is the zone that the upstream CoreDNS server named
+	// upstreamLargeName answers with large TXT responses.
+	upstreamLargeZone = "large.com"
+	// upstreamLargeMarker is the last string of the large TXT response.
+	upstreamLargeMarker = "end-of-large-response"
+	// upstreamTLSName is the name of the DNS-over-TLS upstream CoreDNS
+	// server used for testing DNS forwarding over TLS.
+	upstreamTLSName = "test-upstream-tls"
+	// upstreamTLSCorefile is the Corefile used by the DNS-over-TLS
+	// upstream CoreDNS server.  The health endpoint is served from a
+	// plain server block for the pod's probes.
+	upstreamTLSCorefile = `tls://.:8853 {
+    tls /etc/coredns/tls/tls.crt /etc/coredns/tls/tls.key
+    hosts {
+      1.2.3.4 www.foo.com
+    }
+    errors
+    log
+}
+.:5353 {
+    health
+}
 `
 )
 
@@ -58,6 +82,8 @@ func getClient() (client.Client, error) {
 }
 
 func TestOperatorAvailable(t *testing.T) {
+	t.Parallel()
+
 	cl, err := getClient()
 	if err != nil {
 		t.Fatal(err)
@@ -83,6 +109,8 @@ func TestOperatorAvailable(t *testing.T) {
 }
 
 func TestDefaultDNSExists(t *testing.T) {
+	t.Parallel()
+
 	cl, err := getClient()
 	if err != nil {
 		t.Fatal(err)
@@ -100,7 +128,49 @@ func TestDefaultDNSExists(t *testing.T) {
 	}
 }
 
+// TestClusterServiceResolution verifies that the default dns answers queries
+// for the records of cluster services with the expected records, TTLs, and
+// response codes.
+func TestClusterServiceResolution(t *testing.T) {
+	defer rlockDefaultDNS(t)()
+
+	cl, err := getClient()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	defaultDNS := &operatorv1.DNS{}
+	if err := cl.Get(context.TODO(), types.NamespacedName{Name: operatorcontroller.DefaultDNSController}, defaultDNS); err != nil {
+		t.Fatalf("failed to get default dns: %v", err)
+	}
+	kubernetesSvc := &corev1.Service{}
+	if err := cl.Get(context.TODO(), types.NamespacedName{Namespace: "default", Name: "kubernetes"}, kubernetesSvc); err != nil {
+		t.Fatalf("failed to get service default/kubernetes: %v", err)
+	}
+	dnsService := operatorcontroller.DNSServiceName(defaultDNS)
+	pf, err := startDNSPortForward(dnsService.Namespace, "svc/"+dnsService.Name, 53)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer pf.stop()
+
+	name := "kubernetes.default.svc." + dnsstatus.EffectiveClusterDomain(defaultDNS)
+	if err := lookForDNSAnswer(pf.addr, name, dnsTypeA, expectDNSAnswer(dnsTypeA, kubernetesSvc.Spec.ClusterIP), 30*time.Second); err != nil {
+		t.Fatal(err)
+	}
+	srvName := "_https._tcp." + name
+	if err := lookForDNSAnswer(pf.addr, srvName, dnsTypeSRV, expectDNSAnswer(dnsTypeSRV, " 443 "+name+"."), 30*time.Second); err != nil {
+		t.Fatal(err)
+	}
+	missingName := "no-such-service.default.svc." + dnsstatus.EffectiveClusterDomain(defaultDNS)
+	if err := lookForDNSAnswer(pf.addr, missingName, dnsTypeA, expectDNSRcode(dnsRcodeNXDomain), 30*time.Second); err != nil {
+		t.Fatal(err)
+	}
+}
+
 func TestVersionReporting(t *testing.T) {
+	defer lockDefaultDNS(t)()
+
 	cl, err := getClient()
 	if err != nil {
 		t.Fatal(err)
@@ -166,6 +236,8 @@ func TestVersionReporting(t *testing.T) {
 }
 
 func TestCoreDNSImageUpgrade(t *testing.T) {
+	defer lockDefaultDNS(t)()
+
 	cl, err := getClient()
 	if err != nil {
 		t.Fatal(err)
@@ -251,13 +323,17 @@ func setImage(deployment *appsv1.Deployment, image string) {
 }
 
 func TestDNSForwarding(t *testing.T) {
+	defer lockDefaultDNS(t)()
+
 	cl, err := getClient()
 	if err != nil {
 		t.Fatal(err)
 	}
+	ns, deleteNamespace := createTestNamespace(t, cl)
+	defer deleteNamespace()
 
 	// Create the upstream resolver ConfigMap.
-	upstreamCfgMap := buildConfigMap(upstreamPodName, upstreamPodNs, "Corefile", upstreamCorefile)
+	upstreamCfgMap := buildConfigMap(upstreamPodName, ns, "Corefile", upstreamCorefile)
 	if err := cl.Create(context.TODO(), upstreamCfgMap); err != nil {
 		t.Fatalf("failed to create configmap %s/%s: %v", upstreamCfgMap.Namespace, upstreamCfgMap.Name, err)
 	}
@@ -291,7 +367,7 @@ func TestDNSForwarding(t *testing.T) {
 	}
 
 	// Create the upstream resolver Pod.
-	upstreamResolver := upstreamPod(upstreamPodName, upstreamPodNs, coreImage, upstreamPodName)
+	upstreamResolver := upstreamPod(upstreamPodName, ns, coreImage, upstreamPodName)
 	if err := cl.Create(context.TODO(), upstreamResolver); err != nil {
 		t.Fatalf("failed to create pod %s/%s: %v", upstreamResolver.Namespace, upstreamResolver.Name, err)
 	}
@@ -318,7 +394,7 @@ func TestDNSForwarding(t *testing.T) {
 	}
 
 	// Create the upstream resolver Service and get the ClusterIP.
-	upstreamSvc := upstreamService(upstreamPodName, upstreamPodNs)
+	upstreamSvc := upstreamService(upstreamPodName, ns)
 	if err := cl.Create(context.TODO(), upstreamSvc); err != nil {
 		t.Fatalf("failed to create service %s/%s: %v", upstreamSvc.Namespace, upstreamSvc.Name, err)
 	}
@@ -385,27 +461,265 @@ func TestDNSForwarding(t *testing.T) {
 		}
 	}
 
-	// Get the openshift-cli image.
-	var (
-		cliImage      string
-		cliImageFound bool
+	// Query the example dns forwarding host through the dns service.
+	dnsService := operatorcontroller.DNSServiceName(defaultDNS)
+	pf, err := startDNSPortForward(dnsService.Namespace, "svc/"+dnsService.Name, 53)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer pf.stop()
+	fooName, fooHost := "www.foo.com", "1.2.3.4"
+	if err := lookForDNSAnswer(pf.addr, fooName, dnsTypeA, expectDNSAnswer(dnsTypeA, fooHost), 30*time.Second); err != nil {
+		t.Fatalf("failed to resolve %s through %s: %v", fooName, upstreamIP, err)
+	}
+	// Scrape the upstream resolver logs for the "NOERROR" message.
+	logMsg := "NOERROR"
+	if err := lookForStringInPodLog(upstreamResolver.Namespace, upstreamResolver.Name, upstreamResolver.Name, logMsg, 30*time.Second); err != nil {
+		t.Fatalf("failed to parse %q from pod %s/%s logs: %v", logMsg, upstreamResolver.Namespace, upstreamResolver.Name, err)
+	}
+}
+
+// TestDNSForwardingMultipleZones verifies forwarding for several servers with
+// nested and overlapping zones: a query is forwarded to the upstreams of the
+// server of the most specific zone that contains the name, a zone that an
+// earlier server already lists does not take over the zone, and adding and
+// removing servers at runtime changes where queries are forwarded.
+func TestDNSForwardingMultipleZones(t *testing.T) {
+	defer lockDefaultDNS(t)()
+
+	cl, err := getClient()
+	if err != nil {
+		t.Fatal(err)
+	}
+	ns, deleteNamespace := createTestNamespace(t, cl)
+	defer deleteNamespace()
+
+	coreImage, err := clusterOperatorVersion(cl, operatorcontroller.CoreDNSVersionName)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The outer upstream answers for names in both zones, and the nested
+	// upstream answers differently for names in the nested zone, so the
+	// answer shows which upstream a query was forwarded to.
+	const (
+		outerZone     = "e2e.test"
+		nestedZone    = "nested." + outerZone
+		outerName     = "www." + outerZone
+		nestedName    = "www." + nestedZone
+		outerAddress  = "10.1.0.1"
+		outerNested   = "10.1.0.2"
+		nestedAddress = "10.2.0.2"
 	)
-	for _, ver := range co.Status.Versions {
-		if ver.Name == operatorcontroller.OpenshiftCLIVersionName {
-			if len(ver.Version) == 0 {
-				break
-			}
-			cliImage = ver.Version
-			cliImageFound = true
-			break
+	outerIP, err := createUpstreamResolver(cl, ns, "test-upstream-outer", coreImage, fmt.Sprintf(`.:5353 {
+    hosts {
+      %s %s
+      %s %s
+    }
+    health
+    errors
+    log
+}
+`, outerAddress, outerName, outerNested, nestedName))
+	if err != nil {
+		t.Fatal(err)
+	}
+	nestedIP, err := createUpstreamResolver(cl, ns, "test-upstream-nested", coreImage, fmt.Sprintf(`.:5353 {
+    hosts {
+      %s %s
+    }
+    health
+    errors
+    log
+}
+`, nestedAddress, nestedName))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	outer := operatorv1.Server{
+		Name:          "test-outer",
+		Zones:         []string{outerZone},
+		ForwardPlugin: operatorv1.ForwardPlugin{Upstreams: []string{outerIP}},
+	}
+	nested := operatorv1.Server{
+		Name:          "test-nested",
+		Zones:         []string{nestedZone},
+		ForwardPlugin: operatorv1.ForwardPlugin{Upstreams: []string{nestedIP}},
+	}
+	// The shadow server lists the outer zone again with a different case
+	// and a trailing dot, which the operator ignores in favor of the outer
+	// server rather than rendering a Corefile that CoreDNS cannot load.
+	shadow := operatorv1.Server{
+		Name:          "test-shadow",
+		Zones:         []string{"E2E.test."},
+		ForwardPlugin: operatorv1.ForwardPlugin{Upstreams: []string{nestedIP}},
+	}
+	if err := setDefaultDNSServers(cl, []operatorv1.Server{outer, nested, shadow}); err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		if err := setDefaultDNSServers(cl, nil); err != nil {
+			t.Fatal(err)
 		}
+	}()
+
+	dnsService := operatorcontroller.DNSServiceName(&operatorv1.DNS{ObjectMeta: metav1.ObjectMeta{Name: operatorcontroller.DefaultDNSController}})
+	pf, err := startDNSPortForward(dnsService.Namespace, "svc/"+dnsService.Name, 53)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer pf.stop()
+
+	if err := lookForDNSAnswer(pf.addr, outerName, dnsTypeA, expectDNSAnswer(dnsTypeA, outerAddress), 2*time.Minute); err != nil {
+		t.Fatalf("failed to resolve %s through the outer server: %v", outerName, err)
 	}
-	if !cliImageFound {
-		t.Fatalf("failed to find the %s version for clusteroperator %s", operatorcontroller.OpenshiftCLIVersionName, co.Name)
+	if err := lookForDNSAnswer(pf.addr, nestedName, dnsTypeA, expectDNSAnswer(dnsTypeA, nestedAddress), 2*time.Minute); err != nil {
+		t.Fatalf("failed to resolve %s through the nested server: %v", nestedName, err)
 	}
 
-	// Create the client Pod.
-	testClient := buildPod("test-client", "default", cliImage, []string{"sleep", "3600"})
+	// Remove the nested server: its names are forwarded to the outer
+	// upstream.
+	if err := setDefaultDNSServers(cl, []operatorv1.Server{outer}); err != nil {
+		t.Fatal(err)
+	}
+	if err := lookForDNSAnswer(pf.addr, nestedName, dnsTypeA, expectDNSAnswer(dnsTypeA, outerNested), 2*time.Minute); err != nil {
+		t.Fatalf("failed to resolve %s through the outer server after removing the nested server: %v", nestedName, err)
+	}
+
+	// Add the nested server back: its names are forwarded to the nested
+	// upstream again.
+	if err := setDefaultDNSServers(cl, []operatorv1.Server{outer, nested}); err != nil {
+		t.Fatal(err)
+	}
+	if err := lookForDNSAnswer(pf.addr, nestedName, dnsTypeA, expectDNSAnswer(dnsTypeA, nestedAddress), 2*time.Minute); err != nil {
+		t.Fatalf("failed to resolve %s through the nested server after adding it back: %v", nestedName, err)
+	}
+
+	// Remove all servers: names in the zones are no longer forwarded to
+	// the test upstreams.
+	if err := setDefaultDNSServers(cl, nil); err != nil {
+		t.Fatal(err)
+	}
+	if err := lookForDNSAnswer(pf.addr, outerName, dnsTypeA, expectNoDNSAnswer(dnsTypeA, outerAddress), 2*time.Minute); err != nil {
+		t.Fatalf("failed to observe that %s is no longer forwarded: %v", outerName, err)
+	}
+}
+
+// TestDNSOverTLSUpstream verifies the scaffolding for testing DNS forwarding
+// over TLS: it stands up an in-cluster DNS-over-TLS upstream resolver with a
+// generated certificate and checks that a client trusts the upstream only with
+// the CA that signed its certificate.
+func TestDNSOverTLSUpstream(t *testing.T) {
+	defer rlockDefaultDNS(t)()
+
+	cl, err := getClient()
+	if err != nil {
+		t.Fatal(err)
+	}
+	ns, deleteNamespace := createTestNamespace(t, cl)
+	defer deleteNamespace()
+
+	coreImage, err := clusterOperatorVersion(cl, operatorcontroller.CoreDNSVersionName)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cliImage, err := clusterOperatorVersion(cl, operatorcontroller.OpenshiftCLIVersionName)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Generate the upstream resolver's certificate and a second CA that did
+	// not sign it.
+	upstreamHost := fmt.Sprintf("%s.%s.svc", upstreamTLSName, ns)
+	caPEM, certPEM, keyPEM, err := generateTLSCertificates([]string{upstreamHost, upstreamHost + ".cluster.local"})
+	if err != nil {
+		t.Fatalf("failed to generate certificates: %v", err)
+	}
+	badCAPEM, _, _, err := generateTLSCertificates([]string{upstreamHost})
+	if err != nil {
+		t.Fatalf("failed to generate certificates: %v", err)
+	}
+
+	// Create the upstream resolver Secret, ConfigMap, Pod, and Service.
+	upstreamSecret := buildTLSSecret(upstreamTLSName, ns, certPEM, keyPEM)
+	if err := cl.Create(context.TODO(), upstreamSecret); err != nil {
+		t.Fatalf("failed to create secret %s/%s: %v", upstreamSecret.Namespace, upstreamSecret.Name, err)
+	}
+	defer func() {
+		if err := cl.Delete(context.TODO(), upstreamSecret); err != nil {
+			t.Fatalf("failed to delete secret %s/%s: %v", upstreamSecret.Namespace, upstreamSecret.Name, err)
+		}
+	}()
+	upstreamCfgMap := buildConfigMap(upstreamTLSName, ns, "Corefile", upstreamTLSCorefile)
+	if err := cl.Create(context.TODO(), upstreamCfgMap); err != nil {
+		t.Fatalf("failed to create configmap %s/%s: %v", upstreamCfgMap.Namespace, upstreamCfgMap.Name, err)
+	}
+	defer func() {
+		if err := cl.Delete(context.TODO(), upstreamCfgMap); err != nil {
+			t.Fatalf("failed to delete configmap %s/%s: %v", upstreamCfgMap.Namespace, upstreamCfgMap.Name, err)
+		}
+	}()
+	upstreamResolver := upstreamTLSPod(upstreamTLSName, ns, coreImage, upstreamCfgMap.Name, upstreamSecret.Name)
+	if err := cl.Create(context.TODO(), upstreamResolver); err != nil {
+		t.Fatalf("failed to create pod %s/%s: %v", upstreamResolver.Namespace, upstreamResolver.Name, err)
+	}
+	defer func() {
+		if err := cl.Delete(context.TODO(), upstreamResolver); err != nil {
+			t.Fatalf("failed to delete pod %s/%s: %v", upstreamResolver.Namespace, upstreamResolver.Name, err)
+		}
+	}()
+	if err := waitForPodReady(cl, upstreamResolver, 2*time.Minute); err != nil {
+		t.Fatal(err)
+	}
+	// CoreDNS logs the servers it listens on when it starts.
+	logMsg := "tls://.:8853"
+	if err := lookForStringInPodLog(upstreamResolver.Namespace, upstreamResolver.Name, upstreamResolver.Name, logMsg, 30*time.Second); err != nil {
+		t.Fatalf("failed to parse %q from pod %s/%s logs: %v", logMsg, upstreamResolver.Namespace, upstreamResolver.Name, err)
+	}
+	upstreamSvc := upstreamTLSService(upstreamTLSName, ns)
+	if err := cl.Create(context.TODO(), upstreamSvc); err != nil {
+		t.Fatalf("failed to create service %s/%s: %v", upstreamSvc.Namespace, upstreamSvc.Name, err)
+	}
+	defer func() {
+		if err := cl.Delete(context.TODO(), upstreamSvc); err != nil {
+			t.Fatalf("failed to delete service %s/%s: %v", upstreamSvc.Namespace, upstreamSvc.Name, err)
+		}
+	}()
+
+	// Create the client Pod with both CAs.
+	caCfgMap := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test-client-tls-ca",
+			Namespace: ns,
+		},
+		Data: map[string]string{
+			"ca.crt":     string(caPEM),
+			"bad-ca.crt": string(badCAPEM),
+		},
+	}
+	if err := cl.Create(context.TODO(), caCfgMap); err != nil {
+		t.Fatalf("failed to create configmap %s/%s: %v", caCfgMap.Namespace, caCfgMap.Name, err)
+	}
+	defer func() {
+		if err := cl.Delete(context.TODO(), caCfgMap); err != nil {
+			t.Fatalf("failed to delete configmap %s/%s: %v", caCfgMap.Namespace, caCfgMap.Name, err)
+		}
+	}()
+	testClient := buildPod("test-client-tls", ns, cliImage, []string{"sleep", "3600"})
+	testClient.Spec.Volumes = []corev1.Volume{{
+		Name: "ca",
+		VolumeSource: corev1.VolumeSource{
+			ConfigMap: &corev1.ConfigMapVolumeSource{
+				LocalObjectReference: corev1.LocalObjectReference{Name: caCfgMap.Name},
+			},
+		},
+	}}
+	testClient.Spec.Containers[0].VolumeMounts = []corev1.VolumeMount{{
+		Name:      "ca",
+		ReadOnly:  true,
+		MountPath: "/etc/test-ca",
+	}}
 	if err := cl.Create(context.TODO(), testClient); err != nil {
 		t.Fatalf("failed to create pod %s/%s: %v", testClient.Namespace, testClient.Name, err)
 	}
@@ -414,31 +728,159 @@ func TestDNSForwarding(t *testing.T) {
 			t.Fatalf("failed to delete pod %s/%s: %v", testClient.Namespace, testClient.Name, err)
 		}
 	}()
-	// Wait for the client Pod to be ready.
-	err = wait.PollImmediate(1*time.Second, 60*time.Second, func() (bool, error) {
-		if err := cl.Get(context.TODO(), types.NamespacedName{Namespace: testClient.Namespace, Name: testClient.Name}, testClient); err != nil {
-			return false, nil
+	if err := waitForPodReady(cl, testClient, 60*time.Second); err != nil {
+		t.Fatal(err)
+	}
+	if err := lookForStringInPodExec(testClient.Namespace, testClient.Name, testClient.Name, []string{"sh", "-c", "command -v openssl && echo found"}, "found", 10*time.Second); err != nil {
+		t.Skipf("openssl is not available in image %s", cliImage)
+	}
+
+	// Verify that the upstream resolver's certificate is trusted with the
+	// CA that signed it and is rejected with the other CA.  The exit status
+	// is ignored because it is the output that is checked.
+	connect := func(ca string) []string {
+		return []string{"sh", "-c", fmt.Sprintf("openssl s_client -connect %s:853 -servername %s -CAfile /etc/test-ca/%s -verify_return_error </dev/null 2>&1; true", upstreamHost, upstreamHost, ca)}
+	}
+	if err := lookForStringInPodExec(testClient.Namespace, testClient.Name, testClient.Name, connect("ca.crt"), "Verify return code: 0 (ok)", 60*time.Second); err != nil {
+		t.Fatalf("failed to verify upstream %s with its ca: %v", upstreamHost, err)
+	}
+	if err := lookForStringInPodExec(testClient.Namespace, testClient.Name, testClient.Name, connect("bad-ca.crt"), "verify error", 60*time.Second); err != nil {
+		t.Fatalf("failed to observe verification error for upstream %s with a bad ca: %v", upstreamHost, err)
+	}
+
+}
+
+// TestDNSTCPFallback verifies that responses that are too large for UDP are
+// truncated and that clients retrieve them over TCP through the dns service.
+// The default dns forwards a zone to an upstream resolver that answers TXT
+// queries with a response of several kilobytes.
+func TestDNSTCPFallback(t *testing.T) {
+	defer lockDefaultDNS(t)()
+
+	cl, err := getClient()
+	if err != nil {
+		t.Fatal(err)
+	}
+	ns, deleteNamespace := createTestNamespace(t, cl)
+	defer deleteNamespace()
+
+	coreImage, err := clusterOperatorVersion(cl, operatorcontroller.CoreDNSVersionName)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cliImage, err := clusterOperatorVersion(cl, operatorcontroller.OpenshiftCLIVersionName)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Create the upstream resolver ConfigMap, Pod, and Service.  The Pod
+	// and Service have their own label so that the Service does not select
+	// the upstream resolvers of other tests.
+	upstreamCfgMap := buildConfigMap(upstreamLargeName, ns, "Corefile", largeResponseCorefile(upstreamLargeZone, upstreamLargeMarker))
+	if err := cl.Create(context.TODO(), upstreamCfgMap); err != nil {
+		t.Fatalf("failed to create configmap %s/%s: %v", upstreamCfgMap.Namespace, upstreamCfgMap.Name, err)
+	}
+	defer func() {
+		if err := cl.Delete(context.TODO(), upstreamCfgMap); err != nil {
+			t.Fatalf("failed to delete configmap %s/%s: %v", upstreamCfgMap.Namespace, upstreamCfgMap.Name, err)
 		}
-		for _, cond := range testClient.Status.Conditions {
-			if cond.Type == corev1.ContainersReady &&
-				cond.Status == corev1.ConditionTrue {
-				return true, nil
+	}()
+	upstreamResolver := upstreamPod(upstreamLargeName, ns, coreImage, upstreamCfgMap.Name)
+	upstreamResolver.Labels = map[string]string{"test": "upstream-large"}
+	if err := cl.Create(context.TODO(), upstreamResolver); err != nil {
+		t.Fatalf("failed to create pod %s/%s: %v", upstreamResolver.Namespace, upstreamResolver.Name, err)
+	}
+	defer func() {
+		if err := cl.Delete(context.TODO(), upstreamResolver); err != nil {
+			t.Fatalf("failed to delete pod %s/%s: %v", upstreamResolver.Namespace, upstreamResolver.Name, err)
+		}
+	}()
+	if err := waitForPodReady(cl, upstreamResolver, 2*time.Minute); err != nil {
+		t.Fatal(err)
+	}
+	upstreamSvc := upstreamService(upstreamLargeName, ns)
+	upstreamSvc.Spec.Selector = upstreamResolver.Labels
+	if err := cl.Create(context.TODO(), upstreamSvc); err != nil {
+		t.Fatalf("failed to create service %s/%s: %v", upstreamSvc.Namespace, upstreamSvc.Name, err)
+	}
+	defer func() {
+		if err := cl.Delete(context.TODO(), upstreamSvc); err != nil {
+			t.Fatalf("failed to delete service %s/%s: %v", upstreamSvc.Namespace, upstreamSvc.Name, err)
+		}
+	}()
+	if err := cl.Get(context.TODO(), types.NamespacedName{Namespace: upstreamSvc.Namespace, Name: upstreamSvc.Name}, upstreamSvc); err != nil {
+		t.Fatalf("failed to get service %s/%s: %v", upstreamSvc.Namespace, upstreamSvc.Name, err)
+	}
+	upstreamIP := upstreamSvc.Spec.ClusterIP
+	if len(upstreamIP) == 0 {
+		t.Fatalf("failed to get clusterIP for service %s/%s", upstreamSvc.Namespace, upstreamSvc.Name)
+	}
+
+	// Forward the zone of the large responses to the upstream resolver.
+	defaultDNS := &operatorv1.DNS{}
+	if err := cl.Get(context.TODO(), types.NamespacedName{Name: operatorcontroller.DefaultDNSController}, defaultDNS); err != nil {
+		t.Fatalf("failed to get default dns: %v", err)
+	}
+	defaultDNS.Spec.Servers = []operatorv1.Server{{
+		Name:          "test-large",
+		Zones:         []string{upstreamLargeZone},
+		ForwardPlugin: operatorv1.ForwardPlugin{Upstreams: []string{upstreamIP}},
+	}}
+	if err := cl.Update(context.TODO(), defaultDNS); err != nil {
+		t.Fatalf("failed to update dns %s: %v", defaultDNS.Name, err)
+	}
+	defer func() {
+		defaultDNS = &operatorv1.DNS{}
+		if err := cl.Get(context.TODO(), types.NamespacedName{Name: operatorcontroller.DefaultDNSController}, defaultDNS); err != nil {
+			t.Fatalf("failed to get default dns: %v", err)
+		}
+		if len(defaultDNS.Spec.Servers) != 0 {
+			defaultDNS.Spec.Servers = nil
+			if err := cl.Update(context.TODO(), defaultDNS); err != nil {
+				t.Fatalf("failed to update dns %s: %v", defaultDNS.Name, err)
 			}
 		}
-		return false, nil
-	})
-	if err != nil {
-		t.Fatalf("failed to observe ContainersReady condition for pod %s/%s: %v", testClient.Namespace, testClient.Name, err)
+	}()
+
+	// Create the client Pod.
+	testClient := buildPod("test-client-tcp", ns, cliImage, []string{"sleep", "3600"})
+	if err := cl.Create(context.TODO(), testClient); err != nil {
+		t.Fatalf("failed to create pod %s/%s: %v", testClient.Namespace, testClient.Name, err)
+	}
+	defer func() {
+		if err := cl.Delete(context.TODO(), testClient); err != nil {
+			t.Fatalf("failed to delete pod %s/%s: %v", testClient.Namespace, testClient.Name, err)
+		}
+	}()
+	if err := waitForPodReady(cl, testClient, 60*time.Second); err != nil {
+		t.Fatal(err)
 	}
-	// Dig the example dns forwarding host.
-	digCmd := []string{"dig", "+short", "www.foo.com", "A"}
-	fooHost := "1.2.3.4"
-	if err := lookForStringInPodExec(testClient.Namespace, testClient.Name, testClient.Name, digCmd, fooHost, 30*time.Second); err != nil {
-		t.Fatalf("failed to dig %s: %v", upstreamIP, err)
+
+	// Query over TCP through the dns service, which waits for the dns
+	// pods to load the server for the zone.
+	dnsService := operatorcontroller.DNSServiceName(defaultDNS)
+	pf, err := startDNSPortForward(dnsService.Namespace, "svc/"+dnsService.Name, 53)
+	if err != nil {
+		t.Fatal(err)
 	}
-	// Scrape the upstream resolver logs for the "NOERROR" message.
-	logMsg := "NOERROR"
-	if err := lookForStringInPodLog(upstreamResolver.Namespace, upstreamResolver.Name, upstreamResolver.Name, logMsg, 30*time.Second); err != nil {
-		t.Fatalf("failed to parse %q from pod %s/%s logs: %v", logMsg, upstreamResolver.Namespace, upstreamResolver.Name, err)
+	defer pf.stop()
+	name := "txt." + upstreamLargeZone
+	if err := lookForDNSAnswer(pf.addr, name, dnsTypeTXT, expectDNSAnswer(dnsTypeTXT, upstreamLargeMarker), 2*time.Minute); err != nil {
+		t.Fatalf("failed to query %s over tcp: %v", name, err)
+	}
+	// The port-forward only carries TCP, so the UDP queries run in the
+	// client pod.
+	//
+	// Query over UDP without retrying over TCP: the response must be
+	// truncated.
+	digUDP := []string{"dig", "+notcp", "+ignore", "+bufsize=512", "+noall", "+comments", name, "TXT"}
+	if err := lookForStringInPodExec(testClient.Namespace, testClient.Name, testClient.Name, digUDP, "tc rd", 30*time.Second); err != nil {
+		t.Fatalf("failed to observe truncated response for %s over udp: %v", name, err)
+	}
+	// Query over UDP with the default retry over TCP: the client must get
+	// the whole response.
+	digFallback := []string{"dig", "+bufsize=512", name, "TXT"}
+	if err := lookForStringInPodExec(testClient.Namespace, testClient.Name, testClient.Name, digFallback, upstreamLargeMarker, 30*time.Second); err != nil {
+		t.Fatalf("failed to dig %s with tcp fallback: %v", name, err)
 	}
 }
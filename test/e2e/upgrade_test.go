@@ -0,0 +1,240 @@
+// +build e2e
+
+package e2e
+
+import (
+	"context"
+	"fmt"
+	"os/exec"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	operatorv1 "github.com/openshift/api/operator/v1"
+
+	operatorcontroller "github.com/openshift/cluster-dns-operator/pkg/operator/controller"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/apimachinery/pkg/util/wait"
+)
+
+const (
+	// previousReleaseCorefile is the Corefile that the previous release of
+	// the operator generates for a dns without servers.
+	previousReleaseCorefile = `.:5353 {
+    errors
+    health
+    kubernetes cluster.local in-addr.arpa ip6.arpa {
+        pods insecure
+        upstream
+        fallthrough in-addr.arpa ip6.arpa
+    }
+    prometheus :9153
+    forward . /etc/resolv.conf {
+        policy sequential
+    }
+    cache 30
+    reload
+}
+`
+	// maxConsecutiveLookupFailures is the number of consecutive failed
+	// lookups that is considered a resolution gap.
+	maxConsecutiveLookupFailures = 2
+)
+
+// setPreviousReleaseDNSContainer reverts the dns container of the given
+// daemonset to what the previous release of the operator generates: both
+// probes use the health endpoint on port 8080, and there are no named health
+// and ready ports.
+func setPreviousReleaseDNSContainer(ds *appsv1.DaemonSet) {
+	for i, c := range ds.Spec.Template.Spec.Containers {
+		if c.Name != "dns" {
+			continue
+		}
+		container := &ds.Spec.Template.Spec.Containers[i]
+		ports := []corev1.ContainerPort{}
+		for _, p := range c.Ports {
+			if p.Name == "dns" || p.Name == "dns-tcp" || p.Name == "metrics" {
+				ports = append(ports, p)
+			}
+		}
+		container.Ports = ports
+		for _, probe := range []*corev1.Probe{container.LivenessProbe, container.ReadinessProbe} {
+			if probe != nil && probe.HTTPGet != nil {
+				probe.HTTPGet.Path = "/health"
+				probe.HTTPGet.Port = intstr.FromInt(8080)
+			}
+		}
+	}
+}
+
+// lookupMonitor repeatedly resolves a name from a client pod and records the
+// number of failed lookups and the longest run of consecutive failed lookups.
+type lookupMonitor struct {
+	lock                   sync.Mutex
+	lookups                int
+	failures               int
+	consecutiveFailures    int
+	maxConsecutiveFailures int
+}
+
+// run resolves name from the given pod every 2 seconds, expecting the output
+// to contain expected, until stop is closed.
+func (m *lookupMonitor) run(ns, pod string, name, expected string, stop <-chan struct{}) error {
+	cmdPath, err := exec.LookPath("oc")
+	if err != nil {
+		return err
+	}
+	args := []string{"exec", pod, "-c", pod, fmt.Sprintf("--namespace=%v", ns), "--", "dig", "+short", "+time=2", "+tries=1", name, "A"}
+	go wait.Until(func() {
+		result, err := runCmd(cmdPath, args)
+		m.lock.Lock()
+		defer m.lock.Unlock()
+		m.lookups++
+		if err != nil || !strings.Contains(result, expected) {
+			m.failures++
+			m.consecutiveFailures++
+			if m.consecutiveFailures > m.maxConsecutiveFailures {
+				m.maxConsecutiveFailures = m.consecutiveFailures
+			}
+			return
+		}
+		m.consecutiveFailures = 0
+	}, 2*time.Second, stop)
+	return nil
+}
+
+// result returns the number of lookups, the number of failed lookups, and the
+// longest run of consecutive failed lookups.
+func (m *lookupMonitor) result() (int, int, int) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	return m.lookups, m.failures, m.maxConsecutiveFailures
+}
+
+// TestCorefileUpgradeMigration applies the configmap and daemonset that the
+// previous release of the operator generates and verifies that the operator
+// migrates them to the current format without a gap in name resolution and
+// without repeatedly updating them afterwards.
+func TestCorefileUpgradeMigration(t *testing.T) {
+	defer lockDefaultDNS(t)()
+
+	cl, err := getClient()
+	if err != nil {
+		t.Fatal(err)
+	}
+	ns, deleteNamespace := createTestNamespace(t, cl)
+	defer deleteNamespace()
+
+	defaultDNS := &operatorv1.DNS{}
+	if err := cl.Get(context.TODO(), types.NamespacedName{Name: operatorcontroller.DefaultDNSController}, defaultDNS); err != nil {
+		t.Fatalf("failed to get default dns: %v", err)
+	}
+	if len(defaultDNS.Spec.Servers) != 0 {
+		t.Skipf("dns %s has servers; the previous release configuration is for a dns without servers", defaultDNS.Name)
+	}
+	cmName := operatorcontroller.DNSConfigMapName(defaultDNS)
+	cm := &corev1.ConfigMap{}
+	if err := cl.Get(context.TODO(), cmName, cm); err != nil {
+		t.Fatalf("failed to get configmap %s: %v", cmName, err)
+	}
+	currentCorefile := cm.Data["Corefile"]
+	dsName := operatorcontroller.DNSDaemonSetName(defaultDNS)
+
+	// Resolve the kubernetes service throughout the migration.
+	kubernetesSvc := &corev1.Service{}
+	if err := cl.Get(context.TODO(), types.NamespacedName{Namespace: "default", Name: "kubernetes"}, kubernetesSvc); err != nil {
+		t.Fatalf("failed to get service default/kubernetes: %v", err)
+	}
+	cliImage, err := clusterOperatorVersion(cl, operatorcontroller.OpenshiftCLIVersionName)
+	if err != nil {
+		t.Fatal(err)
+	}
+	testClient := buildPod("test-client-upgrade", ns, cliImage, []string{"sleep", "3600"})
+	if err := cl.Create(context.TODO(), testClient); err != nil {
+		t.Fatalf("failed to create pod %s/%s: %v", testClient.Namespace, testClient.Name, err)
+	}
+	defer func() {
+		if err := cl.Delete(context.TODO(), testClient); err != nil {
+			t.Fatalf("failed to delete pod %s/%s: %v", testClient.Namespace, testClient.Name, err)
+		}
+	}()
+	if err := waitForPodReady(cl, testClient, 60*time.Second); err != nil {
+		t.Fatal(err)
+	}
+	monitor := &lookupMonitor{}
+	stop := make(chan struct{})
+	if err := monitor.run(testClient.Namespace, testClient.Name, "kubernetes.default.svc.cluster.local", kubernetesSvc.Spec.ClusterIP, stop); err != nil {
+		t.Fatalf("failed to start lookups: %v", err)
+	}
+	defer close(stop)
+
+	// Apply the previous release's configmap and daemonset.
+	cm.Data["Corefile"] = previousReleaseCorefile
+	if err := cl.Update(context.TODO(), cm); err != nil {
+		t.Fatalf("failed to update configmap %s: %v", cmName, err)
+	}
+	ds := &appsv1.DaemonSet{}
+	if err := cl.Get(context.TODO(), dsName, ds); err != nil {
+		t.Fatalf("failed to get daemonset %s: %v", dsName, err)
+	}
+	setPreviousReleaseDNSContainer(ds)
+	if err := cl.Update(context.TODO(), ds); err != nil {
+		t.Fatalf("failed to update daemonset %s: %v", dsName, err)
+	}
+
+	// Wait for the operator to restore the current configuration and for
+	// the rollout to complete.
+	err = wait.PollImmediate(2*time.Second, 10*time.Minute, func() (bool, error) {
+		if err := cl.Get(context.TODO(), cmName, cm); err != nil {
+			return false, nil
+		}
+		if cm.Data["Corefile"] != currentCorefile {
+			return false, nil
+		}
+		if err := cl.Get(context.TODO(), dsName, ds); err != nil {
+			return false, nil
+		}
+		for _, c := range ds.Spec.Template.Spec.Containers {
+			if c.Name == "dns" && (c.ReadinessProbe == nil || c.ReadinessProbe.HTTPGet == nil || c.ReadinessProbe.HTTPGet.Path != "/ready") {
+				return false, nil
+			}
+		}
+		status := ds.Status
+		return status.ObservedGeneration == ds.Generation &&
+			status.UpdatedNumberScheduled == status.DesiredNumberScheduled &&
+			status.NumberAvailable == status.DesiredNumberScheduled, nil
+	})
+	if err != nil {
+		t.Fatalf("failed to observe migration of configmap %s and daemonset %s: %v", cmName, dsName, err)
+	}
+
+	// Verify that the operator does not keep updating the migrated
+	// resources.
+	cmVersion, dsGeneration := cm.ResourceVersion, ds.Generation
+	time.Sleep(1 * time.Minute)
+	if err := cl.Get(context.TODO(), cmName, cm); err != nil {
+		t.Fatalf("failed to get configmap %s: %v", cmName, err)
+	}
+	if cm.ResourceVersion != cmVersion {
+		t.Errorf("configmap %s was updated after migration: resource version %s, expected %s", cmName, cm.ResourceVersion, cmVersion)
+	}
+	if err := cl.Get(context.TODO(), dsName, ds); err != nil {
+		t.Fatalf("failed to get daemonset %s: %v", dsName, err)
+	}
+	if ds.Generation != dsGeneration {
+		t.Errorf("daemonset %s was updated after migration: generation %d, expected %d", dsName, ds.Generation, dsGeneration)
+	}
+
+	lookups, _, failures := monitor.result()
+	if lookups == 0 {
+		t.Fatalf("no lookups were made from pod %s/%s", testClient.Namespace, testClient.Name)
+	}
+	if failures >= maxConsecutiveLookupFailures {
+		t.Errorf("observed %d consecutive failed lookups out of %d during migration", failures, lookups)
+	}
+}
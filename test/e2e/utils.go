@@ -3,13 +3,33 @@
 package e2e
 
 import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
 	"fmt"
+	"math/big"
+	"net"
 	"os/exec"
 	"strings"
+	"sync"
+	"testing"
 	"time"
 
+	configv1 "github.com/openshift/api/config/v1"
+	operatorv1 "github.com/openshift/api/operator/v1"
+
+	operatorcontroller "github.com/openshift/cluster-dns-operator/pkg/operator/controller"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/intstr"
 	"k8s.io/apimachinery/pkg/util/wait"
 )
@@ -154,8 +174,7 @@ func upstreamPod(name, ns, image, cfgMap string) *corev1.Pod {
 		},
 		Spec: corev1.PodSpec{
 			Volumes:            []corev1.Volume{cfgVol},
-			Containers:         []corev1.Container{coreContainer},
-			ServiceAccountName: "dns",
+			Containers: []corev1.Container{coreContainer},
 		},
 	}
 }
@@ -189,6 +208,28 @@ func upstreamService(name, ns string) *corev1.Service {
 	}
 }
 
+// largeResponseCorefile returns a Corefile for a test upstream resolver that
+// answers TXT queries for names in zone with a response that is too large for
+// a UDP message without EDNS, and whose last string is marker.
+func largeResponseCorefile(zone, marker string) string {
+	answers := []string{}
+	for i := 0; i < 8; i++ {
+		answers = append(answers, fmt.Sprintf(`        answer "{{ .Name }} 60 IN TXT \"%d-%s\""`, i, strings.Repeat("x", 200)))
+	}
+	answers = append(answers, fmt.Sprintf(`        answer "{{ .Name }} 60 IN TXT \"%s\""`, marker))
+	return fmt.Sprintf(`%s:5353 {
+    template IN TXT %s {
+%s
+    }
+    errors
+    log
+}
+.:5353 {
+    health
+}
+`, zone, zone, strings.Join(answers, "\n"))
+}
+
 // buildConfigMap returns a ConfigMap definition using name
 // for the ConfigMap name, ns as the ConfigMap namespace, k
 // as the ConfigMap data key and v as the ConfigMap data value.
@@ -228,3 +269,283 @@ func buildContainer(name, image string, cmd []string) corev1.Container {
 		Command: cmd,
 	}
 }
+
+// generateTLSCertificates returns a self-signed CA certificate and a serving
+// certificate and key for the given hosts that is signed by the CA, all
+// PEM-encoded.  Each call generates a new CA, so a second call can be used to
+// get a CA that did not sign the serving certificate.
+func generateTLSCertificates(hosts []string) (caPEM, certPEM, keyPEM []byte, err error) {
+	caKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("failed to generate ca key: %v", err)
+	}
+	notBefore := time.Now().Add(-time.Hour)
+	notAfter := notBefore.Add(24 * time.Hour)
+	caTemplate := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "dns-e2e-ca"},
+		NotBefore:             notBefore,
+		NotAfter:              notAfter,
+		KeyUsage:              x509.KeyUsageCertSign | x509.KeyUsageDigitalSignature,
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+	caDER, err := x509.CreateCertificate(rand.Reader, caTemplate, caTemplate, &caKey.PublicKey, caKey)
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("failed to create ca certificate: %v", err)
+	}
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("failed to generate serving key: %v", err)
+	}
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(2),
+		Subject:      pkix.Name{CommonName: hosts[0]},
+		NotBefore:    notBefore,
+		NotAfter:     notAfter,
+		KeyUsage:     x509.KeyUsageDigitalSignature | x509.KeyUsageKeyEncipherment,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+	}
+	for _, h := range hosts {
+		if ip := net.ParseIP(h); ip != nil {
+			template.IPAddresses = append(template.IPAddresses, ip)
+		} else {
+			template.DNSNames = append(template.DNSNames, h)
+		}
+	}
+	certDER, err := x509.CreateCertificate(rand.Reader, template, caTemplate, &key.PublicKey, caKey)
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("failed to create serving certificate: %v", err)
+	}
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("failed to marshal serving key: %v", err)
+	}
+
+	caPEM = pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: caDER})
+	certPEM = pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: certDER})
+	keyPEM = pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER})
+	return caPEM, certPEM, keyPEM, nil
+}
+
+// buildTLSSecret returns a TLS Secret definition using name for the Secret
+// name, ns as the Secret namespace, and the given PEM-encoded certificate and
+// key.
+func buildTLSSecret(name, ns string, certPEM, keyPEM []byte) *corev1.Secret {
+	return &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: ns,
+		},
+		Type: corev1.SecretTypeTLS,
+		Data: map[string][]byte{
+			corev1.TLSCertKey:       certPEM,
+			corev1.TLSPrivateKeyKey: keyPEM,
+		},
+	}
+}
+
+// upstreamTLSPod returns a Pod definition configured for the test DNS-over-TLS
+// upstream resolver.  It is the test upstream resolver with the certificate
+// and key from the given TLS Secret mounted at /etc/coredns/tls and with a
+// port for DNS over TLS.
+func upstreamTLSPod(name, ns, image, cfgMap, secret string) *corev1.Pod {
+	pod := upstreamPod(name, ns, image, cfgMap)
+	pod.Labels = map[string]string{"test": "upstream-tls"}
+	pod.Spec.Volumes = append(pod.Spec.Volumes, corev1.Volume{
+		Name: "tls",
+		VolumeSource: corev1.VolumeSource{
+			Secret: &corev1.SecretVolumeSource{
+				SecretName: secret,
+			},
+		},
+	})
+	container := &pod.Spec.Containers[0]
+	container.VolumeMounts = append(container.VolumeMounts, corev1.VolumeMount{
+		Name:      "tls",
+		ReadOnly:  true,
+		MountPath: "/etc/coredns/tls",
+	})
+	container.Ports = append(container.Ports, corev1.ContainerPort{
+		Name:          "dns-tls",
+		ContainerPort: int32(8853),
+		Protocol:      corev1.Protocol("TCP"),
+	})
+	return pod
+}
+
+// upstreamTLSService returns a Service definition configured for the test
+// DNS-over-TLS upstream resolver.
+func upstreamTLSService(name, ns string) *corev1.Service {
+	return &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: ns,
+		},
+		Spec: corev1.ServiceSpec{
+			Ports: []corev1.ServicePort{
+				{
+					Name:       "dns-tls",
+					Protocol:   "TCP",
+					Port:       853,
+					TargetPort: intstr.IntOrString{IntVal: 8853},
+				},
+			},
+			Selector: map[string]string{"test": "upstream-tls"},
+		},
+	}
+}
+
+// clusterOperatorVersion returns the named version reported by the dns
+// clusteroperator, such as the CoreDNS or openshift-cli image.
+func clusterOperatorVersion(cl client.Client, name string) (string, error) {
+	co := &configv1.ClusterOperator{}
+	if err := cl.Get(context.TODO(), types.NamespacedName{Name: operatorcontroller.DNSOperatorName}, co); err != nil {
+		return "", fmt.Errorf("failed to get clusteroperator %s: %v", operatorcontroller.DNSOperatorName, err)
+	}
+	for _, ver := range co.Status.Versions {
+		if ver.Name == name {
+			if len(ver.Version) == 0 {
+				return "", fmt.Errorf("clusteroperator %s has empty %s version", operatorcontroller.DNSOperatorName, name)
+			}
+			return ver.Version, nil
+		}
+	}
+	return "", fmt.Errorf("version %s not found for clusteroperator %s", name, operatorcontroller.DNSOperatorName)
+}
+
+// waitForPodReady waits for the given pod to have the ContainersReady
+// condition until the timeout is reached.
+func waitForPodReady(cl client.Client, pod *corev1.Pod, timeout time.Duration) error {
+	err := wait.PollImmediate(1*time.Second, timeout, func() (bool, error) {
+		if err := cl.Get(context.TODO(), types.NamespacedName{Namespace: pod.Namespace, Name: pod.Name}, pod); err != nil {
+			return false, nil
+		}
+		for _, cond := range pod.Status.Conditions {
+			if cond.Type == corev1.ContainersReady && cond.Status == corev1.ConditionTrue {
+				return true, nil
+			}
+		}
+		return false, nil
+	})
+	if err != nil {
+		return fmt.Errorf("failed to observe ContainersReady condition for pod %s/%s: %v", pod.Namespace, pod.Name, err)
+	}
+	return nil
+}
+
+// testNamespaceLabel is the label on the namespaces of the tests, whose value
+// is the name of the test that created the namespace.
+const testNamespaceLabel = "dns.operator.openshift.io/e2e-test"
+
+// defaultDNSLock serializes the tests that mutate the default dns, or the
+// operator and the resources that it manages for the default dns, because all
+// tests share them.  Tests that query the default dns share the lock so that
+// they do not observe another test's changes.
+var defaultDNSLock sync.RWMutex
+
+// lockDefaultDNS marks t as a parallel test and blocks until no other test
+// holds the default dns.  It returns a function that releases the default dns,
+// which the caller must defer:
+//
+//	defer lockDefaultDNS(t)()
+//
+// Tests that neither mutate nor query the default dns call t.Parallel instead.
+func lockDefaultDNS(t *testing.T) func() {
+	t.Parallel()
+	defaultDNSLock.Lock()
+	return defaultDNSLock.Unlock
+}
+
+// rlockDefaultDNS is like lockDefaultDNS for tests that query the default dns
+// without mutating it, which run in parallel with each other.
+func rlockDefaultDNS(t *testing.T) func() {
+	t.Parallel()
+	defaultDNSLock.RLock()
+	return defaultDNSLock.RUnlock
+}
+
+// createTestNamespace creates a namespace with a generated name for the
+// resources of t and waits for its default service account, which pods in the
+// namespace use.  It returns the name of the namespace and a function that
+// deletes the namespace and the resources in it, which the caller must defer.
+func createTestNamespace(t *testing.T, cl client.Client) (string, func()) {
+	ns := &corev1.Namespace{
+		ObjectMeta: metav1.ObjectMeta{
+			GenerateName: "e2e-dns-",
+			Labels:       map[string]string{testNamespaceLabel: t.Name()},
+		},
+	}
+	if err := cl.Create(context.TODO(), ns); err != nil {
+		t.Fatalf("failed to create namespace for test %s: %v", t.Name(), err)
+	}
+	deleteNamespace := func() {
+		if err := cl.Delete(context.TODO(), ns); err != nil && !errors.IsNotFound(err) {
+			t.Errorf("failed to delete namespace %s: %v", ns.Name, err)
+		}
+	}
+	err := wait.PollImmediate(1*time.Second, 30*time.Second, func() (bool, error) {
+		sa := &corev1.ServiceAccount{}
+		if err := cl.Get(context.TODO(), types.NamespacedName{Namespace: ns.Name, Name: "default"}, sa); err != nil {
+			return false, nil
+		}
+		return true, nil
+	})
+	if err != nil {
+		deleteNamespace()
+		t.Fatalf("failed to observe default service account in namespace %s: %v", ns.Name, err)
+	}
+	return ns.Name, deleteNamespace
+}
+
+// createUpstreamResolver creates a test upstream resolver with the given name
+// that serves the given Corefile in the given namespace, waits for it to be
+// ready, and returns the cluster IP address of its Service.  The resolver is
+// deleted with the namespace.
+func createUpstreamResolver(cl client.Client, ns, name, image, corefile string) (string, error) {
+	cfgMap := buildConfigMap(name, ns, "Corefile", corefile)
+	if err := cl.Create(context.TODO(), cfgMap); err != nil {
+		return "", fmt.Errorf("failed to create configmap %s/%s: %v", cfgMap.Namespace, cfgMap.Name, err)
+	}
+	pod := upstreamPod(name, ns, image, cfgMap.Name)
+	pod.Labels = map[string]string{"test": name}
+	if err := cl.Create(context.TODO(), pod); err != nil {
+		return "", fmt.Errorf("failed to create pod %s/%s: %v", pod.Namespace, pod.Name, err)
+	}
+	if err := waitForPodReady(cl, pod, 2*time.Minute); err != nil {
+		return "", err
+	}
+	svc := upstreamService(name, ns)
+	svc.Spec.Selector = pod.Labels
+	if err := cl.Create(context.TODO(), svc); err != nil {
+		return "", fmt.Errorf("failed to create service %s/%s: %v", svc.Namespace, svc.Name, err)
+	}
+	if len(svc.Spec.ClusterIP) == 0 {
+		return "", fmt.Errorf("failed to get clusterIP for service %s/%s", svc.Namespace, svc.Name)
+	}
+	return svc.Spec.ClusterIP, nil
+}
+
+// setDefaultDNSServers sets the servers of the default dns, retrying on
+// conflicts with updates by the operator.
+func setDefaultDNSServers(cl client.Client, servers []operatorv1.Server) error {
+	var lastErr error
+	err := wait.PollImmediate(1*time.Second, 30*time.Second, func() (bool, error) {
+		dns := &operatorv1.DNS{}
+		if err := cl.Get(context.TODO(), types.NamespacedName{Name: operatorcontroller.DefaultDNSController}, dns); err != nil {
+			lastErr = err
+			return false, nil
+		}
+		dns.Spec.Servers = servers
+		if err := cl.Update(context.TODO(), dns); err != nil {
+			lastErr = err
+			return false, nil
+		}
+		return true, nil
+	})
+	if err != nil {
+		return fmt.Errorf("failed to update servers of dns %s: %v", operatorcontroller.DefaultDNSController, lastErr)
+	}
+	return nil
+}
@@ -0,0 +1,15 @@
+module github.com/openshift/api
+
+go 1.13
+
+require (
+	github.com/gogo/protobuf v1.3.1
+	github.com/openshift/build-machinery-go v0.0.0-20200211121458-5e3d6e570160
+	github.com/spf13/pflag v1.0.5
+	golang.org/x/net v0.0.0-20191209160850-c0dbc17a3553 // indirect
+	golang.org/x/tools v0.0.0-20200115044656-831fdb1e1868 // indirect
+	k8s.io/api v0.18.0-beta.2
+	k8s.io/apimachinery v0.18.0-beta.2
+	k8s.io/code-generator v0.18.0-beta.2
+	k8s.io/klog v1.0.0
+)
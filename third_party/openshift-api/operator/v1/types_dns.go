@@ -0,0 +1,1947 @@
+package v1
+
+import (
+	configv1 "github.com/openshift/api/config/v1"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// +genclient
+// +genclient:nonNamespaced
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:path=dnses,scope=Cluster
+// +kubebuilder:subresource:status
+
+// DNS manages the CoreDNS component to provide a name resolution service
+// for pods and services in the cluster.
+//
+// This supports the DNS-based service discovery specification:
+// https://github.com/kubernetes/dns/blob/master/docs/specification.md
+//
+// More details: https://kubernetes.io/docs/tasks/administer-cluster/coredns
+type DNS struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// spec is the specification of the desired behavior of the DNS.
+	Spec DNSSpec `json:"spec,omitempty"`
+	// status is the most recently observed status of the DNS.
+	Status DNSStatus `json:"status,omitempty"`
+}
+
+// DNSSpec is the specification of the desired behavior of the DNS.
+type DNSSpec struct {
+	// servers is a list of DNS resolvers that provide name query delegation for one or
+	// more subdomains outside the scope of the cluster domain. If servers consists of
+	// more than one Server, longest suffix match will be used to determine the Server.
+	//
+	// For example, if there are two Servers, one for "foo.com" and another for "a.foo.com",
+	// and the name query is for "www.a.foo.com", it will be routed to the Server with Zone
+	// "a.foo.com".
+	//
+	// If this field is nil, no servers are created.
+	//
+	// +optional
+	Servers []Server `json:"servers,omitempty"`
+
+	// upstreamResolvers defines a schema for configuring CoreDNS to proxy
+	// DNS messages to upstream resolvers for the case of the default (".")
+	// server.
+	//
+	// If this field is not specified, the upstream used will default to
+	// /etc/resolv.conf, with policy "sequential".
+	//
+	// +optional
+	UpstreamResolvers UpstreamResolvers `json:"upstreamResolvers,omitempty"`
+
+	// nodeResolver specifies settings for the node-resolver, which maintains
+	// entries in each node's /etc/hosts file for a set of names so that they
+	// can be resolved by components that do not use cluster DNS (for example,
+	// the container runtime when pulling images).
+	//
+	// +optional
+	NodeResolver NodeResolverConfig `json:"nodeResolver,omitempty"`
+
+	// probePorts specifies the ports on which CoreDNS serves its health and
+	// readiness endpoints. These ports are used by the liveness and readiness
+	// probes of the DNS pods and may need to be changed to avoid conflicts
+	// with other processes, such as sidecar containers or processes on the
+	// host network.
+	//
+	// +optional
+	ProbePorts ProbePorts `json:"probePorts,omitempty"`
+
+	// logLevel describes the desired logging verbosity for CoreDNS.
+	// Any one of the following values may be specified:
+	// * Normal logs errors from upstream resolvers.
+	// * Debug logs errors, NXDOMAIN responses, and NODATA responses.
+	// * Trace logs errors and all responses.
+	// Changes to the log level are applied by reloading the CoreDNS
+	// configuration and do not cause DNS pods to be restarted.
+	//
+	// If unset, the default log level of "Normal" is used.
+	//
+	// +kubebuilder:validation:Enum=Normal;Debug;Trace
+	// +optional
+	LogLevel DNSLogLevel `json:"logLevel,omitempty"`
+
+	// operatorLogLevel describes the desired logging verbosity of the DNS
+	// operator. Only the operatorLogLevel of the default DNS is used.
+	// Any one of the following values may be specified:
+	// * Normal logs the changes that the operator makes.
+	// * Debug also logs the details of each reconciliation.
+	// * Trace logs everything that the operator logs.
+	// Changes to the operator log level take effect at once and do not
+	// restart the operator.
+	//
+	// If unset, the default log level of "Normal" is used.
+	//
+	// +kubebuilder:validation:Enum=Normal;Debug;Trace
+	// +optional
+	OperatorLogLevel DNSLogLevel `json:"operatorLogLevel,omitempty"`
+
+	// queryLogging is a list of rules that select which queries CoreDNS
+	// logs for names in specific zones, for example to log only the
+	// NXDOMAIN responses for a zone while troubleshooting a storm of
+	// queries for names that do not exist. For the names in its zones, a
+	// rule replaces the classes of responses that logLevel selects. If
+	// several rules match a name, the rule with the most specific zone is
+	// used.
+	//
+	// If unset, logLevel selects the responses that CoreDNS logs for all
+	// names.
+	//
+	// +optional
+	QueryLogging []DNSQueryLogRule `json:"queryLogging,omitempty"`
+
+	// logClientAttribution describes how CoreDNS identifies the client of
+	// each query in the query log, for example to account for the queries
+	// of each tenant. Any one of the following values may be specified:
+	// * None logs the address of the client.
+	// * Pod also logs the namespace and name of the pod that has the
+	// address of the client, where it is known. Only queries for names
+	// that are not in the zones of servers are attributed. CoreDNS watches
+	// all pods to look them up, which increases its memory use, and
+	// answers queries for the names of pod IP addresses only if a pod has
+	// the address.
+	//
+	// If unset, the default of "None" is used.
+	//
+	// +kubebuilder:validation:Enum=None;Pod
+	// +optional
+	LogClientAttribution DNSLogClientAttribution `json:"logClientAttribution,omitempty"`
+
+	// performance specifies how CoreDNS uses the CPUs of the nodes that it
+	// runs on. The defaults are suitable for most clusters; these settings
+	// may be tuned for nodes with a high query rate.
+	//
+	// +optional
+	Performance DNSPerformance `json:"performance,omitempty"`
+
+	// kubeDNSAlias specifies whether the operator manages a Service named
+	// "kube-dns" with the label "k8s-app: kube-dns" in the openshift-dns
+	// namespace, for compatibility with upstream tooling that looks up the
+	// cluster DNS service by that name or label. The alias Service selects
+	// the same DNS pods as the DNS Service but has its own cluster IP.
+	// Any one of the following values may be specified:
+	// * Enabled creates and maintains the alias Service.
+	// * Disabled removes the alias Service if the operator created it.
+	//
+	// If unset, the default of "Disabled" is used.
+	//
+	// +kubebuilder:validation:Enum=Enabled;Disabled
+	// +optional
+	KubeDNSAlias KubeDNSAliasState `json:"kubeDNSAlias,omitempty"`
+
+	// serviceSessionAffinity specifies whether the DNS Service, and the
+	// kube-dns alias Service if it is enabled, send the queries of each
+	// client to the same DNS pod. Keeping a client on one pod improves its
+	// cache hits, but unevenly spreads the load of clients with a high query
+	// rate.
+	//
+	// If unset, queries are spread over the DNS pods.
+	//
+	// +optional
+	ServiceSessionAffinity DNSServiceSessionAffinity `json:"serviceSessionAffinity,omitempty"`
+
+	// serviceAliases is a list of DNS names that resolve to Services in the
+	// cluster. This allows pods to resolve a name outside the cluster
+	// domain, such as the host name of an application's Route, directly to
+	// the application's Service instead of reaching it through the ingress
+	// load balancer. A query for an alias is answered with the records of
+	// the Service under the alias name.
+	//
+	// If this field is nil, no aliases are created.
+	//
+	// +optional
+	ServiceAliases []DNSServiceAlias `json:"serviceAliases,omitempty"`
+
+	// ingressSplitHorizon specifies whether pods resolve the host names of
+	// Routes and Ingresses that are admitted by the default ingress
+	// controller to the ingress controller's internal Service rather than
+	// to its external load balancer. This keeps in-cluster traffic to
+	// applications inside the cluster and avoids hairpin NAT through the
+	// load balancer. Any one of the following values may be specified:
+	// * Enabled watches Routes and Ingresses and resolves their host names
+	// to the internal Service.
+	// * Disabled resolves the host names of Routes and Ingresses using the
+	// upstream resolvers.
+	//
+	// If unset, the default of "Disabled" is used.
+	//
+	// +kubebuilder:validation:Enum=Enabled;Disabled
+	// +optional
+	IngressSplitHorizon IngressSplitHorizonState `json:"ingressSplitHorizon,omitempty"`
+
+	// clusterPeers is a list of other clusters whose services pods in this
+	// cluster can resolve. Queries for names in the cluster domain of a
+	// peer are forwarded to the DNS Service of the peer, which must be
+	// reachable from this cluster, for example through a multi-cluster
+	// network.
+	//
+	// If this field is nil, no names are forwarded to peer clusters.
+	//
+	// +optional
+	ClusterPeers []DNSClusterPeer `json:"clusterPeers,omitempty"`
+
+	// serviceMeshCoexistence specifies whether the DNS is configured to
+	// coexist with a service mesh whose sidecar proxies intercept DNS
+	// queries, for example Istio with ISTIO_META_DNS_CAPTURE enabled. Any
+	// one of the following values may be specified:
+	// * Enabled publishes the cluster IP of the DNS Service and the domains
+	// that the DNS serves in a ConfigMap named "dns-<name>-service-mesh" in
+	// the "openshift-dns" namespace, and does not rewrite the host names of
+	// Routes and Ingresses even if ingressSplitHorizon is enabled, because
+	// the mesh proxies resolve those names themselves.
+	// * Disabled does not publish the ConfigMap.
+	//
+	// If unset, the default of "Disabled" is used.
+	//
+	// +kubebuilder:validation:Enum=Enabled;Disabled
+	// +optional
+	ServiceMeshCoexistence ServiceMeshCoexistenceState `json:"serviceMeshCoexistence,omitempty"`
+
+	// internalNames specifies names that are internal to the cluster's
+	// network and that CoreDNS answers with NXDOMAIN rather than forwarding
+	// them to the upstream resolvers, so that the names do not leak to
+	// external resolvers. Names in the cluster domain and in the zones of
+	// servers and cluster peers are still resolved.
+	//
+	// If unset, all names outside of the cluster domain are forwarded.
+	//
+	// +optional
+	InternalNames DNSInternalNames `json:"internalNames,omitempty"`
+
+	// additionalClusterDomains is an ordered list of domains that CoreDNS
+	// serves the cluster's Services and Pods under in addition to the
+	// cluster domain, for example the old cluster domain while workloads
+	// migrate to a new one. Reverse lookups are answered with names in the
+	// cluster domain. Each domain must conform to the rfc1123 definition of
+	// a subdomain. A domain that is the cluster domain, that is listed
+	// earlier, or that is a zone of a server is ignored.
+	//
+	// A maximum of 4 additional cluster domains is allowed.
+	//
+	// If this field is nil, only the cluster domain is served.
+	//
+	// +kubebuilder:validation:MaxItems=4
+	// +optional
+	AdditionalClusterDomains []string `json:"additionalClusterDomains,omitempty"`
+
+	// degradedSuppression specifies how long CoreDNS pods may be
+	// unavailable before the DNS reports that it is degraded, and how long
+	// they must be available again before it stops reporting so. Longer
+	// periods avoid alerts while many nodes are added or replaced at once.
+	//
+	// If unset, the DNS reports that it is degraded as soon as too many pods
+	// are unavailable and stops as soon as enough are available again.
+	//
+	// +optional
+	DegradedSuppression DNSDegradedSuppression `json:"degradedSuppression,omitempty"`
+
+	// nodeOverrides is an ordered list of overrides of the servers for the
+	// nodes that have a given label, for example to forward queries to the
+	// upstream resolvers of the site of a pool of edge nodes. CoreDNS runs on
+	// the nodes of each override in a separate DaemonSet whose Corefile has
+	// the servers of the override instead of the servers of the DNS. A node
+	// that has the labels of more than one override uses the first. An
+	// override whose name or node selector is invalid, or that has the name
+	// or node selector of an override listed earlier, is ignored.
+	//
+	// A maximum of 8 node overrides is allowed.
+	//
+	// If this field is nil, all nodes use the servers of the DNS.
+	//
+	// +kubebuilder:validation:MaxItems=8
+	// +optional
+	NodeOverrides []DNSNodeOverride `json:"nodeOverrides,omitempty"`
+
+	// additionalNetworks is a list of secondary networks that the DNS pods
+	// are attached to, so that workloads on those networks can reach cluster
+	// DNS directly. Each network is attached through the multus CNI plugin
+	// using a NetworkAttachmentDefinition, and CoreDNS listens on port 53 of
+	// the network's interface in addition to its usual listener. A network
+	// whose name or namespace is invalid, or that is listed earlier, is
+	// ignored.
+	//
+	// A maximum of 4 additional networks is allowed.
+	//
+	// If this field is nil, the DNS pods are attached to the cluster network
+	// only.
+	//
+	// +kubebuilder:validation:MaxItems=4
+	// +optional
+	AdditionalNetworks []DNSAdditionalNetwork `json:"additionalNetworks,omitempty"`
+
+	// localhostZones specifies whether CoreDNS answers queries for localhost
+	// and the loopback reverse zones itself rather than forwarding them to
+	// the upstream resolvers. Any one of the following values may be
+	// specified:
+	// * Enabled answers queries for "localhost." and names under it with the
+	// loopback addresses, and answers reverse queries for the loopback
+	// addresses and the "0.in-addr.arpa." and "255.in-addr.arpa." zones.
+	// * Disabled forwards these queries to the upstream resolvers, for
+	// environments that rely on the answers of the upstream resolvers.
+	//
+	// If unset, the default of "Enabled" is used. CoreDNS answers these
+	// queries itself since CoreDNS 1.8.1; with an earlier version, it
+	// forwards them and "Enabled" is ignored.
+	//
+	// +kubebuilder:validation:Enum=Enabled;Disabled
+	// +optional
+	LocalhostZones LocalhostZonesState `json:"localhostZones,omitempty"`
+
+	// debugZone specifies whether CoreDNS serves a zone for debugging which
+	// DNS pod answers a query. The zone is "debug.dns" under the cluster
+	// domain, for example "debug.dns.cluster.local". A TXT query for a name
+	// in the zone is answered with the name of the DNS pod that served it,
+	// and any other query is answered with the source IP address and port
+	// of the client. Any one of the following values may be specified:
+	// * Enabled serves the debug zone. Names in the zone shadow those of
+	// Services in a namespace named "dns".
+	// * Disabled does not serve the debug zone.
+	//
+	// If unset, the default of "Disabled" is used.
+	//
+	// +kubebuilder:validation:Enum=Enabled;Disabled
+	// +optional
+	DebugZone DNSDebugZoneState `json:"debugZone,omitempty"`
+
+	// nsid specifies whether CoreDNS adds a name server identifier (NSID,
+	// RFC 5001) to responses for queries that request one, for example with
+	// "dig +nsid", so that it is possible to tell which node answered a
+	// query. The identifier is the name of the node of the DNS pod that
+	// answered. Any one of the following values may be specified:
+	// * Enabled adds the identifier to responses.
+	// * Disabled does not add the identifier to responses.
+	//
+	// If unset, the default of "Disabled" is used.
+	//
+	// +kubebuilder:validation:Enum=Enabled;Disabled
+	// +optional
+	NSID DNSNSIDState `json:"nsid,omitempty"`
+
+	// listenAddresses specifies the addresses that CoreDNS listens on for
+	// queries. Any one of the following values may be specified:
+	// * Wildcard listens on all addresses of the DNS pod.
+	// * PodIP listens only on the IP address of the DNS pod in the IP family
+	// of the DNS Service, which is the only address that the Service sends
+	// queries to. In dual-stack and IPv6 single-stack clusters, this keeps
+	// CoreDNS from answering on addresses of other families. The setting is
+	// ignored if the cluster network configuration does not have a pod
+	// network in the IP family of the primary service network.
+	//
+	// If unset, the default of "Wildcard" is used.
+	//
+	// +kubebuilder:validation:Enum=Wildcard;PodIP
+	// +optional
+	ListenAddresses DNSListenAddressesPolicy `json:"listenAddresses,omitempty"`
+
+	// extraConfigRefs is a list of references to ConfigMaps in the
+	// "openshift-dns" namespace whose data are snippets of CoreDNS
+	// configuration, so that platform teams can extend the Corefile. Each
+	// ConfigMap is mounted in the DNS pods, and each of its keys is imported
+	// into the Corefile at the extension point of the reference. A reference
+	// whose name is invalid or that is listed earlier is ignored, as is a
+	// ConfigMap that does not exist or whose snippets have unbalanced
+	// braces.
+	//
+	// A maximum of 8 references is allowed.
+	//
+	// If this field is nil, the Corefile imports no snippets.
+	//
+	// +kubebuilder:validation:MaxItems=8
+	// +optional
+	ExtraConfigRefs []DNSExtraConfigReference `json:"extraConfigRefs,omitempty"`
+
+	// apiServerReadiness specifies whether CoreDNS pods report that they are
+	// not ready when they cannot reach the Kubernetes API server, so that
+	// the DNS Service stops routing queries to pods on isolated nodes, whose
+	// answers for cluster names may be stale. The check runs in the
+	// node-resolver container and has no effect if the node-resolver is
+	// disabled.
+	//
+	// If unset, the readiness of CoreDNS pods does not depend on the API
+	// server.
+	//
+	// +optional
+	APIServerReadiness DNSAPIServerReadiness `json:"apiServerReadiness,omitempty"`
+
+	// nodeExclusions is a list of selectors of nodes on which CoreDNS pods
+	// do not run, for example GPU-only or storage nodes whose resources are
+	// reserved for their workloads. Pods on excluded nodes still resolve
+	// names through the DNS Service. A node that has the label of any
+	// exclusion is excluded, also from the DaemonSets of node overrides. An
+	// exclusion whose node selector does not have exactly one valid label,
+	// or has the label of an exclusion listed earlier, is ignored. If the
+	// exclusions leave fewer than 2 ready nodes to run CoreDNS, the DNS
+	// reports the InsufficientNodeCoverage condition.
+	//
+	// A maximum of 8 node exclusions is allowed.
+	//
+	// If this field is nil, CoreDNS runs on all nodes.
+	//
+	// +kubebuilder:validation:MaxItems=8
+	// +optional
+	NodeExclusions []DNSNodeExclusion `json:"nodeExclusions,omitempty"`
+
+	// nodePlacement provides explicit control over the scheduling of the
+	// CoreDNS pods, for example to keep them off infrastructure or edge
+	// nodes, or to run them only on nodes with some taints. The placement
+	// applies to the pods of the DaemonSets of node overrides as well, and
+	// node exclusions still apply within it.
+	//
+	// If unset, CoreDNS runs on all linux nodes and tolerates all taints.
+	//
+	// +optional
+	NodePlacement DNSNodePlacement `json:"nodePlacement,omitempty"`
+
+	// kubernetesFallthrough specifies the reverse zones in which CoreDNS
+	// passes queries that are not for cluster IP addresses on to the
+	// upstream resolvers rather than answering them with NXDOMAIN. Reverse
+	// lookups of addresses outside of the cluster, which some storage and
+	// authentication systems require, are only answered if they fall
+	// through.
+	//
+	// If unset, reverse queries for addresses outside of the cluster fall
+	// through in all reverse zones.
+	//
+	// +optional
+	KubernetesFallthrough DNSKubernetesFallthrough `json:"kubernetesFallthrough,omitempty"`
+
+	// cache specifies for how long CoreDNS caches the responses of the
+	// default server, for example to cache the names of services that are
+	// created and deleted frequently for a shorter time when they do not
+	// exist, or to cache external names for longer at edge sites with a
+	// slow uplink.
+	//
+	// If unset, positive and negative responses are cached for at most 30
+	// seconds.
+	//
+	// +optional
+	Cache DNSCache `json:"cache,omitempty"`
+
+	// termination specifies how DNS pods stop, for example when a node is
+	// drained. A terminating pod keeps answering queries for a delay
+	// before CoreDNS stops, so that kube-proxy or OVN stop sending queries
+	// for the DNS service to the pod before it stops answering them.
+	//
+	// If unset, a terminating pod keeps answering queries for 5 seconds.
+	//
+	// +optional
+	Termination DNSTermination `json:"termination,omitempty"`
+}
+
+// DNSTermination defines how DNS pods stop.
+type DNSTermination struct {
+	// preStopDelay is how long a terminating DNS pod keeps answering
+	// queries before CoreDNS is asked to stop. A terminating pod is removed
+	// from the endpoints of the DNS service at once, but kube-proxy or OVN
+	// may take a moment to stop sending queries to it. A value of "0s"
+	// stops CoreDNS at once.
+	//
+	// If unset, the default of 5s is used.
+	//
+	// +kubebuilder:validation:Pattern=^(0|([0-9]+(\.[0-9]+)?(ns|us|µs|μs|ms|s|m|h))+)$
+	// +kubebuilder:validation:Type:=string
+	// +optional
+	PreStopDelay *metav1.Duration `json:"preStopDelay,omitempty"`
+
+	// gracePeriod is how long a terminating DNS pod may take to stop,
+	// including the preStopDelay, before its containers are killed. The
+	// time is rounded up to whole seconds. A grace period that does not
+	// leave CoreDNS at least 5s to stop after the preStopDelay is raised
+	// accordingly.
+	//
+	// If unset, the preStopDelay plus 30s is used.
+	//
+	// +kubebuilder:validation:Pattern=^(0|([0-9]+(\.[0-9]+)?(ns|us|µs|μs|ms|s|m|h))+)$
+	// +kubebuilder:validation:Type:=string
+	// +optional
+	GracePeriod *metav1.Duration `json:"gracePeriod,omitempty"`
+}
+
+// DNSCache defines for how long CoreDNS caches responses.
+type DNSCache struct {
+	// positiveTTL is the maximum time for which CoreDNS caches a response
+	// with answers. The time is rounded up to whole seconds. A response is
+	// never cached for longer than its TTL.
+	//
+	// If unset, the default of 30 seconds is used.
+	//
+	// +kubebuilder:validation:Pattern=^(0|([0-9]+(\.[0-9]+)?(ns|us|µs|μs|ms|s|m|h))+)$
+	// +kubebuilder:validation:Type:=string
+	// +optional
+	PositiveTTL *metav1.Duration `json:"positiveTTL,omitempty"`
+
+	// negativeTTL is the maximum time for which CoreDNS caches a response
+	// that a name or record does not exist (NXDOMAIN or NODATA). The time
+	// is rounded up to whole seconds.
+	//
+	// If unset, the default of 30 seconds is used.
+	//
+	// +kubebuilder:validation:Pattern=^(0|([0-9]+(\.[0-9]+)?(ns|us|µs|μs|ms|s|m|h))+)$
+	// +kubebuilder:validation:Type:=string
+	// +optional
+	NegativeTTL *metav1.Duration `json:"negativeTTL,omitempty"`
+}
+
+// DNSKubernetesFallthrough defines the reverse zones in which queries that
+// CoreDNS does not answer from the cluster fall through to the upstream
+// resolvers.
+type DNSKubernetesFallthrough struct {
+	// policy specifies in which reverse zones queries fall through. Any
+	// one of the following values may be specified:
+	// * ReverseZones lets queries fall through in the "in-addr.arpa." and
+	// "ip6.arpa." zones.
+	// * Zones lets queries fall through only in the zones in zones, for
+	// example to forward reverse lookups for a corporate network while
+	// answering all others with NXDOMAIN.
+	// * None answers all reverse queries for addresses outside of the
+	// cluster with NXDOMAIN.
+	//
+	// If unset, the default of "ReverseZones" is used.
+	//
+	// +kubebuilder:validation:Enum=ReverseZones;Zones;None
+	// +optional
+	Policy DNSKubernetesFallthroughPolicy `json:"policy,omitempty"`
+
+	// zones is the list of reverse zones in which queries fall through when
+	// the policy is Zones. Each zone must be "in-addr.arpa", "ip6.arpa", or
+	// a subdomain of one of them, for example "10.in-addr.arpa"; any other
+	// zone is ignored.
+	//
+	// A maximum of 15 zones is allowed.
+	//
+	// +kubebuilder:validation:MaxItems=15
+	// +optional
+	Zones []string `json:"zones,omitempty"`
+}
+
+// DNSKubernetesFallthroughPolicy describes in which reverse zones queries
+// fall through to the upstream resolvers.
+type DNSKubernetesFallthroughPolicy string
+
+var (
+	// DNSKubernetesFallthroughReverseZones means that queries fall through
+	// in all reverse zones.
+	DNSKubernetesFallthroughReverseZones DNSKubernetesFallthroughPolicy = "ReverseZones"
+
+	// DNSKubernetesFallthroughZones means that queries fall through in the
+	// listed zones.
+	DNSKubernetesFallthroughZones DNSKubernetesFallthroughPolicy = "Zones"
+
+	// DNSKubernetesFallthroughNone means that queries do not fall through.
+	DNSKubernetesFallthroughNone DNSKubernetesFallthroughPolicy = "None"
+)
+
+// DNSNodeExclusion selects nodes on which CoreDNS pods do not run.
+type DNSNodeExclusion struct {
+	// nodeSelector is the label of the excluded nodes. It must have
+	// exactly one label.
+	//
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinProperties=1
+	// +kubebuilder:validation:MaxProperties=1
+	// +required
+	NodeSelector map[string]string `json:"nodeSelector"`
+}
+
+// DNSNodePlacement describes the node scheduling configuration of CoreDNS
+// pods.
+type DNSNodePlacement struct {
+	// nodeSelector is the node selector of the CoreDNS pods. Its labels
+	// are added to the default node selector, which is currently
+	// "kubernetes.io/os: linux", and a label with the key of a default
+	// label replaces it. A label whose key or value is invalid is ignored.
+	//
+	// If unset, the default node selector is used.
+	//
+	// +optional
+	NodeSelector map[string]string `json:"nodeSelector,omitempty"`
+
+	// tolerations is a list of tolerations of the CoreDNS pods. If set,
+	// the tolerations replace the default toleration of all taints, so
+	// CoreDNS does not run on nodes with taints that the list does not
+	// tolerate. A toleration whose key is invalid, or whose operator is
+	// Exists and that has a value, is ignored.
+	//
+	// If unset, CoreDNS tolerates all taints.
+	//
+	// +optional
+	Tolerations []corev1.Toleration `json:"tolerations,omitempty"`
+}
+
+// DNSAPIServerReadiness defines how the readiness of CoreDNS pods depends on
+// the reachability of the Kubernetes API server.
+type DNSAPIServerReadiness struct {
+	// policy specifies whether CoreDNS pods report that they are not ready
+	// when they cannot reach the API server. Any one of the following values
+	// may be specified:
+	// * Enabled makes a pod report that it is not ready once it has been
+	// unable to reach the API server for the grace period.
+	// * Disabled does not check whether pods can reach the API server.
+	//
+	// If unset, the default of "Disabled" is used.
+	//
+	// +kubebuilder:validation:Enum=Enabled;Disabled
+	// +optional
+	Policy DNSAPIServerReadinessPolicy `json:"policy,omitempty"`
+
+	// gracePeriod is how long a CoreDNS pod may be unable to reach the API
+	// server before it reports that it is not ready. The API server is
+	// checked every 10s, so the grace period is rounded up to a multiple of
+	// 10s.
+	//
+	// If unset, the default grace period of 30s is used.
+	//
+	// +optional
+	GracePeriod *metav1.Duration `json:"gracePeriod,omitempty"`
+}
+
+// DNSAPIServerReadinessPolicy describes whether the readiness of CoreDNS pods
+// depends on the reachability of the API server.
+type DNSAPIServerReadinessPolicy string
+
+var (
+	// DNSAPIServerReadinessEnabled means that CoreDNS pods report that
+	// they are not ready when they cannot reach the API server.
+	DNSAPIServerReadinessEnabled DNSAPIServerReadinessPolicy = "Enabled"
+
+	// DNSAPIServerReadinessDisabled means that the readiness of CoreDNS
+	// pods does not depend on the API server.
+	DNSAPIServerReadinessDisabled DNSAPIServerReadinessPolicy = "Disabled"
+)
+
+// DNSServiceSessionAffinity defines the session affinity of the DNS Service.
+type DNSServiceSessionAffinity struct {
+	// policy specifies the session affinity of the DNS Service. Any one of
+	// the following values may be specified:
+	// * None spreads queries over the DNS pods.
+	// * ClientIP sends the queries from each client IP address to the same
+	// DNS pod until the client has not sent a query for the timeout.
+	//
+	// If unset, the default of "None" is used.
+	//
+	// +kubebuilder:validation:Enum=None;ClientIP
+	// +optional
+	Policy DNSServiceSessionAffinityPolicy `json:"policy,omitempty"`
+
+	// timeoutSeconds is how long a client is kept on the same DNS pod after
+	// its last query when the policy is ClientIP. It must be between 1 and
+	// 86400.
+	//
+	// If unset, the default of 10800 (3 hours) is used.
+	//
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=86400
+	// +optional
+	TimeoutSeconds int32 `json:"timeoutSeconds,omitempty"`
+}
+
+// DNSServiceSessionAffinityPolicy describes the session affinity of the DNS
+// Service.
+type DNSServiceSessionAffinityPolicy string
+
+var (
+	// DNSServiceSessionAffinityNone means that queries are spread over the
+	// DNS pods.
+	DNSServiceSessionAffinityNone DNSServiceSessionAffinityPolicy = "None"
+
+	// DNSServiceSessionAffinityClientIP means that the queries from each
+	// client IP address are sent to the same DNS pod.
+	DNSServiceSessionAffinityClientIP DNSServiceSessionAffinityPolicy = "ClientIP"
+)
+
+// DNSExtraConfigReference references a ConfigMap with snippets of CoreDNS
+// configuration that are imported into the Corefile.
+type DNSExtraConfigReference struct {
+	// name is the name of the ConfigMap in the "openshift-dns" namespace.
+	//
+	// +kubebuilder:validation:Required
+	// +required
+	Name string `json:"name"`
+
+	// extensionPoint is the place in the Corefile into which the snippets
+	// of the ConfigMap are imported. Any one of the following values may be
+	// specified:
+	// * DefaultServer imports the snippets into the server block of the
+	// root zone, so they hold plugin directives, such as "hosts" or
+	// "rewrite", that apply to queries for the cluster domain and for
+	// names that are forwarded to the upstream resolvers.
+	// * ServerBlocks imports the snippets at the top level of the Corefile,
+	// so they hold complete server blocks for additional zones.
+	//
+	// If unset, the default of "DefaultServer" is used.
+	//
+	// +kubebuilder:validation:Enum=DefaultServer;ServerBlocks
+	// +optional
+	ExtensionPoint DNSExtensionPoint `json:"extensionPoint,omitempty"`
+}
+
+// DNSExtensionPoint is a place in the Corefile into which snippets are
+// imported.
+type DNSExtensionPoint string
+
+var (
+	// DNSExtensionPointDefaultServer is the server block of the root zone.
+	DNSExtensionPointDefaultServer DNSExtensionPoint = "DefaultServer"
+
+	// DNSExtensionPointServerBlocks is the top level of the Corefile.
+	DNSExtensionPointServerBlocks DNSExtensionPoint = "ServerBlocks"
+)
+
+// DNSAdditionalNetwork references a NetworkAttachmentDefinition that the DNS
+// pods are attached to.
+type DNSAdditionalNetwork struct {
+	// name is the name of the NetworkAttachmentDefinition.
+	//
+	// +kubebuilder:validation:Required
+	// +required
+	Name string `json:"name"`
+
+	// namespace is the namespace of the NetworkAttachmentDefinition.
+	//
+	// If unset, the "openshift-dns" namespace is used.
+	//
+	// +optional
+	Namespace string `json:"namespace,omitempty"`
+}
+
+// DNSNodeOverride defines the servers that CoreDNS uses on the nodes that have
+// a given label.
+type DNSNodeOverride struct {
+	// name is the name of the override. It must conform to the rfc1123
+	// definition of a label, and it is used in the name of the DaemonSet
+	// that runs CoreDNS on the selected nodes.
+	//
+	// +kubebuilder:validation:Required
+	// +required
+	Name string `json:"name"`
+
+	// nodeSelector is the label that selects the nodes of the override. It
+	// must have exactly one label.
+	//
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinProperties=1
+	// +kubebuilder:validation:MaxProperties=1
+	// +required
+	NodeSelector map[string]string `json:"nodeSelector"`
+
+	// servers replaces the servers of the DNS on the selected nodes. It has
+	// the same format and restrictions as the servers of the DNS.
+	//
+	// If this field is nil, no servers are used on the selected nodes.
+	//
+	// +optional
+	Servers []Server `json:"servers,omitempty"`
+}
+
+// DNSDegradedSuppression defines how long the DNS waits before reporting or
+// clearing degradation caused by unavailable CoreDNS pods.
+type DNSDegradedSuppression struct {
+	// unavailableGracePeriod is how long more CoreDNS pods than the
+	// DaemonSet's maxUnavailable may be unavailable before the DNS reports
+	// Degraded=True. The DNS still reports that it is degraded right away
+	// if no pods are available.
+	//
+	// If unset, the DNS reports that it is degraded right away.
+	//
+	// +optional
+	UnavailableGracePeriod *metav1.Duration `json:"unavailableGracePeriod,omitempty"`
+
+	// recoveryPeriod is how long enough CoreDNS pods must be available
+	// before the DNS stops reporting Degraded=True because too many pods
+	// were unavailable.
+	//
+	// If unset, the DNS stops reporting that it is degraded right away.
+	//
+	// +optional
+	RecoveryPeriod *metav1.Duration `json:"recoveryPeriod,omitempty"`
+}
+
+// DNSInternalNames defines names that CoreDNS does not forward upstream.
+type DNSInternalNames struct {
+	// local specifies how CoreDNS handles queries for names in the "local"
+	// domain, which RFC 6762 reserves for multicast DNS. Any one of the
+	// following values may be specified:
+	// * Forward forwards the queries to the upstream resolvers.
+	// * NXDomain answers the queries with NXDOMAIN.
+	//
+	// If unset, the default of "Forward" is used.
+	//
+	// +kubebuilder:validation:Enum=Forward;NXDomain
+	// +optional
+	Local DNSInternalNamesAction `json:"local,omitempty"`
+
+	// singleLabel specifies how CoreDNS handles queries for single-label
+	// names, such as "intranet". Any one of the following values may be
+	// specified:
+	// * Forward forwards the queries to the upstream resolvers.
+	// * NXDomain answers the queries with NXDOMAIN.
+	//
+	// If unset, the default of "Forward" is used.
+	//
+	// +kubebuilder:validation:Enum=Forward;NXDomain
+	// +optional
+	SingleLabel DNSInternalNamesAction `json:"singleLabel,omitempty"`
+
+	// zones is a list of additional zones whose names CoreDNS answers with
+	// NXDOMAIN. Each zone must conform to the rfc1123 definition of a
+	// subdomain. A zone that is in the cluster domain or an additional
+	// cluster domain, that contains more than one of those domains, or that
+	// is a zone of a server or cluster peer, is ignored.
+	//
+	// A maximum of 32 zones is allowed.
+	//
+	// +kubebuilder:validation:MaxItems=32
+	// +optional
+	Zones []string `json:"zones,omitempty"`
+}
+
+// DNSInternalNamesAction describes how CoreDNS handles queries for internal
+// names.
+type DNSInternalNamesAction string
+
+var (
+	// DNSInternalNamesForward means that CoreDNS forwards queries for the
+	// names to the upstream resolvers.
+	DNSInternalNamesForward DNSInternalNamesAction = "Forward"
+
+	// DNSInternalNamesNXDomain means that CoreDNS answers queries for the
+	// names with NXDOMAIN.
+	DNSInternalNamesNXDomain DNSInternalNamesAction = "NXDomain"
+)
+
+// ServiceMeshCoexistenceState describes whether the DNS is configured to
+// coexist with a service mesh that intercepts DNS queries.
+type ServiceMeshCoexistenceState string
+
+var (
+	// ServiceMeshCoexistenceEnabled means that the DNS publishes its
+	// address and domains for a service mesh and avoids configurations
+	// that conflict with the DNS proxies of the mesh.
+	ServiceMeshCoexistenceEnabled ServiceMeshCoexistenceState = "Enabled"
+
+	// ServiceMeshCoexistenceDisabled means that the DNS makes no
+	// accommodations for a service mesh.
+	ServiceMeshCoexistenceDisabled ServiceMeshCoexistenceState = "Disabled"
+)
+
+// DNSClusterPeer defines another cluster to which queries for names in its
+// cluster domain are forwarded.
+type DNSClusterPeer struct {
+	// name is required and specifies a unique name for the peer. Name must
+	// comply with the Service Name Syntax of rfc6335.
+	//
+	// +kubebuilder:validation:Required
+	// +required
+	Name string `json:"name"`
+
+	// clusterDomain is required and specifies the cluster domain of the
+	// peer, for example "cluster2.local". It must differ from the cluster
+	// domain of this cluster and conform to the rfc1123 definition of a
+	// subdomain.
+	//
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Pattern=`^([a-z0-9]([-a-z0-9]*[a-z0-9])?\.)*[a-z0-9]([-a-z0-9]*[a-z0-9])?$`
+	// +required
+	ClusterDomain string `json:"clusterDomain"`
+
+	// nameservers is required and specifies the addresses of the DNS
+	// Service of the peer. Each nameserver is represented by an IP address
+	// or IP:port if the nameserver listens on a port other than 53. Queries
+	// are distributed randomly across the nameservers.
+	//
+	// A maximum of 15 nameservers is allowed per peer.
+	//
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=15
+	// +required
+	Nameservers []string `json:"nameservers"`
+}
+
+// IngressSplitHorizonState describes whether the host names of Routes and
+// Ingresses are resolved to the internal Service of the default ingress
+// controller.
+type IngressSplitHorizonState string
+
+var (
+	// IngressSplitHorizonEnabled means that the host names of Routes and
+	// Ingresses are resolved to the internal Service of the default ingress
+	// controller.
+	IngressSplitHorizonEnabled IngressSplitHorizonState = "Enabled"
+
+	// IngressSplitHorizonDisabled means that the host names of Routes and
+	// Ingresses are resolved using the upstream resolvers.
+	IngressSplitHorizonDisabled IngressSplitHorizonState = "Disabled"
+)
+
+// DNSServiceAlias defines a DNS name that resolves to a Service.
+type DNSServiceAlias struct {
+	// name is the fully qualified DNS name of the alias, for example
+	// "app.apps.example.com". The name must not be in the cluster domain.
+	//
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Pattern=`^([a-z0-9]([-a-z0-9]*[a-z0-9])?\.)+[a-z0-9]([-a-z0-9]*[a-z0-9])?$`
+	// +required
+	Name string `json:"name"`
+
+	// service is the Service to which the alias resolves.
+	//
+	// +kubebuilder:validation:Required
+	// +required
+	Service DNSServiceReference `json:"service"`
+}
+
+// DNSServiceReference identifies a Service.
+type DNSServiceReference struct {
+	// namespace is the namespace of the Service.
+	//
+	// +kubebuilder:validation:Required
+	// +required
+	Namespace string `json:"namespace"`
+
+	// name is the name of the Service.
+	//
+	// +kubebuilder:validation:Required
+	// +required
+	Name string `json:"name"`
+}
+
+// KubeDNSAliasState describes whether the kube-dns alias Service is managed.
+type KubeDNSAliasState string
+
+var (
+	// KubeDNSAliasEnabled means that the kube-dns alias Service is created
+	// and maintained.
+	KubeDNSAliasEnabled KubeDNSAliasState = "Enabled"
+
+	// KubeDNSAliasDisabled means that the kube-dns alias Service is removed.
+	KubeDNSAliasDisabled KubeDNSAliasState = "Disabled"
+)
+
+// DNSLogClientAttribution describes how CoreDNS identifies the client of a
+// query in the query log.
+type DNSLogClientAttribution string
+
+var (
+	// DNSLogClientAttributionNone means that CoreDNS logs the address of
+	// the client.
+	DNSLogClientAttributionNone DNSLogClientAttribution = "None"
+
+	// DNSLogClientAttributionPod means that CoreDNS also logs the
+	// namespace and name of the pod of the client.
+	DNSLogClientAttributionPod DNSLogClientAttribution = "Pod"
+)
+
+// DNSDebugZoneState describes whether CoreDNS serves the debug zone.
+type DNSDebugZoneState string
+
+var (
+	// DNSDebugZoneEnabled means that CoreDNS serves the debug zone.
+	DNSDebugZoneEnabled DNSDebugZoneState = "Enabled"
+
+	// DNSDebugZoneDisabled means that CoreDNS does not serve the debug
+	// zone.
+	DNSDebugZoneDisabled DNSDebugZoneState = "Disabled"
+)
+
+// DNSNSIDState describes whether CoreDNS adds a name server identifier to
+// responses.
+type DNSNSIDState string
+
+var (
+	// DNSNSIDEnabled means that CoreDNS adds a name server identifier to
+	// responses for queries that request one.
+	DNSNSIDEnabled DNSNSIDState = "Enabled"
+
+	// DNSNSIDDisabled means that CoreDNS does not add a name server
+	// identifier to responses.
+	DNSNSIDDisabled DNSNSIDState = "Disabled"
+)
+
+// DNSListenAddressesPolicy describes the addresses that CoreDNS listens on.
+type DNSListenAddressesPolicy string
+
+var (
+	// DNSListenAddressesWildcard means that CoreDNS listens on all
+	// addresses of the DNS pod.
+	DNSListenAddressesWildcard DNSListenAddressesPolicy = "Wildcard"
+
+	// DNSListenAddressesPodIP means that CoreDNS listens only on the IP
+	// address of the DNS pod in the IP family of the DNS Service.
+	DNSListenAddressesPodIP DNSListenAddressesPolicy = "PodIP"
+)
+
+// LocalhostZonesState describes whether CoreDNS answers queries for localhost
+// and the loopback reverse zones itself.
+type LocalhostZonesState string
+
+var (
+	// LocalhostZonesEnabled means that CoreDNS answers queries for
+	// localhost and the loopback reverse zones itself.
+	LocalhostZonesEnabled LocalhostZonesState = "Enabled"
+
+	// LocalhostZonesDisabled means that queries for localhost and the
+	// loopback reverse zones are forwarded to the upstream resolvers.
+	LocalhostZonesDisabled LocalhostZonesState = "Disabled"
+)
+
+// DNSListenSockets describes how many sockets CoreDNS listens on.
+type DNSListenSockets string
+
+var (
+	// DNSListenSocketsSingle means that CoreDNS listens on a single socket
+	// for each server.
+	DNSListenSocketsSingle DNSListenSockets = "Single"
+
+	// DNSListenSocketsPerCPU means that CoreDNS listens on one socket for
+	// each CPU that it may use, as determined by gomaxprocs, for each
+	// server.
+	DNSListenSocketsPerCPU DNSListenSockets = "PerCPU"
+)
+
+// DNSPerformance defines performance tuning settings for CoreDNS.
+type DNSPerformance struct {
+	// listenSockets describes how many sockets CoreDNS listens on for each
+	// server. Any one of the following values may be specified:
+	// * Single listens on one socket for each server.
+	// * PerCPU listens on one socket for each CPU that CoreDNS may use. Each
+	// socket is opened with SO_REUSEPORT so that the kernel distributes
+	// queries across the sockets and CoreDNS can handle queries on several
+	// CPUs in parallel. PerCPU requires CoreDNS 1.12.0 or later; with an
+	// earlier version, it is ignored.
+	//
+	// If unset, the default of "Single" is used.
+	//
+	// +kubebuilder:validation:Enum=Single;PerCPU
+	// +optional
+	ListenSockets DNSListenSockets `json:"listenSockets,omitempty"`
+
+	// gomaxprocs is the maximum number of CPUs that CoreDNS may use
+	// simultaneously. When listenSockets is PerCPU, this is also the number
+	// of sockets that CoreDNS listens on for each server.
+	//
+	// If unset and listenSockets is PerCPU, the number of CPUs that are
+	// allocatable on each node is used. If unset otherwise, CoreDNS uses all
+	// of the node's CPUs.
+	//
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=1024
+	// +optional
+	GOMAXPROCS int32 `json:"gomaxprocs,omitempty"`
+
+	// queryTimeout is the maximum time that CoreDNS spends on a query
+	// before canceling it, which bounds queries to upstream resolvers that
+	// hang. A value of "0s" uses a default that lets the forward plugin
+	// give up on a query and try another upstream resolver first. A
+	// timeout shorter than the time that the forward plugin needs to
+	// detect that an upstream resolver is down is raised to that time.
+	//
+	// If unset, queries are not canceled.
+	//
+	// +optional
+	QueryTimeout *metav1.Duration `json:"queryTimeout,omitempty"`
+}
+
+// DNSLogLevel is the logging verbosity for CoreDNS.
+type DNSLogLevel string
+
+var (
+	// Normal is the default.  Normal, working log information, everything is fine, but helpful notices for auditing or common operations.  In kube, this is probably glog=2.
+	DNSLogLevelNormal DNSLogLevel = "Normal"
+
+	// Debug is used when something went wrong.  Even common operations may be logged, and less helpful but more quantity of notices.  In kube, this is probably glog=4.
+	DNSLogLevelDebug DNSLogLevel = "Debug"
+
+	// Trace is used when something went really badly and even more verbose logs are needed.  Logging every function call as part of a common operation, to tracing execution of a query.  In kube, this is probably glog=6.
+	DNSLogLevelTrace DNSLogLevel = "Trace"
+)
+
+// DNSQueryLogRule selects the classes of responses that CoreDNS logs for the
+// names in some zones.
+type DNSQueryLogRule struct {
+	// zones is a list of zones to which the rule applies, such as
+	// "example.com". The rule applies to the names in the zones and their
+	// subdomains.
+	//
+	// +kubebuilder:validation:MinItems=1
+	// +required
+	Zones []string `json:"zones"`
+
+	// classes is a list of the classes of responses that CoreDNS logs for
+	// the names in the zones. Any of the following values may be
+	// specified:
+	// * Success logs responses with answers.
+	// * Denial logs NXDOMAIN and NODATA responses.
+	// * Error logs SERVFAIL, NOTIMP, and REFUSED responses, and other
+	// errors.
+	// * All logs all responses.
+	//
+	// +kubebuilder:validation:MinItems=1
+	// +required
+	Classes []DNSQueryLogClass `json:"classes"`
+}
+
+// DNSQueryLogClass is a class of responses that CoreDNS logs.
+// +kubebuilder:validation:Enum=Success;Denial;Error;All
+type DNSQueryLogClass string
+
+const (
+	// DNSQueryLogClassSuccess is the class of responses with answers.
+	DNSQueryLogClassSuccess DNSQueryLogClass = "Success"
+
+	// DNSQueryLogClassDenial is the class of NXDOMAIN and NODATA
+	// responses.
+	DNSQueryLogClassDenial DNSQueryLogClass = "Denial"
+
+	// DNSQueryLogClassError is the class of SERVFAIL, NOTIMP, and REFUSED
+	// responses, and other errors.
+	DNSQueryLogClassError DNSQueryLogClass = "Error"
+
+	// DNSQueryLogClassAll is the class of all responses.
+	DNSQueryLogClassAll DNSQueryLogClass = "All"
+)
+
+// ProbePorts defines the ports on which CoreDNS serves its health and
+// readiness endpoints.
+type ProbePorts struct {
+	// health is the port on which CoreDNS serves its liveness endpoint
+	// (/health). The port must not be 5353, 9153, or 9154, which are
+	// reserved for DNS and metrics, and must differ from the ready port.
+	//
+	// If unset, the default port of 8080 is used.
+	//
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=65535
+	// +optional
+	Health int32 `json:"health,omitempty"`
+
+	// ready is the port on which CoreDNS serves its readiness endpoint
+	// (/ready). The port must not be 5353, 9153, or 9154, which are reserved
+	// for DNS and metrics, and must differ from the health port.
+	//
+	// If unset, the default port of 8181 is used.
+	//
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=65535
+	// +optional
+	Ready int32 `json:"ready,omitempty"`
+}
+
+// NodeResolverConfig defines the schema for configuring the node-resolver.
+type NodeResolverConfig struct {
+	// pollInterval is the interval at which the node-resolver resolves the
+	// names that it manages and refreshes /etc/hosts. The minimum interval is
+	// 5s; shorter intervals are rounded up to 5s.
+	//
+	// If unset, the default interval of 60s is used.
+	//
+	// +optional
+	PollInterval *metav1.Duration `json:"pollInterval,omitempty"`
+
+	// additionalNames is a list of names that the node-resolver maintains in
+	// /etc/hosts in addition to the default names (such as the cluster image
+	// registry service). Relative names (for example, "foo.bar.svc") are
+	// resolved in the cluster domain and are added in both relative and
+	// fully qualified form. Absolute names, which end in ".", are resolved
+	// as-is and are added without the trailing dot; this allows, for example,
+	// mirror registry hostnames to be added to /etc/hosts on disconnected
+	// clusters.
+	//
+	// A maximum of 32 additional names is allowed.
+	//
+	// +kubebuilder:validation:MaxItems=32
+	// +optional
+	AdditionalNames []string `json:"additionalNames,omitempty"`
+
+	// hostAliases is a list of static hostname to IP address mappings that
+	// the node-resolver writes into /etc/hosts on every node, in addition
+	// to the names that it resolves. Each hostname may appear in only one
+	// host alias and must not be a name that the node-resolver resolves.
+	//
+	// A maximum of 32 host aliases is allowed.
+	//
+	// +kubebuilder:validation:MaxItems=32
+	// +optional
+	HostAliases []NodeResolverHostAlias `json:"hostAliases,omitempty"`
+
+	// resolvConfReload specifies whether CoreDNS picks up changes to the
+	// node's /etc/resolv.conf, for example when a DHCP lease is renewed or a
+	// VPN connection comes up or goes down, without the DNS pod being
+	// restarted. Any one of the following values may be specified:
+	// * Enabled makes the node-resolver copy the node's /etc/resolv.conf for
+	// CoreDNS whenever it changes, make CoreDNS reload its upstream
+	// resolvers, and record an event on the DNS pod.
+	// * Disabled makes CoreDNS use the upstream resolvers of the node's
+	// /etc/resolv.conf as it was when the DNS pod started.
+	//
+	// The node's /etc/resolv.conf is checked at the poll interval. The
+	// setting has no effect if the node-resolver is disabled.
+	//
+	// If unset, the default of "Disabled" is used.
+	//
+	// +kubebuilder:validation:Enum=Enabled;Disabled
+	// +optional
+	ResolvConfReload NodeResolverResolvConfReloadState `json:"resolvConfReload,omitempty"`
+}
+
+// NodeResolverResolvConfReloadState describes whether CoreDNS picks up changes
+// to the node's /etc/resolv.conf.
+type NodeResolverResolvConfReloadState string
+
+var (
+	// NodeResolverResolvConfReloadEnabled means that CoreDNS reloads its
+	// upstream resolvers when the node's /etc/resolv.conf changes.
+	NodeResolverResolvConfReloadEnabled NodeResolverResolvConfReloadState = "Enabled"
+
+	// NodeResolverResolvConfReloadDisabled means that CoreDNS uses the
+	// node's /etc/resolv.conf as it was when the DNS pod started.
+	NodeResolverResolvConfReloadDisabled NodeResolverResolvConfReloadState = "Disabled"
+)
+
+// NodeResolverHostAlias maps hostnames to an IP address in /etc/hosts.
+type NodeResolverHostAlias struct {
+	// ip is required and specifies the IPv4 or IPv6 address of the
+	// hostnames.
+	//
+	// +kubebuilder:validation:Required
+	// +required
+	IP string `json:"ip"`
+
+	// hostnames is required and specifies the hostnames for the IP
+	// address. Each hostname must conform to the rfc1123 definition of a
+	// subdomain.
+	//
+	// A maximum of 8 hostnames is allowed per host alias.
+	//
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=8
+	// +required
+	Hostnames []string `json:"hostnames"`
+}
+
+// Server defines the schema for a server that runs per instance of CoreDNS.
+type Server struct {
+	// name is required and specifies a unique name for the server. Name must comply
+	// with the Service Name Syntax of rfc6335.
+	Name string `json:"name"`
+	// zones is required and specifies the subdomains that Server is authoritative for.
+	// Zones must conform to the rfc1123 definition of a subdomain. Specifying the
+	// cluster domain (i.e., "cluster.local") is invalid.
+	Zones []string `json:"zones"`
+	// forwardPlugin defines a schema for configuring CoreDNS to proxy DNS messages
+	// to upstream resolvers.
+	ForwardPlugin ForwardPlugin `json:"forwardPlugin"`
+	// minimalResponses specifies whether CoreDNS removes the authority and
+	// additional sections from successful answers for the zones of the
+	// server, which shrinks the responses on constrained networks. Negative
+	// answers and referrals are not changed. Any one of the following
+	// values may be specified:
+	// * Enabled removes the sections.
+	// * Disabled returns the responses of the upstream resolvers unchanged.
+	//
+	// If unset, the default of "Disabled" is used. Enabled requires CoreDNS
+	// 1.8.1 or later; with an earlier version, it is ignored.
+	//
+	// +kubebuilder:validation:Enum=Enabled;Disabled
+	// +optional
+	MinimalResponses MinimalResponsesState `json:"minimalResponses,omitempty"`
+	// rateLimit limits the rate of queries for the zones of the server that
+	// CoreDNS answers from each client, so that a misbehaving workload
+	// cannot overwhelm the upstream resolvers of the server. Queries that
+	// exceed the limit are dropped and counted in the
+	// coredns_rrl_requests_exceeded_total metric. Rate limiting requires a
+	// CoreDNS build with the external rrl plugin, which the default CoreDNS
+	// image lacks; without it, this field is ignored.
+	//
+	// If this field is nil, queries are not rate limited.
+	//
+	// +optional
+	RateLimit *DNSRateLimit `json:"rateLimit,omitempty"`
+}
+
+// DNSRateLimit defines the rate limit of queries for the zones of a server.
+type DNSRateLimit struct {
+	// requestsPerSecond is the number of queries per second that CoreDNS
+	// answers from each client network.
+	//
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Required
+	// +required
+	RequestsPerSecond int32 `json:"requestsPerSecond"`
+
+	// ipv4PrefixLength is the length of the prefix of the IPv4 address of a
+	// client that identifies its client network. Clients whose addresses
+	// share the prefix share the limit.
+	//
+	// If unset, the default of 32 is used, which limits each client
+	// separately.
+	//
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=32
+	// +optional
+	IPv4PrefixLength int32 `json:"ipv4PrefixLength,omitempty"`
+
+	// ipv6PrefixLength is the length of the prefix of the IPv6 address of a
+	// client that identifies its client network. Clients whose addresses
+	// share the prefix share the limit.
+	//
+	// If unset, the default of 128 is used, which limits each client
+	// separately.
+	//
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=128
+	// +optional
+	IPv6PrefixLength int32 `json:"ipv6PrefixLength,omitempty"`
+
+	// mode describes what CoreDNS does with queries that exceed the limit.
+	// Any one of the following values may be specified:
+	// * Enforce drops the queries.
+	// * ReportOnly answers the queries but counts them in the metric, so
+	// that a limit can be tried out before it is enforced.
+	//
+	// If unset, the default of "Enforce" is used.
+	//
+	// +kubebuilder:validation:Enum=Enforce;ReportOnly
+	// +optional
+	Mode DNSRateLimitMode `json:"mode,omitempty"`
+}
+
+// DNSRateLimitMode describes what CoreDNS does with queries that exceed a rate
+// limit.
+type DNSRateLimitMode string
+
+var (
+	// DNSRateLimitModeEnforce means that CoreDNS drops queries that exceed
+	// the limit.
+	DNSRateLimitModeEnforce DNSRateLimitMode = "Enforce"
+
+	// DNSRateLimitModeReportOnly means that CoreDNS answers queries that
+	// exceed the limit but counts them.
+	DNSRateLimitModeReportOnly DNSRateLimitMode = "ReportOnly"
+)
+
+// MinimalResponsesState describes whether CoreDNS minimizes the responses for
+// the zones of a server.
+type MinimalResponsesState string
+
+var (
+	// MinimalResponsesEnabled means that CoreDNS removes the authority and
+	// additional sections from successful answers.
+	MinimalResponsesEnabled MinimalResponsesState = "Enabled"
+
+	// MinimalResponsesDisabled means that CoreDNS does not change the
+	// responses.
+	MinimalResponsesDisabled MinimalResponsesState = "Disabled"
+)
+
+// ForwardPlugin defines a schema for configuring the CoreDNS forward plugin.
+type ForwardPlugin struct {
+	// upstreams is a list of resolvers to forward name queries for subdomains of Zones.
+	// Upstreams are randomized when more than 1 upstream is specified. Each instance of
+	// CoreDNS performs health checking of Upstreams. When a healthy upstream returns an
+	// error during the exchange, another resolver is tried from Upstreams. Each upstream
+	// is represented by an IP address or IP:port if the upstream listens on a port other
+	// than 53.
+	//
+	// A maximum of 15 upstreams is allowed per ForwardPlugin.
+	//
+	// +kubebuilder:validation:MaxItems=15
+	Upstreams []string `json:"upstreams"`
+
+	// expire is the time after which CoreDNS closes a cached connection to
+	// an upstream resolver. Longer times let CoreDNS reuse connections for
+	// more queries, which reduces the number of source ports that it uses
+	// toward the upstream resolvers on clusters with many queries. A value
+	// of "0s" uses the default.
+	//
+	// If unset, the default of 10s is used.
+	//
+	// +optional
+	Expire *metav1.Duration `json:"expire,omitempty"`
+
+	// protocolPreference describes which protocol CoreDNS uses to forward
+	// queries to the upstream resolvers. Any one of the following values
+	// may be specified:
+	// * MatchClient forwards each query over the protocol over which the
+	// client sent it.
+	// * PreferUDP forwards each query over UDP, even if the client sent it
+	// over TCP, and retries over TCP if the response is truncated.
+	//
+	// If unset, the default of "MatchClient" is used.
+	//
+	// +kubebuilder:validation:Enum=MatchClient;PreferUDP
+	// +optional
+	ProtocolPreference DNSProtocolPreference `json:"protocolPreference,omitempty"`
+
+	// protocolStrategy specifies the protocol to use for upstream DNS
+	// requests. Valid values are "TCP" and omitted. When omitted, CoreDNS
+	// forwards each query over the protocol that protocolPreference
+	// describes. "TCP" forwards all queries to the upstream resolvers over
+	// TCP, even if the client sent them over UDP, and takes precedence
+	// over protocolPreference. This is useful with firewalls that block
+	// or fragment UDP and with large DNSSEC responses, but may increase
+	// the response time. protocolStrategy only affects the queries that
+	// CoreDNS sends to upstream resolvers, not the queries between
+	// clients and CoreDNS.
+	//
+	// +kubebuilder:validation:Enum=TCP;""
+	// +optional
+	ProtocolStrategy ProtocolStrategy `json:"protocolStrategy,omitempty"`
+
+	// except is a list of subdomains of the zones of the server whose names
+	// are not forwarded to upstreams, for example to forward a broad zone to
+	// corporate resolvers while a subdomain that the cluster serves is
+	// resolved elsewhere. Names in an excepted subdomain are resolved by the
+	// server whose zone is the subdomain, if there is one, and otherwise in
+	// the same way as names that are outside of the zones of all servers.
+	// Each subdomain must conform to the rfc1123 definition of a subdomain
+	// and be a strict subdomain of a zone of the server; any other subdomain
+	// is ignored.
+	//
+	// A maximum of 15 subdomains is allowed per ForwardPlugin.
+	//
+	// If this field is nil, all names in the zones of the server are
+	// forwarded to upstreams.
+	//
+	// +kubebuilder:validation:MaxItems=15
+	// +optional
+	Except []string `json:"except,omitempty"`
+
+	// transportConfig is used to configure the transport type, server name,
+	// and optional CA bundle to use when forwarding DNS requests to the
+	// upstream resolvers.
+	//
+	// The default value is "" (empty), which results in a standard
+	// cleartext connection being used when forwarding DNS requests to the
+	// upstream resolvers.
+	//
+	// +optional
+	TransportConfig DNSTransportConfig `json:"transportConfig,omitempty"`
+
+	// policy is used to determine the order in which upstream servers are
+	// selected for querying. Any one of the following values may be
+	// specified:
+	// * "Random" picks a random upstream server for each query.
+	// * "RoundRobin" picks upstream servers in a round-robin order, moving
+	// to the next server for each new query.
+	// * "Sequential" tries querying upstreams in a sequential order until
+	// one responds, starting with the first upstream for each new query.
+	//
+	// If unset, the default of "Random" is used.
+	//
+	// +kubebuilder:validation:Enum=Random;RoundRobin;Sequential
+	// +optional
+	Policy ForwardingPolicy `json:"policy,omitempty"`
+}
+
+// DNSTransportConfig groups related configuration parameters used for
+// configuring forwarding to upstream resolvers that support
+// DNS-over-TLS.
+// +union
+type DNSTransportConfig struct {
+	// transport allows cluster administrators to opt-in to using a
+	// DNS-over-TLS connection between cluster DNS and an upstream resolver.
+	// Any one of the following values may be specified:
+	// * TLS forwards queries to the upstream resolvers over TLS, on port
+	// 853 unless an upstream specifies another port.
+	// * Cleartext forwards queries to the upstream resolvers without
+	// encryption.
+	//
+	// If unset, the default of "Cleartext" is used.
+	//
+	// +unionDiscriminator
+	// +kubebuilder:validation:Enum=TLS;Cleartext;""
+	// +optional
+	Transport DNSTransport `json:"transport,omitempty"`
+
+	// tls contains the additional configuration options to use when
+	// Transport is set to "TLS".
+	//
+	// +optional
+	TLS *DNSOverTLSConfig `json:"tls,omitempty"`
+}
+
+// DNSTransport indicates what type of connection should be used for
+// forwarding queries to upstream resolvers.
+type DNSTransport string
+
+var (
+	// TLSTransport indicates that the connection to the upstream
+	// resolvers uses TLS.
+	TLSTransport DNSTransport = "TLS"
+
+	// CleartextTransport indicates that the connection to the upstream
+	// resolvers is not encrypted.
+	CleartextTransport DNSTransport = "Cleartext"
+)
+
+// DNSOverTLSConfig describes optional DNSTransportConfig fields that should
+// be captured.
+type DNSOverTLSConfig struct {
+	// serverName is the upstream server to connect to when forwarding DNS
+	// queries. This is required when Transport is set to "TLS". CoreDNS
+	// verifies that the certificates of the upstream resolvers are valid
+	// for this name. ServerName must conform to the definition of a
+	// subdomain in rfc1123.
+	//
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MaxLength=253
+	// +kubebuilder:validation:Pattern=`^([a-z0-9]([-a-z0-9]*[a-z0-9])?(\.[a-z0-9]([-a-z0-9]*[a-z0-9])?)*)$`
+	ServerName string `json:"serverName"`
+
+	// caBundle references a ConfigMap that must contain a CA bundle in the
+	// "ca-bundle.crt" key. The ConfigMap must be in the openshift-dns
+	// namespace. CoreDNS uses the CA bundle, in place of the system
+	// trust store, to validate the certificates of the upstream
+	// resolvers.
+	//
+	// If unset, the system trust store, which includes the cluster-wide
+	// trusted CA bundle, is used.
+	//
+	// +optional
+	CABundle configv1.ConfigMapNameReference `json:"caBundle,omitempty"`
+}
+
+// DNSProtocolPreference describes which protocol CoreDNS uses to forward
+// queries to upstream resolvers.
+type DNSProtocolPreference string
+
+var (
+	// DNSProtocolPreferenceMatchClient means that CoreDNS forwards each
+	// query over the protocol over which the client sent it.
+	DNSProtocolPreferenceMatchClient DNSProtocolPreference = "MatchClient"
+
+	// DNSProtocolPreferencePreferUDP means that CoreDNS forwards each query
+	// over UDP and retries over TCP if the response is truncated.
+	DNSProtocolPreferencePreferUDP DNSProtocolPreference = "PreferUDP"
+)
+
+// ProtocolStrategy is the protocol that CoreDNS uses to forward queries to
+// upstream resolvers.
+type ProtocolStrategy string
+
+const (
+	// ProtocolStrategyDefault means that CoreDNS forwards queries over the
+	// default protocol.
+	ProtocolStrategyDefault ProtocolStrategy = ""
+
+	// ProtocolStrategyTCP means that CoreDNS forwards all queries over
+	// TCP.
+	ProtocolStrategyTCP ProtocolStrategy = "TCP"
+)
+
+// UpstreamResolvers defines a schema for configuring the CoreDNS forward
+// plugin in the specific case of the default (".") server. It differs from
+// ForwardPlugin in the default values it accepts:
+// * If no upstreams are specified, /etc/resolv.conf is used.
+// * The default policy is Sequential.
+type UpstreamResolvers struct {
+	// upstreams is a list of resolvers to forward name queries for the "."
+	// domain. Each instance of CoreDNS performs health checking of
+	// Upstreams. When a healthy upstream returns an error during the
+	// exchange, another resolver is tried from Upstreams. The Upstreams are
+	// selected in the order specified in Policy.
+	//
+	// A maximum of 15 upstreams is allowed. If no Upstreams are specified,
+	// /etc/resolv.conf is used by default.
+	//
+	// +kubebuilder:validation:MaxItems=15
+	// +optional
+	Upstreams []Upstream `json:"upstreams,omitempty"`
+
+	// policy is used to determine the order in which upstream servers are
+	// selected for querying. Any one of the following values may be
+	// specified:
+	// * "Random" picks a random upstream server for each query.
+	// * "RoundRobin" picks upstream servers in a round-robin order, moving
+	// to the next server for each new query.
+	// * "Sequential" tries querying upstreams in a sequential order until
+	// one responds, starting with the first upstream for each new query.
+	//
+	// If unset, the default of "Sequential" is used.
+	//
+	// +kubebuilder:validation:Enum=Random;RoundRobin;Sequential
+	// +optional
+	Policy ForwardingPolicy `json:"policy,omitempty"`
+
+	// protocolStrategy specifies the protocol to use for upstream DNS
+	// requests. Valid values are "TCP" and omitted. When omitted, CoreDNS
+	// forwards each query over the protocol over which the client sent
+	// it. "TCP" forwards all queries to the upstream resolvers over TCP,
+	// even if the client sent them over UDP, which is useful with
+	// firewalls that block or fragment UDP and with large DNSSEC
+	// responses, but may increase the response time. protocolStrategy
+	// only affects the queries that CoreDNS sends to upstream resolvers,
+	// not the queries between clients and CoreDNS.
+	//
+	// +kubebuilder:validation:Enum=TCP;""
+	// +optional
+	ProtocolStrategy ProtocolStrategy `json:"protocolStrategy,omitempty"`
+}
+
+// Upstream can either be of type SystemResolvConf, or of type Network.
+//
+// * For an Upstream of type SystemResolvConf, no further fields are
+// necessary: The upstream will be configured to use /etc/resolv.conf.
+// * For an Upstream of type Network, an address needs to be defined, and a
+// port if the upstream listens on a port other than 53.
+type Upstream struct {
+	// type defines whether this upstream is a resolver at an address or the
+	// nameservers of /etc/resolv.conf. Any one of the following values may
+	// be specified:
+	// * SystemResolvConf uses the nameservers of /etc/resolv.conf; no
+	// further fields are required.
+	// * Network uses the resolver at address and port.
+	//
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Enum=SystemResolvConf;Network
+	// +required
+	Type UpstreamType `json:"type"`
+
+	// address must be defined when Type is set to Network. It will be
+	// ignored otherwise. It must be a valid IPv4 or IPv6 address.
+	//
+	// +optional
+	Address string `json:"address,omitempty"`
+
+	// port may be defined when Type is set to Network. It will be ignored
+	// otherwise. Port must be between 1 and 65535.
+	//
+	// If unset, the default of 53 is used.
+	//
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=65535
+	// +optional
+	Port uint32 `json:"port,omitempty"`
+}
+
+// UpstreamType is a type of upstream resolver.
+type UpstreamType string
+
+const (
+	// SystemResolveConfType means that the upstream is the nameservers of
+	// /etc/resolv.conf.
+	SystemResolveConfType UpstreamType = "SystemResolvConf"
+
+	// NetworkResolverType means that the upstream is a resolver at an
+	// address and port.
+	NetworkResolverType UpstreamType = "Network"
+)
+
+// ForwardingPolicy is the policy to use when forwarding DNS requests.
+type ForwardingPolicy string
+
+const (
+	// RandomForwardingPolicy picks a random upstream server for each query.
+	RandomForwardingPolicy ForwardingPolicy = "Random"
+
+	// RoundRobinForwardingPolicy picks upstream servers in a round-robin
+	// order, moving to the next server for each new query.
+	RoundRobinForwardingPolicy ForwardingPolicy = "RoundRobin"
+
+	// SequentialForwardingPolicy tries querying upstreams in a sequential
+	// order until one responds, starting with the first upstream for each
+	// new query.
+	SequentialForwardingPolicy ForwardingPolicy = "Sequential"
+)
+
+const (
+	// Available indicates the DNS controller daemonset is available.
+	DNSAvailable = "Available"
+)
+
+// DNSStatus defines the observed status of the DNS.
+type DNSStatus struct {
+	// clusterIP is the service IP through which this DNS is made available.
+	//
+	// In the case of the default DNS, this will be a well known IP that is used
+	// as the default nameserver for pods that are using the default ClusterFirst DNS policy.
+	//
+	// In general, this IP can be specified in a pod's spec.dnsConfig.nameservers list
+	// or used explicitly when performing name resolution from within the cluster.
+	// Example: dig foo.com @<service IP>
+	//
+	// More info: https://kubernetes.io/docs/concepts/services-networking/service/#virtual-ips-and-service-proxies
+	//
+	// +kubebuilder:validation:Required
+	// +required
+	ClusterIP string `json:"clusterIP"`
+
+	// clusterDomain is the local cluster DNS domain suffix for DNS services.
+	// This will be a subdomain as defined in RFC 1034,
+	// section 3.5: https://tools.ietf.org/html/rfc1034#section-3.5
+	// Example: "cluster.local"
+	//
+	// More info: https://kubernetes.io/docs/concepts/services-networking/dns-pod-service
+	//
+	// +kubebuilder:validation:Required
+	// +required
+	ClusterDomain string `json:"clusterDomain"`
+
+	// conditions provide information about the state of the DNS on the cluster.
+	//
+	// These are the supported DNS conditions:
+	//
+	//   * Available
+	//   - True if the following conditions are met:
+	//     * DNS controller daemonset is available.
+	//   - False if any of those conditions are unsatisfied.
+	//
+	// +patchMergeKey=type
+	// +patchStrategy=merge
+	// +optional
+	Conditions []OperatorCondition `json:"conditions,omitempty" patchStrategy:"merge" patchMergeKey:"type"`
+
+	// cacheStats summarizes cache statistics that are periodically sampled
+	// from the DNS pods. These statistics can help with capacity planning,
+	// for example to decide whether a larger cache or node-local caching
+	// would be beneficial.
+	//
+	// +optional
+	CacheStats *DNSCacheStats `json:"cacheStats,omitempty"`
+
+	// corefileStatus reports whether the DNS pods have loaded the current
+	// CoreDNS configuration. Configuration changes, such as log level
+	// changes, are applied by CoreDNS reloading its configuration rather
+	// than by restarting DNS pods, so this status can be used to verify
+	// that a change has taken effect.
+	//
+	// +optional
+	CorefileStatus *DNSCorefileStatus `json:"corefileStatus,omitempty"`
+
+	// disabledCapabilities lists the cluster capabilities for optional DNS
+	// components that are disabled on the cluster. The components that
+	// correspond to these capabilities are not deployed.
+	//
+	// +optional
+	DisabledCapabilities []string `json:"disabledCapabilities,omitempty"`
+
+	// lastFailure is the time of the most recent failure to reconcile the
+	// DNS that the operator reported in an event. Repeated failures with
+	// the same error are summarized in a single event with a count, so
+	// this time is updated at most periodically while the failures
+	// continue.
+	//
+	// +optional
+	LastFailure *metav1.Time `json:"lastFailure,omitempty"`
+
+	// history lists recent significant actions that the operator took for
+	// the DNS, such as updating the Corefile, rolling out the DaemonSet, or
+	// rejecting invalid configuration, oldest first. Only a bounded number
+	// of the most recent actions is kept.
+	//
+	// +optional
+	History []DNSHistoryEntry `json:"history,omitempty"`
+
+	// forwarders summarizes the health of the upstream resolvers that the
+	// DNS pods forward queries to, based on the forward plugin metrics that
+	// are periodically sampled from the DNS pods.
+	//
+	// +optional
+	Forwarders *DNSForwarderStats `json:"forwarders,omitempty"`
+
+	// podVersions lists the versions that the DNS pods run, by DaemonSet,
+	// CoreDNS image, and pod template generation, so that a rollout that
+	// is stuck on some nodes is visible. More than one version for a
+	// DaemonSet means that its pods are skewed.
+	//
+	// +optional
+	PodVersions []DNSPodVersion `json:"podVersions,omitempty"`
+}
+
+// DNSPodVersion is a version that some DNS pods run.
+type DNSPodVersion struct {
+	// daemonSet is the name of the DaemonSet of the pods.
+	DaemonSet string `json:"daemonSet"`
+
+	// image is the CoreDNS image of the pods.
+	Image string `json:"image"`
+
+	// generation is the generation of the DaemonSet that the pods were
+	// created from.
+	Generation int64 `json:"generation"`
+
+	// pods is the number of pods that run the version.
+	Pods int32 `json:"pods"`
+
+	// outdated is true if other pods of the DaemonSet run a newer
+	// generation.
+	//
+	// +optional
+	Outdated bool `json:"outdated,omitempty"`
+
+	// nodes lists up to 5 nodes of the pods if the version is outdated.
+	//
+	// +optional
+	Nodes []string `json:"nodes,omitempty"`
+}
+
+// DNSForwarderStats summarizes the health of the upstream resolvers of a DNS.
+type DNSForwarderStats struct {
+	// sampleTime is the time at which the statistics were sampled.
+	SampleTime metav1.Time `json:"sampleTime"`
+
+	// podCount is the number of DNS pods that were sampled.
+	PodCount int32 `json:"podCount"`
+
+	// upstreams lists the health of each upstream resolver that the DNS
+	// pods forwarded queries to, ordered by address.
+	//
+	// +optional
+	Upstreams []DNSUpstreamStatus `json:"upstreams,omitempty"`
+}
+
+// DNSUpstreamHealth is a coarse summary of the health of an upstream resolver.
+type DNSUpstreamHealth string
+
+const (
+	// DNSUpstreamHealthOK means that the upstream resolver answered every
+	// query and health check since the previous sample.
+	DNSUpstreamHealthOK DNSUpstreamHealth = "OK"
+
+	// DNSUpstreamHealthFlaky means that the upstream resolver answered some
+	// queries but failed others or failed health checks since the previous
+	// sample.
+	DNSUpstreamHealthFlaky DNSUpstreamHealth = "Flaky"
+
+	// DNSUpstreamHealthDown means that the upstream resolver answered no
+	// queries and failed queries or health checks since the previous sample.
+	DNSUpstreamHealthDown DNSUpstreamHealth = "Down"
+)
+
+// DNSUpstreamStatus reports the health of an upstream resolver.
+type DNSUpstreamStatus struct {
+	// address is the address and port of the upstream resolver.
+	Address string `json:"address"`
+
+	// servers lists the names of the servers in spec.servers that forward
+	// to the upstream resolver. It is empty for the resolvers that the
+	// default server block forwards to.
+	//
+	// +optional
+	Servers []string `json:"servers,omitempty"`
+
+	// health is OK, Flaky, or Down.
+	Health DNSUpstreamHealth `json:"health"`
+
+	// requests is the number of queries that the DNS pods forwarded to the
+	// upstream resolver since the previous sample.
+	Requests int64 `json:"requests"`
+
+	// errors is the number of queries forwarded to the upstream resolver
+	// that were not answered plus the number of failed health checks of
+	// the upstream resolver since the previous sample.
+	Errors int64 `json:"errors"`
+}
+
+// DNSHistoryEntry records a significant action that the operator took for a
+// DNS.
+type DNSHistoryEntry struct {
+	// time is the time at which the action was taken.
+	//
+	// +kubebuilder:validation:Required
+	// +required
+	Time metav1.Time `json:"time"`
+
+	// type is a machine-readable name for the kind of action, for example
+	// "CorefileUpdated", "DaemonSetUpdated", or "CorefileRejected".
+	//
+	// +kubebuilder:validation:Required
+	// +required
+	Type string `json:"type"`
+
+	// message is a human-readable description of the action.
+	//
+	// +optional
+	Message string `json:"message,omitempty"`
+}
+
+// DNSCorefileStatus reports which DNS pods have loaded the current CoreDNS
+// configuration.
+type DNSCorefileStatus struct {
+	// hash is the SHA-512 hash of the current CoreDNS configuration, as a
+	// hexadecimal string.
+	Hash string `json:"hash"`
+
+	// sampleTime is the time at which the DNS pods were sampled.
+	SampleTime metav1.Time `json:"sampleTime"`
+
+	// sampledPods is the number of DNS pods that were successfully sampled.
+	SampledPods int32 `json:"sampledPods"`
+
+	// updatedPods is the number of sampled DNS pods that have loaded the
+	// configuration with the current hash.
+	UpdatedPods int32 `json:"updatedPods"`
+
+	// unverifiedReason says why the DNS pods cannot be checked for the
+	// configuration that they have loaded, if they cannot. The DNS pods
+	// are not sampled then, and sampledPods and updatedPods are zero.
+	//
+	// +optional
+	UnverifiedReason string `json:"unverifiedReason,omitempty"`
+}
+
+// DNSCacheStats summarizes cache statistics sampled from the DNS pods.
+type DNSCacheStats struct {
+	// sampleTime is the time at which the statistics were sampled.
+	SampleTime metav1.Time `json:"sampleTime"`
+
+	// podCount is the number of DNS pods from which statistics were
+	// successfully sampled.
+	PodCount int32 `json:"podCount"`
+
+	// entries summarizes the number of entries in the cache of each DNS pod.
+	Entries CacheStatSummary `json:"entries"`
+
+	// hitRatioPercent summarizes the cache hit ratio of each DNS pod, as a
+	// percentage of cache lookups since the pod started.
+	HitRatioPercent CacheStatSummary `json:"hitRatioPercent"`
+}
+
+// CacheStatSummary summarizes a cache statistic across DNS pods.
+type CacheStatSummary struct {
+	// min is the smallest value of the statistic across DNS pods.
+	Min int64 `json:"min"`
+	// median is the median value of the statistic across DNS pods.
+	Median int64 `json:"median"`
+	// max is the largest value of the statistic across DNS pods.
+	Max int64 `json:"max"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +kubebuilder:object:root=true
+
+// DNSList contains a list of DNS
+type DNSList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+
+	Items []DNS `json:"items"`
+}
@@ -0,0 +1,40 @@
+package v1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// +genclient
+// +genclient:nonNamespaced
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// Etcd provides information to configure an operator to manage kube-apiserver.
+type Etcd struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata"`
+
+	// +kubebuilder:validation:Required
+	// +required
+	Spec EtcdSpec `json:"spec"`
+	// +optional
+	Status EtcdStatus `json:"status"`
+}
+
+type EtcdSpec struct {
+	StaticPodOperatorSpec `json:",inline"`
+}
+
+type EtcdStatus struct {
+	StaticPodOperatorStatus `json:",inline"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// KubeAPISOperatorConfigList is a collection of items
+type EtcdList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata"`
+
+	// Items contains the items
+	Items []Etcd `json:"items"`
+}
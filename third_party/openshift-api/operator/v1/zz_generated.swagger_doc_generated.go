@@ -0,0 +1,1172 @@
+package v1
+
+// This file contains a collection of methods that can be used from go-restful to
+// generate Swagger API documentation for its models. Please read this PR for more
+// information on the implementation: https://github.com/emicklei/go-restful/pull/215
+//
+// TODOs are ignored from the parser (e.g. TODO(andronat):... || TODO:...) if and only if
+// they are on one line! For multiple line or blocks that you want to ignore use ---.
+// Any context after a --- is ignored.
+//
+// Those methods can be generated by using hack/update-swagger-docs.sh
+
+// AUTO-GENERATED FUNCTIONS START HERE
+var map_GenerationStatus = map[string]string{
+	"":               "GenerationStatus keeps track of the generation for a given resource so that decisions about forced updates can be made.",
+	"group":          "group is the group of the thing you're tracking",
+	"resource":       "resource is the resource type of the thing you're tracking",
+	"namespace":      "namespace is where the thing you're tracking is",
+	"name":           "name is the name of the thing you're tracking",
+	"lastGeneration": "lastGeneration is the last generation of the workload controller involved",
+	"hash":           "hash is an optional field set for resources without generation that are content sensitive like secrets and configmaps",
+}
+
+func (GenerationStatus) SwaggerDoc() map[string]string {
+	return map_GenerationStatus
+}
+
+var map_MyOperatorResource = map[string]string{
+	"": "MyOperatorResource is an example operator configuration type",
+}
+
+func (MyOperatorResource) SwaggerDoc() map[string]string {
+	return map_MyOperatorResource
+}
+
+var map_NodeStatus = map[string]string{
+	"":                         "NodeStatus provides information about the current state of a particular node managed by this operator.",
+	"nodeName":                 "nodeName is the name of the node",
+	"currentRevision":          "currentRevision is the generation of the most recently successful deployment",
+	"targetRevision":           "targetRevision is the generation of the deployment we're trying to apply",
+	"lastFailedRevision":       "lastFailedRevision is the generation of the deployment we tried and failed to deploy.",
+	"lastFailedRevisionErrors": "lastFailedRevisionErrors is a list of the errors during the failed deployment referenced in lastFailedRevision",
+}
+
+func (NodeStatus) SwaggerDoc() map[string]string {
+	return map_NodeStatus
+}
+
+var map_OperatorCondition = map[string]string{
+	"": "OperatorCondition is just the standard condition fields.",
+}
+
+func (OperatorCondition) SwaggerDoc() map[string]string {
+	return map_OperatorCondition
+}
+
+var map_OperatorSpec = map[string]string{
+	"":                           "OperatorSpec contains common fields operators need.  It is intended to be anonymous included inside of the Spec struct for your particular operator.",
+	"managementState":            "managementState indicates whether and how the operator should manage the component",
+	"logLevel":                   "logLevel is an intent based logging for an overall component.  It does not give fine grained control, but it is a simple way to manage coarse grained logging choices that operators have to interpret for their operands.",
+	"operatorLogLevel":           "operatorLogLevel is an intent based logging for the operator itself.  It does not give fine grained control, but it is a simple way to manage coarse grained logging choices that operators have to interpret for themselves.",
+	"unsupportedConfigOverrides": "unsupportedConfigOverrides holds a sparse config that will override any previously set options.  It only needs to be the fields to override it will end up overlaying in the following order: 1. hardcoded defaults 2. observedConfig 3. unsupportedConfigOverrides",
+	"observedConfig":             "observedConfig holds a sparse config that controller has observed from the cluster state.  It exists in spec because it is an input to the level for the operator",
+}
+
+func (OperatorSpec) SwaggerDoc() map[string]string {
+	return map_OperatorSpec
+}
+
+var map_OperatorStatus = map[string]string{
+	"observedGeneration": "observedGeneration is the last generation change you've dealt with",
+	"conditions":         "conditions is a list of conditions and their status",
+	"version":            "version is the level this availability applies to",
+	"readyReplicas":      "readyReplicas indicates how many replicas are ready and at the desired state",
+	"generations":        "generations are used to determine when an item needs to be reconciled or has changed in a way that needs a reaction.",
+}
+
+func (OperatorStatus) SwaggerDoc() map[string]string {
+	return map_OperatorStatus
+}
+
+var map_StaticPodOperatorSpec = map[string]string{
+	"":                        "StaticPodOperatorSpec is spec for controllers that manage static pods.",
+	"forceRedeploymentReason": "forceRedeploymentReason can be used to force the redeployment of the operand by providing a unique string. This provides a mechanism to kick a previously failed deployment and provide a reason why you think it will work this time instead of failing again on the same config.",
+	"failedRevisionLimit":     "failedRevisionLimit is the number of failed static pod installer revisions to keep on disk and in the api -1 = unlimited, 0 or unset = 5 (default)",
+	"succeededRevisionLimit":  "succeededRevisionLimit is the number of successful static pod installer revisions to keep on disk and in the api -1 = unlimited, 0 or unset = 5 (default)",
+}
+
+func (StaticPodOperatorSpec) SwaggerDoc() map[string]string {
+	return map_StaticPodOperatorSpec
+}
+
+var map_StaticPodOperatorStatus = map[string]string{
+	"":                              "StaticPodOperatorStatus is status for controllers that manage static pods.  There are different needs because individual node status must be tracked.",
+	"latestAvailableRevision":       "latestAvailableRevision is the deploymentID of the most recent deployment",
+	"latestAvailableRevisionReason": "latestAvailableRevisionReason describe the detailed reason for the most recent deployment",
+	"nodeStatuses":                  "nodeStatuses track the deployment values and errors across individual nodes",
+}
+
+func (StaticPodOperatorStatus) SwaggerDoc() map[string]string {
+	return map_StaticPodOperatorStatus
+}
+
+var map_Authentication = map[string]string{
+	"": "Authentication provides information to configure an operator to manage authentication.",
+}
+
+func (Authentication) SwaggerDoc() map[string]string {
+	return map_Authentication
+}
+
+var map_AuthenticationList = map[string]string{
+	"": "AuthenticationList is a collection of items",
+}
+
+func (AuthenticationList) SwaggerDoc() map[string]string {
+	return map_AuthenticationList
+}
+
+var map_AuthenticationStatus = map[string]string{
+	"managingOAuthAPIServer": "ManagingOAuthAPIServer indicates whether this operator is managing OAuth related APIs. Setting this field to true will cause OAS-O to step down. Note that this field will be removed in the future releases, once https://github.com/openshift/enhancements/blob/master/enhancements/authentication/separate-oauth-resources.md is fully implemented",
+	"oauthAPIServer":         "OAuthAPIServer holds status specific only to oauth-apiserver",
+}
+
+func (AuthenticationStatus) SwaggerDoc() map[string]string {
+	return map_AuthenticationStatus
+}
+
+var map_OAuthAPIServerStatus = map[string]string{
+	"latestAvailableRevision": "LatestAvailableRevision is the latest revision used as suffix of revisioned secrets like encryption-config. A new revision causes a new deployment of pods.",
+}
+
+func (OAuthAPIServerStatus) SwaggerDoc() map[string]string {
+	return map_OAuthAPIServerStatus
+}
+
+var map_Console = map[string]string{
+	"": "Console provides a means to configure an operator to manage the console.",
+}
+
+func (Console) SwaggerDoc() map[string]string {
+	return map_Console
+}
+
+var map_ConsoleCustomization = map[string]string{
+	"":                     "ConsoleCustomization defines a list of optional configuration for the console UI.",
+	"brand":                "brand is the default branding of the web console which can be overridden by providing the brand field.  There is a limited set of specific brand options. This field controls elements of the console such as the logo. Invalid value will prevent a console rollout.",
+	"documentationBaseURL": "documentationBaseURL links to external documentation are shown in various sections of the web console.  Providing documentationBaseURL will override the default documentation URL. Invalid value will prevent a console rollout.",
+	"customProductName":    "customProductName is the name that will be displayed in page titles, logo alt text, and the about dialog instead of the normal OpenShift product name.",
+	"customLogoFile":       "customLogoFile replaces the default OpenShift logo in the masthead and about dialog. It is a reference to a ConfigMap in the openshift-config namespace. This can be created with a command like 'oc create configmap custom-logo --from-file=/path/to/file -n openshift-config'. Image size must be less than 1 MB due to constraints on the ConfigMap size. The ConfigMap key should include a file extension so that the console serves the file with the correct MIME type. Recommended logo specifications: Dimensions: Max height of 68px and max width of 200px SVG format preferred",
+}
+
+func (ConsoleCustomization) SwaggerDoc() map[string]string {
+	return map_ConsoleCustomization
+}
+
+var map_ConsoleProviders = map[string]string{
+	"":           "ConsoleProviders defines a list of optional additional providers of functionality to the console.",
+	"statuspage": "statuspage contains ID for statuspage.io page that provides status info about.",
+}
+
+func (ConsoleProviders) SwaggerDoc() map[string]string {
+	return map_ConsoleProviders
+}
+
+var map_ConsoleSpec = map[string]string{
+	"":              "ConsoleSpec is the specification of the desired behavior of the Console.",
+	"customization": "customization is used to optionally provide a small set of customization options to the web console.",
+	"providers":     "providers contains configuration for using specific service providers.",
+}
+
+func (ConsoleSpec) SwaggerDoc() map[string]string {
+	return map_ConsoleSpec
+}
+
+var map_ConsoleStatus = map[string]string{
+	"": "ConsoleStatus defines the observed status of the Console.",
+}
+
+func (ConsoleStatus) SwaggerDoc() map[string]string {
+	return map_ConsoleStatus
+}
+
+var map_StatuspageProvider = map[string]string{
+	"":       "StatuspageProvider provides identity for statuspage account.",
+	"pageID": "pageID is the unique ID assigned by Statuspage for your page. This must be a public page.",
+}
+
+func (StatuspageProvider) SwaggerDoc() map[string]string {
+	return map_StatuspageProvider
+}
+
+var map_CSISnapshotController = map[string]string{
+	"":       "CSISnapshotController provides a means to configure an operator to manage the CSI snapshots. `cluster` is the canonical name.",
+	"spec":   "spec holds user settable values for configuration",
+	"status": "status holds observed values from the cluster. They may not be overridden.",
+}
+
+func (CSISnapshotController) SwaggerDoc() map[string]string {
+	return map_CSISnapshotController
+}
+
+var map_CSISnapshotControllerList = map[string]string{
+	"": "CSISnapshotControllerList contains a list of CSISnapshotControllers.",
+}
+
+func (CSISnapshotControllerList) SwaggerDoc() map[string]string {
+	return map_CSISnapshotControllerList
+}
+
+var map_CSISnapshotControllerSpec = map[string]string{
+	"": "CSISnapshotControllerSpec is the specification of the desired behavior of the CSISnapshotController operator.",
+}
+
+func (CSISnapshotControllerSpec) SwaggerDoc() map[string]string {
+	return map_CSISnapshotControllerSpec
+}
+
+var map_CSISnapshotControllerStatus = map[string]string{
+	"": "CSISnapshotControllerStatus defines the observed status of the CSISnapshotController operator.",
+}
+
+func (CSISnapshotControllerStatus) SwaggerDoc() map[string]string {
+	return map_CSISnapshotControllerStatus
+}
+
+var map_CacheStatSummary = map[string]string{
+	"":       "CacheStatSummary summarizes a cache statistic across DNS pods.",
+	"min":    "min is the smallest value of the statistic across DNS pods.",
+	"median": "median is the median value of the statistic across DNS pods.",
+	"max":    "max is the largest value of the statistic across DNS pods.",
+}
+
+func (CacheStatSummary) SwaggerDoc() map[string]string {
+	return map_CacheStatSummary
+}
+
+var map_DNS = map[string]string{
+	"":       "DNS manages the CoreDNS component to provide a name resolution service for pods and services in the cluster.\n\nThis supports the DNS-based service discovery specification: https://github.com/kubernetes/dns/blob/master/docs/specification.md\n\nMore details: https://kubernetes.io/docs/tasks/administer-cluster/coredns",
+	"spec":   "spec is the specification of the desired behavior of the DNS.",
+	"status": "status is the most recently observed status of the DNS.",
+}
+
+func (DNS) SwaggerDoc() map[string]string {
+	return map_DNS
+}
+
+var map_DNSAPIServerReadiness = map[string]string{
+	"":            "DNSAPIServerReadiness defines how the readiness of CoreDNS pods depends on the reachability of the Kubernetes API server.",
+	"policy":      "policy specifies whether CoreDNS pods report that they are not ready when they cannot reach the API server. Any one of the following values may be specified: * Enabled makes a pod report that it is not ready once it has been unable to reach the API server for the grace period. * Disabled does not check whether pods can reach the API server.\n\nIf unset, the default of \"Disabled\" is used.",
+	"gracePeriod": "gracePeriod is how long a CoreDNS pod may be unable to reach the API server before it reports that it is not ready. The API server is checked every 10s, so the grace period is rounded up to a multiple of 10s.\n\nIf unset, the default grace period of 30s is used.",
+}
+
+func (DNSAPIServerReadiness) SwaggerDoc() map[string]string {
+	return map_DNSAPIServerReadiness
+}
+
+var map_DNSAdditionalNetwork = map[string]string{
+	"":          "DNSAdditionalNetwork references a NetworkAttachmentDefinition that the DNS pods are attached to.",
+	"name":      "name is the name of the NetworkAttachmentDefinition.",
+	"namespace": "namespace is the namespace of the NetworkAttachmentDefinition.\n\nIf unset, the \"openshift-dns\" namespace is used.",
+}
+
+func (DNSAdditionalNetwork) SwaggerDoc() map[string]string {
+	return map_DNSAdditionalNetwork
+}
+
+var map_DNSCache = map[string]string{
+	"":            "DNSCache defines for how long CoreDNS caches responses.",
+	"positiveTTL": "positiveTTL is the maximum time for which CoreDNS caches a response with answers. The time is rounded up to whole seconds. A response is never cached for longer than its TTL.\n\nIf unset, the default of 30 seconds is used.",
+	"negativeTTL": "negativeTTL is the maximum time for which CoreDNS caches a response that a name or record does not exist (NXDOMAIN or NODATA). The time is rounded up to whole seconds.\n\nIf unset, the default of 30 seconds is used.",
+}
+
+func (DNSCache) SwaggerDoc() map[string]string {
+	return map_DNSCache
+}
+
+var map_DNSCacheStats = map[string]string{
+	"":                "DNSCacheStats summarizes cache statistics sampled from the DNS pods.",
+	"sampleTime":      "sampleTime is the time at which the statistics were sampled.",
+	"podCount":        "podCount is the number of DNS pods from which statistics were successfully sampled.",
+	"entries":         "entries summarizes the number of entries in the cache of each DNS pod.",
+	"hitRatioPercent": "hitRatioPercent summarizes the cache hit ratio of each DNS pod, as a percentage of cache lookups since the pod started.",
+}
+
+func (DNSCacheStats) SwaggerDoc() map[string]string {
+	return map_DNSCacheStats
+}
+
+var map_DNSClusterPeer = map[string]string{
+	"":              "DNSClusterPeer defines another cluster to which queries for names in its cluster domain are forwarded.",
+	"name":          "name is required and specifies a unique name for the peer. Name must comply with the Service Name Syntax of rfc6335.",
+	"clusterDomain": "clusterDomain is required and specifies the cluster domain of the peer, for example \"cluster2.local\". It must differ from the cluster domain of this cluster and conform to the rfc1123 definition of a subdomain.",
+	"nameservers":   "nameservers is required and specifies the addresses of the DNS Service of the peer. Each nameserver is represented by an IP address or IP:port if the nameserver listens on a port other than 53. Queries are distributed randomly across the nameservers.\n\nA maximum of 15 nameservers is allowed per peer.",
+}
+
+func (DNSClusterPeer) SwaggerDoc() map[string]string {
+	return map_DNSClusterPeer
+}
+
+var map_DNSCorefileStatus = map[string]string{
+	"":                 "DNSCorefileStatus reports which DNS pods have loaded the current CoreDNS configuration.",
+	"hash":             "hash is the SHA-512 hash of the current CoreDNS configuration, as a hexadecimal string.",
+	"sampleTime":       "sampleTime is the time at which the DNS pods were sampled.",
+	"sampledPods":      "sampledPods is the number of DNS pods that were successfully sampled.",
+	"updatedPods":      "updatedPods is the number of sampled DNS pods that have loaded the configuration with the current hash.",
+	"unverifiedReason": "unverifiedReason says why the DNS pods cannot be checked for the configuration that they have loaded, if they cannot. The DNS pods are not sampled then, and sampledPods and updatedPods are zero.",
+}
+
+func (DNSCorefileStatus) SwaggerDoc() map[string]string {
+	return map_DNSCorefileStatus
+}
+
+var map_DNSDegradedSuppression = map[string]string{
+	"":                       "DNSDegradedSuppression defines how long the DNS waits before reporting or clearing degradation caused by unavailable CoreDNS pods.",
+	"unavailableGracePeriod": "unavailableGracePeriod is how long more CoreDNS pods than the DaemonSet's maxUnavailable may be unavailable before the DNS reports Degraded=True. The DNS still reports that it is degraded right away if no pods are available.\n\nIf unset, the DNS reports that it is degraded right away.",
+	"recoveryPeriod":         "recoveryPeriod is how long enough CoreDNS pods must be available before the DNS stops reporting Degraded=True because too many pods were unavailable.\n\nIf unset, the DNS stops reporting that it is degraded right away.",
+}
+
+func (DNSDegradedSuppression) SwaggerDoc() map[string]string {
+	return map_DNSDegradedSuppression
+}
+
+var map_DNSExtraConfigReference = map[string]string{
+	"":               "DNSExtraConfigReference references a ConfigMap with snippets of CoreDNS configuration that are imported into the Corefile.",
+	"name":           "name is the name of the ConfigMap in the \"openshift-dns\" namespace.",
+	"extensionPoint": "extensionPoint is the place in the Corefile into which the snippets of the ConfigMap are imported. Any one of the following values may be specified: * DefaultServer imports the snippets into the server block of the root zone, so they hold plugin directives, such as \"hosts\" or \"rewrite\", that apply to queries for the cluster domain and for names that are forwarded to the upstream resolvers. * ServerBlocks imports the snippets at the top level of the Corefile, so they hold complete server blocks for additional zones.\n\nIf unset, the default of \"DefaultServer\" is used.",
+}
+
+func (DNSExtraConfigReference) SwaggerDoc() map[string]string {
+	return map_DNSExtraConfigReference
+}
+
+var map_DNSForwarderStats = map[string]string{
+	"":           "DNSForwarderStats summarizes the health of the upstream resolvers of a DNS.",
+	"sampleTime": "sampleTime is the time at which the statistics were sampled.",
+	"podCount":   "podCount is the number of DNS pods that were sampled.",
+	"upstreams":  "upstreams lists the health of each upstream resolver that the DNS pods forwarded queries to, ordered by address.",
+}
+
+func (DNSForwarderStats) SwaggerDoc() map[string]string {
+	return map_DNSForwarderStats
+}
+
+var map_DNSHistoryEntry = map[string]string{
+	"":        "DNSHistoryEntry records a significant action that the operator took for a DNS.",
+	"time":    "time is the time at which the action was taken.",
+	"type":    "type is a machine-readable name for the kind of action, for example \"CorefileUpdated\", \"DaemonSetUpdated\", or \"CorefileRejected\".",
+	"message": "message is a human-readable description of the action.",
+}
+
+func (DNSHistoryEntry) SwaggerDoc() map[string]string {
+	return map_DNSHistoryEntry
+}
+
+var map_DNSInternalNames = map[string]string{
+	"":            "DNSInternalNames defines names that CoreDNS does not forward upstream.",
+	"local":       "local specifies how CoreDNS handles queries for names in the \"local\" domain, which RFC 6762 reserves for multicast DNS. Any one of the following values may be specified: * Forward forwards the queries to the upstream resolvers. * NXDomain answers the queries with NXDOMAIN.\n\nIf unset, the default of \"Forward\" is used.",
+	"singleLabel": "singleLabel specifies how CoreDNS handles queries for single-label names, such as \"intranet\". Any one of the following values may be specified: * Forward forwards the queries to the upstream resolvers. * NXDomain answers the queries with NXDOMAIN.\n\nIf unset, the default of \"Forward\" is used.",
+	"zones":       "zones is a list of additional zones whose names CoreDNS answers with NXDOMAIN. Each zone must conform to the rfc1123 definition of a subdomain. A zone that is in the cluster domain or an additional cluster domain, that contains more than one of those domains, or that is a zone of a server or cluster peer, is ignored.\n\nA maximum of 32 zones is allowed.",
+}
+
+func (DNSInternalNames) SwaggerDoc() map[string]string {
+	return map_DNSInternalNames
+}
+
+var map_DNSKubernetesFallthrough = map[string]string{
+	"":       "DNSKubernetesFallthrough defines the reverse zones in which queries that CoreDNS does not answer from the cluster fall through to the upstream resolvers.",
+	"policy": "policy specifies in which reverse zones queries fall through. Any one of the following values may be specified: * ReverseZones lets queries fall through in the \"in-addr.arpa.\" and \"ip6.arpa.\" zones. * Zones lets queries fall through only in the zones in zones, for example to forward reverse lookups for a corporate network while answering all others with NXDOMAIN. * None answers all reverse queries for addresses outside of the cluster with NXDOMAIN.\n\nIf unset, the default of \"ReverseZones\" is used.",
+	"zones":  "zones is the list of reverse zones in which queries fall through when the policy is Zones. Each zone must be \"in-addr.arpa\", \"ip6.arpa\", or a subdomain of one of them, for example \"10.in-addr.arpa\"; any other zone is ignored.\n\nA maximum of 15 zones is allowed.",
+}
+
+func (DNSKubernetesFallthrough) SwaggerDoc() map[string]string {
+	return map_DNSKubernetesFallthrough
+}
+
+var map_DNSList = map[string]string{
+	"": "DNSList contains a list of DNS",
+}
+
+func (DNSList) SwaggerDoc() map[string]string {
+	return map_DNSList
+}
+
+var map_DNSNodeExclusion = map[string]string{
+	"":             "DNSNodeExclusion selects nodes on which CoreDNS pods do not run.",
+	"nodeSelector": "nodeSelector is the label of the excluded nodes. It must have exactly one label.",
+}
+
+func (DNSNodeExclusion) SwaggerDoc() map[string]string {
+	return map_DNSNodeExclusion
+}
+
+var map_DNSNodeOverride = map[string]string{
+	"":             "DNSNodeOverride defines the servers that CoreDNS uses on the nodes that have a given label.",
+	"name":         "name is the name of the override. It must conform to the rfc1123 definition of a label, and it is used in the name of the DaemonSet that runs CoreDNS on the selected nodes.",
+	"nodeSelector": "nodeSelector is the label that selects the nodes of the override. It must have exactly one label.",
+	"servers":      "servers replaces the servers of the DNS on the selected nodes. It has the same format and restrictions as the servers of the DNS.\n\nIf this field is nil, no servers are used on the selected nodes.",
+}
+
+func (DNSNodeOverride) SwaggerDoc() map[string]string {
+	return map_DNSNodeOverride
+}
+
+var map_DNSNodePlacement = map[string]string{
+	"":             "DNSNodePlacement describes the node scheduling configuration of CoreDNS pods.",
+	"nodeSelector": "nodeSelector is the node selector of the CoreDNS pods. Its labels are added to the default node selector, which is currently \"kubernetes.io/os: linux\", and a label with the key of a default label replaces it. A label whose key or value is invalid is ignored.\n\nIf unset, the default node selector is used.",
+	"tolerations":  "tolerations is a list of tolerations of the CoreDNS pods. If set, the tolerations replace the default toleration of all taints, so CoreDNS does not run on nodes with taints that the list does not tolerate. A toleration whose key is invalid, or whose operator is Exists and that has a value, is ignored.\n\nIf unset, CoreDNS tolerates all taints.",
+}
+
+func (DNSNodePlacement) SwaggerDoc() map[string]string {
+	return map_DNSNodePlacement
+}
+
+var map_DNSOverTLSConfig = map[string]string{
+	"":           "DNSOverTLSConfig describes optional DNSTransportConfig fields that should be captured.",
+	"serverName": "serverName is the upstream server to connect to when forwarding DNS queries. This is required when Transport is set to \"TLS\". CoreDNS verifies that the certificates of the upstream resolvers are valid for this name. ServerName must conform to the definition of a subdomain in rfc1123.",
+	"caBundle":   "caBundle references a ConfigMap that must contain a CA bundle in the \"ca-bundle.crt\" key. The ConfigMap must be in the openshift-dns namespace. CoreDNS uses the CA bundle, in place of the system trust store, to validate the certificates of the upstream resolvers.\n\nIf unset, the system trust store, which includes the cluster-wide trusted CA bundle, is used.",
+}
+
+func (DNSOverTLSConfig) SwaggerDoc() map[string]string {
+	return map_DNSOverTLSConfig
+}
+
+var map_DNSPerformance = map[string]string{
+	"":              "DNSPerformance defines performance tuning settings for CoreDNS.",
+	"listenSockets": "listenSockets describes how many sockets CoreDNS listens on for each server. Any one of the following values may be specified: * Single listens on one socket for each server. * PerCPU listens on one socket for each CPU that CoreDNS may use. Each socket is opened with SO_REUSEPORT so that the kernel distributes queries across the sockets and CoreDNS can handle queries on several CPUs in parallel. PerCPU requires CoreDNS 1.12.0 or later; with an earlier version, it is ignored.\n\nIf unset, the default of \"Single\" is used.",
+	"gomaxprocs":    "gomaxprocs is the maximum number of CPUs that CoreDNS may use simultaneously. When listenSockets is PerCPU, this is also the number of sockets that CoreDNS listens on for each server.\n\nIf unset and listenSockets is PerCPU, the number of CPUs that are allocatable on each node is used. If unset otherwise, CoreDNS uses all of the node's CPUs.",
+	"queryTimeout":  "queryTimeout is the maximum time that CoreDNS spends on a query before canceling it, which bounds queries to upstream resolvers that hang. A value of \"0s\" uses a default that lets the forward plugin give up on a query and try another upstream resolver first. A timeout shorter than the time that the forward plugin needs to detect that an upstream resolver is down is raised to that time.\n\nIf unset, queries are not canceled.",
+}
+
+func (DNSPerformance) SwaggerDoc() map[string]string {
+	return map_DNSPerformance
+}
+
+var map_DNSPodVersion = map[string]string{
+	"":           "DNSPodVersion is a version that some DNS pods run.",
+	"daemonSet":  "daemonSet is the name of the DaemonSet of the pods.",
+	"image":      "image is the CoreDNS image of the pods.",
+	"generation": "generation is the generation of the DaemonSet that the pods were created from.",
+	"pods":       "pods is the number of pods that run the version.",
+	"outdated":   "outdated is true if other pods of the DaemonSet run a newer generation.",
+	"nodes":      "nodes lists up to 5 nodes of the pods if the version is outdated.",
+}
+
+func (DNSPodVersion) SwaggerDoc() map[string]string {
+	return map_DNSPodVersion
+}
+
+var map_DNSQueryLogRule = map[string]string{
+	"":        "DNSQueryLogRule selects the classes of responses that CoreDNS logs for the names in some zones.",
+	"zones":   "zones is a list of zones to which the rule applies, such as \"example.com\". The rule applies to the names in the zones and their subdomains.",
+	"classes": "classes is a list of the classes of responses that CoreDNS logs for the names in the zones. Any of the following values may be specified: * Success logs responses with answers. * Denial logs NXDOMAIN and NODATA responses. * Error logs SERVFAIL, NOTIMP, and REFUSED responses, and other errors. * All logs all responses.",
+}
+
+func (DNSQueryLogRule) SwaggerDoc() map[string]string {
+	return map_DNSQueryLogRule
+}
+
+var map_DNSRateLimit = map[string]string{
+	"":                  "DNSRateLimit defines the rate limit of queries for the zones of a server.",
+	"requestsPerSecond": "requestsPerSecond is the number of queries per second that CoreDNS answers from each client network.",
+	"ipv4PrefixLength":  "ipv4PrefixLength is the length of the prefix of the IPv4 address of a client that identifies its client network. Clients whose addresses share the prefix share the limit.\n\nIf unset, the default of 32 is used, which limits each client separately.",
+	"ipv6PrefixLength":  "ipv6PrefixLength is the length of the prefix of the IPv6 address of a client that identifies its client network. Clients whose addresses share the prefix share the limit.\n\nIf unset, the default of 128 is used, which limits each client separately.",
+	"mode":              "mode describes what CoreDNS does with queries that exceed the limit. Any one of the following values may be specified: * Enforce drops the queries. * ReportOnly answers the queries but counts them in the metric, so that a limit can be tried out before it is enforced.\n\nIf unset, the default of \"Enforce\" is used.",
+}
+
+func (DNSRateLimit) SwaggerDoc() map[string]string {
+	return map_DNSRateLimit
+}
+
+var map_DNSServiceAlias = map[string]string{
+	"":        "DNSServiceAlias defines a DNS name that resolves to a Service.",
+	"name":    "name is the fully qualified DNS name of the alias, for example \"app.apps.example.com\". The name must not be in the cluster domain.",
+	"service": "service is the Service to which the alias resolves.",
+}
+
+func (DNSServiceAlias) SwaggerDoc() map[string]string {
+	return map_DNSServiceAlias
+}
+
+var map_DNSServiceReference = map[string]string{
+	"":          "DNSServiceReference identifies a Service.",
+	"namespace": "namespace is the namespace of the Service.",
+	"name":      "name is the name of the Service.",
+}
+
+func (DNSServiceReference) SwaggerDoc() map[string]string {
+	return map_DNSServiceReference
+}
+
+var map_DNSServiceSessionAffinity = map[string]string{
+	"":               "DNSServiceSessionAffinity defines the session affinity of the DNS Service.",
+	"policy":         "policy specifies the session affinity of the DNS Service. Any one of the following values may be specified: * None spreads queries over the DNS pods. * ClientIP sends the queries from each client IP address to the same DNS pod until the client has not sent a query for the timeout.\n\nIf unset, the default of \"None\" is used.",
+	"timeoutSeconds": "timeoutSeconds is how long a client is kept on the same DNS pod after its last query when the policy is ClientIP. It must be between 1 and 86400.\n\nIf unset, the default of 10800 (3 hours) is used.",
+}
+
+func (DNSServiceSessionAffinity) SwaggerDoc() map[string]string {
+	return map_DNSServiceSessionAffinity
+}
+
+var map_DNSSpec = map[string]string{
+	"":                         "DNSSpec is the specification of the desired behavior of the DNS.",
+	"servers":                  "servers is a list of DNS resolvers that provide name query delegation for one or more subdomains outside the scope of the cluster domain. If servers consists of more than one Server, longest suffix match will be used to determine the Server.\n\nFor example, if there are two Servers, one for \"foo.com\" and another for \"a.foo.com\", and the name query is for \"www.a.foo.com\", it will be routed to the Server with Zone \"a.foo.com\".\n\nIf this field is nil, no servers are created.",
+	"upstreamResolvers":        "upstreamResolvers defines a schema for configuring CoreDNS to proxy DNS messages to upstream resolvers for the case of the default (\".\") server.\n\nIf this field is not specified, the upstream used will default to /etc/resolv.conf, with policy \"sequential\".",
+	"nodeResolver":             "nodeResolver specifies settings for the node-resolver, which maintains entries in each node's /etc/hosts file for a set of names so that they can be resolved by components that do not use cluster DNS (for example, the container runtime when pulling images).",
+	"probePorts":               "probePorts specifies the ports on which CoreDNS serves its health and readiness endpoints. These ports are used by the liveness and readiness probes of the DNS pods and may need to be changed to avoid conflicts with other processes, such as sidecar containers or processes on the host network.",
+	"logLevel":                 "logLevel describes the desired logging verbosity for CoreDNS. Any one of the following values may be specified: * Normal logs errors from upstream resolvers. * Debug logs errors, NXDOMAIN responses, and NODATA responses. * Trace logs errors and all responses. Changes to the log level are applied by reloading the CoreDNS configuration and do not cause DNS pods to be restarted.\n\nIf unset, the default log level of \"Normal\" is used.",
+	"operatorLogLevel":         "operatorLogLevel describes the desired logging verbosity of the DNS operator. Only the operatorLogLevel of the default DNS is used. Any one of the following values may be specified: * Normal logs the changes that the operator makes. * Debug also logs the details of each reconciliation. * Trace logs everything that the operator logs. Changes to the operator log level take effect at once and do not restart the operator.\n\nIf unset, the default log level of \"Normal\" is used.",
+	"queryLogging":             "queryLogging is a list of rules that select which queries CoreDNS logs for names in specific zones, for example to log only the NXDOMAIN responses for a zone while troubleshooting a storm of queries for names that do not exist. For the names in its zones, a rule replaces the classes of responses that logLevel selects. If several rules match a name, the rule with the most specific zone is used.\n\nIf unset, logLevel selects the responses that CoreDNS logs for all names.",
+	"logClientAttribution":     "logClientAttribution describes how CoreDNS identifies the client of each query in the query log, for example to account for the queries of each tenant. Any one of the following values may be specified: * None logs the address of the client. * Pod also logs the namespace and name of the pod that has the address of the client, where it is known. Only queries for names that are not in the zones of servers are attributed. CoreDNS watches all pods to look them up, which increases its memory use, and answers queries for the names of pod IP addresses only if a pod has the address.\n\nIf unset, the default of \"None\" is used.",
+	"performance":              "performance specifies how CoreDNS uses the CPUs of the nodes that it runs on. The defaults are suitable for most clusters; these settings may be tuned for nodes with a high query rate.",
+	"kubeDNSAlias":             "kubeDNSAlias specifies whether the operator manages a Service named \"kube-dns\" with the label \"k8s-app: kube-dns\" in the openshift-dns namespace, for compatibility with upstream tooling that looks up the cluster DNS service by that name or label. The alias Service selects the same DNS pods as the DNS Service but has its own cluster IP. Any one of the following values may be specified: * Enabled creates and maintains the alias Service. * Disabled removes the alias Service if the operator created it.\n\nIf unset, the default of \"Disabled\" is used.",
+	"serviceSessionAffinity":   "serviceSessionAffinity specifies whether the DNS Service, and the kube-dns alias Service if it is enabled, send the queries of each client to the same DNS pod. Keeping a client on one pod improves its cache hits, but unevenly spreads the load of clients with a high query rate.\n\nIf unset, queries are spread over the DNS pods.",
+	"serviceAliases":           "serviceAliases is a list of DNS names that resolve to Services in the cluster. This allows pods to resolve a name outside the cluster domain, such as the host name of an application's Route, directly to the application's Service instead of reaching it through the ingress load balancer. A query for an alias is answered with the records of the Service under the alias name.\n\nIf this field is nil, no aliases are created.",
+	"ingressSplitHorizon":      "ingressSplitHorizon specifies whether pods resolve the host names of Routes and Ingresses that are admitted by the default ingress controller to the ingress controller's internal Service rather than to its external load balancer. This keeps in-cluster traffic to applications inside the cluster and avoids hairpin NAT through the load balancer. Any one of the following values may be specified: * Enabled watches Routes and Ingresses and resolves their host names to the internal Service. * Disabled resolves the host names of Routes and Ingresses using the upstream resolvers.\n\nIf unset, the default of \"Disabled\" is used.",
+	"clusterPeers":             "clusterPeers is a list of other clusters whose services pods in this cluster can resolve. Queries for names in the cluster domain of a peer are forwarded to the DNS Service of the peer, which must be reachable from this cluster, for example through a multi-cluster network.\n\nIf this field is nil, no names are forwarded to peer clusters.",
+	"serviceMeshCoexistence":   "serviceMeshCoexistence specifies whether the DNS is configured to coexist with a service mesh whose sidecar proxies intercept DNS queries, for example Istio with ISTIO_META_DNS_CAPTURE enabled. Any one of the following values may be specified: * Enabled publishes the cluster IP of the DNS Service and the domains that the DNS serves in a ConfigMap named \"dns-<name>-service-mesh\" in the \"openshift-dns\" namespace, and does not rewrite the host names of Routes and Ingresses even if ingressSplitHorizon is enabled, because the mesh proxies resolve those names themselves. * Disabled does not publish the ConfigMap.\n\nIf unset, the default of \"Disabled\" is used.",
+	"internalNames":            "internalNames specifies names that are internal to the cluster's network and that CoreDNS answers with NXDOMAIN rather than forwarding them to the upstream resolvers, so that the names do not leak to external resolvers. Names in the cluster domain and in the zones of servers and cluster peers are still resolved.\n\nIf unset, all names outside of the cluster domain are forwarded.",
+	"additionalClusterDomains": "additionalClusterDomains is an ordered list of domains that CoreDNS serves the cluster's Services and Pods under in addition to the cluster domain, for example the old cluster domain while workloads migrate to a new one. Reverse lookups are answered with names in the cluster domain. Each domain must conform to the rfc1123 definition of a subdomain. A domain that is the cluster domain, that is listed earlier, or that is a zone of a server is ignored.\n\nA maximum of 4 additional cluster domains is allowed.\n\nIf this field is nil, only the cluster domain is served.",
+	"degradedSuppression":      "degradedSuppression specifies how long CoreDNS pods may be unavailable before the DNS reports that it is degraded, and how long they must be available again before it stops reporting so. Longer periods avoid alerts while many nodes are added or replaced at once.\n\nIf unset, the DNS reports that it is degraded as soon as too many pods are unavailable and stops as soon as enough are available again.",
+	"nodeOverrides":            "nodeOverrides is an ordered list of overrides of the servers for the nodes that have a given label, for example to forward queries to the upstream resolvers of the site of a pool of edge nodes. CoreDNS runs on the nodes of each override in a separate DaemonSet whose Corefile has the servers of the override instead of the servers of the DNS. A node that has the labels of more than one override uses the first. An override whose name or node selector is invalid, or that has the name or node selector of an override listed earlier, is ignored.\n\nA maximum of 8 node overrides is allowed.\n\nIf this field is nil, all nodes use the servers of the DNS.",
+	"additionalNetworks":       "additionalNetworks is a list of secondary networks that the DNS pods are attached to, so that workloads on those networks can reach cluster DNS directly. Each network is attached through the multus CNI plugin using a NetworkAttachmentDefinition, and CoreDNS listens on port 53 of the network's interface in addition to its usual listener. A network whose name or namespace is invalid, or that is listed earlier, is ignored.\n\nA maximum of 4 additional networks is allowed.\n\nIf this field is nil, the DNS pods are attached to the cluster network only.",
+	"localhostZones":           "localhostZones specifies whether CoreDNS answers queries for localhost and the loopback reverse zones itself rather than forwarding them to the upstream resolvers. Any one of the following values may be specified: * Enabled answers queries for \"localhost.\" and names under it with the loopback addresses, and answers reverse queries for the loopback addresses and the \"0.in-addr.arpa.\" and \"255.in-addr.arpa.\" zones. * Disabled forwards these queries to the upstream resolvers, for environments that rely on the answers of the upstream resolvers.\n\nIf unset, the default of \"Enabled\" is used. CoreDNS answers these queries itself since CoreDNS 1.8.1; with an earlier version, it forwards them and \"Enabled\" is ignored.",
+	"debugZone":                "debugZone specifies whether CoreDNS serves a zone for debugging which DNS pod answers a query. The zone is \"debug.dns\" under the cluster domain, for example \"debug.dns.cluster.local\". A TXT query for a name in the zone is answered with the name of the DNS pod that served it, and any other query is answered with the source IP address and port of the client. Any one of the following values may be specified: * Enabled serves the debug zone. Names in the zone shadow those of Services in a namespace named \"dns\". * Disabled does not serve the debug zone.\n\nIf unset, the default of \"Disabled\" is used.",
+	"nsid":                     "nsid specifies whether CoreDNS adds a name server identifier (NSID, RFC 5001) to responses for queries that request one, for example with \"dig +nsid\", so that it is possible to tell which node answered a query. The identifier is the name of the node of the DNS pod that answered. Any one of the following values may be specified: * Enabled adds the identifier to responses. * Disabled does not add the identifier to responses.\n\nIf unset, the default of \"Disabled\" is used.",
+	"listenAddresses":          "listenAddresses specifies the addresses that CoreDNS listens on for queries. Any one of the following values may be specified: * Wildcard listens on all addresses of the DNS pod. * PodIP listens only on the IP address of the DNS pod in the IP family of the DNS Service, which is the only address that the Service sends queries to. In dual-stack and IPv6 single-stack clusters, this keeps CoreDNS from answering on addresses of other families. The setting is ignored if the cluster network configuration does not have a pod network in the IP family of the primary service network.\n\nIf unset, the default of \"Wildcard\" is used.",
+	"extraConfigRefs":          "extraConfigRefs is a list of references to ConfigMaps in the \"openshift-dns\" namespace whose data are snippets of CoreDNS configuration, so that platform teams can extend the Corefile. Each ConfigMap is mounted in the DNS pods, and each of its keys is imported into the Corefile at the extension point of the reference. A reference whose name is invalid or that is listed earlier is ignored, as is a ConfigMap that does not exist or whose snippets have unbalanced braces.\n\nA maximum of 8 references is allowed.\n\nIf this field is nil, the Corefile imports no snippets.",
+	"apiServerReadiness":       "apiServerReadiness specifies whether CoreDNS pods report that they are not ready when they cannot reach the Kubernetes API server, so that the DNS Service stops routing queries to pods on isolated nodes, whose answers for cluster names may be stale. The check runs in the node-resolver container and has no effect if the node-resolver is disabled.\n\nIf unset, the readiness of CoreDNS pods does not depend on the API server.",
+	"nodeExclusions":           "nodeExclusions is a list of selectors of nodes on which CoreDNS pods do not run, for example GPU-only or storage nodes whose resources are reserved for their workloads. Pods on excluded nodes still resolve names through the DNS Service. A node that has the label of any exclusion is excluded, also from the DaemonSets of node overrides. An exclusion whose node selector does not have exactly one valid label, or has the label of an exclusion listed earlier, is ignored. If the exclusions leave fewer than 2 ready nodes to run CoreDNS, the DNS reports the InsufficientNodeCoverage condition.\n\nA maximum of 8 node exclusions is allowed.\n\nIf this field is nil, CoreDNS runs on all nodes.",
+	"nodePlacement":            "nodePlacement provides explicit control over the scheduling of the CoreDNS pods, for example to keep them off infrastructure or edge nodes, or to run them only on nodes with some taints. The placement applies to the pods of the DaemonSets of node overrides as well, and node exclusions still apply within it.\n\nIf unset, CoreDNS runs on all linux nodes and tolerates all taints.",
+	"kubernetesFallthrough":    "kubernetesFallthrough specifies the reverse zones in which CoreDNS passes queries that are not for cluster IP addresses on to the upstream resolvers rather than answering them with NXDOMAIN. Reverse lookups of addresses outside of the cluster, which some storage and authentication systems require, are only answered if they fall through.\n\nIf unset, reverse queries for addresses outside of the cluster fall through in all reverse zones.",
+	"cache":                    "cache specifies for how long CoreDNS caches the responses of the default server, for example to cache the names of services that are created and deleted frequently for a shorter time when they do not exist, or to cache external names for longer at edge sites with a slow uplink.\n\nIf unset, positive and negative responses are cached for at most 30 seconds.",
+	"termination":              "termination specifies how DNS pods stop, for example when a node is drained. A terminating pod keeps answering queries for a delay before CoreDNS stops, so that kube-proxy or OVN stop sending queries for the DNS service to the pod before it stops answering them.\n\nIf unset, a terminating pod keeps answering queries for 5 seconds.",
+}
+
+func (DNSSpec) SwaggerDoc() map[string]string {
+	return map_DNSSpec
+}
+
+var map_DNSStatus = map[string]string{
+	"":                     "DNSStatus defines the observed status of the DNS.",
+	"clusterIP":            "clusterIP is the service IP through which this DNS is made available.\n\nIn the case of the default DNS, this will be a well known IP that is used as the default nameserver for pods that are using the default ClusterFirst DNS policy.\n\nIn general, this IP can be specified in a pod's spec.dnsConfig.nameservers list or used explicitly when performing name resolution from within the cluster. Example: dig foo.com @<service IP>\n\nMore info: https://kubernetes.io/docs/concepts/services-networking/service/#virtual-ips-and-service-proxies",
+	"clusterDomain":        "clusterDomain is the local cluster DNS domain suffix for DNS services. This will be a subdomain as defined in RFC 1034, section 3.5: https://tools.ietf.org/html/rfc1034#section-3.5 Example: \"cluster.local\"\n\nMore info: https://kubernetes.io/docs/concepts/services-networking/dns-pod-service",
+	"conditions":           "conditions provide information about the state of the DNS on the cluster.\n\nThese are the supported DNS conditions:\n\n  * Available\n  - True if the following conditions are met:\n    * DNS controller daemonset is available.\n  - False if any of those conditions are unsatisfied.",
+	"cacheStats":           "cacheStats summarizes cache statistics that are periodically sampled from the DNS pods. These statistics can help with capacity planning, for example to decide whether a larger cache or node-local caching would be beneficial.",
+	"corefileStatus":       "corefileStatus reports whether the DNS pods have loaded the current CoreDNS configuration. Configuration changes, such as log level changes, are applied by CoreDNS reloading its configuration rather than by restarting DNS pods, so this status can be used to verify that a change has taken effect.",
+	"disabledCapabilities": "disabledCapabilities lists the cluster capabilities for optional DNS components that are disabled on the cluster. The components that correspond to these capabilities are not deployed.",
+	"lastFailure":          "lastFailure is the time of the most recent failure to reconcile the DNS that the operator reported in an event. Repeated failures with the same error are summarized in a single event with a count, so this time is updated at most periodically while the failures continue.",
+	"history":              "history lists recent significant actions that the operator took for the DNS, such as updating the Corefile, rolling out the DaemonSet, or rejecting invalid configuration, oldest first. Only a bounded number of the most recent actions is kept.",
+	"forwarders":           "forwarders summarizes the health of the upstream resolvers that the DNS pods forward queries to, based on the forward plugin metrics that are periodically sampled from the DNS pods.",
+	"podVersions":          "podVersions lists the versions that the DNS pods run, by DaemonSet, CoreDNS image, and pod template generation, so that a rollout that is stuck on some nodes is visible. More than one version for a DaemonSet means that its pods are skewed.",
+}
+
+func (DNSStatus) SwaggerDoc() map[string]string {
+	return map_DNSStatus
+}
+
+var map_DNSTermination = map[string]string{
+	"":             "DNSTermination defines how DNS pods stop.",
+	"preStopDelay": "preStopDelay is how long a terminating DNS pod keeps answering queries before CoreDNS is asked to stop. A terminating pod is removed from the endpoints of the DNS service at once, but kube-proxy or OVN may take a moment to stop sending queries to it. A value of \"0s\" stops CoreDNS at once.\n\nIf unset, the default of 5s is used.",
+	"gracePeriod":  "gracePeriod is how long a terminating DNS pod may take to stop, including the preStopDelay, before its containers are killed. The time is rounded up to whole seconds. A grace period that does not leave CoreDNS at least 5s to stop after the preStopDelay is raised accordingly.\n\nIf unset, the preStopDelay plus 30s is used.",
+}
+
+func (DNSTermination) SwaggerDoc() map[string]string {
+	return map_DNSTermination
+}
+
+var map_DNSTransportConfig = map[string]string{
+	"":          "DNSTransportConfig groups related configuration parameters used for configuring forwarding to upstream resolvers that support DNS-over-TLS.",
+	"transport": "transport allows cluster administrators to opt-in to using a DNS-over-TLS connection between cluster DNS and an upstream resolver. Any one of the following values may be specified: * TLS forwards queries to the upstream resolvers over TLS, on port 853 unless an upstream specifies another port. * Cleartext forwards queries to the upstream resolvers without encryption.\n\nIf unset, the default of \"Cleartext\" is used.",
+	"tls":       "tls contains the additional configuration options to use when Transport is set to \"TLS\".",
+}
+
+func (DNSTransportConfig) SwaggerDoc() map[string]string {
+	return map_DNSTransportConfig
+}
+
+var map_DNSUpstreamStatus = map[string]string{
+	"":         "DNSUpstreamStatus reports the health of an upstream resolver.",
+	"address":  "address is the address and port of the upstream resolver.",
+	"servers":  "servers lists the names of the servers in spec.servers that forward to the upstream resolver. It is empty for the resolvers that the default server block forwards to.",
+	"health":   "health is OK, Flaky, or Down.",
+	"requests": "requests is the number of queries that the DNS pods forwarded to the upstream resolver since the previous sample.",
+	"errors":   "errors is the number of queries forwarded to the upstream resolver that were not answered plus the number of failed health checks of the upstream resolver since the previous sample.",
+}
+
+func (DNSUpstreamStatus) SwaggerDoc() map[string]string {
+	return map_DNSUpstreamStatus
+}
+
+var map_ForwardPlugin = map[string]string{
+	"":                   "ForwardPlugin defines a schema for configuring the CoreDNS forward plugin.",
+	"upstreams":          "upstreams is a list of resolvers to forward name queries for subdomains of Zones. Upstreams are randomized when more than 1 upstream is specified. Each instance of CoreDNS performs health checking of Upstreams. When a healthy upstream returns an error during the exchange, another resolver is tried from Upstreams. Each upstream is represented by an IP address or IP:port if the upstream listens on a port other than 53.\n\nA maximum of 15 upstreams is allowed per ForwardPlugin.",
+	"expire":             "expire is the time after which CoreDNS closes a cached connection to an upstream resolver. Longer times let CoreDNS reuse connections for more queries, which reduces the number of source ports that it uses toward the upstream resolvers on clusters with many queries. A value of \"0s\" uses the default.\n\nIf unset, the default of 10s is used.",
+	"protocolPreference": "protocolPreference describes which protocol CoreDNS uses to forward queries to the upstream resolvers. Any one of the following values may be specified: * MatchClient forwards each query over the protocol over which the client sent it. * PreferUDP forwards each query over UDP, even if the client sent it over TCP, and retries over TCP if the response is truncated.\n\nIf unset, the default of \"MatchClient\" is used.",
+	"protocolStrategy":   "protocolStrategy specifies the protocol to use for upstream DNS requests. Valid values are \"TCP\" and omitted. When omitted, CoreDNS forwards each query over the protocol that protocolPreference describes. \"TCP\" forwards all queries to the upstream resolvers over TCP, even if the client sent them over UDP, and takes precedence over protocolPreference. This is useful with firewalls that block or fragment UDP and with large DNSSEC responses, but may increase the response time. protocolStrategy only affects the queries that CoreDNS sends to upstream resolvers, not the queries between clients and CoreDNS.",
+	"except":             "except is a list of subdomains of the zones of the server whose names are not forwarded to upstreams, for example to forward a broad zone to corporate resolvers while a subdomain that the cluster serves is resolved elsewhere. Names in an excepted subdomain are resolved by the server whose zone is the subdomain, if there is one, and otherwise in the same way as names that are outside of the zones of all servers. Each subdomain must conform to the rfc1123 definition of a subdomain and be a strict subdomain of a zone of the server; any other subdomain is ignored.\n\nA maximum of 15 subdomains is allowed per ForwardPlugin.\n\nIf this field is nil, all names in the zones of the server are forwarded to upstreams.",
+	"transportConfig":    "transportConfig is used to configure the transport type, server name, and optional CA bundle to use when forwarding DNS requests to the upstream resolvers.\n\nThe default value is \"\" (empty), which results in a standard cleartext connection being used when forwarding DNS requests to the upstream resolvers.",
+	"policy":             "policy is used to determine the order in which upstream servers are selected for querying. Any one of the following values may be specified: * \"Random\" picks a random upstream server for each query. * \"RoundRobin\" picks upstream servers in a round-robin order, moving to the next server for each new query. * \"Sequential\" tries querying upstreams in a sequential order until one responds, starting with the first upstream for each new query.\n\nIf unset, the default of \"Random\" is used.",
+}
+
+func (ForwardPlugin) SwaggerDoc() map[string]string {
+	return map_ForwardPlugin
+}
+
+var map_NodeResolverConfig = map[string]string{
+	"":                 "NodeResolverConfig defines the schema for configuring the node-resolver.",
+	"pollInterval":     "pollInterval is the interval at which the node-resolver resolves the names that it manages and refreshes /etc/hosts. The minimum interval is 5s; shorter intervals are rounded up to 5s.\n\nIf unset, the default interval of 60s is used.",
+	"additionalNames":  "additionalNames is a list of names that the node-resolver maintains in /etc/hosts in addition to the default names (such as the cluster image registry service). Relative names (for example, \"foo.bar.svc\") are resolved in the cluster domain and are added in both relative and fully qualified form. Absolute names, which end in \".\", are resolved as-is and are added without the trailing dot; this allows, for example, mirror registry hostnames to be added to /etc/hosts on disconnected clusters.\n\nA maximum of 32 additional names is allowed.",
+	"hostAliases":      "hostAliases is a list of static hostname to IP address mappings that the node-resolver writes into /etc/hosts on every node, in addition to the names that it resolves. Each hostname may appear in only one host alias and must not be a name that the node-resolver resolves.\n\nA maximum of 32 host aliases is allowed.",
+	"resolvConfReload": "resolvConfReload specifies whether CoreDNS picks up changes to the node's /etc/resolv.conf, for example when a DHCP lease is renewed or a VPN connection comes up or goes down, without the DNS pod being restarted. Any one of the following values may be specified: * Enabled makes the node-resolver copy the node's /etc/resolv.conf for CoreDNS whenever it changes, make CoreDNS reload its upstream resolvers, and record an event on the DNS pod. * Disabled makes CoreDNS use the upstream resolvers of the node's /etc/resolv.conf as it was when the DNS pod started.\n\nThe node's /etc/resolv.conf is checked at the poll interval. The setting has no effect if the node-resolver is disabled.\n\nIf unset, the default of \"Disabled\" is used.",
+}
+
+func (NodeResolverConfig) SwaggerDoc() map[string]string {
+	return map_NodeResolverConfig
+}
+
+var map_NodeResolverHostAlias = map[string]string{
+	"":          "NodeResolverHostAlias maps hostnames to an IP address in /etc/hosts.",
+	"ip":        "ip is required and specifies the IPv4 or IPv6 address of the hostnames.",
+	"hostnames": "hostnames is required and specifies the hostnames for the IP address. Each hostname must conform to the rfc1123 definition of a subdomain.\n\nA maximum of 8 hostnames is allowed per host alias.",
+}
+
+func (NodeResolverHostAlias) SwaggerDoc() map[string]string {
+	return map_NodeResolverHostAlias
+}
+
+var map_ProbePorts = map[string]string{
+	"":       "ProbePorts defines the ports on which CoreDNS serves its health and readiness endpoints.",
+	"health": "health is the port on which CoreDNS serves its liveness endpoint (/health). The port must not be 5353, 9153, or 9154, which are reserved for DNS and metrics, and must differ from the ready port.\n\nIf unset, the default port of 8080 is used.",
+	"ready":  "ready is the port on which CoreDNS serves its readiness endpoint (/ready). The port must not be 5353, 9153, or 9154, which are reserved for DNS and metrics, and must differ from the health port.\n\nIf unset, the default port of 8181 is used.",
+}
+
+func (ProbePorts) SwaggerDoc() map[string]string {
+	return map_ProbePorts
+}
+
+var map_Server = map[string]string{
+	"":                 "Server defines the schema for a server that runs per instance of CoreDNS.",
+	"name":             "name is required and specifies a unique name for the server. Name must comply with the Service Name Syntax of rfc6335.",
+	"zones":            "zones is required and specifies the subdomains that Server is authoritative for. Zones must conform to the rfc1123 definition of a subdomain. Specifying the cluster domain (i.e., \"cluster.local\") is invalid.",
+	"forwardPlugin":    "forwardPlugin defines a schema for configuring CoreDNS to proxy DNS messages to upstream resolvers.",
+	"minimalResponses": "minimalResponses specifies whether CoreDNS removes the authority and additional sections from successful answers for the zones of the server, which shrinks the responses on constrained networks. Negative answers and referrals are not changed. Any one of the following values may be specified: * Enabled removes the sections. * Disabled returns the responses of the upstream resolvers unchanged.\n\nIf unset, the default of \"Disabled\" is used. Enabled requires CoreDNS 1.8.1 or later; with an earlier version, it is ignored.",
+	"rateLimit":        "rateLimit limits the rate of queries for the zones of the server that CoreDNS answers from each client, so that a misbehaving workload cannot overwhelm the upstream resolvers of the server. Queries that exceed the limit are dropped and counted in the coredns_rrl_requests_exceeded_total metric. Rate limiting requires a CoreDNS build with the external rrl plugin, which the default CoreDNS image lacks; without it, this field is ignored.\n\nIf this field is nil, queries are not rate limited.",
+}
+
+func (Server) SwaggerDoc() map[string]string {
+	return map_Server
+}
+
+var map_Upstream = map[string]string{
+	"":        "Upstream can either be of type SystemResolvConf, or of type Network.\n\n* For an Upstream of type SystemResolvConf, no further fields are necessary: The upstream will be configured to use /etc/resolv.conf. * For an Upstream of type Network, an address needs to be defined, and a port if the upstream listens on a port other than 53.",
+	"type":    "type defines whether this upstream is a resolver at an address or the nameservers of /etc/resolv.conf. Any one of the following values may be specified: * SystemResolvConf uses the nameservers of /etc/resolv.conf; no further fields are required. * Network uses the resolver at address and port.",
+	"address": "address must be defined when Type is set to Network. It will be ignored otherwise. It must be a valid IPv4 or IPv6 address.",
+	"port":    "port may be defined when Type is set to Network. It will be ignored otherwise. Port must be between 1 and 65535.\n\nIf unset, the default of 53 is used.",
+}
+
+func (Upstream) SwaggerDoc() map[string]string {
+	return map_Upstream
+}
+
+var map_UpstreamResolvers = map[string]string{
+	"":                 "UpstreamResolvers defines a schema for configuring the CoreDNS forward plugin in the specific case of the default (\".\") server. It differs from ForwardPlugin in the default values it accepts: * If no upstreams are specified, /etc/resolv.conf is used. * The default policy is Sequential.",
+	"upstreams":        "upstreams is a list of resolvers to forward name queries for the \".\" domain. Each instance of CoreDNS performs health checking of Upstreams. When a healthy upstream returns an error during the exchange, another resolver is tried from Upstreams. The Upstreams are selected in the order specified in Policy.\n\nA maximum of 15 upstreams is allowed. If no Upstreams are specified, /etc/resolv.conf is used by default.",
+	"policy":           "policy is used to determine the order in which upstream servers are selected for querying. Any one of the following values may be specified: * \"Random\" picks a random upstream server for each query. * \"RoundRobin\" picks upstream servers in a round-robin order, moving to the next server for each new query. * \"Sequential\" tries querying upstreams in a sequential order until one responds, starting with the first upstream for each new query.\n\nIf unset, the default of \"Sequential\" is used.",
+	"protocolStrategy": "protocolStrategy specifies the protocol to use for upstream DNS requests. Valid values are \"TCP\" and omitted. When omitted, CoreDNS forwards each query over the protocol over which the client sent it. \"TCP\" forwards all queries to the upstream resolvers over TCP, even if the client sent them over UDP, which is useful with firewalls that block or fragment UDP and with large DNSSEC responses, but may increase the response time. protocolStrategy only affects the queries that CoreDNS sends to upstream resolvers, not the queries between clients and CoreDNS.",
+}
+
+func (UpstreamResolvers) SwaggerDoc() map[string]string {
+	return map_UpstreamResolvers
+}
+
+var map_Etcd = map[string]string{
+	"": "Etcd provides information to configure an operator to manage kube-apiserver.",
+}
+
+func (Etcd) SwaggerDoc() map[string]string {
+	return map_Etcd
+}
+
+var map_EtcdList = map[string]string{
+	"":      "KubeAPISOperatorConfigList is a collection of items",
+	"items": "Items contains the items",
+}
+
+func (EtcdList) SwaggerDoc() map[string]string {
+	return map_EtcdList
+}
+
+var map_EndpointPublishingStrategy = map[string]string{
+	"":             "EndpointPublishingStrategy is a way to publish the endpoints of an IngressController, and represents the type and any additional configuration for a specific type.",
+	"type":         "type is the publishing strategy to use. Valid values are:\n\n* LoadBalancerService\n\nPublishes the ingress controller using a Kubernetes LoadBalancer Service.\n\nIn this configuration, the ingress controller deployment uses container networking. A LoadBalancer Service is created to publish the deployment.\n\nSee: https://kubernetes.io/docs/concepts/services-networking/service/#loadbalancer\n\nIf domain is set, a wildcard DNS record will be managed to point at the LoadBalancer Service's external name. DNS records are managed only in DNS zones defined by dns.config.openshift.io/cluster .spec.publicZone and .spec.privateZone.\n\nWildcard DNS management is currently supported only on the AWS, Azure, and GCP platforms.\n\n* HostNetwork\n\nPublishes the ingress controller on node ports where the ingress controller is deployed.\n\nIn this configuration, the ingress controller deployment uses host networking, bound to node ports 80 and 443. The user is responsible for configuring an external load balancer to publish the ingress controller via the node ports.\n\n* Private\n\nDoes not publish the ingress controller.\n\nIn this configuration, the ingress controller deployment uses container networking, and is not explicitly published. The user must manually publish the ingress controller.\n\n* NodePortService\n\nPublishes the ingress controller using a Kubernetes NodePort Service.\n\nIn this configuration, the ingress controller deployment uses container networking. A NodePort Service is created to publish the deployment. The specific node ports are dynamically allocated by OpenShift; however, to support static port allocations, user changes to the node port field of the managed NodePort Service will preserved.",
+	"loadBalancer": "loadBalancer holds parameters for the load balancer. Present only if type is LoadBalancerService.",
+	"hostNetwork":  "hostNetwork holds parameters for the HostNetwork endpoint publishing strategy. Present only if type is HostNetwork.",
+	"private":      "private holds parameters for the Private endpoint publishing strategy. Present only if type is Private.",
+	"nodePort":     "nodePort holds parameters for the NodePortService endpoint publishing strategy. Present only if type is NodePortService.",
+}
+
+func (EndpointPublishingStrategy) SwaggerDoc() map[string]string {
+	return map_EndpointPublishingStrategy
+}
+
+var map_HostNetworkStrategy = map[string]string{
+	"": "HostNetworkStrategy holds parameters for the HostNetwork endpoint publishing strategy.",
+}
+
+func (HostNetworkStrategy) SwaggerDoc() map[string]string {
+	return map_HostNetworkStrategy
+}
+
+var map_IngressController = map[string]string{
+	"":       "IngressController describes a managed ingress controller for the cluster. The controller can service OpenShift Route and Kubernetes Ingress resources.\n\nWhen an IngressController is created, a new ingress controller deployment is created to allow external traffic to reach the services that expose Ingress or Route resources. Updating this resource may lead to disruption for public facing network connections as a new ingress controller revision may be rolled out.\n\nhttps://kubernetes.io/docs/concepts/services-networking/ingress-controllers\n\nWhenever possible, sensible defaults for the platform are used. See each field for more details.",
+	"spec":   "spec is the specification of the desired behavior of the IngressController.",
+	"status": "status is the most recently observed status of the IngressController.",
+}
+
+func (IngressController) SwaggerDoc() map[string]string {
+	return map_IngressController
+}
+
+var map_IngressControllerList = map[string]string{
+	"": "IngressControllerList contains a list of IngressControllers.",
+}
+
+func (IngressControllerList) SwaggerDoc() map[string]string {
+	return map_IngressControllerList
+}
+
+var map_IngressControllerSpec = map[string]string{
+	"":                           "IngressControllerSpec is the specification of the desired behavior of the IngressController.",
+	"domain":                     "domain is a DNS name serviced by the ingress controller and is used to configure multiple features:\n\n* For the LoadBalancerService endpoint publishing strategy, domain is\n  used to configure DNS records. See endpointPublishingStrategy.\n\n* When using a generated default certificate, the certificate will be valid\n  for domain and its subdomains. See defaultCertificate.\n\n* The value is published to individual Route statuses so that end-users\n  know where to target external DNS records.\n\ndomain must be unique among all IngressControllers, and cannot be updated.\n\nIf empty, defaults to ingress.config.openshift.io/cluster .spec.domain.",
+	"replicas":                   "replicas is the desired number of ingress controller replicas. If unset, defaults to 2.",
+	"endpointPublishingStrategy": "endpointPublishingStrategy is used to publish the ingress controller endpoints to other networks, enable load balancer integrations, etc.\n\nIf unset, the default is based on infrastructure.config.openshift.io/cluster .status.platform:\n\n  AWS:      LoadBalancerService (with External scope)\n  Azure:    LoadBalancerService (with External scope)\n  GCP:      LoadBalancerService (with External scope)\n  IBMCloud: LoadBalancerService (with External scope)\n  Libvirt:  HostNetwork\n\nAny other platform types (including None) default to HostNetwork.\n\nendpointPublishingStrategy cannot be updated.",
+	"defaultCertificate":         "defaultCertificate is a reference to a secret containing the default certificate served by the ingress controller. When Routes don't specify their own certificate, defaultCertificate is used.\n\nThe secret must contain the following keys and data:\n\n  tls.crt: certificate file contents\n  tls.key: key file contents\n\nIf unset, a wildcard certificate is automatically generated and used. The certificate is valid for the ingress controller domain (and subdomains) and the generated certificate's CA will be automatically integrated with the cluster's trust store.\n\nThe in-use certificate (whether generated or user-specified) will be automatically integrated with OpenShift's built-in OAuth server.",
+	"namespaceSelector":          "namespaceSelector is used to filter the set of namespaces serviced by the ingress controller. This is useful for implementing shards.\n\nIf unset, the default is no filtering.",
+	"routeSelector":              "routeSelector is used to filter the set of Routes serviced by the ingress controller. This is useful for implementing shards.\n\nIf unset, the default is no filtering.",
+	"nodePlacement":              "nodePlacement enables explicit control over the scheduling of the ingress controller.\n\nIf unset, defaults are used. See NodePlacement for more details.",
+	"tlsSecurityProfile":         "tlsSecurityProfile specifies settings for TLS connections for ingresscontrollers.\n\nIf unset, the default is based on the apiservers.config.openshift.io/cluster resource.\n\nNote that when using the Old, Intermediate, and Modern profile types, the effective profile configuration is subject to change between releases. For example, given a specification to use the Intermediate profile deployed on release X.Y.Z, an upgrade to release X.Y.Z+1 may cause a new profile configuration to be applied to the ingress controller, resulting in a rollout.\n\nNote that the minimum TLS version for ingress controllers is 1.1, and the maximum TLS version is 1.2.  An implication of this restriction is that the Modern TLS profile type cannot be used because it requires TLS 1.3.",
+	"routeAdmission":             "routeAdmission defines a policy for handling new route claims (for example, to allow or deny claims across namespaces).\n\nIf empty, defaults will be applied. See specific routeAdmission fields for details about their defaults.",
+}
+
+func (IngressControllerSpec) SwaggerDoc() map[string]string {
+	return map_IngressControllerSpec
+}
+
+var map_IngressControllerStatus = map[string]string{
+	"":                           "IngressControllerStatus defines the observed status of the IngressController.",
+	"availableReplicas":          "availableReplicas is number of observed available replicas according to the ingress controller deployment.",
+	"selector":                   "selector is a label selector, in string format, for ingress controller pods corresponding to the IngressController. The number of matching pods should equal the value of availableReplicas.",
+	"domain":                     "domain is the actual domain in use.",
+	"endpointPublishingStrategy": "endpointPublishingStrategy is the actual strategy in use.",
+	"conditions":                 "conditions is a list of conditions and their status.\n\nAvailable means the ingress controller deployment is available and servicing route and ingress resources (i.e, .status.availableReplicas equals .spec.replicas)\n\nThere are additional conditions which indicate the status of other ingress controller features and capabilities.\n\n  * LoadBalancerManaged\n  - True if the following conditions are met:\n    * The endpoint publishing strategy requires a service load balancer.\n  - False if any of those conditions are unsatisfied.\n\n  * LoadBalancerReady\n  - True if the following conditions are met:\n    * A load balancer is managed.\n    * The load balancer is ready.\n  - False if any of those conditions are unsatisfied.\n\n  * DNSManaged\n  - True if the following conditions are met:\n    * The endpoint publishing strategy and platform support DNS.\n    * The ingress controller domain is set.\n    * dns.config.openshift.io/cluster configures DNS zones.\n  - False if any of those conditions are unsatisfied.\n\n  * DNSReady\n  - True if the following conditions are met:\n    * DNS is managed.\n    * DNS records have been successfully created.\n  - False if any of those conditions are unsatisfied.",
+	"tlsProfile":                 "tlsProfile is the TLS connection configuration that is in effect.",
+	"observedGeneration":         "observedGeneration is the most recent generation observed.",
+}
+
+func (IngressControllerStatus) SwaggerDoc() map[string]string {
+	return map_IngressControllerStatus
+}
+
+var map_LoadBalancerStrategy = map[string]string{
+	"":      "LoadBalancerStrategy holds parameters for a load balancer.",
+	"scope": "scope indicates the scope at which the load balancer is exposed. Possible values are \"External\" and \"Internal\".",
+}
+
+func (LoadBalancerStrategy) SwaggerDoc() map[string]string {
+	return map_LoadBalancerStrategy
+}
+
+var map_NodePlacement = map[string]string{
+	"":             "NodePlacement describes node scheduling configuration for an ingress controller.",
+	"nodeSelector": "nodeSelector is the node selector applied to ingress controller deployments.\n\nIf unset, the default is:\n\n  beta.kubernetes.io/os: linux\n  node-role.kubernetes.io/worker: ''\n\nIf set, the specified selector is used and replaces the default.",
+	"tolerations":  "tolerations is a list of tolerations applied to ingress controller deployments.\n\nThe default is an empty list.\n\nSee https://kubernetes.io/docs/concepts/configuration/taint-and-toleration/",
+}
+
+func (NodePlacement) SwaggerDoc() map[string]string {
+	return map_NodePlacement
+}
+
+var map_NodePortStrategy = map[string]string{
+	"": "NodePortStrategy holds parameters for the NodePortService endpoint publishing strategy.",
+}
+
+func (NodePortStrategy) SwaggerDoc() map[string]string {
+	return map_NodePortStrategy
+}
+
+var map_PrivateStrategy = map[string]string{
+	"": "PrivateStrategy holds parameters for the Private endpoint publishing strategy.",
+}
+
+func (PrivateStrategy) SwaggerDoc() map[string]string {
+	return map_PrivateStrategy
+}
+
+var map_RouteAdmissionPolicy = map[string]string{
+	"":                   "RouteAdmissionPolicy is an admission policy for allowing new route claims.",
+	"namespaceOwnership": "namespaceOwnership describes how host name claims across namespaces should be handled.\n\nValue must be one of:\n\n- Strict: Do not allow routes in different namespaces to claim the same host.\n\n- InterNamespaceAllowed: Allow routes to claim different paths of the same\n  host name across namespaces.\n\nIf empty, the default is Strict.",
+}
+
+func (RouteAdmissionPolicy) SwaggerDoc() map[string]string {
+	return map_RouteAdmissionPolicy
+}
+
+var map_KubeAPIServer = map[string]string{
+	"":       "KubeAPIServer provides information to configure an operator to manage kube-apiserver.",
+	"spec":   "spec is the specification of the desired behavior of the Kubernetes API Server",
+	"status": "status is the most recently observed status of the Kubernetes API Server",
+}
+
+func (KubeAPIServer) SwaggerDoc() map[string]string {
+	return map_KubeAPIServer
+}
+
+var map_KubeAPIServerList = map[string]string{
+	"":      "KubeAPIServerList is a collection of items",
+	"items": "Items contains the items",
+}
+
+func (KubeAPIServerList) SwaggerDoc() map[string]string {
+	return map_KubeAPIServerList
+}
+
+var map_KubeControllerManager = map[string]string{
+	"":       "KubeControllerManager provides information to configure an operator to manage kube-controller-manager.",
+	"spec":   "spec is the specification of the desired behavior of the Kubernetes Controller Manager",
+	"status": "status is the most recently observed status of the Kubernetes Controller Manager",
+}
+
+func (KubeControllerManager) SwaggerDoc() map[string]string {
+	return map_KubeControllerManager
+}
+
+var map_KubeControllerManagerList = map[string]string{
+	"":      "KubeControllerManagerList is a collection of items",
+	"items": "Items contains the items",
+}
+
+func (KubeControllerManagerList) SwaggerDoc() map[string]string {
+	return map_KubeControllerManagerList
+}
+
+var map_KubeStorageVersionMigrator = map[string]string{
+	"": "KubeStorageVersionMigrator provides information to configure an operator to manage kube-storage-version-migrator.",
+}
+
+func (KubeStorageVersionMigrator) SwaggerDoc() map[string]string {
+	return map_KubeStorageVersionMigrator
+}
+
+var map_KubeStorageVersionMigratorList = map[string]string{
+	"":      "KubeStorageVersionMigratorList is a collection of items",
+	"items": "Items contains the items",
+}
+
+func (KubeStorageVersionMigratorList) SwaggerDoc() map[string]string {
+	return map_KubeStorageVersionMigratorList
+}
+
+var map_AdditionalNetworkDefinition = map[string]string{
+	"":                    "AdditionalNetworkDefinition configures an extra network that is available but not created by default. Instead, pods must request them by name. type must be specified, along with exactly one \"Config\" that matches the type.",
+	"type":                "type is the type of network The supported values are NetworkTypeRaw, NetworkTypeSimpleMacvlan",
+	"name":                "name is the name of the network. This will be populated in the resulting CRD This must be unique.",
+	"namespace":           "namespace is the namespace of the network. This will be populated in the resulting CRD If not given the network will be created in the default namespace.",
+	"rawCNIConfig":        "rawCNIConfig is the raw CNI configuration json to create in the NetworkAttachmentDefinition CRD",
+	"simpleMacvlanConfig": "SimpleMacvlanConfig configures the macvlan interface in case of type:NetworkTypeSimpleMacvlan",
+}
+
+func (AdditionalNetworkDefinition) SwaggerDoc() map[string]string {
+	return map_AdditionalNetworkDefinition
+}
+
+var map_ClusterNetworkEntry = map[string]string{
+	"": "ClusterNetworkEntry is a subnet from which to allocate PodIPs. A network of size HostPrefix (in CIDR notation) will be allocated when nodes join the cluster. Not all network providers support multiple ClusterNetworks",
+}
+
+func (ClusterNetworkEntry) SwaggerDoc() map[string]string {
+	return map_ClusterNetworkEntry
+}
+
+var map_DefaultNetworkDefinition = map[string]string{
+	"":                    "DefaultNetworkDefinition represents a single network plugin's configuration. type must be specified, along with exactly one \"Config\" that matches the type.",
+	"type":                "type is the type of network All NetworkTypes are supported except for NetworkTypeRaw",
+	"openshiftSDNConfig":  "openShiftSDNConfig configures the openshift-sdn plugin",
+	"ovnKubernetesConfig": "oVNKubernetesConfig configures the ovn-kubernetes plugin. This is currently not implemented.",
+	"kuryrConfig":         "KuryrConfig configures the kuryr plugin",
+}
+
+func (DefaultNetworkDefinition) SwaggerDoc() map[string]string {
+	return map_DefaultNetworkDefinition
+}
+
+var map_HybridOverlayConfig = map[string]string{
+	"hybridClusterNetwork": "HybridClusterNetwork defines a network space given to nodes on an additional overlay network.",
+}
+
+func (HybridOverlayConfig) SwaggerDoc() map[string]string {
+	return map_HybridOverlayConfig
+}
+
+var map_IPAMConfig = map[string]string{
+	"":                 "IPAMConfig contains configurations for IPAM (IP Address Management)",
+	"type":             "Type is the type of IPAM module will be used for IP Address Management(IPAM). The supported values are IPAMTypeDHCP, IPAMTypeStatic",
+	"staticIPAMConfig": "StaticIPAMConfig configures the static IP address in case of type:IPAMTypeStatic",
+}
+
+func (IPAMConfig) SwaggerDoc() map[string]string {
+	return map_IPAMConfig
+}
+
+var map_KuryrConfig = map[string]string{
+	"":                             "KuryrConfig configures the Kuryr-Kubernetes SDN",
+	"daemonProbesPort":             "The port kuryr-daemon will listen for readiness and liveness requests.",
+	"controllerProbesPort":         "The port kuryr-controller will listen for readiness and liveness requests.",
+	"openStackServiceNetwork":      "openStackServiceNetwork contains the CIDR of network from which to allocate IPs for OpenStack Octavia's Amphora VMs. Please note that with Amphora driver Octavia uses two IPs from that network for each loadbalancer - one given by OpenShift and second for VRRP connections. As the first one is managed by OpenShift's and second by Neutron's IPAMs, those need to come from different pools. Therefore `openStackServiceNetwork` needs to be at least twice the size of `serviceNetwork`, and whole `serviceNetwork` must be overlapping with `openStackServiceNetwork`. cluster-network-operator will then make sure VRRP IPs are taken from the ranges inside `openStackServiceNetwork` that are not overlapping with `serviceNetwork`, effectivly preventing conflicts. If not set cluster-network-operator will use `serviceNetwork` expanded by decrementing the prefix size by 1.",
+	"enablePortPoolsPrepopulation": "enablePortPoolsPrepopulation when true will make Kuryr prepopulate each newly created port pool with a minimum number of ports. Kuryr uses Neutron port pooling to fight the fact that it takes a significant amount of time to create one. Instead of creating it when pod is being deployed, Kuryr keeps a number of ports ready to be attached to pods. By default port prepopulation is disabled.",
+	"poolMaxPorts":                 "poolMaxPorts sets a maximum number of free ports that are being kept in a port pool. If the number of ports exceeds this setting, free ports will get deleted. Setting 0 will disable this upper bound, effectively preventing pools from shrinking and this is the default value. For more information about port pools see enablePortPoolsPrepopulation setting.",
+	"poolMinPorts":                 "poolMinPorts sets a minimum number of free ports that should be kept in a port pool. If the number of ports is lower than this setting, new ports will get created and added to pool. The default is 1. For more information about port pools see enablePortPoolsPrepopulation setting.",
+	"poolBatchPorts":               "poolBatchPorts sets a number of ports that should be created in a single batch request to extend the port pool. The default is 3. For more information about port pools see enablePortPoolsPrepopulation setting.",
+}
+
+func (KuryrConfig) SwaggerDoc() map[string]string {
+	return map_KuryrConfig
+}
+
+var map_Network = map[string]string{
+	"": "Network describes the cluster's desired network configuration. It is consumed by the cluster-network-operator.",
+}
+
+func (Network) SwaggerDoc() map[string]string {
+	return map_Network
+}
+
+var map_NetworkList = map[string]string{
+	"": "NetworkList contains a list of Network configurations",
+}
+
+func (NetworkList) SwaggerDoc() map[string]string {
+	return map_NetworkList
+}
+
+var map_NetworkSpec = map[string]string{
+	"":                    "NetworkSpec is the top-level network configuration object.",
+	"clusterNetwork":      "clusterNetwork is the IP address pool to use for pod IPs. Some network providers, e.g. OpenShift SDN, support multiple ClusterNetworks. Others only support one. This is equivalent to the cluster-cidr.",
+	"serviceNetwork":      "serviceNetwork is the ip address pool to use for Service IPs Currently, all existing network providers only support a single value here, but this is an array to allow for growth.",
+	"defaultNetwork":      "defaultNetwork is the \"default\" network that all pods will receive",
+	"additionalNetworks":  "additionalNetworks is a list of extra networks to make available to pods when multiple networks are enabled.",
+	"disableMultiNetwork": "disableMultiNetwork specifies whether or not multiple pod network support should be disabled. If unset, this property defaults to 'false' and multiple network support is enabled.",
+	"deployKubeProxy":     "deployKubeProxy specifies whether or not a standalone kube-proxy should be deployed by the operator. Some network providers include kube-proxy or similar functionality. If unset, the plugin will attempt to select the correct value, which is false when OpenShift SDN and ovn-kubernetes are used and true otherwise.",
+	"kubeProxyConfig":     "kubeProxyConfig lets us configure desired proxy configuration. If not specified, sensible defaults will be chosen by OpenShift directly. Not consumed by all network providers - currently only openshift-sdn.",
+	"logLevel":            "logLevel allows configuring the logging level of the components deployed by the operator. Currently only Kuryr SDN is affected by this setting. Please note that turning on extensive logging may affect performance. The default value is \"Normal\".",
+}
+
+func (NetworkSpec) SwaggerDoc() map[string]string {
+	return map_NetworkSpec
+}
+
+var map_NetworkStatus = map[string]string{
+	"": "NetworkStatus is currently unused. Instead, status is reported in the Network.config.openshift.io object.",
+}
+
+func (NetworkStatus) SwaggerDoc() map[string]string {
+	return map_NetworkStatus
+}
+
+var map_OVNKubernetesConfig = map[string]string{
+	"":                    "ovnKubernetesConfig contains the configuration parameters for networks using the ovn-kubernetes network project",
+	"mtu":                 "mtu is the MTU to use for the tunnel interface. This must be 100 bytes smaller than the uplink mtu. Default is 1400",
+	"genevePort":          "geneve port is the UDP port to be used by geneve encapulation. Default is 6081",
+	"hybridOverlayConfig": "HybridOverlayConfig configures an additional overlay network for peers that are not using OVN.",
+}
+
+func (OVNKubernetesConfig) SwaggerDoc() map[string]string {
+	return map_OVNKubernetesConfig
+}
+
+var map_OpenShiftSDNConfig = map[string]string{
+	"":                       "OpenShiftSDNConfig configures the three openshift-sdn plugins",
+	"mode":                   "mode is one of \"Multitenant\", \"Subnet\", or \"NetworkPolicy\"",
+	"vxlanPort":              "vxlanPort is the port to use for all vxlan packets. The default is 4789.",
+	"mtu":                    "mtu is the mtu to use for the tunnel interface. Defaults to 1450 if unset. This must be 50 bytes smaller than the machine's uplink.",
+	"useExternalOpenvswitch": "useExternalOpenvswitch tells the operator not to install openvswitch, because it will be provided separately. If set, you must provide it yourself.",
+	"enableUnidling":         "enableUnidling controls whether or not the service proxy will support idling and unidling of services. By default, unidling is enabled.",
+}
+
+func (OpenShiftSDNConfig) SwaggerDoc() map[string]string {
+	return map_OpenShiftSDNConfig
+}
+
+var map_ProxyConfig = map[string]string{
+	"":                   "ProxyConfig defines the configuration knobs for kubeproxy All of these are optional and have sensible defaults",
+	"iptablesSyncPeriod": "The period that iptables rules are refreshed. Default: 30s",
+	"bindAddress":        "The address to \"bind\" on Defaults to 0.0.0.0",
+	"proxyArguments":     "Any additional arguments to pass to the kubeproxy process",
+}
+
+func (ProxyConfig) SwaggerDoc() map[string]string {
+	return map_ProxyConfig
+}
+
+var map_SimpleMacvlanConfig = map[string]string{
+	"":           "SimpleMacvlanConfig contains configurations for macvlan interface.",
+	"master":     "master is the host interface to create the macvlan interface from. If not specified, it will be default route interface",
+	"ipamConfig": "IPAMConfig configures IPAM module will be used for IP Address Management (IPAM).",
+	"mode":       "mode is the macvlan mode: bridge, private, vepa, passthru. The default is bridge",
+	"mtu":        "mtu is the mtu to use for the macvlan interface. if unset, host's kernel will select the value.",
+}
+
+func (SimpleMacvlanConfig) SwaggerDoc() map[string]string {
+	return map_SimpleMacvlanConfig
+}
+
+var map_StaticIPAMAddresses = map[string]string{
+	"":        "StaticIPAMAddresses provides IP address and Gateway for static IPAM addresses",
+	"address": "Address is the IP address in CIDR format",
+	"gateway": "Gateway is IP inside of subnet to designate as the gateway",
+}
+
+func (StaticIPAMAddresses) SwaggerDoc() map[string]string {
+	return map_StaticIPAMAddresses
+}
+
+var map_StaticIPAMConfig = map[string]string{
+	"":          "StaticIPAMConfig contains configurations for static IPAM (IP Address Management)",
+	"addresses": "Addresses configures IP address for the interface",
+	"routes":    "Routes configures IP routes for the interface",
+	"dns":       "DNS configures DNS for the interface",
+}
+
+func (StaticIPAMConfig) SwaggerDoc() map[string]string {
+	return map_StaticIPAMConfig
+}
+
+var map_StaticIPAMDNS = map[string]string{
+	"":            "StaticIPAMDNS provides DNS related information for static IPAM",
+	"nameservers": "Nameservers points DNS servers for IP lookup",
+	"domain":      "Domain configures the domainname the local domain used for short hostname lookups",
+	"search":      "Search configures priority ordered search domains for short hostname lookups",
+}
+
+func (StaticIPAMDNS) SwaggerDoc() map[string]string {
+	return map_StaticIPAMDNS
+}
+
+var map_StaticIPAMRoutes = map[string]string{
+	"":            "StaticIPAMRoutes provides Destination/Gateway pairs for static IPAM routes",
+	"destination": "Destination points the IP route destination",
+	"gateway":     "Gateway is the route's next-hop IP address If unset, a default gateway is assumed (as determined by the CNI plugin).",
+}
+
+func (StaticIPAMRoutes) SwaggerDoc() map[string]string {
+	return map_StaticIPAMRoutes
+}
+
+var map_OpenShiftAPIServer = map[string]string{
+	"":       "OpenShiftAPIServer provides information to configure an operator to manage openshift-apiserver.",
+	"spec":   "spec is the specification of the desired behavior of the OpenShift API Server.",
+	"status": "status defines the observed status of the OpenShift API Server.",
+}
+
+func (OpenShiftAPIServer) SwaggerDoc() map[string]string {
+	return map_OpenShiftAPIServer
+}
+
+var map_OpenShiftAPIServerList = map[string]string{
+	"":      "OpenShiftAPIServerList is a collection of items",
+	"items": "Items contains the items",
+}
+
+func (OpenShiftAPIServerList) SwaggerDoc() map[string]string {
+	return map_OpenShiftAPIServerList
+}
+
+var map_OpenShiftAPIServerStatus = map[string]string{
+	"latestAvailableRevision": "latestAvailableRevision is the latest revision used as suffix of revisioned secrets like encryption-config. A new revision causes a new deployment of pods.",
+}
+
+func (OpenShiftAPIServerStatus) SwaggerDoc() map[string]string {
+	return map_OpenShiftAPIServerStatus
+}
+
+var map_OpenShiftControllerManager = map[string]string{
+	"": "OpenShiftControllerManager provides information to configure an operator to manage openshift-controller-manager.",
+}
+
+func (OpenShiftControllerManager) SwaggerDoc() map[string]string {
+	return map_OpenShiftControllerManager
+}
+
+var map_OpenShiftControllerManagerList = map[string]string{
+	"":      "OpenShiftControllerManagerList is a collection of items",
+	"items": "Items contains the items",
+}
+
+func (OpenShiftControllerManagerList) SwaggerDoc() map[string]string {
+	return map_OpenShiftControllerManagerList
+}
+
+var map_KubeScheduler = map[string]string{
+	"":       "KubeScheduler provides information to configure an operator to manage scheduler.",
+	"spec":   "spec is the specification of the desired behavior of the Kubernetes Scheduler",
+	"status": "status is the most recently observed status of the Kubernetes Scheduler",
+}
+
+func (KubeScheduler) SwaggerDoc() map[string]string {
+	return map_KubeScheduler
+}
+
+var map_KubeSchedulerList = map[string]string{
+	"":      "KubeSchedulerList is a collection of items",
+	"items": "Items contains the items",
+}
+
+func (KubeSchedulerList) SwaggerDoc() map[string]string {
+	return map_KubeSchedulerList
+}
+
+var map_ServiceCA = map[string]string{
+	"":       "ServiceCA provides information to configure an operator to manage the service cert controllers",
+	"spec":   "spec holds user settable values for configuration",
+	"status": "status holds observed values from the cluster. They may not be overridden.",
+}
+
+func (ServiceCA) SwaggerDoc() map[string]string {
+	return map_ServiceCA
+}
+
+var map_ServiceCAList = map[string]string{
+	"":      "ServiceCAList is a collection of items",
+	"items": "Items contains the items",
+}
+
+func (ServiceCAList) SwaggerDoc() map[string]string {
+	return map_ServiceCAList
+}
+
+var map_ServiceCatalogAPIServer = map[string]string{
+	"": "ServiceCatalogAPIServer provides information to configure an operator to manage Service Catalog API Server DEPRECATED: will be removed in 4.6",
+}
+
+func (ServiceCatalogAPIServer) SwaggerDoc() map[string]string {
+	return map_ServiceCatalogAPIServer
+}
+
+var map_ServiceCatalogAPIServerList = map[string]string{
+	"":      "ServiceCatalogAPIServerList is a collection of items DEPRECATED: will be removed in 4.6",
+	"items": "Items contains the items",
+}
+
+func (ServiceCatalogAPIServerList) SwaggerDoc() map[string]string {
+	return map_ServiceCatalogAPIServerList
+}
+
+var map_ServiceCatalogControllerManager = map[string]string{
+	"": "ServiceCatalogControllerManager provides information to configure an operator to manage Service Catalog Controller Manager DEPRECATED: will be removed in 4.6",
+}
+
+func (ServiceCatalogControllerManager) SwaggerDoc() map[string]string {
+	return map_ServiceCatalogControllerManager
+}
+
+var map_ServiceCatalogControllerManagerList = map[string]string{
+	"":      "ServiceCatalogControllerManagerList is a collection of items DEPRECATED: will be removed in 4.6",
+	"items": "Items contains the items",
+}
+
+func (ServiceCatalogControllerManagerList) SwaggerDoc() map[string]string {
+	return map_ServiceCatalogControllerManagerList
+}
+
+// AUTO-GENERATED FUNCTIONS END HERE